@@ -0,0 +1,403 @@
+//
+//Copyright 2025 The Crossplane Authors.
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//http://www.apache.org/licenses/LICENSE-2.0
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v0.0.0
+// source: backend/proto/v1alpha1/backend.proto
+
+// buf:lint:ignore PACKAGE_DIRECTORY_MATCH
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetContentTypesRequest requests the content types the backend supports.
+type GetContentTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentTypesRequest) Reset() {
+	*x = GetContentTypesRequest{}
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentTypesRequest) ProtoMessage() {}
+
+func (x *GetContentTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentTypesRequest.ProtoReflect.Descriptor instead.
+func (*GetContentTypesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_proto_v1alpha1_backend_proto_rawDescGZIP(), []int{0}
+}
+
+// GetContentTypesResponse lists the content types the backend supports.
+type GetContentTypesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The supported content types, e.g. application/json.
+	ContentTypes  []string `protobuf:"bytes,1,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentTypesResponse) Reset() {
+	*x = GetContentTypesResponse{}
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentTypesResponse) ProtoMessage() {}
+
+func (x *GetContentTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentTypesResponse.ProtoReflect.Descriptor instead.
+func (*GetContentTypesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_proto_v1alpha1_backend_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetContentTypesResponse) GetContentTypes() []string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+// PerformRequest requests that the backend perform an operation.
+type PerformRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the operation, e.g. GetBucket.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// The content type the payload is encoded with.
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The operation's encoded request.
+	Payload       []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformRequest) Reset() {
+	*x = PerformRequest{}
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformRequest) ProtoMessage() {}
+
+func (x *PerformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformRequest.ProtoReflect.Descriptor instead.
+func (*PerformRequest) Descriptor() ([]byte, []int) {
+	return file_backend_proto_v1alpha1_backend_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PerformRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PerformRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PerformRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// PerformResponse is the result of a successful operation.
+type PerformResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operation's encoded response, using the content type of the request.
+	Payload       []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformResponse) Reset() {
+	*x = PerformResponse{}
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformResponse) ProtoMessage() {}
+
+func (x *PerformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformResponse.ProtoReflect.Descriptor instead.
+func (*PerformResponse) Descriptor() ([]byte, []int) {
+	return file_backend_proto_v1alpha1_backend_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PerformResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// WatchRequest requests a stream of changes made to the backend.
+type WatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content type events should be encoded with.
+	ContentType   string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_backend_proto_v1alpha1_backend_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// WatchResponse is a change made to the backend.
+type WatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The encoded event.
+	Payload       []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResponse) Reset() {
+	*x = WatchResponse{}
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResponse) ProtoMessage() {}
+
+func (x *WatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_proto_v1alpha1_backend_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return file_backend_proto_v1alpha1_backend_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_backend_proto_v1alpha1_backend_proto protoreflect.FileDescriptor
+
+const file_backend_proto_v1alpha1_backend_proto_rawDesc = "" +
+	"\n" +
+	"$backend/proto/v1alpha1/backend.proto\x12\x16backend.proto.v1alpha1\"\x18\n" +
+	"\x16GetContentTypesRequest\">\n" +
+	"\x17GetContentTypesResponse\x12#\n" +
+	"\rcontent_types\x18\x01 \x03(\tR\fcontentTypes\"k\n" +
+	"\x0ePerformRequest\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\"+\n" +
+	"\x0fPerformResponse\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\"1\n" +
+	"\fWatchRequest\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\")\n" +
+	"\rWatchResponse\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload2\xbe\x02\n" +
+	"\x0eBackendService\x12t\n" +
+	"\x0fGetContentTypes\x12..backend.proto.v1alpha1.GetContentTypesRequest\x1a/.backend.proto.v1alpha1.GetContentTypesResponse\"\x00\x12\\\n" +
+	"\aPerform\x12&.backend.proto.v1alpha1.PerformRequest\x1a'.backend.proto.v1alpha1.PerformResponse\"\x00\x12X\n" +
+	"\x05Watch\x12$.backend.proto.v1alpha1.WatchRequest\x1a%.backend.proto.v1alpha1.WatchResponse\"\x000\x01BAZ?github.com/crossplane/provider-bork/apis/backend/proto/v1alpha1b\x06proto3"
+
+var (
+	file_backend_proto_v1alpha1_backend_proto_rawDescOnce sync.Once
+	file_backend_proto_v1alpha1_backend_proto_rawDescData []byte
+)
+
+func file_backend_proto_v1alpha1_backend_proto_rawDescGZIP() []byte {
+	file_backend_proto_v1alpha1_backend_proto_rawDescOnce.Do(func() {
+		file_backend_proto_v1alpha1_backend_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backend_proto_v1alpha1_backend_proto_rawDesc), len(file_backend_proto_v1alpha1_backend_proto_rawDesc)))
+	})
+	return file_backend_proto_v1alpha1_backend_proto_rawDescData
+}
+
+var file_backend_proto_v1alpha1_backend_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_backend_proto_v1alpha1_backend_proto_goTypes = []any{
+	(*GetContentTypesRequest)(nil),  // 0: backend.proto.v1alpha1.GetContentTypesRequest
+	(*GetContentTypesResponse)(nil), // 1: backend.proto.v1alpha1.GetContentTypesResponse
+	(*PerformRequest)(nil),          // 2: backend.proto.v1alpha1.PerformRequest
+	(*PerformResponse)(nil),         // 3: backend.proto.v1alpha1.PerformResponse
+	(*WatchRequest)(nil),            // 4: backend.proto.v1alpha1.WatchRequest
+	(*WatchResponse)(nil),           // 5: backend.proto.v1alpha1.WatchResponse
+}
+var file_backend_proto_v1alpha1_backend_proto_depIdxs = []int32{
+	0, // 0: backend.proto.v1alpha1.BackendService.GetContentTypes:input_type -> backend.proto.v1alpha1.GetContentTypesRequest
+	2, // 1: backend.proto.v1alpha1.BackendService.Perform:input_type -> backend.proto.v1alpha1.PerformRequest
+	4, // 2: backend.proto.v1alpha1.BackendService.Watch:input_type -> backend.proto.v1alpha1.WatchRequest
+	1, // 3: backend.proto.v1alpha1.BackendService.GetContentTypes:output_type -> backend.proto.v1alpha1.GetContentTypesResponse
+	3, // 4: backend.proto.v1alpha1.BackendService.Perform:output_type -> backend.proto.v1alpha1.PerformResponse
+	5, // 5: backend.proto.v1alpha1.BackendService.Watch:output_type -> backend.proto.v1alpha1.WatchResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_backend_proto_v1alpha1_backend_proto_init() }
+func file_backend_proto_v1alpha1_backend_proto_init() {
+	if File_backend_proto_v1alpha1_backend_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_proto_v1alpha1_backend_proto_rawDesc), len(file_backend_proto_v1alpha1_backend_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_proto_v1alpha1_backend_proto_goTypes,
+		DependencyIndexes: file_backend_proto_v1alpha1_backend_proto_depIdxs,
+		MessageInfos:      file_backend_proto_v1alpha1_backend_proto_msgTypes,
+	}.Build()
+	File_backend_proto_v1alpha1_backend_proto = out.File
+	file_backend_proto_v1alpha1_backend_proto_goTypes = nil
+	file_backend_proto_v1alpha1_backend_proto_depIdxs = nil
+}
@@ -0,0 +1,231 @@
+//
+//Copyright 2025 The Crossplane Authors.
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//http://www.apache.org/licenses/LICENSE-2.0
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v0.0.0
+// source: backend/proto/v1alpha1/backend.proto
+
+// buf:lint:ignore PACKAGE_DIRECTORY_MATCH
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BackendService_GetContentTypes_FullMethodName = "/backend.proto.v1alpha1.BackendService/GetContentTypes"
+	BackendService_Perform_FullMethodName         = "/backend.proto.v1alpha1.BackendService/Perform"
+	BackendService_Watch_FullMethodName           = "/backend.proto.v1alpha1.BackendService/Watch"
+)
+
+// BackendServiceClient is the client API for BackendService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BackendService serves the bork backend over gRPC. Requests and responses
+// are opaque payloads encoded using one of the content types the backend
+// supports, just as they are when the backend is served over HTTP.
+type BackendServiceClient interface {
+	// GetContentTypes returns the content types the backend supports, in
+	// order of preference.
+	GetContentTypes(ctx context.Context, in *GetContentTypesRequest, opts ...grpc.CallOption) (*GetContentTypesResponse, error)
+	// Perform performs an operation against the backend.
+	Perform(ctx context.Context, in *PerformRequest, opts ...grpc.CallOption) (*PerformResponse, error)
+	// Watch streams the changes made to the backend after it was called.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResponse], error)
+}
+
+type backendServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBackendServiceClient(cc grpc.ClientConnInterface) BackendServiceClient {
+	return &backendServiceClient{cc}
+}
+
+func (c *backendServiceClient) GetContentTypes(ctx context.Context, in *GetContentTypesRequest, opts ...grpc.CallOption) (*GetContentTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContentTypesResponse)
+	err := c.cc.Invoke(ctx, BackendService_GetContentTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendServiceClient) Perform(ctx context.Context, in *PerformRequest, opts ...grpc.CallOption) (*PerformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PerformResponse)
+	err := c.cc.Invoke(ctx, BackendService_Perform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BackendService_ServiceDesc.Streams[0], BackendService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, WatchResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackendService_WatchClient = grpc.ServerStreamingClient[WatchResponse]
+
+// BackendServiceServer is the server API for BackendService service.
+// All implementations must embed UnimplementedBackendServiceServer
+// for forward compatibility.
+//
+// BackendService serves the bork backend over gRPC. Requests and responses
+// are opaque payloads encoded using one of the content types the backend
+// supports, just as they are when the backend is served over HTTP.
+type BackendServiceServer interface {
+	// GetContentTypes returns the content types the backend supports, in
+	// order of preference.
+	GetContentTypes(context.Context, *GetContentTypesRequest) (*GetContentTypesResponse, error)
+	// Perform performs an operation against the backend.
+	Perform(context.Context, *PerformRequest) (*PerformResponse, error)
+	// Watch streams the changes made to the backend after it was called.
+	Watch(*WatchRequest, grpc.ServerStreamingServer[WatchResponse]) error
+	mustEmbedUnimplementedBackendServiceServer()
+}
+
+// UnimplementedBackendServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBackendServiceServer struct{}
+
+func (UnimplementedBackendServiceServer) GetContentTypes(context.Context, *GetContentTypesRequest) (*GetContentTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentTypes not implemented")
+}
+func (UnimplementedBackendServiceServer) Perform(context.Context, *PerformRequest) (*PerformResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Perform not implemented")
+}
+func (UnimplementedBackendServiceServer) Watch(*WatchRequest, grpc.ServerStreamingServer[WatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedBackendServiceServer) mustEmbedUnimplementedBackendServiceServer() {}
+func (UnimplementedBackendServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBackendServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BackendServiceServer will
+// result in compilation errors.
+type UnsafeBackendServiceServer interface {
+	mustEmbedUnimplementedBackendServiceServer()
+}
+
+func RegisterBackendServiceServer(s grpc.ServiceRegistrar, srv BackendServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBackendServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BackendService_ServiceDesc, srv)
+}
+
+func _BackendService_GetContentTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServiceServer).GetContentTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackendService_GetContentTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServiceServer).GetContentTypes(ctx, req.(*GetContentTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackendService_Perform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServiceServer).Perform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackendService_Perform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServiceServer).Perform(ctx, req.(*PerformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackendService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackendServiceServer).Watch(m, &grpc.GenericServerStream[WatchRequest, WatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackendService_WatchServer = grpc.ServerStreamingServer[WatchResponse]
+
+// BackendService_ServiceDesc is the grpc.ServiceDesc for BackendService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BackendService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.proto.v1alpha1.BackendService",
+	HandlerType: (*BackendServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetContentTypes",
+			Handler:    _BackendService_GetContentTypes_Handler,
+		},
+		{
+			MethodName: "Perform",
+			Handler:    _BackendService_Perform_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _BackendService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "backend/proto/v1alpha1/backend.proto",
+}
@@ -21,11 +21,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	borkv1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
 )
 
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
+		v1alpha1.SchemeBuilder.AddToScheme,
 		borkv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkAccessPolicyParameters are the configurable fields of a
+// BorkAccessPolicy.
+type BorkAccessPolicyParameters struct {
+	// Policy is the policy's JSON document. It's compared semantically with
+	// the document the backend normalized when it was written, so it may
+	// order its keys, space itself and omit defaults however it likes.
+	// +kubebuilder:validation:MinLength=2
+	Policy string `json:"policy"`
+
+	// Tags attached to the policy.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// BorkAccessPolicyObservation are the observable fields of a
+// BorkAccessPolicy.
+type BorkAccessPolicyObservation struct {
+	// Policy is the policy's JSON document, as normalized by the backend.
+	Policy string `json:"policy,omitempty"`
+
+	// Tags last observed in the backend.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Revision is the backend revision of the policy when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkAccessPolicy's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkAccessPolicySpec defines the desired state of a BorkAccessPolicy.
+type BorkAccessPolicySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkAccessPolicyParameters `json:"forProvider"`
+}
+
+// A BorkAccessPolicyStatus represents the observed state of a
+// BorkAccessPolicy.
+type BorkAccessPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkAccessPolicyObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this
+	// BorkAccessPolicy with the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkAccessPolicy's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkAccessPolicy's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkAccessPolicy is a JSON policy document that the backend normalizes
+// when it's written, sorting its keys, removing whitespace and filling in
+// defaults. The provider compares documents semantically, so normalization
+// doesn't cause a perpetual diff.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkAccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkAccessPolicySpec   `json:"spec"`
+	Status BorkAccessPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkAccessPolicyList contains a list of BorkAccessPolicy
+type BorkAccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkAccessPolicy `json:"items"`
+}
+
+// GetObservedGeneration of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkAccessPolicy type metadata.
+var (
+	BorkAccessPolicyKind             = reflect.TypeOf(BorkAccessPolicy{}).Name()
+	BorkAccessPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: BorkAccessPolicyKind}.String()
+	BorkAccessPolicyKindAPIVersion   = BorkAccessPolicyKind + "." + SchemeGroupVersion.String()
+	BorkAccessPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BorkAccessPolicyKind)
+)
+
+// GetTags of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) GetTags() map[string]string {
+	return mg.Spec.ForProvider.Tags
+}
+
+// SetTags of this BorkAccessPolicy.
+func (mg *BorkAccessPolicy) SetTags(tags map[string]string) {
+	mg.Spec.ForProvider.Tags = tags
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkAccessPolicy{}, &BorkAccessPolicyList{})
+}
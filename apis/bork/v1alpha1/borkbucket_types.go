@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// A LifecycleRule expires objects in a BorkBucket.
+type LifecycleRule struct {
+	// ID uniquely identifies the rule within its bucket.
+	ID string `json:"id"`
+
+	// Prefix limits the rule to objects whose keys have this prefix. The rule
+	// applies to all objects if omitted.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// ExpirationDays after which matching objects are deleted.
+	// +kubebuilder:validation:Minimum=1
+	ExpirationDays int `json:"expirationDays"`
+
+	// Enabled is true if the rule should be applied.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// A VersioningConfiguration configures object versioning for a BorkBucket.
+type VersioningConfiguration struct {
+	// Enabled is true if the bucket should retain previous versions of
+	// objects.
+	Enabled bool `json:"enabled"`
+}
+
+// BorkBucketParameters are the configurable fields of a BorkBucket.
+type BorkBucketParameters struct {
+	// Tags attached to the bucket.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// LifecycleRules applied to objects in the bucket.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	LifecycleRules []LifecycleRule `json:"lifecycleRules,omitempty"`
+
+	// Versioning configuration of the bucket. Versioning is disabled if
+	// omitted.
+	// +optional
+	Versioning *VersioningConfiguration `json:"versioning,omitempty"`
+}
+
+// BorkBucketObservation are the observable fields of a BorkBucket.
+type BorkBucketObservation struct {
+	// Tags last observed in the backend.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// LifecycleRules last observed in the backend.
+	LifecycleRules []LifecycleRule `json:"lifecycleRules,omitempty"`
+
+	// Versioning is true if versioning was last observed to be enabled.
+	Versioning bool `json:"versioning,omitempty"`
+
+	// Revision is the backend revision of the bucket when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkBucket's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkBucketSpec defines the desired state of a BorkBucket.
+type BorkBucketSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkBucketParameters `json:"forProvider"`
+}
+
+// A BorkBucketStatus represents the observed state of a BorkBucket.
+type BorkBucketStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkBucketObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkBucket with the
+	// backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkBucket's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkBucket's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkBucket is a container for bork objects.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkBucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkBucketSpec   `json:"spec"`
+	Status BorkBucketStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkBucketList contains a list of BorkBucket
+type BorkBucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkBucket `json:"items"`
+}
+
+// GetObservedGeneration of this BorkBucket.
+func (mg *BorkBucket) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkBucket.
+func (mg *BorkBucket) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkBucket.
+func (mg *BorkBucket) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkBucket.
+func (mg *BorkBucket) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkBucket.
+func (mg *BorkBucket) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkBucket.
+func (mg *BorkBucket) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkBucket.
+func (mg *BorkBucket) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkBucket.
+func (mg *BorkBucket) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkBucket.
+func (mg *BorkBucket) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkBucket.
+func (mg *BorkBucket) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkBucket type metadata.
+var (
+	BorkBucketKind             = reflect.TypeOf(BorkBucket{}).Name()
+	BorkBucketGroupKind        = schema.GroupKind{Group: Group, Kind: BorkBucketKind}.String()
+	BorkBucketKindAPIVersion   = BorkBucketKind + "." + SchemeGroupVersion.String()
+	BorkBucketGroupVersionKind = SchemeGroupVersion.WithKind(BorkBucketKind)
+)
+
+// GetTags of this BorkBucket.
+func (mg *BorkBucket) GetTags() map[string]string {
+	return mg.Spec.ForProvider.Tags
+}
+
+// SetTags of this BorkBucket.
+func (mg *BorkBucket) SetTags(tags map[string]string) {
+	mg.Spec.ForProvider.Tags = tags
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkBucket{}, &BorkBucketList{})
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkCertificateParameters are the configurable fields of a BorkCertificate.
+// They can't be changed once the certificate is issued.
+type BorkCertificateParameters struct {
+	// CommonName of the certificate's subject.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="commonName is immutable"
+	CommonName string `json:"commonName"`
+
+	// DNSNames the certificate is valid for.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dnsNames is immutable"
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// TTL is how long each certificate is valid for once it's issued, e.g.
+	// "1h". The backend removes the certificate once it expires, and the
+	// provider issues a new one.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('10s')",message="ttl must be at least 10s"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ttl is immutable"
+	TTL metav1.Duration `json:"ttl"`
+
+	// ConnectionTemplate shapes the connection details the BorkCertificate
+	// publishes. It isn't written to the backend.
+	// +optional
+	ConnectionTemplate *ConnectionTemplate `json:"connectionTemplate,omitempty"`
+}
+
+// BorkCertificateObservation are the observable fields of a BorkCertificate.
+type BorkCertificateObservation struct {
+	// SerialNumber of the certificate last observed in the backend.
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// CommonName last observed in the backend.
+	CommonName string `json:"commonName,omitempty"`
+
+	// DNSNames last observed in the backend.
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// NotBefore is when the certificate was issued.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is when the certificate expires.
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// Renewals is how many times the certificate was issued again because
+	// it expired.
+	Renewals int64 `json:"renewals,omitempty"`
+
+	// Revision is the backend revision of the certificate when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkCertificate's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkCertificateSpec defines the desired state of a BorkCertificate.
+type BorkCertificateSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkCertificateParameters `json:"forProvider"`
+}
+
+// A BorkCertificateStatus represents the observed state of a BorkCertificate.
+type BorkCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkCertificateObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this
+	// BorkCertificate with the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkCertificate's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkCertificate's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkCertificate is a certificate that expires. Once it expires the
+// backend removes it, and the provider issues a new one, modelling resources
+// that are rotated when they expire.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NOT-AFTER",type="string",JSONPath=".status.atProvider.notAfter"
+// +kubebuilder:printcolumn:name="RENEWALS",type="integer",JSONPath=".status.atProvider.renewals"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkCertificateSpec   `json:"spec"`
+	Status BorkCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkCertificateList contains a list of BorkCertificate
+type BorkCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkCertificate `json:"items"`
+}
+
+// GetObservedGeneration of this BorkCertificate.
+func (mg *BorkCertificate) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkCertificate.
+func (mg *BorkCertificate) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkCertificate.
+func (mg *BorkCertificate) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkCertificate.
+func (mg *BorkCertificate) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkCertificate.
+func (mg *BorkCertificate) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkCertificate.
+func (mg *BorkCertificate) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkCertificate.
+func (mg *BorkCertificate) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkCertificate.
+func (mg *BorkCertificate) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkCertificate.
+func (mg *BorkCertificate) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkCertificate.
+func (mg *BorkCertificate) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkCertificate type metadata.
+var (
+	BorkCertificateKind             = reflect.TypeOf(BorkCertificate{}).Name()
+	BorkCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: BorkCertificateKind}.String()
+	BorkCertificateKindAPIVersion   = BorkCertificateKind + "." + SchemeGroupVersion.String()
+	BorkCertificateGroupVersionKind = SchemeGroupVersion.WithKind(BorkCertificateKind)
+)
+
+// GetConnectionTemplate of this BorkCertificate.
+func (mg *BorkCertificate) GetConnectionTemplate() *ConnectionTemplate {
+	return mg.Spec.ForProvider.ConnectionTemplate
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkCertificate{}, &BorkCertificateList{})
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkCostExportParameters are the configurable fields of a BorkCostExport.
+// +kubebuilder:validation:XValidation:rule="has(self.uri) != (has(self.bucketName) || has(self.bucketRef) || has(self.bucketSelector))",message="exactly one of uri or a bucket must be specified"
+type BorkCostExportParameters struct {
+	// Interval between exports, e.g. "24h".
+	// +kubebuilder:default="24h"
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// BucketName is the external name of the BorkBucket to which usage data
+	// is exported.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketRef references the BorkBucket to which usage data is exported.
+	// +optional
+	BucketRef *xpv1.NamespacedReference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects the BorkBucket to which usage data is exported.
+	// +optional
+	BucketSelector *xpv1.NamespacedSelector `json:"bucketSelector,omitempty"`
+
+	// Prefix of the keys of the objects usage data is exported to in the
+	// bucket.
+	// +kubebuilder:default="cost-exports/"
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// URI to which usage data is exported.
+	// +optional
+	URI *string `json:"uri,omitempty"`
+}
+
+// BorkCostExportObservation are the observable fields of a BorkCostExport.
+type BorkCostExportObservation struct {
+	// LastExportTime is the time at which usage data was last exported
+	// successfully.
+	LastExportTime *metav1.Time `json:"lastExportTime,omitempty"`
+
+	// LastExportError is the error encountered by the most recent export, if
+	// it failed.
+	LastExportError string `json:"lastExportError,omitempty"`
+
+	// Exports is the number of times usage data has been exported
+	// successfully.
+	Exports int `json:"exports,omitempty"`
+
+	// Revision is the backend revision of the export when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkCostExport's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkCostExportSpec defines the desired state of a BorkCostExport.
+type BorkCostExportSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkCostExportParameters `json:"forProvider"`
+}
+
+// A BorkCostExportStatus represents the observed state of a BorkCostExport.
+type BorkCostExportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkCostExportObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkCostExport with
+	// the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkCostExport's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkCostExport's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkCostExport periodically exports the bork backend's usage data to a
+// bucket or URI. It becomes ready once its first export has succeeded.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LAST-EXPORT",type="date",JSONPath=".status.atProvider.lastExportTime"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkCostExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkCostExportSpec   `json:"spec"`
+	Status BorkCostExportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkCostExportList contains a list of BorkCostExport
+type BorkCostExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkCostExport `json:"items"`
+}
+
+// GetObservedGeneration of this BorkCostExport.
+func (mg *BorkCostExport) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkCostExport.
+func (mg *BorkCostExport) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkCostExport.
+func (mg *BorkCostExport) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkCostExport.
+func (mg *BorkCostExport) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkCostExport.
+func (mg *BorkCostExport) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkCostExport.
+func (mg *BorkCostExport) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkCostExport.
+func (mg *BorkCostExport) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkCostExport.
+func (mg *BorkCostExport) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkCostExport.
+func (mg *BorkCostExport) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkCostExport.
+func (mg *BorkCostExport) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkCostExport type metadata.
+var (
+	BorkCostExportKind             = reflect.TypeOf(BorkCostExport{}).Name()
+	BorkCostExportGroupKind        = schema.GroupKind{Group: Group, Kind: BorkCostExportKind}.String()
+	BorkCostExportKindAPIVersion   = BorkCostExportKind + "." + SchemeGroupVersion.String()
+	BorkCostExportGroupVersionKind = SchemeGroupVersion.WithKind(BorkCostExportKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkCostExport{}, &BorkCostExportList{})
+}
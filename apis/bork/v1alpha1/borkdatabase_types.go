@@ -0,0 +1,265 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkDatabaseParameters are the configurable fields of a BorkDatabase.
+type BorkDatabaseParameters struct {
+	// Engine the database runs. It can't be changed once the database is
+	// created.
+	// +kubebuilder:validation:Enum=postgres;mysql
+	Engine string `json:"engine"`
+
+	// Size of the database's instance. It can't be changed once the
+	// database is created.
+	// +kubebuilder:validation:Enum=small;medium;large
+	// +kubebuilder:default=small
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// StorageGB is how much storage the database has.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=16384
+	// +kubebuilder:default=20
+	// +optional
+	StorageGB int `json:"storageGB,omitempty"`
+
+	// BackupRetentionDays is how long backups of the database are kept. A
+	// retention of 0 disables backups.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=35
+	// +kubebuilder:default=7
+	// +optional
+	BackupRetentionDays *int `json:"backupRetentionDays,omitempty"`
+
+	// MaintenanceWindow is when the backend may perform maintenance, e.g.
+	// sun:03:00-sun:04:00.
+	// +kubebuilder:validation:Pattern=`^(mon|tue|wed|thu|fri|sat|sun):[0-2][0-9]:[0-5][0-9]-(mon|tue|wed|thu|fri|sat|sun):[0-2][0-9]:[0-5][0-9]$`
+	// +optional
+	MaintenanceWindow string `json:"maintenanceWindow,omitempty"`
+
+	// Tags attached to the database.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// ConnectionTemplate shapes the connection details the BorkDatabase
+	// publishes. It isn't written to the backend.
+	// +optional
+	ConnectionTemplate *ConnectionTemplate `json:"connectionTemplate,omitempty"`
+}
+
+// BorkDatabaseObservation are the observable fields of a BorkDatabase.
+type BorkDatabaseObservation struct {
+	// Engine last observed in the backend.
+	Engine string `json:"engine,omitempty"`
+
+	// Size last observed in the backend.
+	Size string `json:"size,omitempty"`
+
+	// StorageGB last observed in the backend.
+	StorageGB int `json:"storageGB,omitempty"`
+
+	// BackupRetentionDays last observed in the backend.
+	BackupRetentionDays int `json:"backupRetentionDays,omitempty"`
+
+	// MaintenanceWindow last observed in the backend.
+	MaintenanceWindow string `json:"maintenanceWindow,omitempty"`
+
+	// Tags last observed in the backend.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Endpoint clients connect to.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Revision is the backend revision of the database when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkDatabase's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A FieldDiff is a field whose desired value differs from the value observed
+// in the backend.
+type FieldDiff struct {
+	// Field that differs, e.g. storageGB.
+	Field string `json:"field"`
+
+	// Desired value of the field.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Observed value of the field.
+	// +optional
+	Observed string `json:"observed,omitempty"`
+
+	// Immutable is true if the field can't be changed once the external
+	// resource is created, so the difference can't be resolved by updating
+	// it.
+	// +optional
+	Immutable bool `json:"immutable,omitempty"`
+}
+
+// A BorkDatabaseSpec defines the desired state of a BorkDatabase.
+type BorkDatabaseSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkDatabaseParameters `json:"forProvider"`
+}
+
+// A BorkDatabaseStatus represents the observed state of a BorkDatabase.
+type BorkDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkDatabaseObservation `json:"atProvider,omitempty"`
+
+	// FieldDiffs are the fields whose desired value differed from the
+	// backend when the database was last observed, sorted by field.
+	// +optional
+	// +listType=map
+	// +listMapKey=field
+	FieldDiffs []FieldDiff `json:"fieldDiffs,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkDatabase
+	// with the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkDatabase's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkDatabase's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkDatabase is a managed database instance. Its engine and size can't be
+// changed once it's created; the rest of its fields can.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".status.atProvider.engine"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkDatabaseSpec   `json:"spec"`
+	Status BorkDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkDatabaseList contains a list of BorkDatabase
+type BorkDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkDatabase `json:"items"`
+}
+
+// GetObservedGeneration of this BorkDatabase.
+func (mg *BorkDatabase) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkDatabase.
+func (mg *BorkDatabase) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkDatabase.
+func (mg *BorkDatabase) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkDatabase.
+func (mg *BorkDatabase) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkDatabase.
+func (mg *BorkDatabase) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkDatabase.
+func (mg *BorkDatabase) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkDatabase.
+func (mg *BorkDatabase) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkDatabase.
+func (mg *BorkDatabase) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkDatabase.
+func (mg *BorkDatabase) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkDatabase.
+func (mg *BorkDatabase) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkDatabase type metadata.
+var (
+	BorkDatabaseKind             = reflect.TypeOf(BorkDatabase{}).Name()
+	BorkDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: BorkDatabaseKind}.String()
+	BorkDatabaseKindAPIVersion   = BorkDatabaseKind + "." + SchemeGroupVersion.String()
+	BorkDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(BorkDatabaseKind)
+)
+
+// GetConnectionTemplate of this BorkDatabase.
+func (mg *BorkDatabase) GetConnectionTemplate() *ConnectionTemplate {
+	return mg.Spec.ForProvider.ConnectionTemplate
+}
+
+// GetTags of this BorkDatabase.
+func (mg *BorkDatabase) GetTags() map[string]string {
+	return mg.Spec.ForProvider.Tags
+}
+
+// SetTags of this BorkDatabase.
+func (mg *BorkDatabase) SetTags(tags map[string]string) {
+	mg.Spec.ForProvider.Tags = tags
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkDatabase{}, &BorkDatabaseList{})
+}
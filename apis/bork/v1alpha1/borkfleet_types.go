@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// LabelFleet is the label of a BorkResource that names the BorkFleet it
+// belongs to.
+const LabelFleet = "bork.crossplane.io/fleet"
+
+// A BorkFleetTemplate describes the BorkResources of a BorkFleet.
+type BorkFleetTemplate struct {
+	// Labels added to the fleet's BorkResources.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ProviderConfigReference of the fleet's BorkResources.
+	// +kubebuilder:default={"kind": "ClusterProviderConfig", "name": "default"}
+	ProviderConfigReference *xpv1.ProviderConfigReference `json:"providerConfigRef,omitempty"`
+
+	// ManagementPolicies of the fleet's BorkResources.
+	// +optional
+	// +kubebuilder:default={"*"}
+	ManagementPolicies xpv1.ManagementPolicies `json:"managementPolicies,omitempty"`
+
+	// ForProvider are the parameters of the fleet's BorkResources.
+	ForProvider BorkResourceParameters `json:"forProvider"`
+}
+
+// A BorkFleetSpec defines the desired state of a BorkFleet.
+type BorkFleetSpec struct {
+	// Replicas is how many BorkResources the fleet has.
+	// +kubebuilder:validation:Minimum=0
+	Replicas int `json:"replicas"`
+
+	// Template of the fleet's BorkResources.
+	Template BorkFleetTemplate `json:"template"`
+
+	// Transactional creates the backend records of the fleet's missing
+	// BorkResources in a single transaction before it creates them, so that
+	// all of their records are created or none are. Each BorkResource then
+	// adopts its record. A transactional fleet's template can't have
+	// provisioning hooks, which run as each record is created.
+	// +optional
+	Transactional bool `json:"transactional,omitempty"`
+}
+
+// A BorkFleetStatus represents the observed state of a BorkFleet.
+type BorkFleetStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// Replicas is how many of the fleet's BorkResources exist.
+	Replicas int `json:"replicas,omitempty"`
+
+	// ReadyReplicas is how many of the fleet's BorkResources are ready.
+	ReadyReplicas int `json:"readyReplicas,omitempty"`
+
+	// ObservedGeneration is the generation of the fleet's spec that its
+	// BorkResources were last made to match.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkFleet is a collection of identical BorkResources, named for the fleet
+// and their index. The fleet creates, updates and deletes its BorkResources
+// to match its spec, and is ready when all of them are. Unlike other bork
+// kinds it isn't a managed resource: it calls the backend only to create the
+// records of a transactional fleet, and its BorkResources are garbage
+// collected when it's deleted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="REPLICAS",type="integer",JSONPath=".spec.replicas"
+// +kubebuilder:printcolumn:name="READY-REPLICAS",type="integer",JSONPath=".status.readyReplicas"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,bork}
+type BorkFleet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkFleetSpec   `json:"spec"`
+	Status BorkFleetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkFleetList contains a list of BorkFleet
+type BorkFleetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkFleet `json:"items"`
+}
+
+// GetCondition of this BorkFleet.
+func (f *BorkFleet) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return f.Status.GetCondition(ct)
+}
+
+// SetConditions of this BorkFleet.
+func (f *BorkFleet) SetConditions(c ...xpv1.Condition) {
+	f.Status.SetConditions(c...)
+}
+
+// BorkFleet type metadata.
+var (
+	BorkFleetKind             = reflect.TypeOf(BorkFleet{}).Name()
+	BorkFleetGroupKind        = schema.GroupKind{Group: Group, Kind: BorkFleetKind}.String()
+	BorkFleetKindAPIVersion   = BorkFleetKind + "." + SchemeGroupVersion.String()
+	BorkFleetGroupVersionKind = SchemeGroupVersion.WithKind(BorkFleetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkFleet{}, &BorkFleetList{})
+}
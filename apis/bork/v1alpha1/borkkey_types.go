@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkKeyParameters are the configurable fields of a BorkKey.
+type BorkKeyParameters struct {
+	// Description of the key's consumer.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// PlanID is the external name of the BorkThrottlePlan the key is attached
+	// to. The key is not rate limited if it is not attached to a plan.
+	// +optional
+	PlanID *string `json:"planId,omitempty"`
+
+	// PlanRef references the BorkThrottlePlan used to set PlanID.
+	// +optional
+	PlanRef *xpv1.NamespacedReference `json:"planRef,omitempty"`
+
+	// PlanSelector selects the BorkThrottlePlan used to set PlanID.
+	// +optional
+	PlanSelector *xpv1.NamespacedSelector `json:"planSelector,omitempty"`
+
+	// ConnectionTemplate shapes the connection details the BorkKey
+	// publishes. It isn't written to the backend.
+	// +optional
+	ConnectionTemplate *ConnectionTemplate `json:"connectionTemplate,omitempty"`
+}
+
+// BorkKeyObservation are the observable fields of a BorkKey.
+type BorkKeyObservation struct {
+	// PlanID is the external name of the plan the key was last observed to be
+	// attached to.
+	PlanID string `json:"planId,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkKey's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkKeySpec defines the desired state of a BorkKey.
+type BorkKeySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkKeyParameters `json:"forProvider"`
+}
+
+// A BorkKeyStatus represents the observed state of a BorkKey.
+type BorkKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkKeyObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkKey with the
+	// backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkKey's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkKey's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkKey is a credential used by a consumer to call the backend.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PLAN",type="string",JSONPath=".spec.forProvider.planId"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkKeySpec   `json:"spec"`
+	Status BorkKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkKeyList contains a list of BorkKey
+type BorkKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkKey `json:"items"`
+}
+
+// GetObservedGeneration of this BorkKey.
+func (mg *BorkKey) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkKey.
+func (mg *BorkKey) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkKey.
+func (mg *BorkKey) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkKey.
+func (mg *BorkKey) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkKey.
+func (mg *BorkKey) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkKey.
+func (mg *BorkKey) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkKey.
+func (mg *BorkKey) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkKey.
+func (mg *BorkKey) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkKey.
+func (mg *BorkKey) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkKey.
+func (mg *BorkKey) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkKey type metadata.
+var (
+	BorkKeyKind             = reflect.TypeOf(BorkKey{}).Name()
+	BorkKeyGroupKind        = schema.GroupKind{Group: Group, Kind: BorkKeyKind}.String()
+	BorkKeyKindAPIVersion   = BorkKeyKind + "." + SchemeGroupVersion.String()
+	BorkKeyGroupVersionKind = SchemeGroupVersion.WithKind(BorkKeyKind)
+)
+
+// GetConnectionTemplate of this BorkKey.
+func (mg *BorkKey) GetConnectionTemplate() *ConnectionTemplate {
+	return mg.Spec.ForProvider.ConnectionTemplate
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkKey{}, &BorkKeyList{})
+}
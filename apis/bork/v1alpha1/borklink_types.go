@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// An EndpointDeletionPolicy determines what deleting one of a BorkLink's
+// endpoints does to its link.
+type EndpointDeletionPolicy string
+
+// Endpoint deletion policies.
+const (
+	// EndpointDeletionCascade deletes the link along with either of its
+	// endpoints.
+	EndpointDeletionCascade EndpointDeletionPolicy = "Cascade"
+
+	// EndpointDeletionOrphan leaves the link dangling when either of its
+	// endpoints is deleted.
+	EndpointDeletionOrphan EndpointDeletionPolicy = "Orphan"
+
+	// EndpointDeletionRestrict refuses to delete either of the link's
+	// endpoints until the link is deleted.
+	EndpointDeletionRestrict EndpointDeletionPolicy = "Restrict"
+)
+
+// BorkLinkParameters are the configurable fields of a BorkLink.
+type BorkLinkParameters struct {
+	// SourceName is the external name of the BorkResource the link links
+	// from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceName is immutable"
+	SourceName *string `json:"sourceName,omitempty"`
+
+	// SourceRef references the BorkResource used to set SourceName.
+	// +optional
+	SourceRef *xpv1.NamespacedReference `json:"sourceRef,omitempty"`
+
+	// SourceSelector selects the BorkResource used to set SourceName.
+	// +optional
+	SourceSelector *xpv1.NamespacedSelector `json:"sourceSelector,omitempty"`
+
+	// TargetName is the external name of the BorkResource the link links
+	// to.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="targetName is immutable"
+	TargetName *string `json:"targetName,omitempty"`
+
+	// TargetRef references the BorkResource used to set TargetName.
+	// +optional
+	TargetRef *xpv1.NamespacedReference `json:"targetRef,omitempty"`
+
+	// TargetSelector selects the BorkResource used to set TargetName.
+	// +optional
+	TargetSelector *xpv1.NamespacedSelector `json:"targetSelector,omitempty"`
+
+	// EndpointDeletionPolicy determines what deleting the link's source or
+	// target BorkResource does to the link. Cascade deletes the link,
+	// Orphan leaves it dangling, and Restrict refuses to delete the
+	// BorkResource's record until the link is deleted.
+	// +optional
+	// +kubebuilder:validation:Enum=Cascade;Orphan;Restrict
+	// +kubebuilder:default=Cascade
+	EndpointDeletionPolicy EndpointDeletionPolicy `json:"endpointDeletionPolicy,omitempty"`
+}
+
+// BorkLinkObservation are the observable fields of a BorkLink.
+type BorkLinkObservation struct {
+	// Source and Target are the external names of the records the link was
+	// last observed to link.
+	Source string `json:"source,omitempty"`
+	Target string `json:"target,omitempty"`
+
+	// Dangling is true if the link's source or target was deleted while the
+	// link was orphaned by its deletion.
+	Dangling bool `json:"dangling"`
+
+	// Revision is the backend revision of the link when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkLink's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkLinkSpec defines the desired state of a BorkLink.
+type BorkLinkSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkLinkParameters `json:"forProvider"`
+}
+
+// A BorkLinkStatus represents the observed state of a BorkLink.
+type BorkLinkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkLinkObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkLink with
+	// the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkLink's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkLink's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkLink is a relationship between two BorkResources. It exists in the
+// backend only as the pair of records it links, which is its external name.
+// What deleting either BorkResource does to the link is determined by its
+// endpoint deletion policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="POLICY",type="string",JSONPath=".spec.forProvider.endpointDeletionPolicy"
+// +kubebuilder:printcolumn:name="DANGLING",type="boolean",JSONPath=".status.atProvider.dangling"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkLinkSpec   `json:"spec"`
+	Status BorkLinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkLinkList contains a list of BorkLink
+type BorkLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkLink `json:"items"`
+}
+
+// GetObservedGeneration of this BorkLink.
+func (mg *BorkLink) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkLink.
+func (mg *BorkLink) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkLink.
+func (mg *BorkLink) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkLink.
+func (mg *BorkLink) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkLink.
+func (mg *BorkLink) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkLink.
+func (mg *BorkLink) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkLink.
+func (mg *BorkLink) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkLink.
+func (mg *BorkLink) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkLink.
+func (mg *BorkLink) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkLink.
+func (mg *BorkLink) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkLink type metadata.
+var (
+	BorkLinkKind             = reflect.TypeOf(BorkLink{}).Name()
+	BorkLinkGroupKind        = schema.GroupKind{Group: Group, Kind: BorkLinkKind}.String()
+	BorkLinkKindAPIVersion   = BorkLinkKind + "." + SchemeGroupVersion.String()
+	BorkLinkGroupVersionKind = SchemeGroupVersion.WithKind(BorkLinkKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkLink{}, &BorkLinkList{})
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkObjectParameters are the configurable fields of a BorkObject.
+type BorkObjectParameters struct {
+	// BucketName is the external name of the BorkBucket in which the object
+	// is stored.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketRef references the BorkBucket used to set BucketName.
+	// +optional
+	BucketRef *xpv1.NamespacedReference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects the BorkBucket used to set BucketName.
+	// +optional
+	BucketSelector *xpv1.NamespacedSelector `json:"bucketSelector,omitempty"`
+
+	// Key of the object within its bucket.
+	Key string `json:"key"`
+
+	// Content of the object.
+	// +optional
+	Content string `json:"content,omitempty"`
+}
+
+// BorkObjectObservation are the observable fields of a BorkObject.
+type BorkObjectObservation struct {
+	// BucketName is the external name of the bucket the object was last
+	// observed in.
+	BucketName string `json:"bucketName,omitempty"`
+
+	// Size of the object's content in bytes.
+	Size int `json:"size,omitempty"`
+
+	// Revision is the backend revision of the object when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkObject's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkObjectSpec defines the desired state of a BorkObject.
+type BorkObjectSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkObjectParameters `json:"forProvider"`
+}
+
+// A BorkObjectStatus represents the observed state of a BorkObject.
+type BorkObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkObjectObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkObject with the
+	// backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkObject's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkObject's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkObject is a blob stored in a BorkBucket.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkObjectSpec   `json:"spec"`
+	Status BorkObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkObjectList contains a list of BorkObject
+type BorkObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkObject `json:"items"`
+}
+
+// GetObservedGeneration of this BorkObject.
+func (mg *BorkObject) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkObject.
+func (mg *BorkObject) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkObject.
+func (mg *BorkObject) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkObject.
+func (mg *BorkObject) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkObject.
+func (mg *BorkObject) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkObject.
+func (mg *BorkObject) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkObject.
+func (mg *BorkObject) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkObject.
+func (mg *BorkObject) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkObject.
+func (mg *BorkObject) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkObject.
+func (mg *BorkObject) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkObject type metadata.
+var (
+	BorkObjectKind             = reflect.TypeOf(BorkObject{}).Name()
+	BorkObjectGroupKind        = schema.GroupKind{Group: Group, Kind: BorkObjectKind}.String()
+	BorkObjectKindAPIVersion   = BorkObjectKind + "." + SchemeGroupVersion.String()
+	BorkObjectGroupVersionKind = SchemeGroupVersion.WithKind(BorkObjectKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkObject{}, &BorkObjectList{})
+}
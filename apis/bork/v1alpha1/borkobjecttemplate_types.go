@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkObjectTemplateParameters are the configurable fields of a
+// BorkObjectTemplate.
+type BorkObjectTemplateParameters struct {
+	// Manifest of the Kubernetes object the BorkObjectTemplate materializes.
+	// A namespaced object is materialized in the BorkObjectTemplate's
+	// namespace, which its manifest may omit but can't contradict.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:EmbeddedResource
+	Manifest runtime.RawExtension `json:"manifest"`
+}
+
+// BorkObjectTemplateObservation are the observable fields of a
+// BorkObjectTemplate.
+type BorkObjectTemplateObservation struct {
+	// Manifest of the Kubernetes object as it was last observed.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Manifest runtime.RawExtension `json:"manifest,omitempty"`
+
+	// UID of the Kubernetes object.
+	UID string `json:"uid,omitempty"`
+
+	// ResourceVersion of the Kubernetes object when it was last observed.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkObjectTemplate's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkObjectTemplateSpec defines the desired state of a
+// BorkObjectTemplate.
+type BorkObjectTemplateSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkObjectTemplateParameters `json:"forProvider"`
+}
+
+// A BorkObjectTemplateStatus represents the observed state of a
+// BorkObjectTemplate.
+type BorkObjectTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkObjectTemplateObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this
+	// BorkObjectTemplate with its Kubernetes object, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkObjectTemplate's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkObjectTemplate's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkObjectTemplate materializes an arbitrary Kubernetes object, in the
+// same cluster as the provider, as its external resource. Unlike other bork
+// kinds it never calls the backend: the fields of its manifest are server-side
+// applied to the object, which is deleted with the BorkObjectTemplate. It
+// models providers, like provider-kubernetes, that manage in-cluster state
+// through the managed reconciler.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.forProvider.manifest.kind"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkObjectTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkObjectTemplateSpec   `json:"spec"`
+	Status BorkObjectTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkObjectTemplateList contains a list of BorkObjectTemplate
+type BorkObjectTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkObjectTemplate `json:"items"`
+}
+
+// GetObservedGeneration of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkObjectTemplate.
+func (mg *BorkObjectTemplate) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkObjectTemplate type metadata.
+var (
+	BorkObjectTemplateKind             = reflect.TypeOf(BorkObjectTemplate{}).Name()
+	BorkObjectTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: BorkObjectTemplateKind}.String()
+	BorkObjectTemplateKindAPIVersion   = BorkObjectTemplateKind + "." + SchemeGroupVersion.String()
+	BorkObjectTemplateGroupVersionKind = SchemeGroupVersion.WithKind(BorkObjectTemplateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkObjectTemplate{}, &BorkObjectTemplateList{})
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkPlacementPolicyParameters are the configurable fields of a
+// BorkPlacementPolicy.
+type BorkPlacementPolicyParameters struct {
+	// Zone in which the targeted BorkResources should be placed.
+	Zone string `json:"zone"`
+
+	// TargetSelector selects the BorkResources in the same namespace as the
+	// policy that should be placed.
+	TargetSelector metav1.LabelSelector `json:"targetSelector"`
+}
+
+// A PlacementTarget is a BorkResource selected by a BorkPlacementPolicy.
+type PlacementTarget struct {
+	// Name of the BorkResource.
+	Name string `json:"name"`
+
+	// ExternalName of the BorkResource. Targets that have not yet been
+	// created in the backend have no external name, and are not placed.
+	ExternalName string `json:"externalName,omitempty"`
+}
+
+// BorkPlacementPolicyObservation are the observable fields of a
+// BorkPlacementPolicy.
+type BorkPlacementPolicyObservation struct {
+	// Targets are the BorkResources currently selected by the policy's
+	// target selector.
+	Targets []PlacementTarget `json:"targets,omitempty"`
+
+	// Zone last observed in the backend.
+	Zone string `json:"zone,omitempty"`
+
+	// Placed are the external names of the bork records last observed to be
+	// placed by the backend.
+	Placed []string `json:"placed,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkPlacementPolicy's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkPlacementPolicySpec defines the desired state of a
+// BorkPlacementPolicy.
+type BorkPlacementPolicySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkPlacementPolicyParameters `json:"forProvider"`
+}
+
+// A BorkPlacementPolicyStatus represents the observed state of a
+// BorkPlacementPolicy.
+type BorkPlacementPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkPlacementPolicyObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkPlacementPolicy
+	// with the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkPlacementPolicy's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkPlacementPolicy's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkPlacementPolicy places the BorkResources matching a label selector
+// into a backend zone.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkPlacementPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkPlacementPolicySpec   `json:"spec"`
+	Status BorkPlacementPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkPlacementPolicyList contains a list of BorkPlacementPolicy
+type BorkPlacementPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkPlacementPolicy `json:"items"`
+}
+
+// GetObservedGeneration of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkPlacementPolicy.
+func (mg *BorkPlacementPolicy) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkPlacementPolicy type metadata.
+var (
+	BorkPlacementPolicyKind             = reflect.TypeOf(BorkPlacementPolicy{}).Name()
+	BorkPlacementPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: BorkPlacementPolicyKind}.String()
+	BorkPlacementPolicyKindAPIVersion   = BorkPlacementPolicyKind + "." + SchemeGroupVersion.String()
+	BorkPlacementPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BorkPlacementPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkPlacementPolicy{}, &BorkPlacementPolicyList{})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkQueueParameters are the configurable fields of a BorkQueue.
+type BorkQueueParameters struct {
+	// Tags attached to the queue.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// VisibilityTimeoutSeconds is how long a received message is hidden from
+	// other consumers.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=43200
+	// +kubebuilder:default=30
+	// +optional
+	VisibilityTimeoutSeconds *int64 `json:"visibilityTimeoutSeconds,omitempty"`
+
+	// MessageRetentionSeconds is how long a message is kept before it is
+	// discarded.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=1209600
+	// +kubebuilder:default=345600
+	// +optional
+	MessageRetentionSeconds *int64 `json:"messageRetentionSeconds,omitempty"`
+
+	// ConsistencyWindow simulates an eventually consistent API. For this
+	// long after the queue is written, e.g. "10s", the backend returns the
+	// queue as it was before the write: a queue that was just created isn't
+	// found, a queue that was just updated is stale, and a queue that was
+	// just deleted is still found. A window of "0s" makes reads consistent.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="consistencyWindow must not be negative"
+	// +kubebuilder:default="10s"
+	// +optional
+	ConsistencyWindow *metav1.Duration `json:"consistencyWindow,omitempty"`
+}
+
+// BorkQueueObservation are the observable fields of a BorkQueue.
+type BorkQueueObservation struct {
+	// Tags last observed in the backend.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// VisibilityTimeoutSeconds last observed in the backend.
+	VisibilityTimeoutSeconds int64 `json:"visibilityTimeoutSeconds,omitempty"`
+
+	// MessageRetentionSeconds last observed in the backend.
+	MessageRetentionSeconds int64 `json:"messageRetentionSeconds,omitempty"`
+
+	// ConsistencyWindow last observed in the backend.
+	ConsistencyWindow *metav1.Duration `json:"consistencyWindow,omitempty"`
+
+	// Revision is the backend revision of the queue when it was last
+	// observed. It lags the revision of the latest write to the queue for
+	// the queue's consistency window.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkQueue's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkQueueSpec defines the desired state of a BorkQueue.
+type BorkQueueSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkQueueParameters `json:"forProvider"`
+}
+
+// A BorkQueueStatus represents the observed state of a BorkQueue.
+type BorkQueueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkQueueObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkQueue with the
+	// backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkQueue's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkQueue's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkQueue is a message queue, served by an eventually consistent API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkQueueSpec   `json:"spec"`
+	Status BorkQueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkQueueList contains a list of BorkQueue
+type BorkQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkQueue `json:"items"`
+}
+
+// GetObservedGeneration of this BorkQueue.
+func (mg *BorkQueue) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkQueue.
+func (mg *BorkQueue) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkQueue.
+func (mg *BorkQueue) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkQueue.
+func (mg *BorkQueue) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkQueue.
+func (mg *BorkQueue) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkQueue.
+func (mg *BorkQueue) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkQueue.
+func (mg *BorkQueue) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkQueue.
+func (mg *BorkQueue) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkQueue.
+func (mg *BorkQueue) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkQueue.
+func (mg *BorkQueue) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkQueue type metadata.
+var (
+	BorkQueueKind             = reflect.TypeOf(BorkQueue{}).Name()
+	BorkQueueGroupKind        = schema.GroupKind{Group: Group, Kind: BorkQueueKind}.String()
+	BorkQueueKindAPIVersion   = BorkQueueKind + "." + SchemeGroupVersion.String()
+	BorkQueueGroupVersionKind = SchemeGroupVersion.WithKind(BorkQueueKind)
+)
+
+// GetTags of this BorkQueue.
+func (mg *BorkQueue) GetTags() map[string]string {
+	return mg.Spec.ForProvider.Tags
+}
+
+// SetTags of this BorkQueue.
+func (mg *BorkQueue) SetTags(tags map[string]string) {
+	mg.Spec.ForProvider.Tags = tags
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkQueue{}, &BorkQueueList{})
+}
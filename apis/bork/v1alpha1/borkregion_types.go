@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkRegionParameters are the configurable fields of a BorkRegion. They
+// filter which regions are reported.
+type BorkRegionParameters struct {
+	// Tier limits the reported regions to those offering this tier of
+	// service. All regions are reported if omitted.
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
+	// IncludeUnavailable reports regions that are not currently accepting new
+	// records.
+	// +optional
+	IncludeUnavailable bool `json:"includeUnavailable,omitempty"`
+}
+
+// A RegionCapabilities describes a region offered by the bork backend.
+type RegionCapabilities struct {
+	// Name of the region, for use as a BorkResource's region.
+	Name string `json:"name"`
+
+	// Tiers of service offered in the region.
+	Tiers []string `json:"tiers,omitempty"`
+
+	// Versioning is true if buckets in the region support object versioning.
+	Versioning bool `json:"versioning,omitempty"`
+
+	// MaxRequestsPerSecond any one key may sustain in the region.
+	MaxRequestsPerSecond int `json:"maxRequestsPerSecond,omitempty"`
+
+	// Available is false if the region is not currently accepting new
+	// records.
+	Available bool `json:"available"`
+}
+
+// BorkRegionObservation are the observable fields of a BorkRegion.
+type BorkRegionObservation struct {
+	// Regions offered by the backend that match the BorkRegion's filters,
+	// sorted by name.
+	Regions []RegionCapabilities `json:"regions,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkRegion's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkRegionSpec defines the desired state of a BorkRegion.
+type BorkRegionSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkRegionParameters `json:"forProvider"`
+}
+
+// A BorkRegionStatus represents the observed state of a BorkRegion.
+type BorkRegionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkRegionObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkRegion with the
+	// backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkRegion's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkRegion's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkRegion is an observe-only resource that reports the regions offered by
+// the bork backend, and their capabilities. It never creates, updates, or
+// deletes anything in the backend; its status is refreshed every poll
+// interval.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkRegion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkRegionSpec   `json:"spec"`
+	Status BorkRegionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkRegionList contains a list of BorkRegion
+type BorkRegionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkRegion `json:"items"`
+}
+
+// GetObservedGeneration of this BorkRegion.
+func (mg *BorkRegion) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkRegion.
+func (mg *BorkRegion) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkRegion.
+func (mg *BorkRegion) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkRegion.
+func (mg *BorkRegion) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkRegion.
+func (mg *BorkRegion) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkRegion.
+func (mg *BorkRegion) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkRegion.
+func (mg *BorkRegion) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkRegion.
+func (mg *BorkRegion) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkRegion.
+func (mg *BorkRegion) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkRegion.
+func (mg *BorkRegion) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkRegion type metadata.
+var (
+	BorkRegionKind             = reflect.TypeOf(BorkRegion{}).Name()
+	BorkRegionGroupKind        = schema.GroupKind{Group: Group, Kind: BorkRegionKind}.String()
+	BorkRegionKindAPIVersion   = BorkRegionKind + "." + SchemeGroupVersion.String()
+	BorkRegionGroupVersionKind = SchemeGroupVersion.WithKind(BorkRegionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkRegion{}, &BorkRegionList{})
+}
@@ -26,10 +26,391 @@ import (
 	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
+// AnnotationKeyPollInterval overrides how often a BorkResource is checked for
+// drift from its desired state. Its value is a duration, e.g. "30s". It is
+// ignored if the BorkResource's pollIntervalSeconds parameter is set.
+const AnnotationKeyPollInterval = "bork.crossplane.io/poll-interval"
+
+// TagKeyRetained is the tag attached to bork records that will outlive their
+// BorkResource, because its management policies don't allow it to delete
+// them.
+const TagKeyRetained = "bork.crossplane.io/retained"
+
+// An Activation configures how the bork record of a BorkResource is
+// activated.
+type Activation struct {
+	// Delay is how long the backend takes to activate the record once it
+	// has been asked to, e.g. "10s".
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="delay must not be negative"
+	// +kubebuilder:default="10s"
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+}
+
+// A ConfigMapKeySelector selects a key of a ConfigMap in the same namespace
+// as the resource that refers to it.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Key of the ConfigMap's data to select.
+	Key string `json:"key"`
+}
+
+// A BorkValueSource selects the value of a key of a BorkResource's borkValue
+// from a key of a ConfigMap or a Secret in the BorkResource's namespace.
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.secretKeyRef)",message="exactly one of configMapKeyRef or secretKeyRef must be set"
+type BorkValueSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.LocalSecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// A ReadinessProbeType determines when a BorkResource is ready.
+// +kubebuilder:validation:Enum=Always;ValueMatches;AfterSeconds;Never
+type ReadinessProbeType string
+
+// Readiness probe types.
+const (
+	// ReadinessProbeAlways BorkResources are ready as soon as their record
+	// exists.
+	ReadinessProbeAlways ReadinessProbeType = "Always"
+
+	// ReadinessProbeValueMatches BorkResources are ready once their record
+	// has been borked, i.e. its data value matches the borkValue.
+	ReadinessProbeValueMatches ReadinessProbeType = "ValueMatches"
+
+	// ReadinessProbeAfterSeconds BorkResources are ready once the probe's
+	// seconds have passed since their record was created.
+	ReadinessProbeAfterSeconds ReadinessProbeType = "AfterSeconds"
+
+	// ReadinessProbeNever BorkResources are never ready.
+	ReadinessProbeNever ReadinessProbeType = "Never"
+)
+
+// A ReadinessProbe determines when a BorkResource is ready, so that slow and
+// never ready resources can be simulated.
+// +kubebuilder:validation:XValidation:rule="self.type != 'AfterSeconds' || has(self.seconds)",message="seconds is required when type is AfterSeconds"
+type ReadinessProbe struct {
+	// Type of the probe.
+	// +kubebuilder:default=Always
+	Type ReadinessProbeType `json:"type"`
+
+	// Seconds after the record was created that an AfterSeconds probe
+	// passes. Records the BorkResource adopted are timed from the
+	// BorkResource's creation.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Seconds *int64 `json:"seconds,omitempty"`
+}
+
+// An UpdateStrategy determines how borking a record writes the borkValue to
+// its data value.
+// +kubebuilder:validation:Enum=Replace;Merge;JSONPatch
+type UpdateStrategy string
+
+// Update strategies.
+const (
+	// UpdateStrategyReplace overwrites the record's data value with the
+	// borkValue, removing keys the borkValue doesn't have.
+	UpdateStrategyReplace UpdateStrategy = "Replace"
+
+	// UpdateStrategyMerge merges the borkValue into the record's data value,
+	// like a JSON merge patch. Keys the borkValue doesn't have are kept, and
+	// keys whose value is empty are removed. The record is up to date once
+	// its data value has every other key of the borkValue.
+	UpdateStrategyMerge UpdateStrategy = "Merge"
+
+	// UpdateStrategyJSONPatch sends the backend a JSON patch that changes
+	// only the keys of the record's data value that differ from the
+	// borkValue, and tests that they haven't changed since they were
+	// observed. The update fails with a conflict if they have. The result is
+	// that of UpdateStrategyReplace.
+	UpdateStrategyJSONPatch UpdateStrategy = "JSONPatch"
+)
+
+// A RenamePolicy determines what a BorkResource does when its record is
+// renamed by someone other than the provider.
+// +kubebuilder:validation:Enum=Adopt;Recreate
+type RenamePolicy string
+
+// Rename policies.
+const (
+	// RenamePolicyAdopt BorkResources adopt their renamed record, changing
+	// their external name to its new name.
+	RenamePolicyAdopt RenamePolicy = "Adopt"
+
+	// RenamePolicyRecreate BorkResources leave their renamed record as it is,
+	// and create a new one.
+	RenamePolicyRecreate RenamePolicy = "Recreate"
+)
+
 // BorkResourceParameters are the configurable fields of a BorkResource.
+// +kubebuilder:validation:XValidation:rule="!has(self.readinessProbe) || self.readinessProbe.type != 'ValueMatches' || !has(self.ignoreFields) || !self.ignoreFields.exists(f, f == 'borkValue' || f == 'dataValue')",message="a ValueMatches readinessProbe can't be used when borkValue or dataValue is ignored"
 type BorkResourceParameters struct {
-	DataValue int `json:"dataValue"`
-	BorkValue int `json:"borkValue"`
+	// DataValue is written to the bork record when it is created. The
+	// provider then borks the record, writing the borkValue to its data
+	// value as the updateStrategy determines, so the record's data value only
+	// matches this one if it matches the borkValue. The provider never
+	// changes this field.
+	// +kubebuilder:validation:MaxProperties=64
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[A-Za-z0-9]([A-Za-z0-9_.-]{0,61}[A-Za-z0-9])?$'))",message="dataValue keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(self[k]) <= 256)",message="dataValue values must be at most 256 characters"
+	// +optional
+	DataValue map[string]string `json:"dataValue,omitempty"`
+
+	// BorkValue is required unless valueFrom is set, or the BorkResource's
+	// management policies only allow it to be observed.
+	// +kubebuilder:validation:MaxProperties=64
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[A-Za-z0-9]([A-Za-z0-9_.-]{0,61}[A-Za-z0-9])?$'))",message="borkValue keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(self[k]) <= 256)",message="borkValue values must be at most 256 characters"
+	// +optional
+	BorkValue map[string]string `json:"borkValue,omitempty"`
+
+	// UpdateStrategy determines how borking the record writes the borkValue
+	// to its data value.
+	// +kubebuilder:default=Replace
+	// +optional
+	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// Region in which the bork record is stored. Defaulted by the backend,
+	// and late-initialized from it, if omitted.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// Tier of service the bork record is stored at. Defaulted by the
+	// backend, and late-initialized from it, if omitted.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
+	// Tags attached to the bork record. Tags the backend adds by default are
+	// late-initialized into this map.
+	// +kubebuilder:validation:MaxProperties=50
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(k) > 0 && size(k) <= 128)",message="tags keys must be 1 to 128 characters"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(self[k]) <= 256)",message="tags values must be at most 256 characters"
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DriftInterval enables drift simulation. The backend randomly mutates
+	// the bork record's value or one of its tags when the record hasn't
+	// been written for this long, e.g. "5m". The mutation is detected and
+	// corrected the next time the BorkResource is polled.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="driftInterval must be at least 1s"
+	// +optional
+	DriftInterval *metav1.Duration `json:"driftInterval,omitempty"`
+
+	// TeardownDelay is how long the backend takes to remove the bork record
+	// once it has been deleted, e.g. "30s". The record is observed as
+	// DELETING until then, and the BorkResource isn't removed until the
+	// record is. A delay of "0s" removes the record immediately.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="teardownDelay must not be negative"
+	// +kubebuilder:default="5s"
+	// +optional
+	TeardownDelay *metav1.Duration `json:"teardownDelay,omitempty"`
+
+	// ValueFrom sets values of the borkValue from keys of ConfigMaps or
+	// Secrets in the BorkResource's namespace, keyed by the borkValue key
+	// they set. A value from ValueFrom takes precedence over the same key of
+	// the borkValue. The BorkResource is reconciled whenever a ConfigMap or
+	// Secret it selects changes. Values from Secrets are stored and reported
+	// like any other borkValue; use secretValue for sensitive values.
+	// +kubebuilder:validation:MaxProperties=64
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[A-Za-z0-9]([A-Za-z0-9_.-]{0,61}[A-Za-z0-9])?$'))",message="valueFrom keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit"
+	// +optional
+	ValueFrom map[string]BorkValueSource `json:"valueFrom,omitempty"`
+
+	// SecretValue selects a key of a Secret in the BorkResource's namespace
+	// whose value is stored with the bork record. The value is sensitive: it
+	// is never written to the BorkResource's status or logged, and is only
+	// published to its connection secret, under the secretValue key.
+	// +optional
+	SecretValue *xpv1.LocalSecretKeySelector `json:"secretValue,omitempty"`
+
+	// Hooks simulate the steps of provisioning the BorkResource around the
+	// creation of its bork record. PreCreate hooks run before the record is
+	// created and PostCreate hooks after, each in order. The BorkResource
+	// isn't created until all of them succeed. A hook that fails is retried,
+	// resuming from it, when the BorkResource is next reconciled. Hooks aren't
+	// written to the bork record.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Hooks []ProvisioningHook `json:"hooks,omitempty"`
+
+	// Activation simulates a resource that is provisioned in several steps.
+	// If set, the bork record is PENDING once it is created, until the
+	// provider activates it, then ACTIVATING for the activation delay. The
+	// BorkResource doesn't report that the record exists until it is ACTIVE.
+	// It can't be changed once the BorkResource is created.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="activation is immutable"
+	// +optional
+	Activation *Activation `json:"activation,omitempty"`
+
+	// PollIntervalSeconds overrides how often the BorkResource is checked
+	// for drift from its desired state, which is otherwise the provider's
+	// --poll interval. It takes precedence over the poll interval
+	// annotation. It isn't written to the bork record.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PollIntervalSeconds *int64 `json:"pollIntervalSeconds,omitempty"`
+
+	// ReadinessProbe determines when the BorkResource is ready. It is ready
+	// as soon as its record exists if unset. It isn't written to the bork
+	// record.
+	// +optional
+	ReadinessProbe *ReadinessProbe `json:"readinessProbe,omitempty"`
+
+	// FreezeSeconds is how long after the provider creates the bork record
+	// that it can't be modified, like a resource that rejects changes
+	// shortly after it's provisioned. Updates are deferred until then, and
+	// reported by the BorkResource's Frozen condition. A record the
+	// BorkResource adopted is never frozen. It isn't written to the bork
+	// record.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FreezeSeconds *int64 `json:"freezeSeconds,omitempty"`
+
+	// IgnoreFields are fields of the bork record that are managed outside
+	// the provider. They're written when the record is created, but never
+	// compared to the record when deciding whether it's up to date, and an
+	// update keeps their observed values, so that drift on them doesn't
+	// cause an update. Each is one of borkValue, dataValue, region, tier,
+	// tags, driftInterval or teardownDelay, or tags[<key>] to ignore only
+	// one tag.
+	// +kubebuilder:validation:items:Pattern=`^(borkValue|dataValue|region|tier|tags|driftInterval|teardownDelay|tags\[.+\])$`
+	// +listType=set
+	// +optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
+
+	// AutoRepair rewrites the bork record when it's found to be corrupted,
+	// for example because it's missing fields or can't be decoded, using
+	// this spec and the record's last observation. A corrupted record is
+	// always reported by the BorkResource's Corrupted condition, but is
+	// otherwise treated like any other record unless it's repaired. It isn't
+	// written to the bork record.
+	// +optional
+	AutoRepair bool `json:"autoRepair,omitempty"`
+
+	// ConnectionTemplate shapes the connection details the BorkResource
+	// publishes. It isn't written to the backend.
+	// +optional
+	ConnectionTemplate *ConnectionTemplate `json:"connectionTemplate,omitempty"`
+
+	// RenamePolicy determines what the BorkResource does when its record is
+	// renamed by someone other than the provider, which it detects because
+	// no record has its external name, but one has the UID it last observed.
+	// A renamed record is always reported by the BorkResource's
+	// RenamedExternally condition. It isn't written to the bork record.
+	// +kubebuilder:default=Adopt
+	// +optional
+	RenamePolicy RenamePolicy `json:"renamePolicy,omitempty"`
+
+	// PayloadSizeKB is the size, in KiB, of a payload written to the bork
+	// record, and observed in the BorkResource's status, so that large
+	// records can be simulated. The payload's content is generated, and
+	// only its size is compared to the record's. The record has no payload
+	// if it's unset or zero.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1024
+	// +optional
+	PayloadSizeKB *int64 `json:"payloadSizeKB,omitempty"`
+}
+
+// BorkResourceObservation are the observable fields of a BorkResource.
+type BorkResourceObservation struct {
+	// ID is the UID of the record in the backend, which is minted when the
+	// record is created. A record that is deleted and recreated with the same
+	// name has a different ID. It is also published to the BorkResource's
+	// connection secret.
+	ID string `json:"id,omitempty"`
+
+	// ARN uniquely identifies the record across all bork backends.
+	ARN string `json:"arn,omitempty"`
+
+	// State of the record in the backend; either PENDING, ACTIVATING,
+	// ACTIVE or DELETING.
+	State string `json:"state,omitempty"`
+
+	// ActivatedAt is when the record was activated, if it requires
+	// activation.
+	ActivatedAt *metav1.Time `json:"activatedAt,omitempty"`
+
+	// Generation counts the times the record has been written in the
+	// backend, including when it was created.
+	Generation int64 `json:"generation,omitempty"`
+
+	// LastModified is when the record was last written in the backend.
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// BorkValue is the value last observed in the backend.
+	BorkValue map[string]string `json:"borkValue,omitempty"`
+
+	// DataValue is the data value last observed in the backend.
+	DataValue map[string]string `json:"dataValue,omitempty"`
+
+	// Region last observed in the backend.
+	Region string `json:"region,omitempty"`
+
+	// Tier last observed in the backend.
+	Tier string `json:"tier,omitempty"`
+
+	// Tags last observed in the backend.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DriftInterval last observed in the backend.
+	DriftInterval *metav1.Duration `json:"driftInterval,omitempty"`
+
+	// HasSecretValue is true if the record was last observed to have a
+	// secret value. The value itself is only published to the connection
+	// secret.
+	HasSecretValue bool `json:"hasSecretValue,omitempty"`
+
+	// TeardownDelay last observed in the backend.
+	TeardownDelay *metav1.Duration `json:"teardownDelay,omitempty"`
+
+	// Payload last observed in the backend, per the payloadSizeKB.
+	Payload string `json:"payload,omitempty"`
+
+	// UnknownFields of the record last observed in the backend, which are
+	// fields of a newer version of the bork API than the provider knows
+	// about. They're sent back unchanged when the record is updated, so
+	// that they aren't lost.
+	// +optional
+	UnknownFields map[string]string `json:"unknownFields,omitempty"`
+
+	// Revision is the backend revision of the record when it was last
+	// observed. It is used to skip a full read of the record when it has not
+	// changed since the previous observation.
+	Revision int64 `json:"revision,omitempty"`
+
+	// History of the borkValues applied to the record, oldest first, as
+	// kept by the backend. Only the most recent are kept.
+	// +optional
+	History []BorkValueRevision `json:"history,omitempty"`
+
+	// PlannedChanges are the changes the BorkResource would have made to its
+	// record when it was last reconciled, had it not been a dry run.
+	// +optional
+	PlannedChanges []PlannedChange `json:"plannedChanges,omitempty"`
+
+	// ProviderConfigInfo describes the provider config the BorkResource's
+	// providerConfigRef resolved to when it was last observed.
+	// +optional
+	ProviderConfigInfo *ProviderConfigInfo `json:"providerConfigInfo,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkResource's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
 }
 
 // A BorkResourceSpec defines the desired state of a BorkResource.
@@ -41,6 +422,29 @@ type BorkResourceSpec struct {
 // A BorkResourceStatus represents the observed state of a BorkResource.
 type BorkResourceStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkResourceObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkResource with the
+	// backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkResource's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkResource's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+
+	// Hooks is the progress of the BorkResource's provisioning hooks, in the
+	// order they run.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Hooks []HookProgress `json:"hooks,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -52,6 +456,7 @@ type BorkResourceStatus struct {
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+// +kubebuilder:validation:XValidation:rule="!('*' in self.spec.managementPolicies || 'Create' in self.spec.managementPolicies || 'Update' in self.spec.managementPolicies) || has(self.spec.forProvider.borkValue) || has(self.spec.forProvider.valueFrom)",message="spec.forProvider.borkValue is a required parameter"
 type BorkResource struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -69,6 +474,56 @@ type BorkResourceList struct {
 	Items           []BorkResource `json:"items"`
 }
 
+// GetObservedGeneration of this BorkResource.
+func (mg *BorkResource) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkResource.
+func (mg *BorkResource) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkResource.
+func (mg *BorkResource) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkResource.
+func (mg *BorkResource) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkResource.
+func (mg *BorkResource) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkResource.
+func (mg *BorkResource) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkResource.
+func (mg *BorkResource) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkResource.
+func (mg *BorkResource) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkResource.
+func (mg *BorkResource) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkResource.
+func (mg *BorkResource) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
 // BorkResource type metadata.
 var (
 	BorkResourceKind             = reflect.TypeOf(BorkResource{}).Name()
@@ -77,6 +532,21 @@ var (
 	BorkResourceGroupVersionKind = SchemeGroupVersion.WithKind(BorkResourceKind)
 )
 
+// GetConnectionTemplate of this BorkResource.
+func (mg *BorkResource) GetConnectionTemplate() *ConnectionTemplate {
+	return mg.Spec.ForProvider.ConnectionTemplate
+}
+
+// GetTags of this BorkResource.
+func (mg *BorkResource) GetTags() map[string]string {
+	return mg.Spec.ForProvider.Tags
+}
+
+// SetTags of this BorkResource.
+func (mg *BorkResource) SetTags(tags map[string]string) {
+	mg.Spec.ForProvider.Tags = tags
+}
+
 func init() {
 	SchemeBuilder.Register(&BorkResource{}, &BorkResourceList{})
 }
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// A MaintenanceWindow is when a BorkSchedule's schedule may be updated. It's
+// either a cron expression of when the window opens, and how long it stays
+// open, or a list of time ranges.
+// +kubebuilder:validation:XValidation:rule="has(self.cron) != has(self.ranges)",message="exactly one of cron or ranges must be specified"
+// +kubebuilder:validation:XValidation:rule="has(self.cron) == has(self.duration)",message="duration must be specified with, and only with, cron"
+type MaintenanceWindow struct {
+	// Cron is a five field cron expression of when the window opens, in UTC,
+	// e.g. "0 2 * * 6" opens it at 02:00 every Saturday. Fields are minute,
+	// hour, day of month, month and day of week, each of which may be *, or
+	// a list of numbers and ranges with an optional step, e.g. 1-5 or */15.
+	// +optional
+	Cron string `json:"cron,omitempty"`
+
+	// Duration the window stays open each time it opens.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="duration must be at least 1m"
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Ranges of time during which the window is open.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Ranges []TimeRange `json:"ranges,omitempty"`
+}
+
+// A TimeRange is a range of time.
+// +kubebuilder:validation:XValidation:rule="timestamp(self.end) > timestamp(self.start)",message="end must be after start"
+type TimeRange struct {
+	// Start of the range, as an RFC 3339 time, e.g. 2025-06-01T02:00:00Z.
+	Start metav1.Time `json:"start"`
+
+	// End of the range, as an RFC 3339 time. The range doesn't include its
+	// end.
+	End metav1.Time `json:"end"`
+}
+
+// BorkScheduleParameters are the configurable fields of a BorkSchedule.
+type BorkScheduleParameters struct {
+	// MaintenanceWindow is when the schedule may be updated. Changes to its
+	// settings made outside the window are deferred until the window opens.
+	MaintenanceWindow MaintenanceWindow `json:"maintenanceWindow"`
+
+	// Settings of the schedule.
+	// +optional
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// BorkScheduleObservation are the observable fields of a BorkSchedule.
+type BorkScheduleObservation struct {
+	// Settings last observed in the backend.
+	Settings map[string]string `json:"settings,omitempty"`
+
+	// Revision is the backend revision of the schedule when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// NextWindowStart is when the maintenance window next opens. It's only
+	// set while an update is deferred until the window opens.
+	NextWindowStart *metav1.Time `json:"nextWindowStart,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this
+	// BorkSchedule's conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkScheduleSpec defines the desired state of a BorkSchedule.
+type BorkScheduleSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkScheduleParameters `json:"forProvider"`
+}
+
+// A BorkScheduleStatus represents the observed state of a BorkSchedule.
+type BorkScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkScheduleObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkSchedule
+	// with the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkSchedule's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkSchedule's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkSchedule is a backend resource that may only be updated during its
+// maintenance window. Changes to its settings made outside the window are
+// deferred, with a PendingWindow condition, until the window opens. It's
+// created and deleted whenever it's asked to be.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PENDING",type="string",JSONPath=".status.conditions[?(@.type=='PendingWindow')].status"
+// +kubebuilder:printcolumn:name="NEXT-WINDOW",type="date",JSONPath=".status.atProvider.nextWindowStart"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkScheduleSpec   `json:"spec"`
+	Status BorkScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkScheduleList contains a list of BorkSchedule
+type BorkScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkSchedule `json:"items"`
+}
+
+// GetObservedGeneration of this BorkSchedule.
+func (mg *BorkSchedule) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkSchedule.
+func (mg *BorkSchedule) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkSchedule.
+func (mg *BorkSchedule) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkSchedule.
+func (mg *BorkSchedule) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkSchedule.
+func (mg *BorkSchedule) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkSchedule.
+func (mg *BorkSchedule) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkSchedule.
+func (mg *BorkSchedule) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkSchedule.
+func (mg *BorkSchedule) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkSchedule.
+func (mg *BorkSchedule) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkSchedule.
+func (mg *BorkSchedule) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkSchedule type metadata.
+var (
+	BorkScheduleKind             = reflect.TypeOf(BorkSchedule{}).Name()
+	BorkScheduleGroupKind        = schema.GroupKind{Group: Group, Kind: BorkScheduleKind}.String()
+	BorkScheduleKindAPIVersion   = BorkScheduleKind + "." + SchemeGroupVersion.String()
+	BorkScheduleGroupVersionKind = SchemeGroupVersion.WithKind(BorkScheduleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkSchedule{}, &BorkScheduleList{})
+}
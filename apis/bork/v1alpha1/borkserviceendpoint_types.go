@@ -0,0 +1,217 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkServiceEndpointParameters are the configurable fields of a
+// BorkServiceEndpoint.
+type BorkServiceEndpointParameters struct {
+	// Service to which the endpoint connects.
+	// +kubebuilder:validation:Enum=bork-api;bork-metrics;bork-storage
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="service is immutable"
+	Service string `json:"service"`
+
+	// Region in which the endpoint is provisioned. Defaults to the backend's
+	// default region.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region *string `json:"region,omitempty"`
+
+	// PrivateDNSEnabled assigns the endpoint a private DNS name once it is
+	// available.
+	// +optional
+	// +kubebuilder:default=true
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// Tags attached to the endpoint.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// ConnectionTemplate shapes the connection details the BorkServiceEndpoint
+	// publishes. It isn't written to the backend.
+	// +optional
+	ConnectionTemplate *ConnectionTemplate `json:"connectionTemplate,omitempty"`
+}
+
+// BorkServiceEndpointObservation are the observable fields of a
+// BorkServiceEndpoint.
+type BorkServiceEndpointObservation struct {
+	// State of the endpoint's connection to its service. Endpoints are
+	// PendingAcceptance until their service accepts them, then Accepted
+	// until they are provisioned and become Available.
+	State string `json:"state,omitempty"`
+
+	// Region in which the endpoint was provisioned.
+	Region string `json:"region,omitempty"`
+
+	// PrivateDNSName of the endpoint, once it is available.
+	PrivateDNSName string `json:"privateDnsName,omitempty"`
+
+	// Tags last observed in the backend.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Revision of the endpoint last observed in the backend.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkServiceEndpoint's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkServiceEndpointSpec defines the desired state of a BorkServiceEndpoint.
+type BorkServiceEndpointSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkServiceEndpointParameters `json:"forProvider"`
+}
+
+// A BorkServiceEndpointStatus represents the observed state of a BorkServiceEndpoint.
+type BorkServiceEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkServiceEndpointObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkServiceEndpoint
+	// with the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkServiceEndpoint's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkServiceEndpoint's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkServiceEndpoint is a private connection to a backend service. Its
+// private DNS name is published to its connection details once it is
+// available.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.service"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkServiceEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkServiceEndpointSpec   `json:"spec"`
+	Status BorkServiceEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkServiceEndpointList contains a list of BorkServiceEndpoint
+type BorkServiceEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkServiceEndpoint `json:"items"`
+}
+
+// GetObservedGeneration of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkServiceEndpoint type metadata.
+var (
+	BorkServiceEndpointKind             = reflect.TypeOf(BorkServiceEndpoint{}).Name()
+	BorkServiceEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: BorkServiceEndpointKind}.String()
+	BorkServiceEndpointKindAPIVersion   = BorkServiceEndpointKind + "." + SchemeGroupVersion.String()
+	BorkServiceEndpointGroupVersionKind = SchemeGroupVersion.WithKind(BorkServiceEndpointKind)
+)
+
+// GetConnectionTemplate of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) GetConnectionTemplate() *ConnectionTemplate {
+	return mg.Spec.ForProvider.ConnectionTemplate
+}
+
+// GetTags of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) GetTags() map[string]string {
+	return mg.Spec.ForProvider.Tags
+}
+
+// SetTags of this BorkServiceEndpoint.
+func (mg *BorkServiceEndpoint) SetTags(tags map[string]string) {
+	mg.Spec.ForProvider.Tags = tags
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkServiceEndpoint{}, &BorkServiceEndpointList{})
+}
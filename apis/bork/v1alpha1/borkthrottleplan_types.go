@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkThrottlePlanParameters are the configurable fields of a
+// BorkThrottlePlan.
+type BorkThrottlePlanParameters struct {
+	// Tier is a human readable name for the plan, e.g. "gold".
+	Tier string `json:"tier"`
+
+	// RequestsPerSecond each attached BorkKey may sustain.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int `json:"requestsPerSecond"`
+
+	// Burst of requests each attached BorkKey may make above its sustained
+	// rate.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Burst int `json:"burst,omitempty"`
+}
+
+// BorkThrottlePlanObservation are the observable fields of a
+// BorkThrottlePlan.
+type BorkThrottlePlanObservation struct {
+	// AttachedKeys is the number of keys attached to the plan.
+	AttachedKeys int `json:"attachedKeys,omitempty"`
+
+	// Keys are the external names of the keys attached to the plan.
+	Keys []string `json:"keys,omitempty"`
+
+	// AllocatedRequestsPerSecond is the sum of the sustained rate allocated
+	// to every attached key.
+	AllocatedRequestsPerSecond int `json:"allocatedRequestsPerSecond,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkThrottlePlan's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkThrottlePlanSpec defines the desired state of a BorkThrottlePlan.
+type BorkThrottlePlanSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkThrottlePlanParameters `json:"forProvider"`
+}
+
+// A BorkThrottlePlanStatus represents the observed state of a BorkThrottlePlan.
+type BorkThrottlePlanStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkThrottlePlanObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkThrottlePlan with
+	// the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkThrottlePlan's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkThrottlePlan's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkThrottlePlan limits the rate at which the BorkKeys attached to it may
+// call the backend.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TIER",type="string",JSONPath=".spec.forProvider.tier"
+// +kubebuilder:printcolumn:name="KEYS",type="integer",JSONPath=".status.atProvider.attachedKeys"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkThrottlePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkThrottlePlanSpec   `json:"spec"`
+	Status BorkThrottlePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkThrottlePlanList contains a list of BorkThrottlePlan
+type BorkThrottlePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkThrottlePlan `json:"items"`
+}
+
+// GetObservedGeneration of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkThrottlePlan.
+func (mg *BorkThrottlePlan) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkThrottlePlan type metadata.
+var (
+	BorkThrottlePlanKind             = reflect.TypeOf(BorkThrottlePlan{}).Name()
+	BorkThrottlePlanGroupKind        = schema.GroupKind{Group: Group, Kind: BorkThrottlePlanKind}.String()
+	BorkThrottlePlanKindAPIVersion   = BorkThrottlePlanKind + "." + SchemeGroupVersion.String()
+	BorkThrottlePlanGroupVersionKind = SchemeGroupVersion.WithKind(BorkThrottlePlanKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BorkThrottlePlan{}, &BorkThrottlePlanList{})
+}
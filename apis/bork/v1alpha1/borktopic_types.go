@@ -0,0 +1,230 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BorkTopicParameters are the configurable fields of a BorkTopic.
+type BorkTopicParameters struct {
+	// Partitions the topic's messages are spread across.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=256
+	// +kubebuilder:default=1
+	// +optional
+	Partitions int `json:"partitions,omitempty"`
+
+	// RetentionHours is how long the topic keeps messages.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=168
+	// +optional
+	RetentionHours int `json:"retentionHours,omitempty"`
+
+	// Tags attached to the topic.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// RotateCredentialsEvery is how many times the topic may be observed
+	// before the backend rotates its password. Zero disables rotation.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=10
+	// +optional
+	RotateCredentialsEvery *int `json:"rotateCredentialsEvery,omitempty"`
+
+	// ConnectionTemplate shapes the connection details the BorkTopic
+	// publishes. It isn't written to the backend.
+	// +optional
+	ConnectionTemplate *ConnectionTemplate `json:"connectionTemplate,omitempty"`
+}
+
+// BorkTopicObservation are the observable fields of a BorkTopic.
+type BorkTopicObservation struct {
+	// Endpoint consumers of the topic connect to.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Username consumers of the topic authenticate as.
+	Username string `json:"username,omitempty"`
+
+	// Partitions last observed in the backend.
+	Partitions int `json:"partitions,omitempty"`
+
+	// RetentionHours last observed in the backend.
+	RetentionHours int `json:"retentionHours,omitempty"`
+
+	// Tags last observed in the backend.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// CredentialsVersion is how many times the topic's password was
+	// rotated. It's published as the version connection detail, alongside
+	// the password itself.
+	CredentialsVersion int64 `json:"credentialsVersion,omitempty"`
+
+	// CredentialsRotatedAt is when the topic's password was last rotated,
+	// or when the topic was created if it never was.
+	CredentialsRotatedAt *metav1.Time `json:"credentialsRotatedAt,omitempty"`
+
+	// Revision is the backend revision of the topic when it was last
+	// observed.
+	Revision int64 `json:"revision,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkTopic's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkTopicSpec defines the desired state of a BorkTopic.
+type BorkTopicSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkTopicParameters `json:"forProvider"`
+}
+
+// A BorkTopicStatus represents the observed state of a BorkTopic.
+type BorkTopicStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkTopicObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkTopic
+	// with the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkTopic's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkTopic's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkTopic is a message topic whose connection secret holds credentials
+// that the backend rotates every few observations. The provider publishes
+// the new password each time, modelling credentials that consumers of the
+// connection secret must handle being rotated.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="CREDENTIALS-VERSION",type="integer",JSONPath=".status.atProvider.credentialsVersion"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkTopic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkTopicSpec   `json:"spec"`
+	Status BorkTopicStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkTopicList contains a list of BorkTopic
+type BorkTopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkTopic `json:"items"`
+}
+
+// GetObservedGeneration of this BorkTopic.
+func (mg *BorkTopic) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkTopic.
+func (mg *BorkTopic) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkTopic.
+func (mg *BorkTopic) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkTopic.
+func (mg *BorkTopic) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkTopic.
+func (mg *BorkTopic) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkTopic.
+func (mg *BorkTopic) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkTopic.
+func (mg *BorkTopic) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkTopic.
+func (mg *BorkTopic) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkTopic.
+func (mg *BorkTopic) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkTopic.
+func (mg *BorkTopic) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkTopic type metadata.
+var (
+	BorkTopicKind             = reflect.TypeOf(BorkTopic{}).Name()
+	BorkTopicGroupKind        = schema.GroupKind{Group: Group, Kind: BorkTopicKind}.String()
+	BorkTopicKindAPIVersion   = BorkTopicKind + "." + SchemeGroupVersion.String()
+	BorkTopicGroupVersionKind = SchemeGroupVersion.WithKind(BorkTopicKind)
+)
+
+// GetConnectionTemplate of this BorkTopic.
+func (mg *BorkTopic) GetConnectionTemplate() *ConnectionTemplate {
+	return mg.Spec.ForProvider.ConnectionTemplate
+}
+
+// GetTags of this BorkTopic.
+func (mg *BorkTopic) GetTags() map[string]string {
+	return mg.Spec.ForProvider.Tags
+}
+
+// SetTags of this BorkTopic.
+func (mg *BorkTopic) SetTags(tags map[string]string) {
+	mg.Spec.ForProvider.Tags = tags
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkTopic{}, &BorkTopicList{})
+}
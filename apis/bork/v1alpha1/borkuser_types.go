@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// AnnotationKeyRotatePassword rotates a BorkUser's password when it's set to
+// a value other than the one it was last rotated for, e.g. the current time.
+const AnnotationKeyRotatePassword = "bork.crossplane.io/rotate-password"
+
+// BorkUserParameters are the configurable fields of a BorkUser.
+type BorkUserParameters struct {
+	// DisplayName of the user.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// ConnectionTemplate shapes the connection details the BorkUser
+	// publishes. It isn't written to the backend.
+	// +optional
+	ConnectionTemplate *ConnectionTemplate `json:"connectionTemplate,omitempty"`
+}
+
+// BorkUserObservation are the observable fields of a BorkUser.
+type BorkUserObservation struct {
+	// PasswordVersion is incremented every time the user's password changes.
+	PasswordVersion int64 `json:"passwordVersion,omitempty"`
+
+	// PasswordRotatedFor is the value of the rotate-password annotation the
+	// user's password was last rotated for.
+	// +optional
+	PasswordRotatedFor string `json:"passwordRotatedFor,omitempty"`
+
+	// ConditionHistory is the most recent transitions of this BorkUser's
+	// conditions, oldest first.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// A BorkUserSpec defines the desired state of a BorkUser.
+type BorkUserSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              BorkUserParameters `json:"forProvider"`
+}
+
+// A BorkUserStatus represents the observed state of a BorkUser.
+type BorkUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BorkUserObservation `json:"atProvider,omitempty"`
+
+	// SyncRequests are the most recent requests to sync this BorkUser with
+	// the backend, newest first.
+	// +optional
+	SyncRequests []SyncRequest `json:"syncRequests,omitempty"`
+
+	// RetryBudget tracks the failed attempts to create or update this
+	// BorkUser's external resource, if its retries are budgeted.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// RecreatedFor is the value of the recreate annotation for which this
+	// BorkUser's external resource was last recreated.
+	// +optional
+	RecreatedFor string `json:"recreatedFor,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BorkUser is an identity that consumers authenticate as using a password.
+// The provider generates the password per its provider config's password
+// policy, publishes it as a connection detail, and rotates it when the
+// user's rotate-password annotation changes.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PASSWORD-VERSION",type="integer",JSONPath=".status.atProvider.passwordVersion"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,bork}
+type BorkUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BorkUserSpec   `json:"spec"`
+	Status BorkUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BorkUserList contains a list of BorkUser
+type BorkUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BorkUser `json:"items"`
+}
+
+// GetObservedGeneration of this BorkUser.
+func (mg *BorkUser) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this BorkUser.
+func (mg *BorkUser) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetSyncRequests of this BorkUser.
+func (mg *BorkUser) GetSyncRequests() []SyncRequest {
+	return mg.Status.SyncRequests
+}
+
+// SetSyncRequests of this BorkUser.
+func (mg *BorkUser) SetSyncRequests(r []SyncRequest) {
+	mg.Status.SyncRequests = r
+}
+
+// GetRetryBudget of this BorkUser.
+func (mg *BorkUser) GetRetryBudget() *RetryBudget {
+	return mg.Status.RetryBudget
+}
+
+// SetRetryBudget of this BorkUser.
+func (mg *BorkUser) SetRetryBudget(b *RetryBudget) {
+	mg.Status.RetryBudget = b
+}
+
+// GetRecreatedFor of this BorkUser.
+func (mg *BorkUser) GetRecreatedFor() string {
+	return mg.Status.RecreatedFor
+}
+
+// SetRecreatedFor of this BorkUser.
+func (mg *BorkUser) SetRecreatedFor(v string) {
+	mg.Status.RecreatedFor = v
+}
+
+// GetConditionHistory of this BorkUser.
+func (mg *BorkUser) GetConditionHistory() []ConditionTransition {
+	return mg.Status.AtProvider.ConditionHistory
+}
+
+// SetConditionHistory of this BorkUser.
+func (mg *BorkUser) SetConditionHistory(h []ConditionTransition) {
+	mg.Status.AtProvider.ConditionHistory = h
+}
+
+// BorkUser type metadata.
+var (
+	BorkUserKind             = reflect.TypeOf(BorkUser{}).Name()
+	BorkUserGroupKind        = schema.GroupKind{Group: Group, Kind: BorkUserKind}.String()
+	BorkUserKindAPIVersion   = BorkUserKind + "." + SchemeGroupVersion.String()
+	BorkUserGroupVersionKind = SchemeGroupVersion.WithKind(BorkUserKind)
+)
+
+// GetConnectionTemplate of this BorkUser.
+func (mg *BorkUser) GetConnectionTemplate() *ConnectionTemplate {
+	return mg.Spec.ForProvider.ConnectionTemplate
+}
+
+func init() {
+	SchemeBuilder.Register(&BorkUser{}, &BorkUserList{})
+}
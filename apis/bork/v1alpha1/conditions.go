@@ -0,0 +1,48 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// MaxConditionHistory is the number of condition transitions recorded in the
+// status of a Bork managed resource.
+const MaxConditionHistory = 10
+
+// A ConditionTransition records that one of a managed resource's conditions
+// changed status or reason.
+type ConditionTransition struct {
+	// Type of the condition.
+	Type xpv1.ConditionType `json:"type"`
+
+	// Status the condition transitioned to.
+	Status corev1.ConditionStatus `json:"status"`
+
+	// Reason the condition transitioned.
+	Reason xpv1.ConditionReason `json:"reason"`
+
+	// Message describing the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is when the condition transitioned.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// A ConnectionTemplate shapes the connection details a managed resource
+// publishes to its connection secret, so that consumers that need them in a
+// particular format, such as a kubeconfig or a DSN, can be modelled.
+type ConnectionTemplate struct {
+	// Keys of the connection secret, and the Go template each key's value is
+	// rendered from. A template may refer to the resource's .Name,
+	// .Namespace and .ExternalName, to .Details, the connection details it
+	// would otherwise publish, and to .AtProvider, its observed state as it
+	// appears in status.atProvider. Referring to a missing key is an error;
+	// use index to treat it as empty. The b64enc and b64dec functions encode
+	// and decode base64.
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=20
+	Keys map[string]string `json:"keys"`
+
+	// OmitDefaultKeys publishes only the templated keys, rather than adding
+	// them to the connection details the resource would otherwise publish.
+	// Keys that were already published aren't removed from the connection
+	// secret.
+	// +optional
+	OmitDefaultKeys bool `json:"omitDefaultKeys,omitempty"`
+}
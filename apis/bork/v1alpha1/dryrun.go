@@ -0,0 +1,52 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// AnnotationKeyDryRun makes a BorkResource a dry run when its value is
+// "true". A dry run records the changes creating, updating or deleting its
+// record would make in its status, rather than making them.
+const AnnotationKeyDryRun = "bork.crossplane.io/dry-run"
+
+// A PlannedAction is what a dry run would have done to its record.
+type PlannedAction string
+
+// Planned actions.
+const (
+	PlannedActionCreate PlannedAction = "Create"
+	PlannedActionUpdate PlannedAction = "Update"
+	PlannedActionDelete PlannedAction = "Delete"
+)
+
+// A PlannedChange is a change a dry run would have made to its record.
+type PlannedChange struct {
+	// Action that would have made the change.
+	Action PlannedAction `json:"action"`
+
+	// Field that would have changed, e.g. borkValue. Unset if the whole
+	// record would have been deleted.
+	// +optional
+	Field string `json:"field,omitempty"`
+
+	// From is the field's current value. Unset if the record would have been
+	// created.
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// To is the value the field would have changed to.
+	// +optional
+	To string `json:"to,omitempty"`
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationKeyRollbackToRevision rolls a BorkResource's record back to one
+// of the revisions in its history. Its value is the revision, e.g. "7". While
+// it's set the record is borked with the borkValue applied at that revision
+// rather than the one in the BorkResource's spec. Remove it to roll forward.
+const AnnotationKeyRollbackToRevision = "bork.crossplane.io/rollback-to-revision"
+
+// A BorkValueRevision is a borkValue that was applied to a record.
+type BorkValueRevision struct {
+	// Revision of the record at which the borkValue was applied.
+	Revision int64 `json:"revision"`
+
+	// BorkValue that was applied.
+	// +optional
+	BorkValue map[string]string `json:"borkValue,omitempty"`
+
+	// AppliedAt is when the borkValue was applied.
+	AppliedAt metav1.Time `json:"appliedAt"`
+}
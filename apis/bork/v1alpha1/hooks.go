@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A HookPhase determines when a provisioning hook runs.
+// +kubebuilder:validation:Enum=PreCreate;PostCreate
+type HookPhase string
+
+// Hook phases.
+const (
+	// HookPhasePreCreate hooks run before the bork record is created.
+	HookPhasePreCreate HookPhase = "PreCreate"
+
+	// HookPhasePostCreate hooks run once the bork record is created, and
+	// active if it requires activation.
+	HookPhasePostCreate HookPhase = "PostCreate"
+)
+
+// A ProvisioningHook is a simulated step of provisioning a BorkResource,
+// like a script run before or after its bork record is created.
+type ProvisioningHook struct {
+	// Name of the hook, unique among the BorkResource's hooks.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Phase in which the hook runs. Hooks of each phase run in the order
+	// they're listed.
+	// +kubebuilder:default=PreCreate
+	// +optional
+	Phase HookPhase `json:"phase,omitempty"`
+
+	// Duration each attempt to run the hook takes, e.g. "5s". At most 30s, so
+	// that hooks don't hold a worker for long.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s') && duration(self) <= duration('30s')",message="duration must be between 0s and 30s"
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// FailurePercent is the probability, as a percentage, that an attempt to
+	// run the hook fails once its duration has passed.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	FailurePercent int64 `json:"failurePercent,omitempty"`
+}
+
+// A HookState is the state of a provisioning hook.
+type HookState string
+
+// Hook states.
+const (
+	HookPending   HookState = "Pending"
+	HookRunning   HookState = "Running"
+	HookSucceeded HookState = "Succeeded"
+	HookFailed    HookState = "Failed"
+)
+
+// HookProgress is the progress of a provisioning hook.
+type HookProgress struct {
+	// Name of the hook.
+	Name string `json:"name"`
+
+	// Phase in which the hook runs.
+	Phase HookPhase `json:"phase"`
+
+	// State of the hook. A hook that failed is run again when its
+	// BorkResource is next reconciled, but hooks that succeeded never are.
+	State HookState `json:"state"`
+
+	// Attempts is how many times the hook has been run.
+	// +optional
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// LastAttemptTime is when the hook was last run.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+
+	// Message describing why the hook's last attempt failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ProviderConfigInfo describes the provider config a managed resource's
+// providerConfigRef resolved to.
+type ProviderConfigInfo struct {
+	// Kind of the provider config, i.e. ProviderConfig or
+	// ClusterProviderConfig.
+	Kind string `json:"kind"`
+
+	// Name of the provider config.
+	Name string `json:"name"`
+
+	// Namespace of the provider config. Unset for a ClusterProviderConfig.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// CredentialsSource is the source of the provider config's credentials.
+	// +optional
+	CredentialsSource xpv1.CredentialsSource `json:"credentialsSource,omitempty"`
+
+	// Fallback is true if the providerConfigRef named a ProviderConfig that
+	// doesn't exist in the managed resource's namespace, and so resolved to
+	// the ClusterProviderConfig of that name.
+	Fallback bool `json:"fallback"`
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// AnnotationKeyRecreate requests that a Bork managed resource's external
+// resource be deleted and created again, e.g. to replace one that's known to
+// be bad. Its value identifies the request, typically the time it was made,
+// and must be changed to request another recreation.
+const AnnotationKeyRecreate = "bork.crossplane.io/recreate"
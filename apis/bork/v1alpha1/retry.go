@@ -0,0 +1,41 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A RetryBudget tracks the failed attempts to create or update a managed
+// resource's external resource within the current window. Once as many
+// attempts as the budget allows have failed, no more are made until the
+// window ends or the managed resource's spec changes.
+type RetryBudget struct {
+	// Limit is how many attempts may fail per window.
+	Limit int `json:"limit"`
+
+	// FailedAttempts is how many attempts have failed since the window
+	// started.
+	FailedAttempts int `json:"failedAttempts"`
+
+	// WindowStart is when the current window started.
+	WindowStart metav1.Time `json:"windowStart"`
+
+	// ObservedGeneration is the generation of the spec with which the
+	// attempts were made. The budget is reset when it changes.
+	ObservedGeneration int64 `json:"observedGeneration"`
+}
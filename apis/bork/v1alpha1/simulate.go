@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// AnnotationKeySimulateError makes operations on the external resource of a
+// Bork managed resource fail with a class of backend error, so that how each
+// class surfaces can be validated. Its value is an error code, e.g.
+// Throttled, which fails every operation, or a comma separated list of
+// operations and the codes they fail with, e.g. update=Conflict,delete=Internal.
+// Operations are observe, create, update and delete.
+const AnnotationKeySimulateError = "bork.crossplane.io/simulate-error"
+
+// AnnotationKeySimulatePanic makes an operation on the external resource of a
+// Bork managed resource panic the Nth time it's invoked, so that how the
+// provider recovers from a panic can be validated. Its value is N, e.g. 3,
+// which panics the third observe, create and update, or a comma separated
+// list of operations and invocations, e.g. observe=5,update=1. Operations are
+// observe, create and update. Invocations are counted from when the provider
+// starts.
+const AnnotationKeySimulatePanic = "bork.crossplane.io/simulate-panic"
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationKeySyncRequest requests that a Bork managed resource be synced
+// with the backend, even if it appears to be up to date. Its value identifies
+// the request, and must be changed to request another sync.
+const AnnotationKeySyncRequest = "bork.crossplane.io/sync-request"
+
+// MaxSyncRequests is the number of sync requests recorded in the status of a
+// Bork managed resource.
+const MaxSyncRequests = 5
+
+// A SyncOutcome is the outcome of a sync request.
+type SyncOutcome string
+
+// Sync request outcomes.
+const (
+	SyncOutcomePending   SyncOutcome = "Pending"
+	SyncOutcomeSucceeded SyncOutcome = "Succeeded"
+	SyncOutcomeFailed    SyncOutcome = "Failed"
+)
+
+// A SyncRequest records a request to sync a managed resource with the
+// backend, and its outcome.
+type SyncRequest struct {
+	// ID of the request, from the sync request annotation.
+	ID string `json:"id"`
+
+	// Requester is the field manager that last set the sync request
+	// annotation, if known.
+	// +optional
+	Requester string `json:"requester,omitempty"`
+
+	// ObservedAt is the time at which the request was first observed.
+	ObservedAt metav1.Time `json:"observedAt"`
+
+	// Outcome of the request.
+	Outcome SyncOutcome `json:"outcome"`
+
+	// Message describing the outcome of the request.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// CompletedAt is the time at which the request succeeded or failed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
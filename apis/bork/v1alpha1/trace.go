@@ -0,0 +1,27 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// AnnotationKeyTrace traces the decisions the provider makes about a Bork
+// managed resource for its next few reconciles when its value is "true": what
+// each observed, whether it found the external resource up to date, and what
+// it did about it. Remove and set it again to trace another few reconciles.
+const AnnotationKeyTrace = "bork.crossplane.io/trace"
+
+// AnnotationKeyTraceCycles sets how many reconciles a Bork managed resource
+// annotated with AnnotationKeyTrace traces, e.g. "10".
+const AnnotationKeyTraceCycles = "bork.crossplane.io/trace-cycles"
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Limits of a BorkResource's parameters. The BorkResource CRD's validation
+// rules enforce them, as does the provider before it writes a record.
+const (
+	MaxValueKeys   = 64
+	MaxValueLength = 256
+
+	MaxTags           = 50
+	MaxTagKeyLength   = 128
+	MaxTagValueLength = 256
+
+	MaxRegionLength = 63
+	MaxTierLength   = 63
+
+	MaxPayloadSizeKB = 1024
+)
+
+var (
+	// valueKey matches the keys of a borkValue or dataValue.
+	valueKey = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_.-]{0,61}[A-Za-z0-9])?$`)
+
+	// label matches regions and tiers.
+	label = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+)
+
+// Validate returns the ways in which the parameters, found at the supplied
+// path, break the BorkResource CRD's validation rules. It checks the same
+// rules as the CRD, so that parameters that got past the API server, for
+// example because the CRD is out of date, aren't written to the backend.
+func (p *BorkResourceParameters) Validate(fp *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateValue(p.BorkValue, fp.Child("borkValue"))...)
+	errs = append(errs, validateValue(p.DataValue, fp.Child("dataValue"))...)
+	errs = append(errs, validateValueFrom(p.ValueFrom, fp.Child("valueFrom"))...)
+	errs = append(errs, validateLabel(p.Region, MaxRegionLength, fp.Child("region"))...)
+	errs = append(errs, validateLabel(p.Tier, MaxTierLength, fp.Child("tier"))...)
+
+	tp := fp.Child("tags")
+	if len(p.Tags) > MaxTags {
+		errs = append(errs, field.TooMany(tp, len(p.Tags), MaxTags))
+	}
+	for _, k := range slices.Sorted(maps.Keys(p.Tags)) {
+		if k == "" || len(k) > MaxTagKeyLength {
+			errs = append(errs, field.Invalid(tp, k, "tags keys must be 1 to 128 characters"))
+		}
+		if len(p.Tags[k]) > MaxTagValueLength {
+			errs = append(errs, field.TooLong(tp.Key(k), p.Tags[k], MaxTagValueLength))
+		}
+	}
+
+	if p.PayloadSizeKB != nil && (*p.PayloadSizeKB < 0 || *p.PayloadSizeKB > MaxPayloadSizeKB) {
+		errs = append(errs, field.Invalid(fp.Child("payloadSizeKB"), *p.PayloadSizeKB, "payloadSizeKB must be between 0 and 1024"))
+	}
+
+	if p.ReadinessProbe != nil && p.ReadinessProbe.Type == ReadinessProbeValueMatches &&
+		(slices.Contains(p.IgnoreFields, "borkValue") || slices.Contains(p.IgnoreFields, "dataValue")) {
+		errs = append(errs, field.Invalid(fp.Child("readinessProbe", "type"), p.ReadinessProbe.Type, "a ValueMatches readinessProbe can't be used when borkValue or dataValue is ignored"))
+	}
+	return errs
+}
+
+// validateValue validates a borkValue or dataValue.
+func validateValue(v map[string]string, fp *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if len(v) > MaxValueKeys {
+		errs = append(errs, field.TooMany(fp, len(v), MaxValueKeys))
+	}
+	for _, k := range slices.Sorted(maps.Keys(v)) {
+		if !valueKey.MatchString(k) {
+			errs = append(errs, field.Invalid(fp, k, "keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit"))
+		}
+		if len(v[k]) > MaxValueLength {
+			errs = append(errs, field.TooLong(fp.Key(k), v[k], MaxValueLength))
+		}
+	}
+	return errs
+}
+
+// validateValueFrom validates the sources of a borkValue.
+func validateValueFrom(v map[string]BorkValueSource, fp *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if len(v) > MaxValueKeys {
+		errs = append(errs, field.TooMany(fp, len(v), MaxValueKeys))
+	}
+	for _, k := range slices.Sorted(maps.Keys(v)) {
+		if !valueKey.MatchString(k) {
+			errs = append(errs, field.Invalid(fp, k, "keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit"))
+		}
+		if (v[k].ConfigMapKeyRef == nil) == (v[k].SecretKeyRef == nil) {
+			errs = append(errs, field.Invalid(fp.Key(k), v[k], "exactly one of configMapKeyRef or secretKeyRef must be set"))
+		}
+	}
+	return errs
+}
+
+// validateLabel validates a region or tier.
+func validateLabel(v *string, maxLength int, fp *field.Path) field.ErrorList {
+	switch {
+	case v == nil:
+		return nil
+	case len(*v) > maxLength:
+		return field.ErrorList{field.TooLong(fp, *v, maxLength)}
+	case !label.MatchString(*v):
+		return field.ErrorList{field.Invalid(fp, *v, "must be lowercase letters, digits or '-', and start and end with a letter or digit")}
+	}
+	return nil
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// crdValidator validates BorkResources as the API server would, using the
+// schema and validation rules of the generated BorkResource CRD.
+type crdValidator struct {
+	schema     validation.SchemaValidator
+	structural *structuralschema.Structural
+	rules      *cel.Validator
+}
+
+func newCRDValidator(t *testing.T) crdValidator {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("..", "..", "..", "package", "crds", "bork.crossplane.io_borkresources.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	crd := &extv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(b, crd); err != nil {
+		t.Fatal(err)
+	}
+	props := &apiextensions.JSONSchemaProps{}
+	if err := extv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(crd.Spec.Versions[0].Schema.OpenAPIV3Schema, props, nil); err != nil {
+		t.Fatal(err)
+	}
+	sv, _, err := validation.NewSchemaValidator(props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := structuralschema.NewStructural(props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crdValidator{schema: sv, structural: s, rules: cel.NewValidator(s, true, celconfig.PerCallLimit)}
+}
+
+// validate returns the errors the API server would return creating a
+// BorkResource with the supplied parameters.
+func (v crdValidator) validate(t *testing.T, p BorkResourceParameters) field.ErrorList {
+	t.Helper()
+	return v.validateUpdate(t, nil, p)
+}
+
+// validateUpdate returns the errors the API server would return updating a
+// BorkResource with the supplied old parameters to the supplied parameters,
+// or creating it if there are no old parameters.
+func (v crdValidator) validateUpdate(t *testing.T, old *BorkResourceParameters, p BorkResourceParameters) field.ErrorList {
+	t.Helper()
+	obj := unstructuredBorkResource(t, p)
+	var oldObj any
+	if old != nil {
+		oldObj = unstructuredBorkResource(t, *old)
+	}
+	errs := validation.ValidateCustomResource(nil, obj, v.schema)
+	ruleErrs, _ := v.rules.Validate(context.Background(), nil, v.structural, obj, oldObj, celconfig.RuntimeCELCostBudget)
+	return append(errs, ruleErrs...)
+}
+
+// messages returns the message of every validation rule of the CRD.
+func (v crdValidator) messages() []string {
+	var msgs []string
+	var walk func(s *structuralschema.Structural)
+	walk = func(s *structuralschema.Structural) {
+		if s == nil {
+			return
+		}
+		for _, r := range s.XValidations {
+			msgs = append(msgs, r.Message)
+		}
+		for _, p := range slices.Sorted(maps.Keys(s.Properties)) {
+			prop := s.Properties[p]
+			walk(&prop)
+		}
+		walk(s.Items)
+		if s.AdditionalProperties != nil {
+			walk(s.AdditionalProperties.Structural)
+		}
+	}
+	walk(v.structural)
+	return msgs
+}
+
+// unstructuredBorkResource returns a BorkResource with the supplied
+// parameters, which may be created and updated, as the API server stores it.
+func unstructuredBorkResource(t *testing.T, p BorkResourceParameters) map[string]any {
+	t.Helper()
+	cr := &BorkResource{Spec: BorkResourceSpec{ForProvider: p}}
+	cr.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+// TestValidate checks that the BorkResource CRD, and Validate, accept and
+// reject the same parameters.
+func TestValidate(t *testing.T) {
+	valid := func(mutate func(p *BorkResourceParameters)) BorkResourceParameters {
+		p := BorkResourceParameters{
+			BorkValue: map[string]string{"bork": "2"},
+			DataValue: map[string]string{"bork": "1"},
+			Region:    ptr.To("bork-central-1"),
+			Tier:      ptr.To("standard"),
+			Tags:      map[string]string{"bork.crossplane.io/backend": "in-memory"},
+		}
+		mutate(&p)
+		return p
+	}
+	many := func(n int) map[string]string {
+		m := make(map[string]string, n)
+		for i := range n {
+			m[fmt.Sprintf("k%03d", i)] = "v"
+		}
+		return m
+	}
+
+	cases := map[string]struct {
+		p       BorkResourceParameters
+		invalid bool
+	}{
+		"Valid": {
+			p: valid(func(*BorkResourceParameters) {}),
+		},
+		"ValueKeyWithDotsAndDashes": {
+			p: valid(func(p *BorkResourceParameters) { p.BorkValue = map[string]string{"bork.v-1_a": "2"} }),
+		},
+		"TooManyBorkValueKeys": {
+			p:       valid(func(p *BorkResourceParameters) { p.BorkValue = many(MaxValueKeys + 1) }),
+			invalid: true,
+		},
+		"BorkValueKeyStartsWithDash": {
+			p:       valid(func(p *BorkResourceParameters) { p.BorkValue = map[string]string{"-bork": "2"} }),
+			invalid: true,
+		},
+		"BorkValueKeyWithSpace": {
+			p:       valid(func(p *BorkResourceParameters) { p.BorkValue = map[string]string{"bork bork": "2"} }),
+			invalid: true,
+		},
+		"BorkValueKeyTooLong": {
+			p:       valid(func(p *BorkResourceParameters) { p.BorkValue = map[string]string{strings.Repeat("b", 64): "2"} }),
+			invalid: true,
+		},
+		"BorkValueTooLong": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.BorkValue = map[string]string{"bork": strings.Repeat("2", MaxValueLength+1)}
+			}),
+			invalid: true,
+		},
+		"DataValueKeyEmpty": {
+			p:       valid(func(p *BorkResourceParameters) { p.DataValue = map[string]string{"": "1"} }),
+			invalid: true,
+		},
+		"RegionUppercase": {
+			p:       valid(func(p *BorkResourceParameters) { p.Region = ptr.To("Bork-Central-1") }),
+			invalid: true,
+		},
+		"TierTooLong": {
+			p:       valid(func(p *BorkResourceParameters) { p.Tier = ptr.To(strings.Repeat("t", MaxTierLength+1)) }),
+			invalid: true,
+		},
+		"TooManyTags": {
+			p:       valid(func(p *BorkResourceParameters) { p.Tags = many(MaxTags + 1) }),
+			invalid: true,
+		},
+		"TagKeyTooLong": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.Tags = map[string]string{strings.Repeat("t", MaxTagKeyLength+1): "v"}
+			}),
+			invalid: true,
+		},
+		"TagValueTooLong": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.Tags = map[string]string{"team": strings.Repeat("v", MaxTagValueLength+1)}
+			}),
+			invalid: true,
+		},
+		"ValueMatchesWithIgnoredDataValue": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.ReadinessProbe = &ReadinessProbe{Type: ReadinessProbeValueMatches}
+				p.IgnoreFields = []string{"dataValue"}
+			}),
+			invalid: true,
+		},
+		"ValueMatchesWithIgnoredTags": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.ReadinessProbe = &ReadinessProbe{Type: ReadinessProbeValueMatches}
+				p.IgnoreFields = []string{"tags"}
+			}),
+		},
+	}
+
+	crd := newCRDValidator(t)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if errs := crd.validate(t, tc.p); (len(errs) > 0) != tc.invalid {
+				t.Errorf("CRD: got errors %v, want invalid %t", errs, tc.invalid)
+			}
+			if errs := tc.p.Validate(field.NewPath("spec", "forProvider")); (len(errs) > 0) != tc.invalid {
+				t.Errorf("Validate: got errors %v, want invalid %t", errs, tc.invalid)
+			}
+		})
+	}
+}
+
+// TestCRDRules checks that each of the BorkResource CRD's validation rules
+// rejects what it should, with its message, as the API server evaluates it.
+func TestCRDRules(t *testing.T) {
+	valid := func(mutate func(p *BorkResourceParameters)) BorkResourceParameters {
+		p := BorkResourceParameters{BorkValue: map[string]string{"bork": "2"}}
+		mutate(&p)
+		return p
+	}
+	seconds := func(s float64) *metav1.Duration {
+		return &metav1.Duration{Duration: time.Duration(s * float64(time.Second))}
+	}
+
+	cases := map[string]struct {
+		old     *BorkResourceParameters
+		p       BorkResourceParameters
+		message string
+	}{
+		"ActivationDelayNegative": {
+			p:       valid(func(p *BorkResourceParameters) { p.Activation = &Activation{Delay: seconds(-1)} }),
+			message: "delay must not be negative",
+		},
+		"ActivationChanged": {
+			old:     ptr.To(valid(func(p *BorkResourceParameters) { p.Activation = &Activation{Delay: seconds(10)} })),
+			p:       valid(func(p *BorkResourceParameters) { p.Activation = &Activation{Delay: seconds(20)} }),
+			message: "activation is immutable",
+		},
+		"BorkValueKeyInvalid": {
+			p:       valid(func(p *BorkResourceParameters) { p.BorkValue = map[string]string{"bork bork": "2"} }),
+			message: "borkValue keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit",
+		},
+		"BorkValueTooLong": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.BorkValue = map[string]string{"bork": strings.Repeat("2", MaxValueLength+1)}
+			}),
+			message: "borkValue values must be at most 256 characters",
+		},
+		"DataValueKeyInvalid": {
+			p:       valid(func(p *BorkResourceParameters) { p.DataValue = map[string]string{"-bork": "1"} }),
+			message: "dataValue keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit",
+		},
+		"DataValueTooLong": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.DataValue = map[string]string{"bork": strings.Repeat("1", MaxValueLength+1)}
+			}),
+			message: "dataValue values must be at most 256 characters",
+		},
+		"DriftIntervalTooShort": {
+			p:       valid(func(p *BorkResourceParameters) { p.DriftInterval = seconds(0.5) }),
+			message: "driftInterval must be at least 1s",
+		},
+		"HookTooLong": {
+			p:       valid(func(p *BorkResourceParameters) { p.Hooks = []ProvisioningHook{{Name: "bork", Duration: seconds(31)}} }),
+			message: "duration must be between 0s and 30s",
+		},
+		"AfterSecondsWithoutSeconds": {
+			p:       valid(func(p *BorkResourceParameters) { p.ReadinessProbe = &ReadinessProbe{Type: ReadinessProbeAfterSeconds} }),
+			message: "seconds is required when type is AfterSeconds",
+		},
+		"TagKeyEmpty": {
+			p:       valid(func(p *BorkResourceParameters) { p.Tags = map[string]string{"": "v"} }),
+			message: "tags keys must be 1 to 128 characters",
+		},
+		"TagValueTooLong": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.Tags = map[string]string{"team": strings.Repeat("v", MaxTagValueLength+1)}
+			}),
+			message: "tags values must be at most 256 characters",
+		},
+		"TeardownDelayNegative": {
+			p:       valid(func(p *BorkResourceParameters) { p.TeardownDelay = seconds(-1) }),
+			message: "teardownDelay must not be negative",
+		},
+		"ValueFromBothRefs": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.ValueFrom = map[string]BorkValueSource{"bork": {
+					ConfigMapKeyRef: &ConfigMapKeySelector{Name: "bork", Key: "bork"},
+					SecretKeyRef:    &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "bork"}, Key: "bork"},
+				}}
+			}),
+			message: "exactly one of configMapKeyRef or secretKeyRef must be set",
+		},
+		"ValueFromKeyInvalid": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.ValueFrom = map[string]BorkValueSource{"bork.": {ConfigMapKeyRef: &ConfigMapKeySelector{Name: "bork", Key: "bork"}}}
+			}),
+			message: "valueFrom keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit",
+		},
+		"ValueMatchesWithIgnoredBorkValue": {
+			p: valid(func(p *BorkResourceParameters) {
+				p.ReadinessProbe = &ReadinessProbe{Type: ReadinessProbeValueMatches}
+				p.IgnoreFields = []string{"borkValue"}
+			}),
+			message: "a ValueMatches readinessProbe can't be used when borkValue or dataValue is ignored",
+		},
+		"BorkValueMissing": {
+			p:       BorkResourceParameters{},
+			message: "spec.forProvider.borkValue is a required parameter",
+		},
+	}
+
+	crd := newCRDValidator(t)
+	if errs := crd.validate(t, valid(func(*BorkResourceParameters) {})); len(errs) > 0 {
+		t.Fatalf("CRD: got errors %v validating valid parameters, want none", errs)
+	}
+
+	tested := map[string]bool{}
+	for name, tc := range cases {
+		tested[tc.message] = true
+		t.Run(name, func(t *testing.T) {
+			errs := crd.validateUpdate(t, tc.old, tc.p)
+			if !slices.ContainsFunc(errs, func(err *field.Error) bool { return err.Detail == tc.message }) {
+				t.Errorf("CRD: got errors %v, want one with message %q", errs, tc.message)
+			}
+		})
+	}
+
+	// Every rule must be tested, including rules added after this test was.
+	for _, msg := range crd.messages() {
+		if !tested[msg] {
+			t.Errorf("CRD: validation rule with message %q isn't tested", msg)
+		}
+	}
+}
@@ -17,13 +17,29 @@ limitations under the License.
 // NOTE: See the below link for details on what is happening here.
 // https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module
 
-// Remove existing CRDs
-//go:generate rm -rf ../package/crds
+// Remove existing CRDs and webhook configurations
+//go:generate rm -rf ../package/crds ../package/webhookconfigurations
 
 // Generate deepcopy methodsets and CRD manifests
 //go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./... crd:crdVersions=v1 output:artifacts:config=../package/crds
 
+// Generate webhook configurations for the admission webhooks
+//go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen webhook paths=../internal/webhook/... output:webhook:artifacts:config=../package/webhookconfigurations
+
 // Generate crossplane-runtime methodsets (resource.Claim, etc)
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
+// Generate gRPC types and stubs. See buf.gen.yaml for buf's configuration.
+// We install protoc-gen-go and protoc-gen-go-grpc at the versions pinned in
+// go.mod because buf invokes them from $PATH.
+//go:generate go install google.golang.org/protobuf/cmd/protoc-gen-go google.golang.org/grpc/cmd/protoc-gen-go-grpc
+//go:generate go run github.com/bufbuild/buf/cmd/buf@v1.36.0 generate
+
+// Generate the example composite resource definition and Composition
+//go:generate go run ../cmd/borkgen --output-dir ../examples/composition
+
+// Generate an example of every kind from the CRDs
+//go:generate rm -rf ../examples/generated
+//go:generate go run ../cmd/borkgen examples --crd-dir ../package/crds --output-dir ../examples/generated
+
 package apis
@@ -0,0 +1,430 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// A ContentType is an encoding the bork backend accepts for payloads.
+// +kubebuilder:validation:Enum=application/json;application/msgpack
+type ContentType string
+
+// Content types supported by the bork backend.
+const (
+	ContentTypeJSON        ContentType = "application/json"
+	ContentTypeMessagePack ContentType = "application/msgpack"
+)
+
+// A ProviderConfigSpec defines the desired state of a ProviderConfig.
+// +kubebuilder:validation:XValidation:rule="!has(self.regions) || !has(self.endpoint)",message="regions are only supported by the in-process backend, not an endpoint"
+type ProviderConfigSpec struct {
+	// Credentials required to authenticate to this provider. Credentials
+	// other than None are presented to the endpoint as a bearer token.
+	// Expiring credentials are also presented to the in-process backend.
+	Credentials ProviderCredentials `json:"credentials"`
+
+	// Endpoint of a bork API server. Managed resources are reconciled
+	// against the provider's in-process backend if no endpoint is set.
+	// +optional
+	Endpoint *Endpoint `json:"endpoint,omitempty"`
+
+	// ContentTypes the provider may use to encode payloads it exchanges with
+	// the backend, in order of preference. The first content type the
+	// backend supports is used. Defaults to JSON.
+	// +optional
+	ContentTypes []ContentType `json:"contentTypes,omitempty"`
+
+	// Watch configures a subscription to changes in the backend.
+	// +optional
+	Watch *WatchConfig `json:"watch,omitempty"`
+
+	// Quota limits how many managed resources may use this provider config.
+	// Managed resources may use it without limit if unset.
+	// +optional
+	Quota *QuotaConfig `json:"quota,omitempty"`
+
+	// Concurrency limits how many managed resources that use this provider
+	// config may be reconciled at once. Managed resources may be reconciled
+	// using all of the provider's workers if unset.
+	// +optional
+	Concurrency *ConcurrencyConfig `json:"concurrency,omitempty"`
+
+	// CircuitBreaker stops calling the backend on behalf of managed
+	// resources that use this provider config once too many calls in a row
+	// have failed, until it recovers. Calls are made however many fail if
+	// unset.
+	// +optional
+	CircuitBreaker *CircuitBreakerConfig `json:"circuitBreaker,omitempty"`
+
+	// TagPropagation tags the external resources of managed resources that
+	// use this provider config with some of their labels and annotations.
+	// Only managed resources whose kind supports tags are tagged. No labels
+	// or annotations are propagated if unset.
+	// +optional
+	TagPropagation *TagPropagationConfig `json:"tagPropagation,omitempty"`
+
+	// PasswordPolicy is the complexity policy of the passwords the provider
+	// generates for managed resources that use this provider config, like
+	// BorkUsers. Passwords are 24 characters of lowercase and uppercase
+	// letters and digits if unset.
+	// +optional
+	PasswordPolicy *PasswordPolicy `json:"passwordPolicy,omitempty"`
+
+	// Capabilities limit what the managed resources that use this provider
+	// config may ask for, like an organization's policy would. Managed
+	// resources may ask for anything if unset.
+	// +optional
+	Capabilities *Capabilities `json:"capabilities,omitempty"`
+
+	// Regions of the in-process backend that managed resources are
+	// reconciled against, in order of preference. Each region is a
+	// partition of the backend that stores resources of its own. Operations
+	// are performed in the first region, failing over to the next when a
+	// region is unhealthy. The backend isn't partitioned if unset.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MaxLength=63
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	Regions []string `json:"regions,omitempty"`
+}
+
+// An Endpoint is a bork API server, such as the one served by bork-server.
+type Endpoint struct {
+	// URL of the bork API server, e.g. https://bork.example.org. When the
+	// gRPC transport is used the URL's scheme determines whether TLS is
+	// used, and its host and port are dialed.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// Transport used to reach the bork API server.
+	// +kubebuilder:validation:Enum=HTTP;GRPC
+	// +kubebuilder:default=HTTP
+	// +optional
+	Transport Transport `json:"transport,omitempty"`
+
+	// CABundle is a PEM encoded bundle of certificate authorities used to
+	// verify the server's certificate. The system's certificate authorities
+	// are used if unset.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// InsecureSkipTLSVerify disables verification of the server's
+	// certificate. It should only be used for testing.
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// A Transport is used to reach a bork API server.
+type Transport string
+
+// Transports the provider can use to reach a bork API server.
+const (
+	// TransportHTTP exchanges payloads with the server using HTTP requests.
+	// A connection is established every time a managed resource is
+	// reconciled.
+	TransportHTTP Transport = "HTTP"
+
+	// TransportGRPC exchanges payloads with the server using gRPC calls
+	// over a single connection, which is kept alive for as long as the
+	// managed resource's external client is open.
+	TransportGRPC Transport = "GRPC"
+)
+
+// A WatchMode determines how the backend tells the provider about changes.
+// +kubebuilder:validation:Enum=Stream;Webhook
+type WatchMode string
+
+// Watch modes.
+const (
+	// WatchModeStream streams changes from the backend over a connection the
+	// provider keeps open.
+	WatchModeStream WatchMode = "Stream"
+
+	// WatchModeWebhook has the backend POST each change to the provider's
+	// notification receiver, which must be enabled.
+	WatchModeWebhook WatchMode = "Webhook"
+)
+
+// A WatchConfig configures a subscription to changes in the backend. While
+// subscribed, managed resources that use the provider config are reconciled
+// as soon as their external resources change, rather than when they are next
+// polled. Resources are still polled, so drift is detected even if the
+// subscription drops.
+type WatchConfig struct {
+	// Enabled subscribes to changes in the backend.
+	Enabled bool `json:"enabled"`
+
+	// Mode in which the backend tells the provider about changes.
+	// +optional
+	// +kubebuilder:default=Stream
+	Mode WatchMode `json:"mode,omitempty"`
+}
+
+// A QuotaConfig limits how many managed resources may use a provider config,
+// simulating a backend account's resource limits. Managed resources are
+// counted in the order they started using the provider config. Those beyond
+// the quota can't create their external resources, and have a QuotaExceeded
+// condition. Lowering the quota doesn't affect external resources that were
+// already created.
+type QuotaConfig struct {
+	// MaxResources is how many managed resources may use the provider
+	// config.
+	// +kubebuilder:validation:Minimum=0
+	MaxResources int64 `json:"maxResources"`
+}
+
+// Capabilities limit the parameters of the managed resources that use a
+// provider config. A managed resource whose spec asks for more than they allow
+// isn't created or updated, and has a CapabilityDenied condition until its
+// spec is within them. Only parameters that are set are checked; those the
+// backend defaults aren't. Unset capabilities don't limit anything. Only
+// BorkResources are checked, because no other kind has these parameters.
+type Capabilities struct {
+	// AllowedTiers are the tiers managed resources may ask for.
+	// +optional
+	// +listType=set
+	AllowedTiers []string `json:"allowedTiers,omitempty"`
+
+	// AllowedRegions are the regions managed resources may ask for.
+	// +optional
+	// +listType=set
+	AllowedRegions []string `json:"allowedRegions,omitempty"`
+
+	// MaxPayloadSizeKB is the largest payload, in KiB, managed resources
+	// may ask for.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPayloadSizeKB *int64 `json:"maxPayloadSizeKB,omitempty"`
+}
+
+// A ConcurrencyConfig limits how many managed resources that use a provider
+// config may be reconciled at once, across every kind, so that a provider
+// config with many managed resources can't starve others of workers.
+// Reconciles beyond the limit are deferred, freeing their worker to reconcile
+// managed resources that use other provider configs.
+type ConcurrencyConfig struct {
+	// MaxConcurrentReconciles is how many managed resources that use the
+	// provider config may be reconciled at once.
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentReconciles int64 `json:"maxConcurrentReconciles"`
+}
+
+// A CircuitBreakerConfig configures the circuit breaker of a provider config.
+// Each kind of managed resource that uses the provider config has a circuit
+// of its own. A circuit opens once FailureThreshold calls to the backend in a
+// row have failed because the backend was unavailable, timed out or failed
+// internally. While it's open calls aren't made, and managed resources that
+// would have made them have a CircuitOpen condition. After OpenDuration one
+// call is let through to probe whether the backend has recovered. The circuit
+// closes if the probe succeeds, and stays open for another OpenDuration if it
+// doesn't.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is how many calls in a row must fail for the circuit
+	// to open.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=5
+	// +optional
+	FailureThreshold int64 `json:"failureThreshold,omitempty"`
+
+	// OpenDuration is how long the circuit stays open before it's probed.
+	// +kubebuilder:default="30s"
+	// +optional
+	OpenDuration *metav1.Duration `json:"openDuration,omitempty"`
+}
+
+// A TagPropagationConfig names the labels and annotations of managed resources
+// that are propagated to their external resources' tags. A propagated label or
+// annotation is a tag with the same key and value, unless the managed resource
+// sets a tag with its key, which takes precedence. Propagated tags aren't
+// written to the managed resource's spec, but are reported in its status with
+// the other tags observed in the backend.
+type TagPropagationConfig struct {
+	// Labels whose keys are propagated.
+	// +optional
+	// +listType=set
+	Labels []string `json:"labels,omitempty"`
+
+	// Annotations whose keys are propagated.
+	// +optional
+	// +listType=set
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// A PasswordCharset is a set of characters a password may contain.
+// +kubebuilder:validation:Enum=Lowercase;Uppercase;Digits;Symbols
+type PasswordCharset string
+
+// Password charsets.
+const (
+	PasswordCharsetLowercase PasswordCharset = "Lowercase"
+	PasswordCharsetUppercase PasswordCharset = "Uppercase"
+	PasswordCharsetDigits    PasswordCharset = "Digits"
+	PasswordCharsetSymbols   PasswordCharset = "Symbols"
+)
+
+// A PasswordPolicy determines how complex generated passwords are.
+type PasswordPolicy struct {
+	// Length of generated passwords.
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=24
+	// +optional
+	Length int64 `json:"length,omitempty"`
+
+	// Charsets generated passwords are drawn from. Every generated password
+	// contains at least one character of each.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:default={"Lowercase","Uppercase","Digits"}
+	// +listType=set
+	// +optional
+	Charsets []PasswordCharset `json:"charsets,omitempty"`
+}
+
+// CredentialsSourceExpiring credentials are tokens issued by the backend that
+// expire, and must be renewed. The provider gets a token by presenting the
+// Secret selected by the credentials' secretRef, if any, to the backend. It
+// keeps using the token until the backend rejects it as expired, then gets a
+// new one. This simulates credentials that are rotated.
+const CredentialsSourceExpiring xpv1.CredentialsSource = "Expiring"
+
+// ProviderCredentials required to authenticate.
+type ProviderCredentials struct {
+	// Source of the provider credentials.
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;Expiring
+	Source xpv1.CredentialsSource `json:"source"`
+
+	// ExpiresAfter is how long each token issued to the provider is valid
+	// when the source is Expiring. Defaults to 5m.
+	// +optional
+	ExpiresAfter *metav1.Duration `json:"expiresAfter,omitempty"`
+
+	xpv1.CommonCredentialSelectors `json:",inline"`
+}
+
+// A ProviderIdentity is who the backend authenticated the provider as, using
+// a provider config's credentials.
+type ProviderIdentity struct {
+	// AccountID of the backend account the credentials belong to.
+	AccountID string `json:"accountId"`
+
+	// ContentType the provider negotiated with the backend.
+	// +optional
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// A ProviderConfigStatus reflects the observed state of a ProviderConfig.
+type ProviderConfigStatus struct {
+	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// Identity the backend authenticated the provider as when it last
+	// validated the provider config's credentials. It's unset while the
+	// credentials are invalid.
+	// +optional
+	Identity *ProviderIdentity `json:"identity,omitempty"`
+
+	// LastHealthCheckTime is when the provider last validated the provider
+	// config's credentials.
+	// +optional
+	LastHealthCheckTime *metav1.Time `json:"lastHealthCheckTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProviderConfig configures the Bork provider for managed resources in its
+// namespace.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="HEALTHY",type="string",JSONPath=".status.conditions[?(@.type=='Healthy')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.identity.accountId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,provider,bork}
+type ProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigList contains a list of ProviderConfig.
+type ProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfig `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterProviderConfig configures the Bork provider for managed resources
+// in any namespace.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="HEALTHY",type="string",JSONPath=".status.conditions[?(@.type=='Healthy')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.identity.accountId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,bork}
+type ClusterProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProviderConfigList contains a list of ClusterProviderConfig.
+type ClusterProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterProviderConfig `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProviderConfigUsage records that a managed resource is using a
+// ProviderConfig or ClusterProviderConfig. A provider config can't be deleted
+// while it has usages. Usages are named after the UID of the managed resource
+// that uses the provider config, and are in its namespace.
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CONFIG-KIND",type="string",JSONPath=".providerConfigRef.kind"
+// +kubebuilder:printcolumn:name="CONFIG-NAME",type="string",JSONPath=".providerConfigRef.name"
+// +kubebuilder:printcolumn:name="RESOURCE-KIND",type="string",JSONPath=".resourceRef.kind"
+// +kubebuilder:printcolumn:name="RESOURCE-NAME",type="string",JSONPath=".resourceRef.name"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,provider,bork}
+type ProviderConfigUsage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	xpv2.TypedProviderConfigUsage `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigUsageList contains a list of ProviderConfigUsage.
+type ProviderConfigUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfigUsage `json:"items"`
+}
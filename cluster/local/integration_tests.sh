@@ -173,6 +173,20 @@ echo_step "waiting for provider to be installed"
 
 kubectl wait "provider.pkg.crossplane.io/${PACKAGE_NAME}" --for=condition=healthy --timeout=180s
 
+echo_step "installing the example composition"
+
+"${KUBECTL}" apply -f "${projectdir}/examples/composition/functions.yaml"
+"${KUBECTL}" wait function.pkg.crossplane.io --all --for=condition=healthy --timeout=180s
+"${KUBECTL}" apply -f "${projectdir}/examples/composition/definition.yaml"
+"${KUBECTL}" wait compositeresourcedefinition.apiextensions.crossplane.io --all --for=condition=established --timeout=60s
+"${KUBECTL}" apply -f "${projectdir}/examples/composition/composition.yaml"
+
+echo_step "uninstalling the example composition"
+
+"${KUBECTL}" delete -f "${projectdir}/examples/composition/composition.yaml"
+"${KUBECTL}" delete -f "${projectdir}/examples/composition/definition.yaml"
+"${KUBECTL}" delete -f "${projectdir}/examples/composition/functions.yaml"
+
 echo_step "uninstalling ${PROJECT_NAME}"
 
 echo "${INSTALL_YAML}" | "${KUBECTL}" delete -f -
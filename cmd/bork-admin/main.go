@@ -0,0 +1,354 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main inspects and manipulates the state of a running bork provider
+// using its admin API: it lists the backend's stored resources, shows how
+// BorkResources differ from their records, makes records drift, prints the
+// decisions traced for managed resources, snapshots and restores the backend,
+// and dumps the provider's metrics.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/admin"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/controller/borkresource"
+)
+
+const (
+	errGetConfig   = "cannot get API server rest config"
+	errAddToScheme = "cannot add APIs to scheme"
+	errNewClient   = "cannot create Kubernetes client"
+	errGetBork     = "cannot get BorkResource"
+	errListBorks   = "cannot list BorkResources"
+	errMetricsFmt  = "metrics endpoint returned %s"
+	errCreateFile  = "cannot write snapshot file"
+	errOpenFile    = "cannot open snapshot file"
+)
+
+func main() {
+	var (
+		app = kingpin.New(filepath.Base(os.Args[0]), "Inspect and manipulate the state of a running bork provider using its admin API.").DefaultEnvars()
+
+		url     = app.Flag("url", "URL of the provider's admin API, e.g. a port forward of its --admin-address.").Default("http://localhost:9090").String()
+		token   = app.Flag("token", "Token presented to the admin API, per the provider's --admin-token.").String()
+		tenant  = app.Flag("tenant", "Namespace whose tenant's store is operated on when the backend is multi-tenant. The shared store is used if unset.").String()
+		region  = app.Flag("region", "Region of the store to operate on. The store itself is used if unset.").String()
+		timeout = app.Flag("timeout", "How long to wait for each call to the provider.").Default("10s").Duration()
+
+		list     = app.Command("list", "List the names of the backend's stored resources.")
+		listKind = list.Arg("kind", "Kind of resource to list, e.g. bucket. Every kind is listed if unset. One of "+strings.Join(backend.Kinds, ", ")+".").Enum(backend.Kinds...)
+
+		get     = app.Command("get", "Print a backend record as JSON.")
+		getName = get.Arg("record", "Name of the record.").Required().String()
+
+		diff          = app.Command("diff", "Show how BorkResources differ from their backend records, as the provider would when it next observes them. Exits 1 if any differ.")
+		diffNamespace = diff.Flag("namespace", "Namespace of the BorkResources.").Short('n').Default("default").String()
+		diffName      = diff.Arg("name", "Name of the BorkResource. Every BorkResource in the namespace is compared if unset.").String()
+
+		drift          = app.Command("drift", "Make a backend record drift, as though someone other than the provider changed it. Keys set to an empty value are removed.")
+		driftName      = drift.Arg("record", "Name of the record.").Required().String()
+		driftBorkValue = drift.Flag("bork-value", "Key and value to merge into the record's bork value, e.g. bork=drifted. May be repeated.").StringMap()
+		driftDataValue = drift.Flag("data-value", "Key and value to merge into the record's data value. May be repeated.").StringMap()
+		driftTags      = drift.Flag("tag", "Key and value to merge into the record's tags. May be repeated.").StringMap()
+
+		corrupt     = app.Command("corrupt", "Corrupt a backend record.")
+		corruptName = corrupt.Arg("record", "Name of the record.").Required().String()
+		corruptMode = corrupt.Flag("mode", "How to corrupt the record.").Default(string(backend.CorruptionGarbage)).Enum(modes()...)
+
+		rename     = app.Command("rename", "Rename a backend record, as though someone other than the provider renamed it. The record keeps its UID.")
+		renameName = rename.Arg("record", "Name of the record.").Required().String()
+		renameTo   = rename.Arg("to", "Name to rename the record to.").Required().String()
+
+		controllers = app.Command("controllers", "List the provider's controllers, and whether each is active or waiting for the CRD of its kind.")
+
+		trace          = app.Command("trace", "Print the decisions traced for a managed resource annotated with "+v1alpha1.AnnotationKeyTrace+" as JSON, or list every traced resource.")
+		traceNamespace = trace.Flag("namespace", "Namespace of the managed resource.").Short('n').Default("default").String()
+		traceKind      = trace.Arg("kind", "Kind of the managed resource, e.g. BorkResource. Every traced resource is listed if unset.").String()
+		traceName      = trace.Arg("name", "Name of the managed resource.").String()
+
+		snapshot     = app.Command("snapshot", "Save a snapshot of everything the backend stores, as a gzipped tarball.")
+		snapshotFile = snapshot.Arg("file", "File to write the snapshot to, or - for stdout.").Required().String()
+
+		restore     = app.Command("restore", "Replace everything the backend stores with the contents of a snapshot.")
+		restoreFile = restore.Arg("file", "File to read the snapshot from, or - for stdin.").Required().String()
+
+		dump       = app.Command("metrics", "Dump the provider's Prometheus metrics.")
+		dumpURL    = dump.Flag("metrics-url", "URL of the provider's Prometheus metrics, e.g. a port forward of its metrics port.").Default("http://localhost:8080/metrics").String()
+		dumpPrefix = dump.Flag("prefix", "Only dump metrics whose names have this prefix. Every metric is dumped if it's empty.").Default("bork_").String()
+	)
+	cmd := kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	hc := &http.Client{Timeout: *timeout}
+	c := &admin.Client{URL: *url, Token: *token, Namespace: *tenant, Region: *region, HTTP: hc}
+
+	switch cmd {
+	case list.FullCommand():
+		kingpin.FatalIfError(listNames(ctx, c, *listKind), "Cannot list stored resources")
+	case get.FullCommand():
+		rec, err := c.Record(ctx, *getName)
+		kingpin.FatalIfError(err, "Cannot get record")
+		kingpin.FatalIfError(printJSON(rec), "Cannot print record")
+	case diff.FullCommand():
+		differ, err := diffResources(ctx, c, *diffNamespace, *diffName)
+		kingpin.FatalIfError(err, "Cannot diff BorkResources")
+		if differ {
+			os.Exit(1)
+		}
+	case drift.FullCommand():
+		rec, err := c.MutateRecord(ctx, *driftName, backend.RecordMutation{BorkValue: *driftBorkValue, DataValue: *driftDataValue, Tags: *driftTags})
+		kingpin.FatalIfError(err, "Cannot make record drift")
+		kingpin.FatalIfError(printJSON(rec), "Cannot print record")
+	case corrupt.FullCommand():
+		rec, err := c.CorruptRecord(ctx, *corruptName, backend.CorruptionMode(*corruptMode))
+		kingpin.FatalIfError(err, "Cannot corrupt record")
+		kingpin.FatalIfError(printJSON(rec), "Cannot print record")
+	case rename.FullCommand():
+		rec, err := c.RenameRecord(ctx, *renameName, *renameTo)
+		kingpin.FatalIfError(err, "Cannot rename record")
+		kingpin.FatalIfError(printJSON(rec), "Cannot print record")
+	case controllers.FullCommand():
+		kingpin.FatalIfError(listControllers(ctx, c), "Cannot list controllers")
+	case trace.FullCommand():
+		kingpin.FatalIfError(printTrace(ctx, c, *traceKind, *traceNamespace, *traceName), "Cannot get trace")
+	case snapshot.FullCommand():
+		kingpin.FatalIfError(saveSnapshot(ctx, c, *snapshotFile), "Cannot save snapshot")
+	case restore.FullCommand():
+		kingpin.FatalIfError(restoreSnapshot(ctx, c, *restoreFile), "Cannot restore snapshot")
+	case dump.FullCommand():
+		kingpin.FatalIfError(dumpMetrics(ctx, hc, *dumpURL, *dumpPrefix), "Cannot dump metrics")
+	}
+}
+
+// listControllers prints the provider's controllers, and the state of each.
+func listControllers(ctx context.Context, c *admin.Client) error {
+	ctrls, err := c.Controllers(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KIND\tSTATE\tSINCE")
+	for _, ctrl := range ctrls {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", ctrl.Kind, ctrl.State, ctrl.Since.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+// printTrace prints the trace of the managed resource of the supplied kind,
+// namespace and name, or lists every traced resource if the kind is empty.
+func printTrace(ctx context.Context, c *admin.Client, kind, namespace, name string) error {
+	if kind != "" {
+		t, err := c.Trace(ctx, kind, namespace, name)
+		if err != nil {
+			return err
+		}
+		return printJSON(t)
+	}
+	ts, err := c.Traces(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KIND\tNAMESPACE\tNAME\tCYCLES\tREMAINING\tSTARTED")
+	for _, t := range ts {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", t.Resource.Kind, t.Resource.Namespace, t.Resource.Name, len(t.Cycles), t.Remaining, t.Started.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+// listNames prints the names of the stored resources of the supplied kind, or
+// of every kind if it's empty.
+func listNames(ctx context.Context, c *admin.Client, kind string) error {
+	inv := map[string][]string{}
+	kinds := backend.Kinds
+	if kind != "" {
+		names, err := c.Names(ctx, kind)
+		if err != nil {
+			return err
+		}
+		inv[kind] = names
+		kinds = []string{kind}
+	} else {
+		var err error
+		if inv, err = c.Inventory(ctx); err != nil {
+			return err
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KIND\tNAME")
+	for _, k := range kinds {
+		for _, name := range inv[k] {
+			_, _ = fmt.Fprintf(w, "%s\t%s\n", k, name)
+		}
+	}
+	return w.Flush()
+}
+
+// diffResources prints how the named BorkResource, or every BorkResource in
+// the supplied namespace if the name is empty, differs from its record. It
+// returns true if any differ.
+func diffResources(ctx context.Context, c *admin.Client, namespace, name string) (bool, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return false, errors.Wrap(err, errGetConfig)
+	}
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		return false, errors.Wrap(err, errAddToScheme)
+	}
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		return false, errors.Wrap(err, errNewClient)
+	}
+
+	l := &v1alpha1.BorkResourceList{}
+	if name != "" {
+		cr := v1alpha1.BorkResource{}
+		if err := kube.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &cr); err != nil {
+			return false, errors.Wrap(err, errGetBork)
+		}
+		l.Items = append(l.Items, cr)
+	} else if err := kube.List(ctx, l, client.InNamespace(namespace)); err != nil {
+		return false, errors.Wrap(err, errListBorks)
+	}
+
+	// A tenant's records are stored in the store of its BorkResources'
+	// namespace.
+	tc := *c
+	tc.Namespace = namespace
+
+	differ := false
+	for _, cr := range l.Items {
+		ext := meta.GetExternalName(&cr)
+		if ext == "" {
+			fmt.Printf("%s: not created yet\n", cr.GetName())
+			differ = true
+			continue
+		}
+		rec, err := tc.Record(ctx, ext)
+		if err != nil {
+			fmt.Printf("%s: %v\n", cr.GetName(), err)
+			differ = true
+			continue
+		}
+		d := borkresource.Diff(cr.Spec.ForProvider, rec)
+		if d == "" {
+			fmt.Printf("%s: record %s is up to date\n", cr.GetName(), ext)
+			continue
+		}
+		fmt.Printf("%s: record %s differs (-desired +observed):\n%s\n", cr.GetName(), ext, d)
+		differ = true
+	}
+	return differ, nil
+}
+
+// dumpMetrics prints the metrics served at the supplied URL, with their help
+// and type, whose names have the supplied prefix.
+func dumpMetrics(ctx context.Context, hc *http.Client, url, prefix string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf(errMetricsFmt, resp.Status)
+	}
+
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		line := sc.Text()
+		name := strings.TrimPrefix(strings.TrimPrefix(line, "# HELP "), "# TYPE ")
+		if strings.HasPrefix(name, prefix) {
+			fmt.Println(line)
+		}
+	}
+	return sc.Err()
+}
+
+// saveSnapshot writes a snapshot of the backend to the supplied file, or to
+// stdout if it's -.
+func saveSnapshot(ctx context.Context, c *admin.Client, file string) error {
+	if file == "-" {
+		return c.Snapshot(ctx, os.Stdout)
+	}
+	f, err := os.Create(filepath.Clean(file))
+	if err != nil {
+		return errors.Wrap(err, errCreateFile)
+	}
+	if err := c.Snapshot(ctx, f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return errors.Wrap(f.Close(), errCreateFile)
+}
+
+// restoreSnapshot restores the snapshot read from the supplied file, or from
+// stdin if it's -.
+func restoreSnapshot(ctx context.Context, c *admin.Client, file string) error {
+	if file == "-" {
+		return c.Restore(ctx, os.Stdin)
+	}
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return errors.Wrap(err, errOpenFile)
+	}
+	defer func() { _ = f.Close() }()
+	return c.Restore(ctx, f)
+}
+
+func printJSON(v any) error {
+	e := json.NewEncoder(os.Stdout)
+	e.SetIndent("", "  ")
+	return e.Encode(v)
+}
+
+func modes() []string {
+	m := make([]string, len(backend.CorruptionModes))
+	for i, mode := range backend.CorruptionModes {
+		m[i] = string(mode)
+	}
+	return m
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main generates load against the provider by creating, updating and
+// deleting BorkResources, and reports the provider's reconcile latency.
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/load"
+)
+
+func main() {
+	var (
+		app = kingpin.New(filepath.Base(os.Args[0]), "Generate load against the bork provider and report its reconcile latency.").DefaultEnvars()
+
+		run            = app.Flag("run", "Name of the load run. BorkResources are named for and labelled with the run. Generated if unset.").String()
+		namespace      = app.Flag("namespace", "Namespace to create BorkResources in.").Default("default").String()
+		providerConfig = app.Flag("provider-config", "Name of the ClusterProviderConfig the BorkResources reference.").Default("default").String()
+		count          = app.Flag("count", "How many BorkResources to create.").Default("1000").Int()
+		createRate     = app.Flag("create-rate", "BorkResources to create per second.").Default("50").Float64()
+		updateRate     = app.Flag("update-rate", "BorkResources to update per second during the soak.").Default("50").Float64()
+		deleteRate     = app.Flag("delete-rate", "BorkResources to delete per second.").Default("50").Float64()
+		soak           = app.Flag("soak", "How long to update BorkResources for once they're all created.").Default("5m").Duration()
+		settle         = app.Flag("settle", "How long to wait for the provider to finish reconciling before reporting its reconcile latency.").Default("30s").Duration()
+
+		metricsURL = app.Flag("metrics-url", "URL of the provider's Prometheus metrics, e.g. a port forward of its metrics port. Reconcile latency isn't reported if unset.").Default("http://localhost:8080/metrics").String()
+		qps        = app.Flag("kube-qps", "Requests per second the load generator may make to the API server.").Default("500").Float32()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	if *run == "" {
+		*run = "load-" + rand.String(5)
+	}
+	o := load.Options{
+		Run:            *run,
+		Namespace:      *namespace,
+		ProviderConfig: &xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: *providerConfig},
+		Count:          *count,
+		CreateRate:     *createRate,
+		UpdateRate:     *updateRate,
+		DeleteRate:     *deleteRate,
+		Soak:           *soak,
+	}
+	kingpin.FatalIfError(o.Validate(), "Invalid options")
+
+	cfg, err := ctrl.GetConfig()
+	kingpin.FatalIfError(err, "Cannot get API server rest config")
+	cfg.QPS = *qps
+	cfg.Burst = int(*qps) * 2
+
+	s := runtime.NewScheme()
+	kingpin.FatalIfError(apis.AddToScheme(s), "Cannot add APIs to scheme")
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	kingpin.FatalIfError(err, "Cannot create Kubernetes client")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	controller := managed.ControllerName(v1alpha1.BorkResourceGroupKind)
+	hc := &http.Client{Timeout: 10 * time.Second}
+	var before load.Histogram
+	if *metricsURL != "" {
+		before, err = load.Scrape(ctx, hc, *metricsURL, controller)
+		kingpin.FatalIfError(err, "Cannot scrape provider metrics")
+	}
+
+	fmt.Printf("Starting load run %s: %d BorkResources in namespace %s\n", o.Run, o.Count, o.Namespace)
+	start := time.Now()
+	r, err := load.NewGenerator(kube, o).Run(ctx)
+	fmt.Printf("Created %d, updated %d and deleted %d BorkResources in %s, with %d errors\n", r.Created, r.Updated, r.Deleted, time.Since(start).Round(time.Second), r.Errors)
+	if r.LastError != nil {
+		fmt.Printf("Last error: %v\n", r.LastError)
+	}
+	if err != nil {
+		kingpin.Fatalf("Load run interrupted: %v. Leftover BorkResources can be deleted with kubectl delete borkresources -n %s -l %s=%s", err, o.Namespace, load.LabelRun, o.Run)
+	}
+
+	if *metricsURL == "" {
+		return
+	}
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(*settle):
+	}
+	after, err := load.Scrape(ctx, hc, *metricsURL, controller)
+	kingpin.FatalIfError(err, "Cannot scrape provider metrics")
+	h := after.Sub(before)
+	fmt.Printf("Reconciles: %d, mean %s, p50 %s, p90 %s, p99 %s\n", h.Count, seconds(h.Mean()), seconds(h.Quantile(0.5)), seconds(h.Quantile(0.9)), seconds(h.Quantile(0.99)))
+}
+
+func seconds(s float64) string {
+	if math.IsNaN(s) {
+		return "n/a"
+	}
+	return time.Duration(s * float64(time.Second)).Round(time.Millisecond).String()
+}
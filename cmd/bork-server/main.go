@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main implements a bork API server, serving an in-memory bork backend
+// over HTTP or gRPC.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/tracing"
+	"github.com/crossplane/provider-bork/internal/version"
+)
+
+func main() {
+	var (
+		app   = kingpin.New(filepath.Base(os.Args[0]), "A bork API server backed by an in-memory bork backend.").DefaultEnvars()
+		debug = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
+
+		protocol = app.Flag("backend", "Protocol to serve the bork API with.").Default("http").Envar("BORK_SERVER_BACKEND").Enum("http", "grpc")
+		address  = app.Flag("address", "Address to listen on.").Default(":8080").Envar("BORK_SERVER_ADDRESS").String()
+		tlsCert  = app.Flag("tls-cert-file", "Path to a PEM encoded TLS certificate. The server serves without TLS unless a certificate and key are supplied.").Envar("BORK_SERVER_TLS_CERT_FILE").ExistingFile()
+		tlsKey   = app.Flag("tls-key-file", "Path to the PEM encoded private key of the TLS certificate.").Envar("BORK_SERVER_TLS_KEY_FILE").ExistingFile()
+		token    = app.Flag("token", "Bearer token clients must present. Requests are not authenticated if unset.").Envar("BORK_SERVER_TOKEN").String()
+		shutdown = app.Flag("shutdown-timeout", "How long to wait for in-flight requests to finish when shutting down.").Default("10s").Duration()
+
+		throttleRate  = app.Flag("throttle-rate", "Simulate API throttling by serving at most this many operations per second. Operations in excess of the rate are rejected with a hint of when to retry. Requests are not throttled if unset.").Envar("BORK_SERVER_THROTTLE_RATE").Float64()
+		throttleBurst = app.Flag("throttle-burst", "How many operations may be served in a burst when throttling.").Default("10").Envar("BORK_SERVER_THROTTLE_BURST").Int()
+
+		hang           = app.Flag("hang", "Simulate an unresponsive API by waiting this long before serving each operation. Operations whose clients give up waiting first are not served. The server doesn't hang if unset.").Envar("BORK_SERVER_HANG").Duration()
+		hangOperations = app.Flag("hang-operation", "Operation that hangs, e.g. Update or CreateBucket. May be repeated. Every operation hangs if unset.").Envar("BORK_SERVER_HANG_OPERATIONS").Strings()
+
+		duplicateCreates = app.Flag("duplicate-creates", "What to do when asked to create a resource that already exists, as when a create that succeeded is retried. Reject fails the create with AlreadyExists. Succeed returns the existing resource, like an idempotent API.").Default(string(backend.DuplicateCreateReject)).Envar("BORK_SERVER_DUPLICATE_CREATES").Enum(string(backend.DuplicateCreateReject), string(backend.DuplicateCreateSucceed))
+
+		unknownFields = app.Flag("unknown-fields", "Whether to attach fields clients don't know about to records, as a newer version of the bork API would. Off attaches none. Attach attaches them, and an update that doesn't send them back loses them. Strict also fails such updates, to catch a client that clobbers them.").Default(string(backend.UnknownFieldsOff)).Envar("BORK_SERVER_UNKNOWN_FIELDS").Enum(string(backend.UnknownFieldsOff), string(backend.UnknownFieldsAttach), string(backend.UnknownFieldsStrict))
+
+		clockSkew  = app.Flag("clock-skew", "Simulate a server whose clock differs from its clients' by offsetting the timestamps of the records it returns, e.g. 10m to put its clock ahead or -10m to put it behind.").Envar("BORK_SERVER_CLOCK_SKEW").Duration()
+		apiVersion = app.Flag("api-version", "Version of the bork API to serve, a date like "+backend.ClientAPIVersion+". Clients that expect a different version report that it's deprecated.").Default(backend.DefaultAPIVersion).Envar("BORK_SERVER_API_VERSION").String()
+
+		otlpEndpoint    = app.Flag("tracing-otlp-endpoint", "Address of an OTLP gRPC collector to export traces to, e.g. otel-collector:4317. Tracing is disabled if unset.").Envar("BORK_SERVER_TRACING_OTLP_ENDPOINT").String()
+		otlpInsecure    = app.Flag("tracing-otlp-insecure", "Export traces without TLS.").Envar("BORK_SERVER_TRACING_OTLP_INSECURE").Bool()
+		traceSampleRate = app.Flag("tracing-sample-ratio", "Fraction of traces to sample, from 0 to 1. Calls from a client whose trace was sampled are always sampled.").Default("1").Envar("BORK_SERVER_TRACING_SAMPLE_RATIO").Float64()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	if (*tlsCert == "") != (*tlsKey == "") {
+		kingpin.Fatalf("--tls-cert-file and --tls-key-file must be supplied together")
+	}
+
+	kingpin.FatalIfError(backend.ValidateAPIVersion(*apiVersion), "Invalid --api-version")
+
+	log := logging.NewLogrLogger(zap.New(zap.UseDevMode(*debug)).WithName("bork-server"))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	stopTracing, err := tracing.Setup(ctx, tracing.Options{
+		Service:     "bork-server",
+		Endpoint:    *otlpEndpoint,
+		Insecure:    *otlpInsecure,
+		SampleRatio: *traceSampleRate,
+	})
+	kingpin.FatalIfError(err, "Cannot setup tracing")
+	defer func() {
+		if err := stopTracing(context.Background()); err != nil {
+			log.Info("Cannot flush traces", "error", err)
+		}
+	}()
+
+	store := backend.NewStore()
+	store.SetThrottle(*throttleRate, *throttleBurst)
+	store.SetHang(*hang, *hangOperations...)
+	store.SetDuplicateCreatePolicy(backend.DuplicateCreatePolicy(*duplicateCreates))
+	store.SetUnknownFieldsMode(backend.UnknownFieldsMode(*unknownFields))
+	store.SetAPIVersion(*apiVersion)
+	store.SetClockSkew(*clockSkew)
+	log.Info("Serving bork API", "version", version.Version, "api-version", *apiVersion, "backend", *protocol, "address", *address, "tls", *tlsCert != "", "authenticated", *token != "", "throttle-rate", *throttleRate)
+
+	if *protocol == "grpc" {
+		serveGRPC(ctx, log, store, *address, *tlsCert, *tlsKey, *token, *shutdown)
+		return
+	}
+	serveHTTP(ctx, log, store, *address, *tlsCert, *tlsKey, *token, *shutdown)
+}
+
+func serveHTTP(ctx context.Context, log logging.Logger, store *backend.Store, address, tlsCert, tlsKey, token string, shutdown time.Duration) {
+	srv := &http.Server{
+		Addr:              address,
+		Handler:           backend.NewHandler(store, token),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		sctx, cancel := context.WithTimeout(context.Background(), shutdown)
+		defer cancel()
+		if err := srv.Shutdown(sctx); err != nil {
+			log.Info("Cannot shut down gracefully", "error", err)
+		}
+	}()
+
+	var err error
+	if tlsCert != "" {
+		err = srv.ListenAndServeTLS(tlsCert, tlsKey)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
+		kingpin.FatalIfError(err, "Cannot serve bork API")
+	}
+}
+
+func serveGRPC(ctx context.Context, log logging.Logger, store *backend.Store, address, tlsCert, tlsKey, token string, shutdown time.Duration) {
+	var o []grpc.ServerOption
+	if tlsCert != "" {
+		cert, err := tls.LoadX509KeyPair(tlsCert, tlsKey)
+		kingpin.FatalIfError(err, "Cannot load TLS certificate")
+		o = append(o, grpc.Creds(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12, Certificates: []tls.Certificate{cert}})))
+	}
+	srv := backend.NewGRPCServer(store, token, o...)
+
+	lis, err := net.Listen("tcp", address)
+	kingpin.FatalIfError(err, "Cannot listen")
+
+	go func() {
+		<-ctx.Done()
+		done := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(shutdown):
+			log.Info("Cannot shut down gracefully", "error", "timed out waiting for in-flight calls")
+			srv.Stop()
+		}
+	}()
+
+	kingpin.FatalIfError(srv.Serve(lis), "Cannot serve bork API")
+}
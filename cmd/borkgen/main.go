@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main generates a composite resource definition, a Composition that
+// composes bork managed resources, the functions it calls and an example
+// composite resource. It also generates an example of every kind of the
+// provider's API from its CustomResourceDefinitions.
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/provider-bork/internal/composition"
+	"github.com/crossplane/provider-bork/internal/examples"
+)
+
+const header = "# Generated by borkgen. DO NOT EDIT.\n"
+
+const errMarshalFmt = "cannot marshal %s %s"
+
+func main() {
+	var (
+		app = kingpin.New(filepath.Base(os.Args[0]), "Generate manifests that use bork managed resources.").DefaultEnvars()
+
+		compositionCmd = app.Command("composition", "Generate a composite resource definition and a Composition that compose bork managed resources.").Default()
+		outputDir      = compositionCmd.Flag("output-dir", "Directory to write the generated manifests to. Manifests are written to stdout if unset.").String()
+		group          = compositionCmd.Flag("group", "API group of the composite resource.").Default(composition.DefaultGroup).String()
+		kind           = compositionCmd.Flag("kind", "Kind of the composite resource.").Default(composition.DefaultKind).String()
+		kinds          = compositionCmd.Flag("compose", "Kind of bork managed resource to compose. May be repeated. One of "+strings.Join(composition.ComposableKinds(), ", ")+".").Default(composition.ComposableKinds()...).Strings()
+
+		ptPackage = compositionCmd.Flag("function-patch-and-transform-package", "Package of function-patch-and-transform.").Default(composition.DefaultPatchAndTransformPackage).String()
+		arPackage = compositionCmd.Flag("function-auto-ready-package", "Package of function-auto-ready.").Default(composition.DefaultAutoReadyPackage).String()
+
+		examplesCmd       = app.Command("examples", "Generate an example of every kind of the provider's API, and of every credentials source of its provider configs.")
+		crdDir            = examplesCmd.Flag("crd-dir", "Directory of the provider's CustomResourceDefinitions.").Default("package/crds").ExistingDir()
+		examplesOutputDir = examplesCmd.Flag("output-dir", "Directory to write the generated examples to. Examples are written to stdout if unset.").String()
+	)
+
+	if kingpin.MustParse(app.Parse(os.Args[1:])) == examplesCmd.FullCommand() {
+		generateExamples(*crdDir, *examplesOutputDir)
+		return
+	}
+
+	o := composition.Options{
+		Group:                    *group,
+		Kind:                     *kind,
+		Kinds:                    *kinds,
+		PatchAndTransformPackage: *ptPackage,
+		AutoReadyPackage:         *arPackage,
+	}
+	kingpin.FatalIfError(o.Validate(), "Invalid options")
+
+	comp, err := composition.Composition(o)
+	kingpin.FatalIfError(err, "Cannot generate Composition")
+
+	files := []struct {
+		name string
+		objs []*unstructured.Unstructured
+	}{
+		{name: "definition.yaml", objs: []*unstructured.Unstructured{composition.Definition(o)}},
+		{name: "composition.yaml", objs: []*unstructured.Unstructured{comp}},
+		{name: "functions.yaml", objs: composition.Functions(o)},
+		{name: "composite.yaml", objs: []*unstructured.Unstructured{composition.Composite(o)}},
+	}
+
+	if *outputDir == "" {
+		for i, f := range files {
+			if i > 0 {
+				_, _ = io.WriteString(os.Stdout, "---\n")
+			}
+			kingpin.FatalIfError(write(os.Stdout, f.objs), "Cannot write %s", f.name)
+		}
+		return
+	}
+
+	kingpin.FatalIfError(os.MkdirAll(*outputDir, 0o755), "Cannot create output directory")
+	for _, f := range files {
+		b := &bytes.Buffer{}
+		b.WriteString(header)
+		kingpin.FatalIfError(write(b, f.objs), "Cannot generate %s", f.name)
+		kingpin.FatalIfError(os.WriteFile(filepath.Join(*outputDir, f.name), b.Bytes(), 0o644), "Cannot write %s", f.name)
+	}
+}
+
+// generateExamples generates an example of every kind defined by the
+// CustomResourceDefinitions in the supplied directory.
+func generateExamples(crdDir, outputDir string) {
+	crds, err := examples.LoadCRDs(crdDir)
+	kingpin.FatalIfError(err, "Cannot load CustomResourceDefinitions")
+	exs, err := examples.Generate(crds)
+	kingpin.FatalIfError(err, "Cannot generate examples")
+
+	if outputDir == "" {
+		for i, e := range exs {
+			if i > 0 {
+				_, _ = io.WriteString(os.Stdout, "---\n")
+			}
+			_, err := os.Stdout.Write(e.Manifest)
+			kingpin.FatalIfError(err, "Cannot write %s", e.Name)
+		}
+		return
+	}
+
+	kingpin.FatalIfError(os.MkdirAll(outputDir, 0o755), "Cannot create output directory")
+	for _, e := range exs {
+		kingpin.FatalIfError(os.WriteFile(filepath.Join(outputDir, e.Name), append([]byte(header), e.Manifest...), 0o644), "Cannot write %s", e.Name)
+	}
+}
+
+// write writes the supplied objects to the supplied writer as a stream of
+// YAML documents.
+func write(w io.Writer, objs []*unstructured.Unstructured) error {
+	for i, o := range objs {
+		b, err := yaml.Marshal(o.Object)
+		if err != nil {
+			return errors.Wrapf(err, errMarshalFmt, o.GetKind(), o.GetName())
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
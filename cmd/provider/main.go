@@ -17,20 +17,35 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/alecthomas/kingpin/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	changelogsv1alpha1 "github.com/crossplane/crossplane-runtime/v2/apis/changelogs/proto/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
@@ -45,25 +60,128 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/crossplane/provider-bork/apis"
+	"github.com/crossplane/provider-bork/internal/admin"
+	"github.com/crossplane/provider-bork/internal/audit"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/changelogsink"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
 	bork "github.com/crossplane/provider-bork/internal/controller"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	borkmetrics "github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/profiling"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/scenario"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
 	"github.com/crossplane/provider-bork/internal/version"
+	borkwebhook "github.com/crossplane/provider-bork/internal/webhook"
 )
 
 func main() {
 	var (
 		app            = kingpin.New(filepath.Base(os.Args[0]), "Bork support for Crossplane.").DefaultEnvars()
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
+		debugExternal  = app.Flag("debug-external", "Log every operation on an external resource, including connecting and observing, at info level. Otherwise only operations that write to an external resource, or fail, are logged, at debug level.").Envar("DEBUG_EXTERNAL").Bool()
 		leaderElection = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").Envar("LEADER_ELECTION").Bool()
 
+		leaseDuration   = app.Flag("leader-election-lease-duration", "How long a standby replica waits after the leader last renewed its lease before it tries to take over. Lower it to fail over faster.").Default("60s").Envar("LEADER_ELECTION_LEASE_DURATION").Duration()
+		renewDeadline   = app.Flag("leader-election-renew-deadline", "How long the leader keeps trying to renew its lease before it stops leading. Must be less than the lease duration.").Default("50s").Envar("LEADER_ELECTION_RENEW_DEADLINE").Duration()
+		retryPeriod     = app.Flag("leader-election-retry-period", "How often replicas try to acquire or renew the lease.").Default("2s").Envar("LEADER_ELECTION_RETRY_PERIOD").Duration()
+		leaderReadiness = app.Flag("leader-election-readiness", "Report the provider ready only while it is the leader, in addition to the usual readiness checks.").Envar("LEADER_ELECTION_READINESS").Bool()
+
 		syncInterval            = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval            = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
 		pollStateMetricInterval = app.Flag("poll-state-metric", "State metric recording interval").Default("5s").Duration()
+		timeout                 = app.Flag("timeout", "How long the operations a managed resource's reconcile performs on its external resource may take in total. Operations still running when it passes are cancelled, and reported by the BackendError condition with the Timeout reason.").Default(middleware.DefaultReconcileTimeout.String()).Envar("TIMEOUT").Duration()
+
+		clientTTL = app.Flag("backend-client-ttl", "How long a backend client is shared by the managed resources that use the same provider config before it's replaced. Set to 0 to connect to the backend every reconcile.").Default(clients.DefaultPoolTTL.String()).Duration()
+
+		backendMode     = app.Flag("backend", "Backend that provider configs without an endpoint connect to. The memory and file backends are the in-process backend; a file backend persists to --backend-file, so that external resources survive provider restarts. The http and grpc backends are the bork API server at --backend-endpoint.").Default(backend.BackendMemory).Envar("BACKEND").Enum(backend.Backends()...)
+		backendEndpoint = app.Flag("backend-endpoint", "URL of the bork API server the http and grpc backends connect to, e.g. https://bork.example.org.").Envar("BACKEND_ENDPOINT").String()
+		tenancy         = app.Flag("backend-tenancy", "How the in-process backend is partitioned between tenants. Shared stores every resource in one store. Namespace gives each namespace a store of its own, with its own account, throttle, drift and quotas, so that one tenant can't affect another's resources.").Default(string(backend.TenancyShared)).Envar("BACKEND_TENANCY").Enum(tenancyModes()...)
+		backendFile     = app.Flag("backend-file", "Path of the JSON file the file backend persists to. It is created if it doesn't exist.").Default("bork-backend.json").Envar("BACKEND_FILE").String()
+
+		leakPolicy      = app.Flag("leak-policy", "What to do with leaked external resources, which no managed resource refers to by external name for longer than --leak-grace-period. Report records an event of the managed resource's CustomResourceDefinition and increments a metric the first time each is found. Delete deletes them from the in-process backend. Ignore disables the sweeper.").Default(string(leak.PolicyReport)).Envar("LEAK_POLICY").Enum(leakPolicies()...)
+		leakInterval    = app.Flag("leak-sweep-interval", "How often the in-process backend is swept for leaked external resources.").Default(leak.DefaultInterval.String()).Envar("LEAK_SWEEP_INTERVAL").Duration()
+		leakGracePeriod = app.Flag("leak-grace-period", "How long an external resource must have no managed resource before it's considered leaked.").Default(leak.DefaultGracePeriod.String()).Envar("LEAK_GRACE_PERIOD").Duration()
+
+		notificationAddress = app.Flag("notification-receiver-address", "Address on which to receive notifications of backend changes for provider configs that watch in Webhook mode, e.g. :8084. The receiver is disabled if unset.").Envar("NOTIFICATION_RECEIVER_ADDRESS").String()
+		notificationURL     = app.Flag("notification-receiver-url", "URL at which the backend reaches the notification receiver, e.g. http://10.0.0.1:8084. It must reach the leader replica. Defaults to http://localhost on the port of --notification-receiver-address.").Envar("NOTIFICATION_RECEIVER_URL").String()
+
+		throttleRate  = app.Flag("backend-throttle-rate", "Simulate API throttling by having the in-process backend perform at most this many operations per second. Throttled resources are requeued once the backend's retry-after hint has passed. The backend is not throttled if unset.").Envar("BACKEND_THROTTLE_RATE").Float64()
+		throttleBurst = app.Flag("backend-throttle-burst", "How many operations the in-process backend may perform in a burst when throttling.").Default("10").Envar("BACKEND_THROTTLE_BURST").Int()
+
+		hang           = app.Flag("backend-hang", "Simulate an unresponsive API by having the in-process backend wait this long before it performs each operation. Set it longer than --timeout to make reconciles time out. The backend doesn't hang if unset.").Envar("BACKEND_HANG").Duration()
+		hangOperations = app.Flag("backend-hang-operation", "Backend operation that hangs, e.g. Update or CreateBucket. May be repeated. Every operation hangs if unset.").Envar("BACKEND_HANG_OPERATIONS").Strings()
+
+		duplicateCreates = app.Flag("backend-duplicate-creates", "What the in-process backend does when asked to create a resource that already exists, as when a create that succeeded is retried. Reject fails the create with AlreadyExists. Succeed returns the existing resource, like an idempotent API.").Default(string(backend.DuplicateCreateReject)).Envar("BACKEND_DUPLICATE_CREATES").Enum(duplicateCreatePolicies()...)
+
+		listPageSize      = app.Flag("backend-list-page-size", "Most names the in-process backend returns in each page of a list, as used by the leak sweeper and orphaned resource metrics.").Default(strconv.Itoa(backend.DefaultListPageSize)).Envar("BACKEND_LIST_PAGE_SIZE").Int()
+		listInconsistency = app.Flag("backend-list-inconsistency", "Fraction of the in-process backend's list pages, from 0 to 1, that repeat some of the names of the page before them, as an eventually consistent API's pages might.").Default("0").Envar("BACKEND_LIST_INCONSISTENCY").Float64()
+
+		unknownFields = app.Flag("backend-unknown-fields", "Whether the in-process backend attaches fields its clients don't know about to records, as a newer version of the bork API would. Off attaches none. Attach attaches them, and an update that doesn't send them back loses them. Strict also fails such updates, to catch a client that clobbers them.").Default(string(backend.UnknownFieldsOff)).Envar("BACKEND_UNKNOWN_FIELDS").Enum(unknownFieldsModes()...)
+		clockSkew     = app.Flag("backend-clock-skew", "Simulate a backend whose clock differs from the provider's by offsetting the timestamps of the records the in-process backend returns, e.g. 10m to put its clock ahead or -10m to put it behind. BorkResources normalize implausible timestamps and report the skew with the bork_backend_clock_skew_seconds metric.").Envar("BACKEND_CLOCK_SKEW").Duration()
+		scenarioFile  = app.Flag("scenario", "Path of a YAML file scripting how the in-process backend behaves over time, as phases that start a while after the provider does, e.g. failing creates for 5m, then drifting every record at 10m, then throttling at 20m. Behaviors a phase changes are restored to how these flags configure them when it ends.").Envar("SCENARIO").ExistingFile()
+		apiVersion    = app.Flag("backend-api-version", "Version of the bork API the in-process backend serves, a date like "+backend.ClientAPIVersion+". Resources observed using a backend that serves a different version than the provider expects have a DeprecatedAPI condition.").Default(backend.DefaultAPIVersion).Envar("BACKEND_API_VERSION").String()
+
+		drainOnShutdown = app.Flag("drain-on-shutdown", "Drain the provider when it receives SIGTERM: start no new reconciles, finish those in flight, and optionally flush pending deletes, waiting at most --drain-timeout before exiting.").Envar("DRAIN_ON_SHUTDOWN").Bool()
+		drainDeletes    = app.Flag("drain-flush-deletes", "While draining, keep reconciling managed resources that are being deleted until their external resources are deleted.").Envar("DRAIN_FLUSH_DELETES").Bool()
+		drainDeleteRate = app.Flag("drain-delete-rate", "Most managed resources that are being deleted to reconcile per second while flushing pending deletes.").Default(strconv.Itoa(drain.DefaultDeleteRate)).Envar("DRAIN_DELETE_RATE").Float64()
+		drainTimeout    = app.Flag("drain-timeout", "How long to wait for pending deletes to be flushed, then for reconciles in flight to finish, when draining.").Default(drain.DefaultTimeout.String()).Envar("DRAIN_TIMEOUT").Duration()
+
+		auditLog = app.Flag("audit-log", "Path of a file to append an audit log of every create, update and delete the provider performs on external resources to, one JSON entry per line, recording who performed it, when, on which resource, with what diff, and its result. Set it to - to write the audit log to stdout. The audit log is disabled if unset.").Envar("AUDIT_LOG").String()
+
+		recordFile = app.Flag("record", "Path of a file to record every call the provider makes to its backends to, one JSON interaction per line, including each call's operation, request, response and latency. The file is truncated.").Envar("RECORD").String()
+		replayFile = app.Flag("replay", "Path of a recording made using --record. Every call the provider makes to a backend is served from the recording instead, to reproduce a bug report deterministically.").Envar("REPLAY").ExistingFile()
+
+		adminAddress = app.Flag("admin-address", "Address on which to serve the admin API, which lists, mutates, corrupts and deletes the in-process backend's records, marks its regions unhealthy, fails its transactions and snapshots profiles, while the provider runs, e.g. :9090. Requires --admin-token. The admin API is disabled if unset.").Envar("ADMIN_ADDRESS").String()
+		adminToken   = app.Flag("admin-token", "Bearer token every request to the admin API must present.").Envar("ADMIN_TOKEN").String()
 
-		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		profile          = app.Flag("profile", "Serve the Go runtime's pprof profiles beneath /debug/pprof/ on the metrics server, and enable writing snapshots of them to --profile-dir periodically and on demand using the admin API.").Envar("PROFILE").Bool()
+		profileDir       = app.Flag("profile-dir", "Directory to write profile snapshots to. It is created if it doesn't exist.").Default(profiling.DefaultDirectory).Envar("PROFILE_DIR").String()
+		profileInterval  = app.Flag("profile-snapshot-interval", "How often to snapshot the goroutine and heap profiles. Profiles are only snapshotted on demand if unset.").Envar("PROFILE_SNAPSHOT_INTERVAL").Duration()
+		profileRetention = app.Flag("profile-snapshot-retain", "How many of the latest snapshots of each profile to keep. Every snapshot is kept if it's 0.").Default(strconv.Itoa(profiling.DefaultRetain)).Envar("PROFILE_SNAPSHOT_RETAIN").Int()
+
+		shardKey   = app.Flag("shard-key", "This replica's shard, from 0 to one less than --shard-count. May also be a name that ends with the shard, like the name of a StatefulSet pod, e.g. provider-bork-2.").Default("0").Envar("SHARD_KEY").String()
+		shardCount = app.Flag("shard-count", "How many shards managed resources are divided between. Each replica reconciles only the managed resources whose namespace and name hash to its shard.").Default("1").Envar("SHARD_COUNT").Int()
+
+		maxReconcileRate  = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		maxReconcileBurst = app.Flag("max-reconcile-burst", "How many resources may be checked in a burst above --max-reconcile-rate, i.e. the size of the global rate limiter's token bucket. Defaults to ten times --max-reconcile-rate.").Envar("MAX_RECONCILE_BURST").Int()
+		backoffBaseDelay  = app.Flag("backoff-base-delay", "How long a resource whose reconcile failed is first requeued after. The delay doubles every time its reconcile fails in a row.").Default(ratelimit.DefaultBaseDelay.String()).Envar("BACKOFF_BASE_DELAY").Duration()
+		backoffMaxDelay   = app.Flag("backoff-max-delay", "The longest a resource whose reconcile keeps failing is requeued after.").Default(ratelimit.DefaultMaxDelay.String()).Envar("BACKOFF_MAX_DELAY").Duration()
+		maxCreateRate     = app.Flag("max-create-rate", "The global maximum rate per second at which external resources may be created, across every kind. Creates in excess of the rate are queued, and their resources get a CreationThrottled condition until their turn comes. Creates aren't limited if zero.").Default("0").Envar("MAX_CREATE_RATE").Float64()
+		maxCreateBurst    = app.Flag("max-create-burst", "How many external resources may be created in a burst above --max-create-rate.").Default("1").Envar("MAX_CREATE_BURST").Int()
+		retryBudget       = app.Flag("retry-budget", "How many attempts to create or update a managed resource's external resource may fail per hour. Once they have, no more are made until the hour ends or its spec changes, and it gets a RetryBudgetExhausted condition. Attempts aren't budgeted if zero.").Default("0").Envar("RETRY_BUDGET").Int()
+
+		disableKinds = app.Flag("disable-kinds", "Comma separated kinds whose controllers aren't started, e.g. BorkBucket,BorkQueue, to quarantine a misbehaving kind. Resources of a disabled kind aren't reconciled, even to be deleted. One of "+strings.Join(bork.Kinds(), ", ")+".").Envar("DISABLE_KINDS").String()
+
+		maxConcurrentReconciles = app.Flag("max-concurrent-reconciles", "How many managed resources of a kind may be reconciled at once, e.g. BorkResource=4. May be repeated. Kinds that aren't limited may use all of their controller's workers, of which there are --max-reconcile-rate.").PlaceHolder("KIND=N").StringMap()
+		concurrencyConfigMap    = app.Flag("concurrency-configmap", "Namespace and name of a ConfigMap, e.g. crossplane-system/bork-concurrency, whose data overrides --max-concurrent-reconciles while the provider runs. Limits are only set by flags if unset.").Envar("CONCURRENCY_CONFIGMAP").String()
 
 		enableManagementPolicies = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
 		enableChangeLogs         = app.Flag("enable-changelogs", "Enable support for capturing change logs during reconciliation.").Default("false").Envar("ENABLE_CHANGE_LOGS").Bool()
+		enableExperimental       = app.Flag("enable-experimental-kinds", "Enable alpha support for experimental kinds, BorkQueue and BorkTopic, whose controllers aren't started unless it's enabled.").Default("false").Envar("ENABLE_EXPERIMENTAL_KINDS").Bool()
+		enableRealtime           = app.Flag("enable-realtime-compositions", "Enable alpha support for realtime compositions, which reconcile BorkObjects as soon as the BorkBucket they reference changes.").Default("false").Envar("ENABLE_REALTIME_COMPOSITIONS").Bool()
 		changelogsSocketPath     = app.Flag("changelogs-socket-path", "Path for changelogs socket (if enabled)").Default("/var/run/changelogs/changelogs.sock").Envar("CHANGELOGS_SOCKET_PATH").String()
+		changelogsSink           = app.Flag("changelogs-sink", "Serve an embedded change log service on --changelogs-socket-path, which records change logs to --changelogs-sink-file and serves the most recent at /changelogs on --changelogs-sink-address. Use it to verify change logs without the change log sidecar.").Envar("CHANGELOGS_SINK").Bool()
+		changelogsSinkFile       = app.Flag("changelogs-sink-file", "File the embedded change log service appends change logs to, one JSON entry per line.").Default("changelogs.jsonl").Envar("CHANGELOGS_SINK_FILE").String()
+		changelogsSinkAddress    = app.Flag("changelogs-sink-address", "Address the embedded change log service serves change logs over HTTP on.").Default(":8083").Envar("CHANGELOGS_SINK_ADDRESS").String()
+		featuresConfigMapRef     = app.Flag("features-configmap", "Namespace and name of a ConfigMap, e.g. crossplane-system/bork-features, whose data enables or disables EnableBetaManagementPolicies, EnableAlphaChangeLogs and EnableAlphaRealtimeCompositions while the provider runs, overriding their flags.").Envar("FEATURES_CONFIGMAP").String()
+
+		webhookCertDir = app.Flag("webhook-tls-cert-dir", "Directory containing the tls.crt and tls.key files the admission webhook server serves. Webhooks are disabled if unset.").Envar("WEBHOOK_TLS_CERT_DIR").String()
+		webhookPort    = app.Flag("webhook-port", "Port the admission webhook server listens on.").Default("9443").Int()
+
+		healthProbeAddress = app.Flag("health-probe-bind-address", "Address the /healthz and /readyz probe endpoints are served on. The provider is ready only when the backend of every provider config can be reached using its credentials.").Default(":8081").Envar("HEALTH_PROBE_BIND_ADDRESS").String()
+
+		otlpEndpoint    = app.Flag("tracing-otlp-endpoint", "Address of an OTLP gRPC collector to export traces to, e.g. otel-collector:4317. Tracing is disabled if unset.").Envar("TRACING_OTLP_ENDPOINT").String()
+		otlpInsecure    = app.Flag("tracing-otlp-insecure", "Export traces without TLS.").Envar("TRACING_OTLP_INSECURE").Bool()
+		traceSampleRate = app.Flag("tracing-sample-ratio", "Fraction of reconciles to trace, from 0 to 1.").Default("1").Envar("TRACING_SAMPLE_RATIO").Float64()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -80,6 +198,86 @@ func main() {
 		ctrl.SetLogger(zap.New(zap.WriteTo(io.Discard)))
 	}
 
+	stopTracing, err := tracing.Setup(context.Background(), tracing.Options{
+		Service:     "provider-bork",
+		Endpoint:    *otlpEndpoint,
+		Insecure:    *otlpInsecure,
+		SampleRatio: *traceSampleRate,
+	})
+	kingpin.FatalIfError(err, "Cannot setup tracing")
+	defer func() {
+		if err := stopTracing(context.Background()); err != nil {
+			log.Info("Cannot flush traces", "error", err)
+		}
+	}()
+
+	if *backendMode == backend.BackendFile && backend.TenancyMode(*tenancy) == backend.TenancyNamespace {
+		kingpin.Fatalf("--backend=file doesn't support --backend-tenancy=%s", backend.TenancyNamespace)
+	}
+	switch remote := *backendMode == backend.BackendHTTP || *backendMode == backend.BackendGRPC; {
+	case remote && *backendEndpoint == "":
+		kingpin.Fatalf("--backend=%s requires --backend-endpoint", *backendMode)
+	case !remote && *backendEndpoint != "":
+		kingpin.Fatalf("--backend-endpoint requires --backend=%s or --backend=%s", backend.BackendHTTP, backend.BackendGRPC)
+	}
+	if *listInconsistency < 0 || *listInconsistency > 1 {
+		kingpin.Fatalf("--backend-list-inconsistency (%v) must be between 0 and 1", *listInconsistency)
+	}
+	if *renewDeadline >= *leaseDuration {
+		kingpin.Fatalf("--leader-election-renew-deadline (%s) must be less than --leader-election-lease-duration (%s)", *renewDeadline, *leaseDuration)
+	}
+	if *recordFile != "" && *replayFile != "" {
+		kingpin.Fatalf("--record can't be combined with --replay")
+	}
+	if *adminAddress != "" && *adminToken == "" {
+		kingpin.Fatalf("--admin-address requires --admin-token")
+	}
+	if *drainDeletes && !*drainOnShutdown {
+		kingpin.Fatalf("--drain-flush-deletes requires --drain-on-shutdown")
+	}
+	if *drainDeleteRate <= 0 {
+		kingpin.Fatalf("--drain-delete-rate (%v) must be greater than 0", *drainDeleteRate)
+	}
+
+	kingpin.FatalIfError(backend.ValidateAPIVersion(*apiVersion), "Invalid --backend-api-version")
+	kingpin.FatalIfError(shard.Default.Set(*shardKey, *shardCount), "Invalid shard")
+
+	disabled := kindList(*disableKinds)
+	for _, k := range disabled {
+		if !slices.Contains(bork.Kinds(), k) {
+			kingpin.Fatalf("Invalid --disable-kinds: unknown kind %q, must be one of %s", k, strings.Join(bork.Kinds(), ", "))
+		}
+	}
+	quarantine.Default.Disable(disabled...)
+
+	// Each shard elects its own leader, so that shards run concurrently.
+	leaderElectionID := "crossplane-leader-election-provider-bork"
+	if shard.Default.Count() > 1 {
+		leaderElectionID = fmt.Sprintf("%s-shard-%d-of-%d", leaderElectionID, shard.Default.Index(), shard.Default.Count())
+		log.Info("Reconciling one shard of managed resources", "shard", shard.Default.Index(), "shards", shard.Default.Count())
+	}
+
+	limits, err := concurrency.Parse(*maxConcurrentReconciles)
+	kingpin.FatalIfError(err, "Invalid --max-concurrent-reconciles")
+	concurrency.Default.Configure(*maxReconcileRate, limits)
+
+	if *maxReconcileBurst == 0 {
+		*maxReconcileBurst = *maxReconcileRate * ratelimit.DefaultBurstPerRate
+	}
+	globalRateLimiter, err := ratelimit.NewGlobal(*maxReconcileRate, *maxReconcileBurst)
+	kingpin.FatalIfError(err, "Invalid --max-reconcile-burst")
+	kingpin.FatalIfError(middleware.Creations.Set(*maxCreateRate, *maxCreateBurst), "Invalid --max-create-burst")
+	kingpin.FatalIfError(ratelimit.Default.Set(*backoffBaseDelay, *backoffMaxDelay), "Invalid backoff")
+
+	limitsConfigMap := configMapRef("--concurrency-configmap", *concurrencyConfigMap)
+	featuresConfigMap := configMapRef("--features-configmap", *featuresConfigMapRef)
+
+	// Only cache the ConfigMaps the provider watches, not every ConfigMap.
+	cacheByObject := map[client.Object]cache.ByObject{}
+	if ns := configMapNamespaces(limitsConfigMap, featuresConfigMap); len(ns) > 0 {
+		cacheByObject[&corev1.ConfigMap{}] = cache.ByObject{Namespaces: ns}
+	}
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -88,6 +286,7 @@ func main() {
 		// The recommended way is to move it to cache.Options instead
 		Cache: cache.Options{
 			SyncPeriod: syncInterval,
+			ByObject:   cacheByObject,
 		},
 
 		// controller-runtime uses both ConfigMaps and Leases for leader
@@ -96,12 +295,32 @@ func main() {
 		// renewal deadlines being exceeded when under high load - i.e.
 		// hundreds of reconciles per second and ~200rps to the API
 		// server. Switching to Leases only and longer leases appears to
-		// alleviate this.
+		// alleviate this, so our defaults are longer. Shorter leases fail
+		// over faster.
 		LeaderElection:             *leaderElection,
-		LeaderElectionID:           "crossplane-leader-election-provider-bork",
+		LeaderElectionID:           leaderElectionID,
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
-		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
-		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+		LeaseDuration:              leaseDuration,
+		RenewDeadline:              renewDeadline,
+		RetryPeriod:                retryPeriod,
+
+		HealthProbeBindAddress: *healthProbeAddress,
+
+		Metrics: metricsserver.Options{
+			ExtraHandlers:  profilingHandlers(*profile),
+			FilterProvider: borkmetrics.OpenMetrics,
+		},
+
+		// A draining provider waits for its reconciles in flight to finish
+		// for as long as it waits for its pending deletes to be flushed.
+		GracefulShutdownTimeout: gracefulShutdownTimeout(*drainOnShutdown, *drainTimeout),
+
+		// The webhook server is only started if webhooks are registered
+		// with it below.
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: *webhookCertDir,
+			Port:    *webhookPort,
+		}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
@@ -113,12 +332,13 @@ func main() {
 
 	metrics.Registry.MustRegister(metricRecorder)
 	metrics.Registry.MustRegister(stateMetrics)
+	metrics.Registry.MustRegister(borkmetrics.Collectors()...)
 
 	o := controller.Options{
 		Logger:                  log,
 		MaxConcurrentReconciles: *maxReconcileRate,
 		PollInterval:            *pollInterval,
-		GlobalRateLimiter:       ratelimiter.NewGlobal(*maxReconcileRate),
+		GlobalRateLimiter:       globalRateLimiter,
 		Features:                &feature.Flags{},
 		Gate:                    new(gate.Gate[schema.GroupVersionKind]),
 		MetricOptions: &controller.MetricOptions{
@@ -136,7 +356,22 @@ func main() {
 	if *enableChangeLogs {
 		o.Features.Enable(feature.EnableAlphaChangeLogs)
 		log.Info("Alpha feature enabled", "flag", feature.EnableAlphaChangeLogs)
+	}
+
+	if *enableExperimental {
+		o.Features.Enable(features.EnableAlphaExperimentalKinds)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaExperimentalKinds)
+	}
 
+	if *enableRealtime {
+		o.Features.Enable(features.EnableAlphaRealtimeCompositions)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaRealtimeCompositions)
+	}
+
+	// Change logs may be enabled by the features ConfigMap while we run, so
+	// we need a change logger if there is one. The client doesn't connect
+	// until changes are logged.
+	if *enableChangeLogs || featuresConfigMap != nil {
 		conn, err := grpc.NewClient("unix://"+*changelogsSocketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		kingpin.FatalIfError(err, "failed to create change logs client connection at %s", *changelogsSocketPath)
 
@@ -148,7 +383,224 @@ func main() {
 		o.ChangeLogOptions = &clo
 	}
 
+	middleware.VerboseExternalLogging = *debugExternal
+	middleware.ReconcileTimeout = *timeout
+	middleware.RetryBudget = *retryBudget
+	backend.DefaultTenants.SetMode(backend.TenancyMode(*tenancy))
+	backend.DefaultTenants.SetThrottle(*throttleRate, *throttleBurst)
+	backend.DefaultTenants.SetHang(*hang, *hangOperations...)
+	backend.DefaultTenants.SetDuplicateCreatePolicy(backend.DuplicateCreatePolicy(*duplicateCreates))
+	backend.DefaultTenants.SetUnknownFieldsMode(backend.UnknownFieldsMode(*unknownFields))
+	backend.DefaultTenants.SetListPaging(*listPageSize, *listInconsistency)
+	backend.DefaultTenants.SetAPIVersion(*apiVersion)
+	backend.DefaultTenants.SetClockSkew(*clockSkew)
+	clients.UseBackend(*backendMode, *backendEndpoint)
+	if *backendMode == backend.BackendFile {
+		kingpin.FatalIfError(backend.Default.PersistTo(*backendFile, func(err error) {
+			log.Info("Cannot persist backend", "error", err)
+		}), "Cannot load file backend")
+		log.Info("Persisting backend to file", "path", *backendFile)
+	}
+	if *auditLog != "" {
+		w, err := audit.Open(*auditLog)
+		kingpin.FatalIfError(err, "Cannot open audit log")
+		host, err := os.Hostname()
+		kingpin.FatalIfError(err, "Cannot get hostname for audit log")
+		audit.Default.SetOutput(w, host, func(err error) {
+			log.Info("Cannot write audit log", "error", err)
+		})
+		log.Info("Writing audit log", "path", *auditLog)
+	}
+	if *recordFile != "" {
+		rec, err := backend.NewRecorder(*recordFile, func(err error) {
+			log.Info("Cannot record backend call", "error", err)
+		})
+		kingpin.FatalIfError(err, "Cannot record backend calls")
+		clients.RecordTo(rec)
+		log.Info("Recording backend calls", "path", *recordFile)
+	}
+	if *replayFile != "" {
+		r, err := backend.LoadReplay(*replayFile)
+		kingpin.FatalIfError(err, "Cannot load recording to replay")
+		clients.ReplayFrom(r)
+		log.Info("Replaying backend calls", "path", *replayFile)
+	}
+
+	if *changelogsSink {
+		sink, err := changelogsink.New(*changelogsSinkFile)
+		kingpin.FatalIfError(err, "Cannot create change log sink")
+		kingpin.FatalIfError(mgr.Add(changelogsink.NewServer(sink, *changelogsSocketPath, *changelogsSinkAddress)), "Cannot add change log sink")
+		log.Info("Serving embedded change log sink", "socket", *changelogsSocketPath, "file", *changelogsSinkFile, "address", *changelogsSinkAddress)
+	}
+
+	if *profile {
+		kingpin.FatalIfError(profiling.Default.Setup(mgr, log, *profileDir, *profileRetention, *profileInterval), "Cannot setup profiling")
+		log.Info("Profiling enabled", "dir", *profileDir, "snapshot-interval", *profileInterval)
+	}
+
+	if *scenarioFile != "" {
+		sc, err := scenario.Load(*scenarioFile)
+		kingpin.FatalIfError(err, "Cannot load scenario")
+		baseline := scenario.Baseline{
+			Throttle: scenario.Throttle{RatePerSecond: *throttleRate, Burst: *throttleBurst},
+			Hang:     scenario.Hang{Duration: metav1.Duration{Duration: *hang}, Operations: *hangOperations},
+		}
+		kingpin.FatalIfError(mgr.Add(scenario.NewRunner(backend.DefaultTenants, sc, baseline, log.WithValues("scenario", *scenarioFile))), "Cannot add scenario runner")
+		log.Info("Running scenario", "path", *scenarioFile, "phases", len(sc.Phases))
+	}
+
+	if *adminAddress != "" {
+		kingpin.FatalIfError(mgr.Add(admin.NewServer(backend.DefaultTenants, *adminAddress, *adminToken)), "Cannot add admin API")
+		log.Info("Serving admin API", "address", *adminAddress)
+	}
+
 	kingpin.FatalIfError(customresourcesgate.Setup(mgr, o), "Cannot setup CRD gate controller")
 	kingpin.FatalIfError(bork.SetupGated(mgr, o), "Cannot setup Bork controllers")
-	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
+	if *notificationAddress != "" {
+		_, port, err := net.SplitHostPort(*notificationAddress)
+		kingpin.FatalIfError(err, "Cannot parse --notification-receiver-address")
+		u := *notificationURL
+		if u == "" {
+			u = "http://" + net.JoinHostPort("localhost", port)
+		}
+		subscription.Default.SetReceiver(*notificationAddress, u)
+		log.Info("Receiving backend notifications", "address", *notificationAddress, "url", u)
+	}
+	kingpin.FatalIfError(subscription.Default.Setup(mgr, log), "Cannot setup backend subscriptions")
+	if limitsConfigMap != nil {
+		kingpin.FatalIfError(concurrency.Default.Setup(mgr, log, limitsConfigMap.Namespace, limitsConfigMap.Name), "Cannot setup concurrency limits")
+	}
+	features.Default.SetFlags(o.Features)
+	if featuresConfigMap != nil {
+		kingpin.FatalIfError(features.Default.Setup(mgr, log, featuresConfigMap.Namespace, featuresConfigMap.Name), "Cannot setup features")
+	}
+	kingpin.FatalIfError(clients.DefaultPool.Setup(mgr, *clientTTL), "Cannot setup backend client pool")
+	kingpin.FatalIfError(leak.Default.Setup(mgr, log, leak.Policy(*leakPolicy), *leakInterval, *leakGracePeriod), "Cannot setup leak sweeper")
+	kingpin.FatalIfError(mgr.Add(clients.NewQuotaRecorder(mgr.GetClient(), log, borkmetrics.QuotaRemaining, *pollStateMetricInterval)), "Cannot add provider config quota recorder")
+	kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add health check")
+	kingpin.FatalIfError(mgr.AddReadyzCheck("backend", clients.BackendReadyz(mgr.GetClient(), backend.DefaultTenants, clients.DefaultProbeTimeout)), "Cannot add backend readiness check")
+	if *leaderReadiness {
+		kingpin.FatalIfError(mgr.AddReadyzCheck("leader", clients.LeaderReadyz(mgr.Elected())), "Cannot add leader readiness check")
+	}
+
+	// Runnables that need leader election only start once this replica is
+	// elected, so this logs when it takes over reconciling.
+	kingpin.FatalIfError(mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		log.Info("Elected leader", "id", leaderElectionID, "leader-election", *leaderElection)
+		<-ctx.Done()
+		return nil
+	})), "Cannot add leader election logger")
+
+	if *webhookCertDir != "" {
+		kingpin.FatalIfError(borkwebhook.Setup(mgr), "Cannot setup Bork webhooks")
+		log.Info("Admission webhooks enabled", "cert-dir", *webhookCertDir, "port", *webhookPort)
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+	if *drainOnShutdown {
+		drain.Default.Setup(mgr, log, *drainDeletes, *drainDeleteRate, *drainTimeout)
+		ctx = drain.Default.Drain(ctx)
+	}
+	kingpin.FatalIfError(mgr.Start(ctx), "Cannot start controller manager")
+}
+
+// gracefulShutdownTimeout returns how long the controller manager waits for
+// its runnables to stop, or nil to use controller-runtime's default.
+func gracefulShutdownTimeout(drain bool, timeout time.Duration) *time.Duration {
+	if !drain {
+		return nil
+	}
+	return &timeout
+}
+
+// profilingHandlers returns the handlers the metrics server serves in addition
+// to metrics, which are the pprof handlers if profiling is enabled.
+func profilingHandlers(enabled bool) map[string]http.Handler {
+	if !enabled {
+		return nil
+	}
+	return profiling.Handlers()
+}
+
+// configMapRef parses the supplied namespace/name value of the supplied flag,
+// returning nil if it's empty.
+func configMapRef(flag, value string) *types.NamespacedName {
+	if value == "" {
+		return nil
+	}
+	ns, name, ok := strings.Cut(value, "/")
+	if !ok || ns == "" || name == "" {
+		kingpin.Fatalf("Invalid %s %q: must be namespace/name", flag, value)
+	}
+	return &types.NamespacedName{Namespace: ns, Name: name}
+}
+
+// configMapNamespaces returns the cache configuration of the namespaces of
+// the supplied ConfigMaps, which may be nil. A namespace that contains only
+// one of the ConfigMaps only caches that ConfigMap.
+func configMapNamespaces(refs ...*types.NamespacedName) map[string]cache.Config {
+	names := map[string][]string{}
+	for _, ref := range refs {
+		if ref != nil && !slices.Contains(names[ref.Namespace], ref.Name) {
+			names[ref.Namespace] = append(names[ref.Namespace], ref.Name)
+		}
+	}
+	ns := make(map[string]cache.Config, len(names))
+	for namespace, n := range names {
+		cfg := cache.Config{}
+		if len(n) == 1 {
+			cfg.FieldSelector = fields.OneTermEqualSelector("metadata.name", n[0])
+		}
+		ns[namespace] = cfg
+	}
+	return ns
+}
+
+// kindList parses the supplied comma separated list of kinds.
+func kindList(value string) []string {
+	var kinds []string
+	for _, k := range strings.Split(value, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			kinds = append(kinds, k)
+		}
+	}
+	return kinds
+}
+
+// tenancyModes returns the backend's tenancy modes, as flag values.
+func tenancyModes() []string {
+	modes := make([]string, len(backend.TenancyModes))
+	for i, m := range backend.TenancyModes {
+		modes[i] = string(m)
+	}
+	return modes
+}
+
+// duplicateCreatePolicies returns the backend's duplicate create policies, as
+// flag values.
+func duplicateCreatePolicies() []string {
+	policies := make([]string, len(backend.DuplicateCreatePolicies))
+	for i, p := range backend.DuplicateCreatePolicies {
+		policies[i] = string(p)
+	}
+	return policies
+}
+
+// unknownFieldsModes returns the backend's unknown fields modes, as flag
+// values.
+func unknownFieldsModes() []string {
+	modes := make([]string, len(backend.UnknownFieldsModes))
+	for i, m := range backend.UnknownFieldsModes {
+		modes[i] = string(m)
+	}
+	return modes
+}
+
+// leakPolicies returns the leak sweeper's policies, as flag values.
+func leakPolicies() []string {
+	policies := make([]string, len(leak.Policies))
+	for i, p := range leak.Policies {
+		policies[i] = string(p)
+	}
+	return policies
 }
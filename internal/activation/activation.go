@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package activation tracks the state of each of the provider's controllers.
+// Controllers are set up with safe-start support, so a controller is only
+// started once the CRD of its kind is established. Before then it's waiting.
+package activation
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+)
+
+// A State of a controller.
+type State string
+
+// States of a controller.
+const (
+	// StateWaiting controllers are waiting for the CRD of their kind to be
+	// established.
+	StateWaiting State = "Waiting"
+
+	// StateActive controllers have been started.
+	StateActive State = "Active"
+
+	// StateDisabled controllers are disabled by --disable-kinds, and are
+	// never started.
+	StateDisabled State = "Disabled"
+
+	// StateExperimental controllers are of experimental kinds, and aren't
+	// started unless experimental kinds are enabled.
+	StateExperimental State = "Experimental"
+)
+
+// A Controller of a kind of resource.
+type Controller struct {
+	// Kind the controller reconciles.
+	Kind string `json:"kind"`
+
+	// State of the controller.
+	State State `json:"state"`
+
+	// Since is when the controller entered its state.
+	Since time.Time `json:"since"`
+}
+
+// Default controllers of the provider.
+var Default = &Controllers{}
+
+// Controllers track the state of each of the provider's controllers.
+type Controllers struct {
+	mu          sync.RWMutex
+	controllers map[string]Controller
+}
+
+// Set the state of the controller of the supplied kind.
+func (c *Controllers) Set(kind string, s State) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.controllers == nil {
+		c.controllers = make(map[string]Controller)
+	}
+	c.controllers[kind] = Controller{Kind: kind, State: s, Since: time.Now()}
+}
+
+// List the controllers, sorted by kind.
+func (c *Controllers) List() []Controller {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	l := make([]Controller, 0, len(c.controllers))
+	for _, ctrl := range c.controllers {
+		l = append(l, ctrl)
+	}
+	slices.SortFunc(l, func(a, b Controller) int { return strings.Compare(a.Kind, b.Kind) })
+	return l
+}
+
+// Gate returns a gate that registers callbacks with the supplied gate. The
+// controller of the supplied kind is waiting once a callback is registered,
+// and active once the callback, which is expected to set it up, returns.
+func (c *Controllers) Gate(kind string, g controller.Gate) controller.Gate {
+	return &gate{Gate: g, controllers: c, kind: kind}
+}
+
+type gate struct {
+	controller.Gate
+
+	controllers *Controllers
+	kind        string
+}
+
+func (g *gate) Register(fn func(), gvks ...schema.GroupVersionKind) {
+	g.controllers.Set(g.kind, StateWaiting)
+	g.Gate.Register(func() {
+		fn()
+		g.controllers.Set(g.kind, StateActive)
+	}, gvks...)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admin serves an HTTP API that controls the simulated backend while
+// the provider runs, so that failures such as a regional outage, drift, a
+// corrupted record or a failed transaction can be injected into a running
+// provider on demand.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const errServe = "cannot serve admin API"
+
+// maxRequestSize is the largest request body the admin API decodes.
+const maxRequestSize = 1 << 20
+
+// Query parameters that select the store an admin request operates on.
+const (
+	// ParamNamespace selects the store of the tenant the namespace belongs
+	// to. The shared store is used if it's unset.
+	ParamNamespace = "namespace"
+
+	// ParamRegion selects a region of the store. The store itself is used
+	// if it's unset.
+	ParamRegion = "region"
+)
+
+// A Server serves the admin API of the supplied tenants' backend.
+type Server struct {
+	tenants *backend.Tenants
+	address string
+	token   string
+}
+
+// NewServer returns a server that serves the admin API of the supplied
+// tenants' backend at the supplied address. Every request must present the
+// supplied bearer token.
+func NewServer(t *backend.Tenants, address, token string) *Server {
+	return &Server{tenants: t, address: address, token: token}
+}
+
+// NeedLeaderElection returns false. Every replica of the provider has an
+// in-process backend of its own.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the admin API until the supplied context is done.
+func (s *Server) Start(ctx context.Context) error {
+	hs := &http.Server{Addr: s.address, Handler: s.Handler(), ReadHeaderTimeout: 10 * time.Second}
+
+	errs := make(chan error, 1)
+	go func() {
+		if err := hs.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- errors.Wrap(err, errServe)
+		}
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+	}
+	_ = hs.Close()
+	return err
+}
+
+// Handler returns a handler that serves the admin API:
+//
+//	GET    PathRegions                       lists the regions that are unhealthy.
+//	GET    PathRegions/{region}              returns the health of a region.
+//	PUT    PathRegions/{region}              sets the health of a region, e.g. to
+//	                                         {"healthy": false}.
+//	GET    PathInventory                     lists the names of stored resources of
+//	                                         every kind.
+//	GET    PathInventory/{kind}              lists the names of stored resources of
+//	                                         a kind, e.g. bucket.
+//	GET    PathRecords                       lists records.
+//	GET    PathRecords/{name}                returns a record.
+//	PATCH  PathRecords/{name}                mutates a record, per RecordMutation.
+//	POST   PathRecords/{name}/corrupt        corrupts a record, per ParamMode.
+//	POST   PathRecords/{name}/rename         renames a record, per RecordRename.
+//	DELETE PathRecords/{name}                deletes a record.
+//	GET    PathTransactionFault              returns the fault transactions fail with.
+//	PUT    PathTransactionFault              makes transactions fail, e.g. with
+//	                                         {"mode": "Abort", "after": 1}.
+//	DELETE PathTransactionFault              stops transactions failing.
+//	GET    PathControllers                   lists the provider's controllers, and
+//	                                         whether each is active.
+//	GET    PathProfiles                      lists the snapshots of the provider's
+//	                                         profiles.
+//	POST   PathProfiles                      snapshots profiles, per ParamProfile.
+//	GET    PathSnapshot                      returns a snapshot of every store, as
+//	                                         a gzipped tarball.
+//	PUT    PathSnapshot                      replaces the contents of every store
+//	                                         with those of a snapshot.
+//	GET    PathTraces                        lists the decisions traced for
+//	                                         managed resources.
+//	GET    PathTraces/{kind}/{namespace}/{name}
+//	                                         returns the decisions traced for a
+//	                                         managed resource, e.g.
+//	                                         BorkResource/default/doh.
+//
+// Requests of the inventory, of records, and of the transaction fault, select a store using
+// ParamNamespace and ParamRegion. A transaction fault is set for every store.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+PathRegions, s.listRegions)
+	mux.HandleFunc("GET "+PathRegions+"/{region}", s.getRegion)
+	mux.HandleFunc("PUT "+PathRegions+"/{region}", s.putRegion)
+	mux.HandleFunc("GET "+PathInventory, s.listInventory)
+	mux.HandleFunc("GET "+PathInventory+"/{kind}", s.getInventory)
+	mux.HandleFunc("GET "+PathRecords, s.listRecords)
+	mux.HandleFunc("GET "+PathRecords+"/{name}", s.getRecord)
+	mux.HandleFunc("PATCH "+PathRecords+"/{name}", s.mutateRecord)
+	mux.HandleFunc("POST "+PathRecords+"/{name}/corrupt", s.corruptRecord)
+	mux.HandleFunc("POST "+PathRecords+"/{name}/rename", s.renameRecord)
+	mux.HandleFunc("DELETE "+PathRecords+"/{name}", s.deleteRecord)
+	mux.HandleFunc("GET "+PathTransactionFault, s.getTransactionFault)
+	mux.HandleFunc("PUT "+PathTransactionFault, s.putTransactionFault)
+	mux.HandleFunc("DELETE "+PathTransactionFault, s.deleteTransactionFault)
+	mux.HandleFunc("GET "+PathControllers, s.listControllers)
+	mux.HandleFunc("GET "+PathProfiles, s.listProfiles)
+	mux.HandleFunc("POST "+PathProfiles, s.snapshotProfiles)
+	mux.HandleFunc("GET "+PathSnapshot, s.getSnapshot)
+	mux.HandleFunc("PUT "+PathSnapshot, s.putSnapshot)
+	mux.HandleFunc("GET "+PathTraces, s.listTraces)
+	mux.HandleFunc("GET "+PathTraces+"/{kind}/{namespace}/{name}", s.getTrace)
+	return s.authenticate(mux)
+}
+
+// authenticate rejects requests that don't present the server's token.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="bork-admin"`)
+			http.Error(w, "a valid admin token is required", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// store returns the store the supplied request selects.
+func (s *Server) store(r *http.Request) *backend.Store {
+	st := s.tenants.For(r.URL.Query().Get(ParamNamespace))
+	if region := r.URL.Query().Get(ParamRegion); region != "" {
+		st = st.Region(region)
+	}
+	return st
+}
+
+func write(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the supplied backend error with a status that classifies
+// it.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch backend.Code(err) {
+	case backend.ErrorCodeNotFound:
+		status = http.StatusNotFound
+	case backend.ErrorCodeBadRequest:
+		status = http.StatusBadRequest
+	case backend.ErrorCodeConflict, backend.ErrorCodeDependencyViolation:
+		status = http.StatusConflict
+	}
+	http.Error(w, err.Error(), status)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const token = "admin-token"
+
+// A record is what's compared of a record the admin API returns.
+type record struct {
+	Name      string
+	BorkValue map[string]string
+}
+
+func recordOf(r backend.Record, err error) (any, error) {
+	return record{Name: r.Name, BorkValue: r.BorkValue}, err
+}
+
+func TestServer(t *testing.T) {
+	type want struct {
+		result any
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		// token the client presents, if it isn't the server's.
+		token     string
+		namespace string
+		call      func(ctx context.Context, c *Client) (any, error)
+		want      want
+	}{
+		"Unauthorized": {
+			reason: "Requests that don't present the server's token should be rejected.",
+			token:  "wrong",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				_, err := c.Records(ctx)
+				return nil, err
+			},
+			want: want{err: errors.Errorf(errStatusFmt, "401 Unauthorized", "a valid admin token is required")},
+		},
+		"Names": {
+			reason: "The names of the shared store's records should be listed if no namespace is selected.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return c.Names(ctx, backend.KindRecord)
+			},
+			want: want{result: []string{"bork"}},
+		},
+		"NamesOfNamespace": {
+			reason:    "The names of the records of the selected namespace's store should be listed.",
+			namespace: "a",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return c.Names(ctx, backend.KindRecord)
+			},
+			want: want{result: []string{"doh"}},
+		},
+		"NamesOfUnknownKind": {
+			reason: "Listing the names of an unknown kind should fail.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return c.Names(ctx, "nope")
+			},
+			want: want{result: []string(nil), err: errors.Errorf(errStatusFmt, "404 Not Found", `unknown kind "nope"`)},
+		},
+		"Record": {
+			reason: "The named record should be returned.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return recordOf(c.Record(ctx, "bork"))
+			},
+			want: want{result: record{Name: "bork", BorkValue: map[string]string{"bork": "1"}}},
+		},
+		"RecordNotFound": {
+			reason: "Getting a record that doesn't exist should fail with Not Found.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				_, err := c.Record(ctx, "nope")
+				return nil, err
+			},
+			want: want{err: errors.Errorf(errStatusFmt, "404 Not Found", `bork record "nope" not found`)},
+		},
+		"MutateRecord": {
+			reason: "Mutating a record should merge the mutation into it.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return recordOf(c.MutateRecord(ctx, "bork", backend.RecordMutation{BorkValue: map[string]string{"drift": "2"}}))
+			},
+			want: want{result: record{Name: "bork", BorkValue: map[string]string{"bork": "1", "drift": "2"}}},
+		},
+		"RenameRecord": {
+			reason: "Renaming a record should store it under its new name.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				if _, err := c.RenameRecord(ctx, "bork", "renamed"); err != nil {
+					return nil, err
+				}
+				return c.Names(ctx, backend.KindRecord)
+			},
+			want: want{result: []string{"renamed"}},
+		},
+		"DeleteRecord": {
+			reason: "Deleting a record should remove it from the store.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				resp, err := c.send(ctx, http.MethodDelete, PathRecords+"/bork", nil, nil, "")
+				if err != nil {
+					return nil, err
+				}
+				_ = resp.Body.Close()
+				return c.Names(ctx, backend.KindRecord)
+			},
+			want: want{result: []string{}},
+		},
+		"UnhealthyRegion": {
+			reason: "Marking a region unhealthy should list it as unhealthy.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				if err := c.do(ctx, http.MethodPut, PathRegions+"/"+backend.DefaultRegion, nil, RegionHealth{Healthy: false}, &RegionHealth{}); err != nil {
+					return nil, err
+				}
+				regions := []RegionHealth{}
+				return regions, c.do(ctx, http.MethodGet, PathRegions, nil, nil, &regions)
+			},
+			want: want{result: []RegionHealth{{Region: backend.DefaultRegion}}},
+		},
+		"HealthyRegion": {
+			reason: "A region is healthy until it's marked unhealthy.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				h := RegionHealth{}
+				return h, c.do(ctx, http.MethodGet, PathRegions+"/"+backend.DefaultRegion, nil, nil, &h)
+			},
+			want: want{result: RegionHealth{Region: backend.DefaultRegion, Healthy: true}},
+		},
+		"NoTransactionFault": {
+			reason: "Getting the transaction fault should fail with Not Found when none is set.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return nil, c.do(ctx, http.MethodGet, PathTransactionFault, nil, nil, &backend.TransactionFault{})
+			},
+			want: want{err: errors.Errorf(errStatusFmt, "404 Not Found", "no transaction fault is set")},
+		},
+		"SetTransactionFault": {
+			reason: "A transaction fault that's set should be returned.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				f := backend.TransactionFault{Mode: backend.TransactionFaultAbort, After: 1}
+				if err := c.do(ctx, http.MethodPut, PathTransactionFault, nil, f, &backend.TransactionFault{}); err != nil {
+					return nil, err
+				}
+				got := backend.TransactionFault{}
+				return got, c.do(ctx, http.MethodGet, PathTransactionFault, nil, nil, &got)
+			},
+			want: want{result: backend.TransactionFault{Mode: backend.TransactionFaultAbort, After: 1}},
+		},
+		"InvalidTransactionFault": {
+			reason: "Setting a transaction fault of an unknown mode should fail with Bad Request.",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return nil, c.do(ctx, http.MethodPut, PathTransactionFault, nil, backend.TransactionFault{Mode: "Explode"}, &backend.TransactionFault{})
+			},
+			want: want{err: errors.Errorf(errStatusFmt, "400 Bad Request", "transaction fault mode must be one of [Abort LoseResponse]")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			tn := backend.NewTenants(backend.NewStore())
+			tn.SetMode(backend.TenancyNamespace)
+			for ns, name := range map[string]string{"": "bork", "a": "doh"} {
+				if _, err := tn.For(ns).Create(ctx, backend.Record{Name: name, BorkValue: map[string]string{"bork": "1"}}); err != nil {
+					t.Fatalf("Create(...): %v", err)
+				}
+			}
+
+			srv := httptest.NewServer(NewServer(tn, "", token).Handler())
+			defer srv.Close()
+			c := &Client{URL: srv.URL, Token: token, Namespace: tc.namespace}
+			if tc.token != "" {
+				c.Token = tc.token
+			}
+
+			got, err := tc.call(ctx, c)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nHandler(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nHandler(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-bork/internal/activation"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/decisions"
+)
+
+const (
+	errEncodeRequest  = "cannot encode admin API request"
+	errNewRequest     = "cannot create admin API request"
+	errDoRequest      = "cannot call admin API"
+	errDecodeResponse = "cannot decode admin API response"
+	errReadResponse   = "cannot read admin API response"
+	errStatusFmt      = "admin API returned %s: %s"
+)
+
+// A Client calls the admin API served by a provider.
+type Client struct {
+	// URL of the admin API, e.g. http://localhost:9090.
+	URL string
+
+	// Token presented as a bearer token.
+	Token string
+
+	// Namespace and Region select the store requests operate on, as
+	// ParamNamespace and ParamRegion do. The shared store is used if both are
+	// unset.
+	Namespace string
+	Region    string
+
+	// HTTP client requests are made with. http.DefaultClient is used if it's
+	// nil.
+	HTTP *http.Client
+}
+
+// Inventory returns the names of the stored resources of every kind, keyed by
+// kind.
+func (c *Client) Inventory(ctx context.Context) (map[string][]string, error) {
+	inv := map[string][]string{}
+	return inv, c.do(ctx, http.MethodGet, PathInventory, nil, nil, &inv)
+}
+
+// Names returns the names of the stored resources of the supplied kind.
+func (c *Client) Names(ctx context.Context, kind string) ([]string, error) {
+	var names []string
+	return names, c.do(ctx, http.MethodGet, PathInventory+"/"+url.PathEscape(kind), nil, nil, &names)
+}
+
+// Records returns the stored records.
+func (c *Client) Records(ctx context.Context) ([]backend.Record, error) {
+	var records []backend.Record
+	return records, c.do(ctx, http.MethodGet, PathRecords, nil, nil, &records)
+}
+
+// Record returns the named record.
+func (c *Client) Record(ctx context.Context, name string) (backend.Record, error) {
+	rec := backend.Record{}
+	return rec, c.do(ctx, http.MethodGet, PathRecords+"/"+url.PathEscape(name), nil, nil, &rec)
+}
+
+// MutateRecord applies the supplied mutation to the named record, as if it
+// drifted, and returns the mutated record.
+func (c *Client) MutateRecord(ctx context.Context, name string, m backend.RecordMutation) (backend.Record, error) {
+	rec := backend.Record{}
+	return rec, c.do(ctx, http.MethodPatch, PathRecords+"/"+url.PathEscape(name), nil, m, &rec)
+}
+
+// CorruptRecord corrupts the named record per the supplied mode, and returns
+// the corrupted record.
+func (c *Client) CorruptRecord(ctx context.Context, name string, mode backend.CorruptionMode) (backend.Record, error) {
+	q := url.Values{}
+	if mode != "" {
+		q.Set(ParamMode, string(mode))
+	}
+	rec := backend.Record{}
+	return rec, c.do(ctx, http.MethodPost, PathRecords+"/"+url.PathEscape(name)+"/corrupt", q, nil, &rec)
+}
+
+// RenameRecord renames the named record, and returns the renamed record.
+func (c *Client) RenameRecord(ctx context.Context, name, to string) (backend.Record, error) {
+	rec := backend.Record{}
+	return rec, c.do(ctx, http.MethodPost, PathRecords+"/"+url.PathEscape(name)+"/rename", nil, RecordRename{Name: to}, &rec)
+}
+
+// Controllers returns the provider's controllers, and the state of each.
+func (c *Client) Controllers(ctx context.Context) ([]activation.Controller, error) {
+	ctrls := []activation.Controller{}
+	return ctrls, c.do(ctx, http.MethodGet, PathControllers, nil, nil, &ctrls)
+}
+
+// Traces returns the decisions traced for every managed resource that has
+// been traced.
+func (c *Client) Traces(ctx context.Context) ([]decisions.Trace, error) {
+	ts := []decisions.Trace{}
+	return ts, c.do(ctx, http.MethodGet, PathTraces, nil, nil, &ts)
+}
+
+// Trace returns the decisions traced for the managed resource of the supplied
+// kind, namespace and name.
+func (c *Client) Trace(ctx context.Context, kind, namespace, name string) (decisions.Trace, error) {
+	t := decisions.Trace{}
+	return t, c.do(ctx, http.MethodGet, PathTraces+"/"+url.PathEscape(kind)+"/"+url.PathEscape(namespace)+"/"+url.PathEscape(name), nil, nil, &t)
+}
+
+// Snapshot writes a snapshot of every store to the supplied writer, as a
+// gzipped tarball.
+func (c *Client) Snapshot(ctx context.Context, w io.Writer) error {
+	resp, err := c.send(ctx, http.MethodGet, PathSnapshot, nil, nil, "")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, err = io.Copy(w, resp.Body)
+	return errors.Wrap(err, errReadResponse)
+}
+
+// Restore replaces the contents of every store with those of the snapshot
+// read from the supplied reader.
+func (c *Client) Restore(ctx context.Context, r io.Reader) error {
+	resp, err := c.send(ctx, http.MethodPut, PathSnapshot, nil, r, ContentTypeSnapshot)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// do calls the admin API, sending the supplied body, if any, and decoding the
+// response into the supplied value.
+func (c *Client) do(ctx context.Context, method, path string, q url.Values, body, into any) error {
+	var rb io.Reader
+	contentType := ""
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, errEncodeRequest)
+		}
+		rb, contentType = bytes.NewReader(b), "application/json"
+	}
+	resp, err := c.send(ctx, method, path, q, rb, contentType)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(into), errDecodeResponse)
+}
+
+// send calls the admin API, sending the supplied body, if any, with the
+// supplied content type. It returns an error if the API returns an error
+// status. The caller must close the response's body.
+func (c *Client) send(ctx context.Context, method, path string, q url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	if q == nil {
+		q = url.Values{}
+	}
+	if c.Namespace != "" {
+		q.Set(ParamNamespace, c.Namespace)
+	}
+	if c.Region != "" {
+		q.Set(ParamRegion, c.Region)
+	}
+	u := strings.TrimSuffix(c.URL, "/") + path
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewRequest)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	hc := c.HTTP
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, errDoRequest)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer func() { _ = resp.Body.Close() }()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxRequestSize))
+		return nil, errors.Errorf(errStatusFmt, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// PathInventory is the path at which the names of the backend's stored
+// resources are served, keyed by kind. The names of a single kind are served
+// at PathInventory/{kind}.
+const PathInventory = "/v1/inventory"
+
+func (s *Server) listInventory(w http.ResponseWriter, r *http.Request) {
+	st := s.store(r)
+	inv := make(map[string][]string, len(backend.Kinds))
+	for _, kind := range backend.Kinds {
+		inv[kind] = orEmpty(st.Names(kind))
+	}
+	write(w, inv)
+}
+
+func (s *Server) getInventory(w http.ResponseWriter, r *http.Request) {
+	kind := r.PathValue("kind")
+	if !slices.Contains(backend.Kinds, kind) {
+		http.Error(w, fmt.Sprintf("unknown kind %q", kind), http.StatusNotFound)
+		return
+	}
+	write(w, orEmpty(s.store(r).Names(kind)))
+}
+
+// orEmpty returns an empty list rather than nil, so that no names are served
+// as [] rather than null.
+func orEmpty(names []string) []string {
+	if names == nil {
+		return []string{}
+	}
+	return names
+}
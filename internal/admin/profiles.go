@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/crossplane/provider-bork/internal/profiling"
+)
+
+// PathProfiles is the path at which snapshots of the provider's profiles are
+// served.
+const PathProfiles = "/v1/profiles"
+
+// ParamProfile selects a profile to snapshot, e.g. heap. It may be repeated.
+// The profiling.DefaultProfiles are snapshotted if it's unset.
+const ParamProfile = "profile"
+
+func (s *Server) listProfiles(w http.ResponseWriter, _ *http.Request) {
+	if !profiling.Default.Enabled() {
+		http.Error(w, "profiling is disabled; run the provider with --profile", http.StatusNotFound)
+		return
+	}
+	paths, err := profiling.Default.Snapshots()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	write(w, paths)
+}
+
+func (s *Server) snapshotProfiles(w http.ResponseWriter, r *http.Request) {
+	if !profiling.Default.Enabled() {
+		http.Error(w, "profiling is disabled; run the provider with --profile", http.StatusNotFound)
+		return
+	}
+	paths, err := profiling.Default.Snapshot(r.URL.Query()[ParamProfile]...)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	write(w, paths)
+}
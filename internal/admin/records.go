@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// PathRecords is the path at which the backend's records are served. A
+// single record is served at PathRecords/{name}.
+const PathRecords = "/v1/records"
+
+// A RecordRename renames a record.
+type RecordRename struct {
+	// Name the record is renamed to.
+	Name string `json:"name"`
+}
+
+// ParamMode selects how a record is corrupted, e.g. MissingFields. Records
+// are corrupted per backend.CorruptionGarbage if it's unset.
+const ParamMode = "mode"
+
+func (s *Server) listRecords(w http.ResponseWriter, r *http.Request) {
+	write(w, s.store(r).Records())
+}
+
+func (s *Server) getRecord(w http.ResponseWriter, r *http.Request) {
+	rec, err := s.store(r).Get(r.Context(), r.PathValue("name"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	write(w, rec)
+}
+
+func (s *Server) mutateRecord(w http.ResponseWriter, r *http.Request) {
+	m := backend.RecordMutation{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&m); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rec, err := s.store(r).MutateRecord(r.PathValue("name"), m)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	write(w, rec)
+}
+
+func (s *Server) corruptRecord(w http.ResponseWriter, r *http.Request) {
+	rec, err := s.store(r).CorruptRecord(r.PathValue("name"), backend.CorruptionMode(r.URL.Query().Get(ParamMode)))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	write(w, rec)
+}
+
+func (s *Server) renameRecord(w http.ResponseWriter, r *http.Request) {
+	rn := RecordRename{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&rn); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rec, err := s.store(r).RenameRecord(r.PathValue("name"), rn.Name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	write(w, rec)
+}
+
+func (s *Server) deleteRecord(w http.ResponseWriter, r *http.Request) {
+	st := s.store(r)
+	name := r.PathValue("name")
+	if _, err := st.Get(r.Context(), name); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := st.Delete(r.Context(), name); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+)
+
+// PathRegions is the path at which the health of the backend's regions is
+// served. The health of a single region is served at PathRegions/{region}.
+const PathRegions = "/v1/regions"
+
+// RegionHealth is the health of a region of the backend.
+type RegionHealth struct {
+	Region  string `json:"region"`
+	Healthy bool   `json:"healthy"`
+}
+
+func (s *Server) listRegions(w http.ResponseWriter, _ *http.Request) {
+	unhealthy := s.tenants.UnhealthyRegions()
+	regions := make([]RegionHealth, len(unhealthy))
+	for i, r := range unhealthy {
+		regions[i] = RegionHealth{Region: r}
+	}
+	write(w, regions)
+}
+
+func (s *Server) getRegion(w http.ResponseWriter, r *http.Request) {
+	write(w, s.health(r.PathValue("region")))
+}
+
+func (s *Server) putRegion(w http.ResponseWriter, r *http.Request) {
+	h := RegionHealth{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&h); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	region := r.PathValue("region")
+	s.tenants.SetRegionHealthy(region, h.Healthy)
+	write(w, s.health(region))
+}
+
+// health returns the health of the named region.
+func (s *Server) health(region string) RegionHealth {
+	return RegionHealth{Region: region, Healthy: !slices.Contains(s.tenants.UnhealthyRegions(), region)}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+)
+
+// PathSnapshot is the path at which a snapshot of everything the backend
+// stores is served, as a gzipped tarball. Putting a snapshot restores it.
+const PathSnapshot = "/v1/snapshot"
+
+// ContentTypeSnapshot is the content type of a snapshot.
+const ContentTypeSnapshot = "application/gzip"
+
+// maxSnapshotSize is the largest snapshot the admin API restores.
+const maxSnapshotSize = 256 << 20
+
+func (s *Server) getSnapshot(w http.ResponseWriter, _ *http.Request) {
+	// The snapshot is buffered so that a failure to write it can be reported.
+	b := &bytes.Buffer{}
+	if err := s.tenants.WriteArchive(b); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ContentTypeSnapshot)
+	w.Header().Set("Content-Length", strconv.Itoa(b.Len()))
+	_, _ = b.WriteTo(w)
+}
+
+func (s *Server) putSnapshot(w http.ResponseWriter, r *http.Request) {
+	if err := s.tenants.ReadArchive(http.MaxBytesReader(w, r.Body, maxSnapshotSize)); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
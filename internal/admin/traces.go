@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/crossplane/provider-bork/internal/decisions"
+)
+
+// PathTraces is the path at which the decisions traced for managed resources
+// annotated to be traced are served.
+const PathTraces = "/v1/traces"
+
+func (s *Server) listTraces(w http.ResponseWriter, _ *http.Request) {
+	write(w, decisions.Default.List())
+}
+
+func (s *Server) getTrace(w http.ResponseWriter, r *http.Request) {
+	kind, ns, name := r.PathValue("kind"), r.PathValue("namespace"), r.PathValue("name")
+	t, ok := decisions.Default.Get(kind, ns, name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("%s %s/%s hasn't been traced", kind, ns, name), http.StatusNotFound)
+		return
+	}
+	write(w, t)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// PathTransactionFault is the path at which the fault the backend fails
+// transactions with is served.
+const PathTransactionFault = "/v1/transactions/fault"
+
+func (s *Server) getTransactionFault(w http.ResponseWriter, r *http.Request) {
+	f := s.store(r).TransactionFault()
+	if f == nil {
+		http.Error(w, "no transaction fault is set", http.StatusNotFound)
+		return
+	}
+	write(w, f)
+}
+
+func (s *Server) putTransactionFault(w http.ResponseWriter, r *http.Request) {
+	f := &backend.TransactionFault{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(f); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !slices.Contains(backend.TransactionFaultModes, f.Mode) {
+		http.Error(w, fmt.Sprintf("transaction fault mode must be one of %v", backend.TransactionFaultModes), http.StatusBadRequest)
+		return
+	}
+	s.tenants.SetTransactionFault(f)
+	write(w, f)
+}
+
+func (s *Server) deleteTransactionFault(w http.ResponseWriter, _ *http.Request) {
+	s.tenants.SetTransactionFault(nil)
+	w.WriteHeader(http.StatusNoContent)
+}
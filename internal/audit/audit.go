@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit writes an append-only log of every create, update and delete
+// the provider performs on external resources, one JSON entry per line, so
+// that tests can verify exactly what the provider did to the backend, when,
+// and on whose behalf.
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errOpenFmt = "cannot open audit log %s"
+	errWrite   = "cannot write audit log entry"
+)
+
+// Stdout is the path that writes the audit log to stdout rather than a file.
+const Stdout = "-"
+
+// An Operation is a mutation of an external resource.
+type Operation string
+
+// Operations that are audited.
+const (
+	OperationCreate Operation = "Create"
+	OperationUpdate Operation = "Update"
+	OperationDelete Operation = "Delete"
+)
+
+// A Result is the outcome of an audited operation.
+type Result string
+
+// Results of audited operations.
+const (
+	ResultSucceeded Result = "Succeeded"
+	ResultFailed    Result = "Failed"
+)
+
+// An Entry records one operation on an external resource.
+type Entry struct {
+	// Time at which the operation started.
+	Time time.Time `json:"time"`
+
+	// Operation that was performed.
+	Operation Operation `json:"operation"`
+
+	// Actor that performed the operation.
+	Actor Actor `json:"actor"`
+
+	// Resource the operation was performed on.
+	Resource Resource `json:"resource"`
+
+	// Diff between the managed resource's desired state and its external
+	// resource's observed state that an update was made to resolve.
+	Diff string `json:"diff,omitempty"`
+
+	// DryRun is true if the operation was only planned, and changed nothing.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Result of the operation.
+	Result Result `json:"result"`
+
+	// Error returned by a failed operation.
+	Error *Error `json:"error,omitempty"`
+
+	// Latency of the operation, in nanoseconds.
+	Latency time.Duration `json:"latency"`
+}
+
+// An Actor performed an operation.
+type Actor struct {
+	// Provider is the replica of the provider that performed the operation.
+	Provider string `json:"provider"`
+
+	// ProviderConfig the managed resource references, whose credentials the
+	// operation was performed with, as Kind/name.
+	ProviderConfig string `json:"providerConfig,omitempty"`
+}
+
+// A Resource identifies the managed resource and external resource an
+// operation was performed on.
+type Resource struct {
+	Kind         string `json:"kind"`
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name"`
+	UID          string `json:"uid"`
+	ExternalName string `json:"externalName,omitempty"`
+}
+
+// An Error returned by a failed operation.
+type Error struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// Default is the audit log of the provider. It records nothing until its
+// output is set.
+var Default = &Log{}
+
+// A Log records entries to its output, one JSON encoded Entry per line.
+type Log struct {
+	mu       sync.Mutex
+	w        io.Writer
+	provider string
+	onError  func(error)
+}
+
+// Open opens the audit log at the supplied path for appending, creating it
+// if it doesn't exist. The Stdout path opens stdout.
+func Open(path string) (io.Writer, error) {
+	if path == Stdout {
+		return os.Stdout, nil
+	}
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, errOpenFmt, path)
+	}
+	return f, nil
+}
+
+// SetOutput makes the log record entries to the supplied writer, as
+// performed by the supplied provider replica. Errors writing entries are
+// passed to the supplied function, which may be nil; operations are
+// unaffected.
+func (l *Log) SetOutput(w io.Writer, provider string, onError func(error)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.w = w
+	l.provider = provider
+	l.onError = onError
+}
+
+// Enabled returns true if the log has an output.
+func (l *Log) Enabled() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w != nil
+}
+
+// Record the supplied entry, as performed by the log's provider replica.
+// Entries are never buffered, so every entry is written once Record returns.
+func (l *Log) Record(e Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.w == nil {
+		return
+	}
+	e.Actor.Provider = l.provider
+	b, err := json.Marshal(e)
+	if err == nil {
+		_, err = l.w.Write(append(b, '\n'))
+	}
+	if err != nil && l.onError != nil {
+		l.onError(errors.Wrap(err, errWrite))
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errPolicyDocumentFmt  = "access policy %q document is not a JSON object"
+	errPolicyStatementFmt = "access policy %q statement %d is not a JSON object"
+)
+
+// Defaults the backend applies to access policy documents that don't specify
+// them.
+const (
+	DefaultPolicyVersion = "2025-01-01"
+	DefaultPolicyEffect  = "Allow"
+)
+
+// Fields of an access policy document the backend normalizes.
+const (
+	PolicyFieldVersion   = "Version"
+	PolicyFieldStatement = "Statement"
+	PolicyFieldEffect    = "Effect"
+	PolicyFieldAction    = "Action"
+	PolicyFieldResource  = "Resource"
+)
+
+// An AccessPolicy is a JSON policy document that the backend normalizes
+// when it's written, modelling the many real APIs that return a document
+// that's equivalent to, but not the same as, the one they were sent.
+type AccessPolicy struct {
+	// Name uniquely identifies the policy within the backend. It is assigned
+	// by the backend when the policy is created.
+	Name string
+
+	// Document is the policy's JSON document. The backend normalizes it when
+	// it's written: its keys are sorted, insignificant whitespace is removed,
+	// its Version and each statement's Effect are defaulted, a lone statement
+	// is wrapped in a list, and lists of one action or resource are replaced
+	// by that action or resource.
+	Document string
+
+	// Tags attached to the policy.
+	Tags map[string]string
+
+	// Revision is assigned by the backend every time the policy is written.
+	Revision int64
+}
+
+// GetAccessPolicy returns the named access policy.
+func (s *Store) GetAccessPolicy(_ context.Context, name string) (AccessPolicy, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.policies[name]
+	if !ok {
+		return AccessPolicy{}, notFound{errors.Errorf(errAccessPolicyNotFoundFmt, name)}
+	}
+	return copyAccessPolicy(p), nil
+}
+
+// CreateAccessPolicy stores the supplied access policy, normalizing its
+// document and assigning it a new revision. If the policy has no name the
+// backend generates a unique one. It returns an error if a policy with the
+// same name already exists, or if its document isn't a JSON object.
+func (s *Store) CreateAccessPolicy(_ context.Context, p AccessPolicy) (AccessPolicy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p.Name == "" {
+		p.Name = generateName("policy")
+	}
+	doc, err := normalizePolicy(p.Name, p.Document)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+	if existing, ok := s.policies[p.Name]; ok {
+		return duplicate(s, copyAccessPolicy(existing), alreadyExists{errors.Errorf(errAccessPolicyAlreadyExistsFmt, p.Name)})
+	}
+	p.Revision = s.revision.Add(1)
+	p.Document = doc
+	s.policies[p.Name] = copyAccessPolicy(p)
+	s.notify(EventCreated, KindAccessPolicy, p.Name, p.Revision)
+	return p, nil
+}
+
+// UpdateAccessPolicy overwrites the document and tags of the supplied access
+// policy, normalizing its document and assigning it a new revision. It
+// returns an error if the policy does not exist, or if its document isn't a
+// JSON object.
+func (s *Store) UpdateAccessPolicy(_ context.Context, p AccessPolicy) (AccessPolicy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.policies[p.Name]
+	if !ok {
+		return AccessPolicy{}, notFound{errors.Errorf(errAccessPolicyNotFoundFmt, p.Name)}
+	}
+	doc, err := normalizePolicy(p.Name, p.Document)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+	existing.Revision = s.revision.Add(1)
+	existing.Document = doc
+	existing.Tags = copyTags(p.Tags)
+	s.policies[p.Name] = existing
+	s.notify(EventUpdated, KindAccessPolicy, p.Name, existing.Revision)
+	return copyAccessPolicy(existing), nil
+}
+
+// DeleteAccessPolicy removes the named access policy. Deleting a policy that
+// does not exist is not an error.
+func (s *Store) DeleteAccessPolicy(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.policies[name]; !ok {
+		return nil
+	}
+	delete(s.policies, name)
+	s.notify(EventDeleted, KindAccessPolicy, name, 0)
+	return nil
+}
+
+// normalizePolicy returns the normalized form of the named policy's
+// document. Marshalling a map sorts its keys and omits insignificant
+// whitespace.
+func normalizePolicy(name, document string) (string, error) {
+	doc := map[string]any{}
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return "", badRequest{errors.Wrapf(err, errPolicyDocumentFmt, name)}
+	}
+	if _, ok := doc[PolicyFieldVersion]; !ok {
+		doc[PolicyFieldVersion] = DefaultPolicyVersion
+	}
+
+	statements, ok := doc[PolicyFieldStatement].([]any)
+	if st, lone := doc[PolicyFieldStatement].(map[string]any); lone {
+		statements, ok = []any{st}, true
+	}
+	if ok {
+		for i := range statements {
+			st, ok := statements[i].(map[string]any)
+			if !ok {
+				return "", badRequest{errors.Errorf(errPolicyStatementFmt, name, i)}
+			}
+			if _, ok := st[PolicyFieldEffect]; !ok {
+				st[PolicyFieldEffect] = DefaultPolicyEffect
+			}
+			for _, f := range []string{PolicyFieldAction, PolicyFieldResource} {
+				if l, ok := st[f].([]any); ok && len(l) == 1 {
+					st[f] = l[0]
+				}
+			}
+		}
+		doc[PolicyFieldStatement] = statements
+	}
+
+	b, err := json.Marshal(doc)
+	return string(b), errors.Wrapf(err, errPolicyDocumentFmt, name)
+}
+
+// copyAccessPolicy ensures callers never share a Tags map with the store.
+func copyAccessPolicy(p AccessPolicy) AccessPolicy {
+	p.Tags = copyTags(p.Tags)
+	return p
+}
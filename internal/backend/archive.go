@@ -0,0 +1,225 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errWriteArchive       = "cannot write archive"
+	errWriteArchiveFmt    = "cannot write %s to archive"
+	errReadArchive        = "cannot read archive"
+	errDecodeArchiveFmt   = "cannot decode %s of archive"
+	errUnknownArchiveFmt  = "archive holds unknown file %s"
+	errArchiveTenantsFmt  = "archive holds the stores of tenants %s, but tenants aren't isolated"
+	errArchiveNoSharedFmt = "archive doesn't hold %s"
+)
+
+// Paths of an archive's files. The shared store is archived as
+// ArchiveShared, and the store of each isolated tenant under ArchiveTenants,
+// e.g. tenants/my-namespace/store.json. The partitions that serve each region
+// of a store are archived in the store's regions directory, e.g.
+// shared/regions/bork-west-1.json.
+const (
+	ArchiveShared  = archiveSharedDir + archiveStore
+	ArchiveTenants = "tenants/"
+
+	archiveSharedDir = "shared/"
+	archiveStore     = "store.json"
+	archiveRegions   = "regions/"
+)
+
+// maxArchiveFileSize is the largest file of an archive that is read.
+const maxArchiveFileSize = 64 << 20
+
+// WriteArchive writes everything the tenants store to the supplied writer, as
+// a gzipped tarball that ReadArchive can restore. It holds the same files a
+// persisted store does, for the shared store, the store of each isolated
+// tenant, and each of their regions' partitions. Stores are archived one at a
+// time, so writes made while the archive is written may be archived for some
+// stores but not others.
+func (t *Tenants) WriteArchive(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+
+	archived := t.archived()
+	for _, name := range slices.Sorted(maps.Keys(archived)) {
+		b, err := archived[name].encode()
+		if err != nil {
+			return err
+		}
+		h := &tar.Header{Name: name, Mode: 0o600, Size: int64(len(b)), ModTime: now, Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(h); err != nil {
+			return errors.Wrapf(err, errWriteArchiveFmt, name)
+		}
+		if _, err := tw.Write(b); err != nil {
+			return errors.Wrapf(err, errWriteArchiveFmt, name)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(err, errWriteArchive)
+	}
+	return errors.Wrap(gz.Close(), errWriteArchive)
+}
+
+// ReadArchive replaces everything the tenants store with the contents of the
+// supplied archive, written by WriteArchive. Stores and partitions that exist
+// but aren't archived are emptied. Nothing is replaced if the archive can't
+// be read, or if it holds the stores of isolated tenants but tenants aren't
+// isolated. Every store's watchers are closed, so that they resync.
+func (t *Tenants) ReadArchive(r io.Reader) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err, errReadArchive)
+	}
+	snaps := map[string]snapshot{}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, errReadArchive)
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(h.Name)
+		if _, _, ok := archivedStore(name); !ok {
+			return errors.Errorf(errUnknownArchiveFmt, name)
+		}
+		snap := snapshot{}
+		if err := json.NewDecoder(io.LimitReader(tr, maxArchiveFileSize)).Decode(&snap); err != nil {
+			return errors.Wrapf(err, errDecodeArchiveFmt, name)
+		}
+		snaps[name] = snap
+	}
+	if _, ok := snaps[ArchiveShared]; !ok {
+		return errors.Errorf(errArchiveNoSharedFmt, ArchiveShared)
+	}
+
+	tenants := map[string]bool{}
+	for name := range snaps {
+		if tenant, _, _ := archivedStore(name); tenant != "" {
+			tenants[tenant] = true
+		}
+	}
+	if len(tenants) > 0 && !t.Isolated() {
+		return errors.Errorf(errArchiveTenantsFmt, strings.Join(slices.Sorted(maps.Keys(tenants)), ", "))
+	}
+
+	// Create the stores and partitions the archive holds, so that they're
+	// restored along with those that exist.
+	for name := range snaps {
+		tenant, region, _ := archivedStore(name)
+		s := t.For(tenant)
+		if region != "" {
+			s.Region(region)
+		}
+	}
+	for name, s := range t.archived() {
+		s.load(snaps[name])
+	}
+	return nil
+}
+
+// archived returns every store the tenants archive, keyed by the path of its
+// archived file.
+func (t *Tenants) archived() map[string]*Store {
+	stores := map[string]*Store{archiveSharedDir: t.shared}
+	t.mu.RLock()
+	for tenant, s := range t.stores {
+		stores[ArchiveTenants+tenant+"/"] = s
+	}
+	t.mu.RUnlock()
+
+	archived := make(map[string]*Store)
+	for dir, s := range stores {
+		archived[dir+archiveStore] = s
+		s.partitionsMu.Lock()
+		for region, p := range s.partitions {
+			archived[dir+archiveRegions+region+".json"] = p
+		}
+		s.partitionsMu.Unlock()
+	}
+	return archived
+}
+
+// archivedStore returns the tenant and region of the store archived at the
+// supplied path. It returns false if no store is archived there.
+func archivedStore(name string) (tenant, region string, ok bool) {
+	dir := archiveSharedDir
+	if rest, isTenant := strings.CutPrefix(name, ArchiveTenants); isTenant {
+		tenant, _, _ = strings.Cut(rest, "/")
+		if tenant == "" {
+			return "", "", false
+		}
+		dir = ArchiveTenants + tenant + "/"
+	}
+	file, isDir := strings.CutPrefix(name, dir)
+	if !isDir {
+		return "", "", false
+	}
+	if file == archiveStore {
+		return tenant, "", true
+	}
+	region, isRegion := strings.CutPrefix(file, archiveRegions)
+	region, isJSON := strings.CutSuffix(region, ".json")
+	if !isRegion || !isJSON || region == "" || strings.Contains(region, "/") {
+		return "", "", false
+	}
+	return tenant, region, true
+}
+
+// encode returns everything the store persists, encoded as JSON.
+func (s *Store) encode() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, err := json.MarshalIndent(s.snapshot(), "", "  ")
+	return b, errors.Wrap(err, errEncodeState)
+}
+
+// load replaces the store's contents with the supplied snapshot, persisting
+// them if the store is persisted, and closes its watchers so that they
+// resync.
+func (s *Store) load(snap snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.restore(snap)
+	if s.persist != nil {
+		s.persist()
+	}
+
+	s.watchersMu.Lock()
+	defer s.watchersMu.Unlock()
+	for ch := range s.watchers {
+		s.unwatch(ch)
+	}
+}
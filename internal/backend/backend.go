@@ -0,0 +1,636 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backend implements the simulated bork backend that Bork managed
+// resources are reconciled against.
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+const (
+	errNotFoundFmt      = "bork record %q not found"
+	errAlreadyExistsFmt = "bork record %q already exists"
+	errDeletingFmt      = "bork record %q is being deleted"
+	errNotActivatedFmt  = "bork record %q has not been activated"
+
+	errPlacementNotFoundFmt      = "placement %q not found"
+	errPlacementAlreadyExistsFmt = "placement %q already exists"
+
+	errBucketNotFoundFmt      = "bucket %q not found"
+	errBucketAlreadyExistsFmt = "bucket %q already exists"
+	errBucketHasObjectsFmt    = "bucket %q cannot be deleted while it holds objects, including %q"
+
+	errPlanNotFoundFmt      = "throttle plan %q not found"
+	errPlanAlreadyExistsFmt = "throttle plan %q already exists"
+
+	errKeyNotFoundFmt      = "key %q not found"
+	errKeyAlreadyExistsFmt = "key %q already exists"
+
+	errObjectNotFoundFmt      = "object %q not found"
+	errObjectAlreadyExistsFmt = "object %q already exists"
+
+	errExportNotFoundFmt      = "export %q not found"
+	errExportAlreadyExistsFmt = "export %q already exists"
+
+	errEndpointNotFoundFmt      = "endpoint %q not found"
+	errEndpointAlreadyExistsFmt = "endpoint %q already exists"
+
+	errQueueNotFoundFmt      = "queue %q not found"
+	errQueueAlreadyExistsFmt = "queue %q already exists"
+
+	errDatabaseNotFoundFmt      = "database %q not found"
+	errDatabaseAlreadyExistsFmt = "database %q already exists"
+
+	errCertificateNotFoundFmt      = "certificate %q not found"
+	errCertificateAlreadyExistsFmt = "certificate %q already exists"
+
+	errTopicNotFoundFmt      = "topic %q not found"
+	errTopicAlreadyExistsFmt = "topic %q already exists"
+
+	errAccessPolicyNotFoundFmt      = "access policy %q not found"
+	errAccessPolicyAlreadyExistsFmt = "access policy %q already exists"
+
+	errScheduleNotFoundFmt      = "schedule %q not found"
+	errScheduleAlreadyExistsFmt = "schedule %q already exists"
+	errUserNotFoundFmt          = "user %q not found"
+	errUserAlreadyExistsFmt     = "user %q already exists"
+	errUserNoPasswordFmt        = "user %q must have a password"
+
+	errLinkNotFoundFmt         = "link %q not found"
+	errLinkAlreadyExistsFmt    = "link %q already exists"
+	errLinkEndpointsFmt        = "link %q must have a source and a target, neither of which may contain '/'"
+	errLinkEndpointNotFoundFmt = "cannot create link %q: bork record %q does not exist"
+	errRecordLinkedFmt         = "bork record %q cannot be deleted while it is linked by %q"
+)
+
+// A Record is a bork resource as stored by the backend.
+type Record struct {
+	// Name uniquely identifies the record within the backend. It is assigned
+	// by the backend when the record is created.
+	Name string
+
+	// BorkValue is the value most recently written to the record.
+	BorkValue map[string]string
+
+	// DataValue is the data value most recently written to the record.
+	DataValue map[string]string
+
+	// Region in which the record is stored. Defaults to DefaultRegion.
+	Region string
+
+	// Tier of service the record is stored at. Defaults to DefaultTier.
+	Tier string
+
+	// Tags attached to the record. The backend always adds DefaultTags.
+	Tags map[string]string
+
+	// SecretValue is a sensitive value stored with the record.
+	SecretValue string
+
+	// Payload is an opaque value stored with the record, which simulates a
+	// large record.
+	Payload string
+
+	// IdempotencyKey identifies the create that created the record, if its
+	// creator supplied one. Another create with the same key returns this
+	// record rather than creating another, for IdempotencyKeyTTL. It never
+	// changes once the record is created.
+	IdempotencyKey string
+
+	// Replayed is true if the record was returned by a create that was
+	// deduplicated, because an earlier create with the same idempotency key
+	// created it. It isn't stored.
+	Replayed bool
+
+	// UnknownFields are fields of a newer version of the bork API than
+	// clients know about. A client must send them back as it observed them
+	// when it updates the record, or they're lost.
+	UnknownFields map[string]string
+
+	// DriftInterval is how long after the record is written the backend
+	// mutates it, simulating a change made by someone other than its owner.
+	// Records never drift if it is zero.
+	DriftInterval time.Duration
+
+	// TeardownDelay is how long the backend takes to remove the record once
+	// it has been deleted. The record is removed as soon as it is deleted if
+	// it is zero.
+	TeardownDelay time.Duration
+
+	// RequiresActivation is true if the record must be activated after it is
+	// created. Such a record is PENDING until it is activated, then
+	// ACTIVATING for its activation delay. It can't be updated until it is
+	// ACTIVE.
+	RequiresActivation bool
+
+	// ActivationDelay is how long the backend takes to activate the record.
+	ActivationDelay time.Duration
+
+	// State of the record. It is managed by the backend.
+	State RecordState
+
+	// ActivatedAt is when the record was activated, if it requires
+	// activation and has been activated.
+	ActivatedAt time.Time
+
+	// DeletedAt is when the record was deleted, if it is being torn down.
+	DeletedAt time.Time
+
+	// UID uniquely identifies this incarnation of the record: a record that
+	// is deleted and recreated with the same name has a different UID. It is
+	// supplied by the record's creator, or minted by the backend if it isn't,
+	// and never changes once the record is created.
+	UID string
+
+	// ARN uniquely identifies the record across all bork backends. It is
+	// assigned by the backend every time the record is written.
+	ARN string
+
+	// Generation counts the times the record has been written. It is 1 when
+	// the record is created, and increases by 1 every time it is updated.
+	Generation int64
+
+	// LastModified is when the record was last written.
+	LastModified time.Time
+
+	// Revision is assigned by the backend every time the record is written.
+	// Revisions are drawn from a single monotonically increasing counter, so
+	// a record that is deleted and recreated never reuses a revision.
+	Revision int64
+
+	// History of the bork values applied to the record, oldest first. It is
+	// managed by the backend.
+	History []RecordRevision
+}
+
+// A RecordState is the lifecycle state of a record.
+type RecordState string
+
+// Record states. A record that requires activation is PENDING until it is
+// activated, and ACTIVATING until its activation delay has passed. A deleted
+// record is DELETING until its teardown delay has passed, after which the
+// backend removes it.
+const (
+	RecordPending    RecordState = "PENDING"
+	RecordActivating RecordState = "ACTIVATING"
+	RecordActive     RecordState = "ACTIVE"
+	RecordDeleting   RecordState = "DELETING"
+)
+
+// arnFmt formats the ARN of a record, given its region and name.
+const arnFmt = "arn:bork:%s:record/%s"
+
+type notFound struct{ error }
+
+func (notFound) NotFound() bool { return true }
+
+// IsNotFound returns true if the supplied error indicates a record does not
+// exist.
+func IsNotFound(err error) bool {
+	var nf interface{ NotFound() bool }
+	return errors.As(err, &nf) && nf.NotFound()
+}
+
+type alreadyExists struct{ error }
+
+func (alreadyExists) AlreadyExists() bool { return true }
+
+// IsAlreadyExists returns true if the supplied error indicates a record
+// already exists.
+func IsAlreadyExists(err error) bool {
+	var ae interface{ AlreadyExists() bool }
+	return errors.As(err, &ae) && ae.AlreadyExists()
+}
+
+// A Store is an in-memory bork backend. It is safe for concurrent use.
+//
+// Operations on a single record hold the store's read lock and the lock of
+// the record's shard, per lockRecord. Every other operation holds the store's
+// write lock, or its read lock if it only reads.
+type Store struct {
+	mu           sync.RWMutex
+	records      *recordTable
+	placements   map[string]Placement
+	buckets      map[string]Bucket
+	plans        map[string]Plan
+	keys         map[string]Key
+	objects      map[string]Object
+	regions      map[string]Region
+	exports      map[string]Export
+	endpoints    map[string]ServiceEndpoint
+	queues       map[string]queueHistory
+	databases    map[string]Database
+	certificates map[string]Certificate
+	topics       map[string]Topic
+	topicReads   map[string]int // Reads of each topic since its last rotation.
+	policies     map[string]AccessPolicy
+	schedules    map[string]Schedule
+	users        map[string]User
+	links        map[string]Link     // Guarded by linksMu too; see unlink.
+	transactions map[string][]string // Names of the records each transaction created.
+	malformed    map[string]int64    // Revisions of records served malformed.
+	tokens       map[string]time.Time
+	account      string
+	revision     atomic.Int64
+
+	// linksMu guards links against concurrent record deletes, which hold
+	// only the store's read lock.
+	linksMu sync.Mutex
+
+	// watchers are sent an event every time the store is written.
+	watchersMu sync.Mutex
+	watchers   map[chan Event]struct{}
+
+	// notifiers deliver events to subscribed URLs, keyed by URL.
+	notifiers map[string]*notifier
+
+	// drifter is started the first time a record that drifts is written.
+	drifter sync.Once
+
+	// limiter throttles operations, if set.
+	limiter atomic.Pointer[rate.Limiter]
+
+	// hang makes operations wait before they're performed, if set.
+	hang atomic.Pointer[hang]
+
+	// opFault fails operations, if set.
+	opFault atomic.Pointer[OperationFault]
+
+	// paging determines how lists are paged, if set.
+	paging atomic.Pointer[paging]
+
+	// apiVersion is the version of the bork API the store serves, if set.
+	apiVersion atomic.Pointer[string]
+
+	// clockSkew offsets the timestamps of records returned to clients, in
+	// nanoseconds.
+	clockSkew atomic.Int64
+
+	// duplicates determines what creating a resource that already exists
+	// does.
+	duplicates DuplicateCreatePolicy
+
+	// idempotency keys of the creates the store remembers.
+	idempotency idempotencyKeys
+
+	// unknownFields determines whether unknown fields are attached to
+	// records, and what losing them does.
+	unknownFields UnknownFieldsMode
+
+	// txFault fails the transactions the store commits, if set.
+	txFault *TransactionFault
+
+	// partitions of the store that serve each region, and the regions that
+	// are unhealthy, whether or not their partitions have been created.
+	partitionsMu     sync.Mutex
+	partitions       map[string]*Store
+	regionsUnhealthy map[string]bool
+
+	// region is the region served by a partition, which fails every
+	// operation while it's unhealthy.
+	region    string
+	unhealthy atomic.Bool
+
+	// persist persists the store every time it is written, if set. It is
+	// called with the store's write lock held.
+	persist func()
+}
+
+// Server-side defaults applied to records that don't specify them.
+const (
+	DefaultRegion = "bork-central-1"
+	DefaultTier   = "standard"
+)
+
+// DefaultTags are added to every record by the backend.
+var DefaultTags = map[string]string{"bork.crossplane.io/backend": "in-memory"}
+
+// Default is the simulated backend shared by all of the provider's
+// controllers, allowing them to observe each other's records.
+var Default = NewStore()
+
+// NewStore returns an in-memory bork backend that offers DefaultRegions, and
+// stores nothing.
+func NewStore() *Store {
+	s := &Store{
+		records:      newRecordTable(),
+		placements:   make(map[string]Placement),
+		buckets:      make(map[string]Bucket),
+		plans:        make(map[string]Plan),
+		keys:         make(map[string]Key),
+		objects:      make(map[string]Object),
+		regions:      make(map[string]Region, len(DefaultRegions)),
+		exports:      make(map[string]Export),
+		endpoints:    make(map[string]ServiceEndpoint),
+		queues:       make(map[string]queueHistory),
+		databases:    make(map[string]Database),
+		certificates: make(map[string]Certificate),
+		topics:       make(map[string]Topic),
+		topicReads:   make(map[string]int),
+		policies:     make(map[string]AccessPolicy),
+		schedules:    make(map[string]Schedule),
+		users:        make(map[string]User),
+		links:        make(map[string]Link),
+		transactions: make(map[string][]string),
+		malformed:    make(map[string]int64),
+		tokens:       make(map[string]time.Time),
+		watchers:     make(map[chan Event]struct{}),
+		notifiers:    make(map[string]*notifier),
+		account:      newAccountID(),
+	}
+	for _, r := range DefaultRegions {
+		s.regions[r.Name] = copyRegion(r)
+	}
+	return s
+}
+
+// Head returns the current revision of the named record without returning
+// the record itself. It is the cheapest way to determine whether a record has
+// changed since it was last read.
+func (s *Store) Head(_ context.Context, name string) (int64, error) {
+	r, ok := s.record(name)
+	if !ok {
+		return 0, notFound{errors.Errorf(errNotFoundFmt, name)}
+	}
+	return r.Revision, nil
+}
+
+// Get returns the named record.
+func (s *Store) Get(_ context.Context, name string) (Record, error) {
+	r, ok := s.record(name)
+	if !ok {
+		return Record{}, notFound{errors.Errorf(errNotFoundFmt, name)}
+	}
+	return copyRecord(r), nil
+}
+
+// record returns the named record, first removing it if it has been torn
+// down, or making it ACTIVE if it has finished activating. Records change
+// state lazily, when they're next read, so that the common case of reading a
+// record only needs the read lock of its shard.
+func (s *Store) record(name string) (Record, bool) {
+	r, ok := s.records.get(name)
+	if !ok || (!tornDown(r, time.Now()) && !activated(r, time.Now())) {
+		return r, ok
+	}
+
+	unlock := s.lockRecord(name)
+	defer unlock()
+
+	// The record may have changed while we weren't holding the lock.
+	r, ok = s.records.get(name)
+	switch {
+	case ok && tornDown(r, time.Now()):
+		s.records.remove(name)
+		s.notify(EventDeleted, KindRecord, name, 0)
+		return Record{}, false
+	case ok && activated(r, time.Now()):
+		r.State = RecordActive
+		r.Revision = s.revision.Add(1)
+		s.records.put(r)
+		s.notify(EventUpdated, KindRecord, name, r.Revision)
+	}
+	return r, ok
+}
+
+// Create stores the supplied record, assigning it a new revision. If the
+// record has no name the backend generates a unique one. It returns an error
+// if a record with the same name already exists. If the record has an
+// idempotency key, and an earlier create with the same key created a record
+// that still exists, that record is returned instead, as Replayed.
+func (s *Store) Create(_ context.Context, r Record) (Record, error) {
+	if r.Name == "" {
+		r.Name = generateName("bork")
+	}
+	if r.IdempotencyKey != "" {
+		s.idempotency.lock(time.Now())
+		defer s.idempotency.unlock()
+		if existing, ok := s.replay(r); ok {
+			return existing, nil
+		}
+	}
+	unlock := s.lockRecord(r.Name)
+	defer unlock()
+
+	if existing, ok := s.records.get(r.Name); ok {
+		return duplicate(s, copyRecord(existing), alreadyExists{errors.Errorf(errAlreadyExistsFmt, r.Name)})
+	}
+	created := s.create(r)
+	if r.IdempotencyKey != "" {
+		s.idempotency.remember(r.IdempotencyKey, r.Name, time.Now())
+	}
+	return created, nil
+}
+
+// create creates the supplied record, which must be named and must not
+// exist. The caller must hold the record's lock, or the store's write lock.
+func (s *Store) create(r Record) Record {
+	r = withDefaults(s.withUnknownFields(r))
+	if r.UID == "" {
+		r.UID = uuid.NewString()
+	}
+	r.Replayed = false
+	r.State = RecordActive
+	if r.RequiresActivation {
+		r.State = RecordPending
+	}
+	r.Revision = s.revision.Add(1)
+	r.Generation = 1
+	r.LastModified = time.Now().UTC()
+	r.History = applied(nil, r)
+	s.records.put(r)
+	s.notify(EventCreated, KindRecord, r.Name, r.Revision)
+	s.startDrifter(r)
+	return copyRecord(r)
+}
+
+// Update overwrites the supplied record, assigning it a new revision and
+// incrementing its generation. Fields that are unset are defaulted just as
+// they are at creation time. It returns an error if the record does not
+// exist, is being deleted, or isn't yet ACTIVE.
+func (s *Store) Update(_ context.Context, r Record) (Record, error) {
+	unlock := s.lockRecord(r.Name)
+	defer unlock()
+
+	existing, err := s.updatable(r.Name)
+	if err != nil {
+		return Record{}, err
+	}
+	if err := s.keepsUnknownFields(existing, r); err != nil {
+		return Record{}, err
+	}
+	return s.overwrite(existing, r), nil
+}
+
+// updatable returns the named record if it can be updated. The caller must
+// hold the record's lock, or the store's write lock.
+func (s *Store) updatable(name string) (Record, error) {
+	existing, ok := s.records.get(name)
+	if !ok {
+		return Record{}, notFound{errors.Errorf(errNotFoundFmt, name)}
+	}
+	if existing.State == RecordDeleting {
+		return Record{}, conflict{errors.Errorf(errDeletingFmt, name)}
+	}
+	if existing.State != RecordActive && !activated(existing, time.Now()) {
+		return Record{}, conflict{errors.Errorf(errNotActivatedFmt, name)}
+	}
+	return existing, nil
+}
+
+// overwrite overwrites the supplied existing record with the supplied record.
+// The caller must hold the record's lock, or the store's write lock.
+func (s *Store) overwrite(existing, r Record) Record {
+	r = withDefaults(r)
+	r.UID = existing.UID
+	r.IdempotencyKey = existing.IdempotencyKey
+	r.Replayed = false
+	r.RequiresActivation = existing.RequiresActivation
+	r.ActivatedAt = existing.ActivatedAt
+	r.State = RecordActive
+	r.Revision = s.revision.Add(1)
+	r.Generation = existing.Generation + 1
+	r.LastModified = time.Now().UTC()
+	r.History = applied(copyHistory(existing.History), r)
+	s.records.put(r)
+	s.notify(EventUpdated, KindRecord, r.Name, r.Revision)
+	s.startDrifter(r)
+	return copyRecord(r)
+}
+
+// Delete deletes the named record. A record with a teardown delay is
+// DELETING, and assigned a new revision, until its delay has passed. Deleting
+// a record that does not exist, or is already being deleted, is not an error.
+// The record's links are deleted or left dangling per their deletion policy
+// as soon as it's deleted; deleting a record a link restricts is a dependency
+// violation.
+func (s *Store) Delete(_ context.Context, name string) error {
+	unlock := s.lockRecord(name)
+	defer unlock()
+
+	r, ok := s.records.get(name)
+	if !ok || r.State == RecordDeleting {
+		return nil
+	}
+	if err := s.unlink(name); err != nil {
+		return err
+	}
+	if r.TeardownDelay <= 0 {
+		s.records.remove(name)
+		s.notify(EventDeleted, KindRecord, name, 0)
+		return nil
+	}
+	r.State = RecordDeleting
+	r.DeletedAt = time.Now().UTC()
+	r.Revision = s.revision.Add(1)
+	s.records.put(r)
+	s.notify(EventUpdated, KindRecord, name, r.Revision)
+	return nil
+}
+
+// Activate activates the named record, which becomes ACTIVE once its
+// activation delay has passed. Activating a record that doesn't require
+// activation, or has already been activated, is not an error. It returns an
+// error if the record does not exist, or is being deleted.
+func (s *Store) Activate(_ context.Context, name string) (Record, error) {
+	unlock := s.lockRecord(name)
+	defer unlock()
+
+	r, ok := s.records.get(name)
+	if !ok {
+		return Record{}, notFound{errors.Errorf(errNotFoundFmt, name)}
+	}
+	if r.State == RecordDeleting {
+		return Record{}, conflict{errors.Errorf(errDeletingFmt, name)}
+	}
+	if r.State != RecordPending {
+		return copyRecord(r), nil
+	}
+	r.State = RecordActivating
+	r.ActivatedAt = time.Now().UTC()
+	r.Revision = s.revision.Add(1)
+	s.records.put(r)
+	s.notify(EventUpdated, KindRecord, name, r.Revision)
+	return copyRecord(r), nil
+}
+
+// activated returns true if the supplied record was activated at least its
+// activation delay before the supplied time, but is not yet ACTIVE.
+func activated(r Record, now time.Time) bool {
+	return r.State == RecordActivating && !now.Before(r.ActivatedAt.Add(r.ActivationDelay))
+}
+
+// tornDown returns true if the supplied record was deleted at least its
+// teardown delay before the supplied time.
+func tornDown(r Record, now time.Time) bool {
+	return r.State == RecordDeleting && !now.Before(r.DeletedAt.Add(r.TeardownDelay))
+}
+
+// withDefaults returns a copy of the supplied record with server-side defaults
+// and its ARN applied.
+func withDefaults(r Record) Record {
+	if r.Region == "" {
+		r.Region = DefaultRegion
+	}
+	if r.Tier == "" {
+		r.Tier = DefaultTier
+	}
+	tags := make(map[string]string, len(r.Tags)+len(DefaultTags))
+	for k, v := range DefaultTags {
+		tags[k] = v
+	}
+	for k, v := range r.Tags {
+		tags[k] = v
+	}
+	r.Tags = tags
+	r.ARN = fmt.Sprintf(arnFmt, r.Region, r.Name)
+	return r
+}
+
+// copyRecord ensures callers never share a map with the store.
+func copyRecord(r Record) Record {
+	r.BorkValue = copyTags(r.BorkValue)
+	r.DataValue = copyTags(r.DataValue)
+	r.Tags = copyTags(r.Tags)
+	r.UnknownFields = copyTags(r.UnknownFields)
+	r.History = copyHistory(r.History)
+	return r
+}
+
+func copyTags(in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func generateName(prefix string) string {
+	return prefix + "-" + uuid.NewString()
+}
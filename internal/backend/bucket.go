@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+// A Bucket is a container for bork objects.
+type Bucket struct {
+	// Name uniquely identifies the bucket within the backend. It is assigned
+	// by the backend when the bucket is created.
+	Name string
+
+	// Tags attached to the bucket.
+	Tags map[string]string
+
+	// LifecycleRules applied to objects in the bucket, in the order they were
+	// supplied.
+	LifecycleRules []LifecycleRule
+
+	// Versioning is true if the bucket retains previous versions of objects.
+	Versioning bool
+
+	// Revision is assigned by the backend every time the bucket is written.
+	Revision int64
+}
+
+// A LifecycleRule expires objects in a bucket.
+type LifecycleRule struct {
+	// ID uniquely identifies the rule within its bucket.
+	ID string
+
+	// Prefix limits the rule to objects whose keys have this prefix.
+	Prefix string
+
+	// ExpirationDays after which matching objects are deleted.
+	ExpirationDays int
+
+	// Enabled is true if the rule is being applied.
+	Enabled bool
+}
+
+// GetBucket returns the named bucket.
+func (s *Store) GetBucket(_ context.Context, name string) (Bucket, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return Bucket{}, notFound{errors.Errorf(errBucketNotFoundFmt, name)}
+	}
+	return copyBucket(b), nil
+}
+
+// CreateBucket stores the supplied bucket, assigning it a new revision. If
+// the bucket has no name the backend generates a unique one. It returns an
+// error if a bucket with the same name already exists.
+func (s *Store) CreateBucket(_ context.Context, b Bucket) (Bucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b.Name == "" {
+		b.Name = generateName("bucket")
+	}
+	if existing, ok := s.buckets[b.Name]; ok {
+		return duplicate(s, copyBucket(existing), alreadyExists{errors.Errorf(errBucketAlreadyExistsFmt, b.Name)})
+	}
+	b.Revision = s.revision.Add(1)
+	s.buckets[b.Name] = copyBucket(b)
+	s.notify(EventCreated, KindBucket, b.Name, b.Revision)
+	return b, nil
+}
+
+// UpdateBucket overwrites the supplied bucket, assigning it a new revision.
+// It returns an error if the bucket does not exist.
+func (s *Store) UpdateBucket(_ context.Context, b Bucket) (Bucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[b.Name]; !ok {
+		return Bucket{}, notFound{errors.Errorf(errBucketNotFoundFmt, b.Name)}
+	}
+	b.Revision = s.revision.Add(1)
+	s.buckets[b.Name] = copyBucket(b)
+	s.notify(EventUpdated, KindBucket, b.Name, b.Revision)
+	return b, nil
+}
+
+// DeleteBucket removes the named bucket. Deleting a bucket that does not
+// exist is not an error, but deleting one that holds objects is a dependency
+// violation: its objects must be deleted first.
+func (s *Store) DeleteBucket(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[name]; !ok {
+		return nil
+	}
+	var objects []string
+	for _, o := range s.objects {
+		if o.Bucket == name {
+			objects = append(objects, o.Name)
+		}
+	}
+	if len(objects) > 0 {
+		slices.Sort(objects)
+		return dependencyViolation{errors.Errorf(errBucketHasObjectsFmt, name, objects[0])}
+	}
+	delete(s.buckets, name)
+	s.notify(EventDeleted, KindBucket, name, 0)
+	return nil
+}
+
+// copyBucket ensures callers never share a Tags map or LifecycleRules slice
+// with the store.
+func copyBucket(b Bucket) Bucket {
+	b.Tags = copyTags(b.Tags)
+	b.LifecycleRules = append([]LifecycleRule(nil), b.LifecycleRules...)
+	return b
+}
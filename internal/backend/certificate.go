@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errCertificateTTL        = "certificate TTL must be greater than zero"
+	errCertificateExpiredFmt = "certificate %q expired at %s"
+)
+
+// A Certificate is an issued certificate. It has a built in TTL: once it
+// expires the backend removes it, so that it must be issued again. Nothing
+// about a certificate can be changed once it's issued.
+type Certificate struct {
+	// Name uniquely identifies the certificate within the backend. It is
+	// assigned by the backend when the certificate is issued.
+	Name string
+
+	// CommonName of the certificate's subject.
+	CommonName string
+
+	// DNSNames the certificate is valid for.
+	DNSNames []string
+
+	// TTL is how long the certificate is valid for once it's issued.
+	TTL time.Duration
+
+	// SerialNumber uniquely identifies the certificate. It is assigned by the
+	// backend when the certificate is issued.
+	SerialNumber string
+
+	// NotBefore is when the certificate was issued.
+	NotBefore time.Time
+
+	// NotAfter is when the certificate expires, and is removed.
+	NotAfter time.Time
+
+	// Revision is assigned by the backend when the certificate is issued.
+	Revision int64
+}
+
+// GetCertificate returns the named certificate. A certificate that has
+// expired is removed, and is not found.
+func (s *Store) GetCertificate(_ context.Context, name string) (Certificate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.certificates[name]
+	if !ok {
+		return Certificate{}, notFound{errors.Errorf(errCertificateNotFoundFmt, name)}
+	}
+	if !time.Now().Before(c.NotAfter) {
+		delete(s.certificates, name)
+		s.notify(EventDeleted, KindCertificate, name, 0)
+		return Certificate{}, notFound{errors.Errorf(errCertificateExpiredFmt, name, c.NotAfter.Format(time.RFC3339))}
+	}
+	return copyCertificate(c), nil
+}
+
+// CreateCertificate issues the supplied certificate, assigning it a new
+// revision, a serial number, and a validity period that starts now and lasts
+// for its TTL. If the certificate has no name the backend generates a unique
+// one. It returns an error if a certificate with the same name already
+// exists.
+func (s *Store) CreateCertificate(_ context.Context, c Certificate) (Certificate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c.TTL <= 0 {
+		return Certificate{}, badRequest{errors.New(errCertificateTTL)}
+	}
+	if c.Name == "" {
+		c.Name = generateName("cert")
+	}
+	if existing, ok := s.certificates[c.Name]; ok {
+		return duplicate(s, copyCertificate(existing), alreadyExists{errors.Errorf(errCertificateAlreadyExistsFmt, c.Name)})
+	}
+	c.Revision = s.revision.Add(1)
+	c.SerialNumber = serialNumber()
+	c.NotBefore = time.Now()
+	c.NotAfter = c.NotBefore.Add(c.TTL)
+	s.certificates[c.Name] = copyCertificate(c)
+	s.notify(EventCreated, KindCertificate, c.Name, c.Revision)
+	return c, nil
+}
+
+// DeleteCertificate removes the named certificate. Deleting a certificate
+// that does not exist is not an error.
+func (s *Store) DeleteCertificate(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.certificates[name]; !ok {
+		return nil
+	}
+	delete(s.certificates, name)
+	s.notify(EventDeleted, KindCertificate, name, 0)
+	return nil
+}
+
+// copyCertificate ensures callers never share a DNSNames slice with the store.
+func copyCertificate(c Certificate) Certificate {
+	c.DNSNames = slices.Clone(c.DNSNames)
+	return c
+}
+
+// serialNumber returns a random certificate serial number.
+func serialNumber() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,580 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errEncodeRequest  = "cannot encode request"
+	errDecodeRequest  = "cannot decode request"
+	errEncodeResponse = "cannot encode response"
+	errDecodeResponse = "cannot decode response"
+
+	errRequestTimeoutFmt = "%s request timed out"
+)
+
+// A Client calls a backend. Every request and response is encoded using the
+// codec negotiated when the client was connected, even when the backend is an
+// in-process Store, so that the cost of each content type is paid and can be
+// measured.
+type Client struct {
+	transport transport
+	codec     Codec
+
+	// release is called instead of closing the transport when a leased
+	// client is closed.
+	release func() error
+
+	// expired is called when the backend reports the client's credentials
+	// expired.
+	expired func()
+
+	// version is the version of the bork API the client's backend serves.
+	version *servedVersion
+}
+
+// A transport delivers encoded requests to a backend.
+type transport interface {
+	// Do asks the backend to perform the named operation, returning its
+	// encoded response.
+	Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error)
+
+	// Watch returns a channel of the changes made to the backend.
+	Watch(ctx context.Context, c Codec) <-chan Event
+
+	// Close releases any resources held by the transport.
+	Close() error
+}
+
+// ContentTypes returns the content types the store accepts, in the store's
+// order of preference.
+func (s *Store) ContentTypes() []string {
+	return []string{ContentTypeJSON, ContentTypeMessagePack}
+}
+
+// Connect returns a client of the store that encodes payloads using the
+// first of the preferred content types the store accepts.
+func (s *Store) Connect(preferred ...string) (*Client, error) {
+	return s.ConnectWithToken("", preferred...)
+}
+
+// ConnectWithToken is like Connect, but the returned client presents the
+// supplied token when connecting and with every call, as a client of a bork
+// API server would. The store only checks tokens it issued; any other token
+// is accepted.
+func (s *Store) ConnectWithToken(token string, preferred ...string) (*Client, error) {
+	if err := s.authenticate(token, ""); err != nil {
+		return nil, err
+	}
+	c, err := Negotiate(s.ContentTypes(), preferred...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{transport: storeTransport{store: s, token: token}, codec: c, version: &servedVersion{}}, nil
+}
+
+// ContentType returns the content type the client encodes payloads with.
+func (c *Client) ContentType() string {
+	return c.codec.ContentType()
+}
+
+// Close releases any resources held by the client, such as idle network
+// connections. Closing a leased client only releases the lease.
+func (c *Client) Close() error {
+	if c.release != nil {
+		return c.release()
+	}
+	return c.transport.Close()
+}
+
+// Lease returns a client that shares this client's transport and codec.
+// Closing the returned client calls the supplied release function rather
+// than closing the shared transport, allowing many callers to use one
+// connection to the backend.
+func (c *Client) Lease(release func() error) *Client {
+	return &Client{transport: c.transport, codec: c.codec, release: release, expired: c.expired, version: c.version}
+}
+
+// OnCredentialsExpired arranges for the supplied function to be called
+// whenever the backend rejects a call because the client's credentials
+// expired, including by clients leased from this one. It must be called
+// before the client is used.
+func (c *Client) OnCredentialsExpired(fn func()) {
+	c.expired = fn
+}
+
+// call encodes the supplied request, asks the backend to perform the named
+// operation, then decodes its response.
+func call[Resp, Req any](ctx context.Context, c *Client, op string, req Req) (out Resp, err error) {
+	ctx, span := tracing.Start(ctx, "bork.client/"+op, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(tracing.AttrOperation.String(op)))
+	defer func() { tracing.End(span, err) }()
+
+	// Don't send a request that would certainly time out.
+	if err := ctx.Err(); err != nil {
+		return out, timedOut(ctx, op, err)
+	}
+
+	b, err := c.codec.Marshal(req)
+	if err != nil {
+		return out, errors.Wrap(err, errEncodeRequest)
+	}
+	b, err = c.transport.Do(ctx, op, c.codec, b)
+	if op != "APIVersion" && (err == nil || IsNotFound(err)) {
+		c.checkAPIVersion(ctx)
+	}
+	if err != nil {
+		if c.expired != nil && IsCredentialsExpired(err) {
+			c.expired()
+		}
+		return out, timedOut(ctx, op, err)
+	}
+	if err := c.codec.Unmarshal(b, &out); err != nil {
+		return out, malformed{errors.Wrap(err, errDecodeResponse)}
+	}
+	return out, nil
+}
+
+// timedOut returns the supplied error of the named operation, classified as
+// a timeout if the supplied context's deadline has passed, so that IsTimeout
+// is true whichever transport returned it.
+func timedOut(ctx context.Context, op string, err error) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) || errors.As(err, &timeout{}) {
+		return err
+	}
+	return timeout{errors.Wrapf(err, errRequestTimeoutFmt, op)}
+}
+
+// Head returns the current revision of the named record.
+func (c *Client) Head(ctx context.Context, name string) (int64, error) {
+	return call[int64](ctx, c, "Head", name)
+}
+
+// Get returns the named record.
+func (c *Client) Get(ctx context.Context, name string) (Record, error) {
+	return call[Record](ctx, c, "Get", name)
+}
+
+// GetByUID returns the record with the supplied UID, whatever its name.
+func (c *Client) GetByUID(ctx context.Context, uid string) (Record, error) {
+	return call[Record](ctx, c, "GetByUID", uid)
+}
+
+// Create creates the supplied record. A record without an idempotency key
+// presents that of the supplied context, if it has one.
+func (c *Client) Create(ctx context.Context, r Record) (Record, error) {
+	replay, ok := ctx.Value(idempotencyKey{}).(*CreateReplay)
+	if ok && r.IdempotencyKey == "" {
+		r.IdempotencyKey = replay.key
+	}
+	out, err := call[Record](ctx, c, "Create", r)
+	if ok && err == nil {
+		replay.record(out.Replayed)
+	}
+	return out, err
+}
+
+// Commit creates every record of the supplied transaction, or none of them.
+func (c *Client) Commit(ctx context.Context, tx Transaction) ([]Record, error) {
+	return call[[]Record](ctx, c, "Commit", tx)
+}
+
+// Update overwrites the supplied record.
+func (c *Client) Update(ctx context.Context, r Record) (Record, error) {
+	return call[Record](ctx, c, "Update", r)
+}
+
+// Patch updates the supplied patch's record, writing its data value per the
+// patch's strategy.
+func (c *Client) Patch(ctx context.Context, p RecordPatch) (Record, error) {
+	return call[Record](ctx, c, "Patch", p)
+}
+
+// Delete removes the named record.
+func (c *Client) Delete(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "Delete", name)
+	return err
+}
+
+// List returns the requested page of the names of the stored resources of a
+// kind.
+func (c *Client) List(ctx context.Context, req ListRequest) (ListPage, error) {
+	return call[ListPage](ctx, c, "List", req)
+}
+
+// Activate activates the named record.
+func (c *Client) Activate(ctx context.Context, name string) (Record, error) {
+	return call[Record](ctx, c, "Activate", name)
+}
+
+// GetPlacement returns the named placement.
+func (c *Client) GetPlacement(ctx context.Context, name string) (Placement, error) {
+	return call[Placement](ctx, c, "GetPlacement", name)
+}
+
+// CreatePlacement creates the supplied placement.
+func (c *Client) CreatePlacement(ctx context.Context, p Placement) (Placement, error) {
+	return call[Placement](ctx, c, "CreatePlacement", p)
+}
+
+// UpdatePlacement overwrites the supplied placement.
+func (c *Client) UpdatePlacement(ctx context.Context, p Placement) (Placement, error) {
+	return call[Placement](ctx, c, "UpdatePlacement", p)
+}
+
+// DeletePlacement removes the named placement.
+func (c *Client) DeletePlacement(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeletePlacement", name)
+	return err
+}
+
+// GetBucket returns the named bucket.
+func (c *Client) GetBucket(ctx context.Context, name string) (Bucket, error) {
+	return call[Bucket](ctx, c, "GetBucket", name)
+}
+
+// CreateBucket creates the supplied bucket.
+func (c *Client) CreateBucket(ctx context.Context, b Bucket) (Bucket, error) {
+	return call[Bucket](ctx, c, "CreateBucket", b)
+}
+
+// UpdateBucket overwrites the supplied bucket.
+func (c *Client) UpdateBucket(ctx context.Context, b Bucket) (Bucket, error) {
+	return call[Bucket](ctx, c, "UpdateBucket", b)
+}
+
+// DeleteBucket removes the named bucket.
+func (c *Client) DeleteBucket(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteBucket", name)
+	return err
+}
+
+// GetPlan returns the named throttle plan.
+func (c *Client) GetPlan(ctx context.Context, name string) (Plan, error) {
+	return call[Plan](ctx, c, "GetPlan", name)
+}
+
+// GetPlanUsage returns the usage statistics of the named throttle plan.
+func (c *Client) GetPlanUsage(ctx context.Context, name string) (PlanUsage, error) {
+	return call[PlanUsage](ctx, c, "GetPlanUsage", name)
+}
+
+// CreatePlan creates the supplied throttle plan.
+func (c *Client) CreatePlan(ctx context.Context, p Plan) (Plan, error) {
+	return call[Plan](ctx, c, "CreatePlan", p)
+}
+
+// UpdatePlan overwrites the supplied throttle plan.
+func (c *Client) UpdatePlan(ctx context.Context, p Plan) (Plan, error) {
+	return call[Plan](ctx, c, "UpdatePlan", p)
+}
+
+// DeletePlan removes the named throttle plan.
+func (c *Client) DeletePlan(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeletePlan", name)
+	return err
+}
+
+// GetKey returns the named key.
+func (c *Client) GetKey(ctx context.Context, name string) (Key, error) {
+	return call[Key](ctx, c, "GetKey", name)
+}
+
+// CreateKey creates the supplied key.
+func (c *Client) CreateKey(ctx context.Context, k Key) (Key, error) {
+	return call[Key](ctx, c, "CreateKey", k)
+}
+
+// UpdateKey overwrites the supplied key.
+func (c *Client) UpdateKey(ctx context.Context, k Key) (Key, error) {
+	return call[Key](ctx, c, "UpdateKey", k)
+}
+
+// DeleteKey removes the named key.
+func (c *Client) DeleteKey(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteKey", name)
+	return err
+}
+
+// GetObject returns the named object.
+func (c *Client) GetObject(ctx context.Context, name string) (Object, error) {
+	return call[Object](ctx, c, "GetObject", name)
+}
+
+// CreateObject creates the supplied object.
+func (c *Client) CreateObject(ctx context.Context, o Object) (Object, error) {
+	return call[Object](ctx, c, "CreateObject", o)
+}
+
+// UpdateObject overwrites the supplied object.
+func (c *Client) UpdateObject(ctx context.Context, o Object) (Object, error) {
+	return call[Object](ctx, c, "UpdateObject", o)
+}
+
+// DeleteObject removes the named object.
+func (c *Client) DeleteObject(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteObject", name)
+	return err
+}
+
+// GetExport returns the named export.
+func (c *Client) GetExport(ctx context.Context, name string) (Export, error) {
+	return call[Export](ctx, c, "GetExport", name)
+}
+
+// CreateExport creates the supplied export.
+func (c *Client) CreateExport(ctx context.Context, e Export) (Export, error) {
+	return call[Export](ctx, c, "CreateExport", e)
+}
+
+// UpdateExport overwrites the supplied export.
+func (c *Client) UpdateExport(ctx context.Context, e Export) (Export, error) {
+	return call[Export](ctx, c, "UpdateExport", e)
+}
+
+// DeleteExport removes the named export.
+func (c *Client) DeleteExport(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteExport", name)
+	return err
+}
+
+// GetServiceEndpoint returns the named service endpoint.
+func (c *Client) GetServiceEndpoint(ctx context.Context, name string) (ServiceEndpoint, error) {
+	return call[ServiceEndpoint](ctx, c, "GetServiceEndpoint", name)
+}
+
+// CreateServiceEndpoint creates the supplied service endpoint.
+func (c *Client) CreateServiceEndpoint(ctx context.Context, e ServiceEndpoint) (ServiceEndpoint, error) {
+	return call[ServiceEndpoint](ctx, c, "CreateServiceEndpoint", e)
+}
+
+// UpdateServiceEndpoint overwrites the supplied service endpoint.
+func (c *Client) UpdateServiceEndpoint(ctx context.Context, e ServiceEndpoint) (ServiceEndpoint, error) {
+	return call[ServiceEndpoint](ctx, c, "UpdateServiceEndpoint", e)
+}
+
+// DeleteServiceEndpoint removes the named service endpoint.
+func (c *Client) DeleteServiceEndpoint(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteServiceEndpoint", name)
+	return err
+}
+
+// GetQueue returns the named queue.
+func (c *Client) GetQueue(ctx context.Context, name string) (Queue, error) {
+	return call[Queue](ctx, c, "GetQueue", name)
+}
+
+// CreateQueue creates the supplied queue.
+func (c *Client) CreateQueue(ctx context.Context, q Queue) (Queue, error) {
+	return call[Queue](ctx, c, "CreateQueue", q)
+}
+
+// UpdateQueue overwrites the supplied queue.
+func (c *Client) UpdateQueue(ctx context.Context, q Queue) (Queue, error) {
+	return call[Queue](ctx, c, "UpdateQueue", q)
+}
+
+// DeleteQueue removes the named queue.
+func (c *Client) DeleteQueue(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteQueue", name)
+	return err
+}
+
+// GetDatabase returns the named database.
+func (c *Client) GetDatabase(ctx context.Context, name string) (Database, error) {
+	return call[Database](ctx, c, "GetDatabase", name)
+}
+
+// CreateDatabase creates the supplied database.
+func (c *Client) CreateDatabase(ctx context.Context, d Database) (Database, error) {
+	return call[Database](ctx, c, "CreateDatabase", d)
+}
+
+// UpdateDatabase overwrites the mutable fields of the supplied database.
+func (c *Client) UpdateDatabase(ctx context.Context, d Database) (Database, error) {
+	return call[Database](ctx, c, "UpdateDatabase", d)
+}
+
+// DeleteDatabase removes the named database.
+func (c *Client) DeleteDatabase(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteDatabase", name)
+	return err
+}
+
+// GetCertificate returns the named certificate.
+func (c *Client) GetCertificate(ctx context.Context, name string) (Certificate, error) {
+	return call[Certificate](ctx, c, "GetCertificate", name)
+}
+
+// CreateCertificate issues the supplied certificate.
+func (c *Client) CreateCertificate(ctx context.Context, cert Certificate) (Certificate, error) {
+	return call[Certificate](ctx, c, "CreateCertificate", cert)
+}
+
+// DeleteCertificate removes the named certificate.
+func (c *Client) DeleteCertificate(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteCertificate", name)
+	return err
+}
+
+// GetTopic returns the named topic.
+func (c *Client) GetTopic(ctx context.Context, name string) (Topic, error) {
+	return call[Topic](ctx, c, "GetTopic", name)
+}
+
+// CreateTopic creates the supplied topic.
+func (c *Client) CreateTopic(ctx context.Context, t Topic) (Topic, error) {
+	return call[Topic](ctx, c, "CreateTopic", t)
+}
+
+// UpdateTopic updates the supplied topic.
+func (c *Client) UpdateTopic(ctx context.Context, t Topic) (Topic, error) {
+	return call[Topic](ctx, c, "UpdateTopic", t)
+}
+
+// DeleteTopic removes the named topic.
+func (c *Client) DeleteTopic(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteTopic", name)
+	return err
+}
+
+// GetAccessPolicy returns the named access policy.
+func (c *Client) GetAccessPolicy(ctx context.Context, name string) (AccessPolicy, error) {
+	return call[AccessPolicy](ctx, c, "GetAccessPolicy", name)
+}
+
+// CreateAccessPolicy creates the supplied access policy.
+func (c *Client) CreateAccessPolicy(ctx context.Context, p AccessPolicy) (AccessPolicy, error) {
+	return call[AccessPolicy](ctx, c, "CreateAccessPolicy", p)
+}
+
+// UpdateAccessPolicy updates the supplied access policy.
+func (c *Client) UpdateAccessPolicy(ctx context.Context, p AccessPolicy) (AccessPolicy, error) {
+	return call[AccessPolicy](ctx, c, "UpdateAccessPolicy", p)
+}
+
+// DeleteAccessPolicy removes the named access policy.
+func (c *Client) DeleteAccessPolicy(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteAccessPolicy", name)
+	return err
+}
+
+// GetSchedule returns the named schedule.
+func (c *Client) GetSchedule(ctx context.Context, name string) (Schedule, error) {
+	return call[Schedule](ctx, c, "GetSchedule", name)
+}
+
+// CreateSchedule creates the supplied schedule.
+func (c *Client) CreateSchedule(ctx context.Context, sc Schedule) (Schedule, error) {
+	return call[Schedule](ctx, c, "CreateSchedule", sc)
+}
+
+// UpdateSchedule updates the supplied schedule.
+func (c *Client) UpdateSchedule(ctx context.Context, sc Schedule) (Schedule, error) {
+	return call[Schedule](ctx, c, "UpdateSchedule", sc)
+}
+
+// DeleteSchedule removes the named schedule.
+func (c *Client) DeleteSchedule(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteSchedule", name)
+	return err
+}
+
+// GetUser returns the named user.
+func (c *Client) GetUser(ctx context.Context, name string) (User, error) {
+	return call[User](ctx, c, "GetUser", name)
+}
+
+// CreateUser creates the supplied user.
+func (c *Client) CreateUser(ctx context.Context, u User) (User, error) {
+	return call[User](ctx, c, "CreateUser", u)
+}
+
+// UpdateUser updates the supplied user.
+func (c *Client) UpdateUser(ctx context.Context, u User) (User, error) {
+	return call[User](ctx, c, "UpdateUser", u)
+}
+
+// DeleteUser removes the named user.
+func (c *Client) DeleteUser(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteUser", name)
+	return err
+}
+
+// GetLink returns the named link.
+func (c *Client) GetLink(ctx context.Context, name string) (Link, error) {
+	return call[Link](ctx, c, "GetLink", name)
+}
+
+// CreateLink creates the supplied link.
+func (c *Client) CreateLink(ctx context.Context, l Link) (Link, error) {
+	return call[Link](ctx, c, "CreateLink", l)
+}
+
+// UpdateLink updates the supplied link.
+func (c *Client) UpdateLink(ctx context.Context, l Link) (Link, error) {
+	return call[Link](ctx, c, "UpdateLink", l)
+}
+
+// DeleteLink removes the named link.
+func (c *Client) DeleteLink(ctx context.Context, name string) error {
+	_, err := call[struct{}](ctx, c, "DeleteLink", name)
+	return err
+}
+
+// ListRegions returns the regions offered by the backend.
+func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
+	return call[[]Region](ctx, c, "ListRegions", struct{}{})
+}
+
+// IssueToken asks the backend to issue a token that expires after the
+// supplied TTL.
+func (c *Client) IssueToken(ctx context.Context, ttl time.Duration) (Token, error) {
+	return call[Token](ctx, c, "IssueToken", TokenRequest{TTL: ttl})
+}
+
+// WhoAmI returns the identity the backend authenticated the client as.
+func (c *Client) WhoAmI(ctx context.Context) (Identity, error) {
+	return call[Identity](ctx, c, "WhoAmI", struct{}{})
+}
+
+// SubscribeNotifications asks the backend to notify the requested URL of the
+// changes made to it, or to renew the URL's subscription.
+func (c *Client) SubscribeNotifications(ctx context.Context, req NotificationRequest) (NotificationSubscription, error) {
+	return call[NotificationSubscription](ctx, c, "SubscribeNotifications", req)
+}
+
+// UnsubscribeNotifications asks the backend to stop notifying the supplied
+// URL.
+func (c *Client) UnsubscribeNotifications(ctx context.Context, url string) error {
+	_, err := call[struct{}](ctx, c, "UnsubscribeNotifications", url)
+	return err
+}
+
+// Watch returns a channel of the changes made to the backend after it was
+// called. The channel is closed when the supplied context is done, or when
+// the watcher falls too far behind or is disconnected.
+func (c *Client) Watch(ctx context.Context) <-chan Event {
+	return c.transport.Watch(ctx, c.codec)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const errUnsupportedContentTypes = "backend supports none of the requested content types %v"
+
+// Content types the backend accepts for payloads.
+const (
+	ContentTypeJSON        = "application/json"
+	ContentTypeMessagePack = "application/msgpack"
+)
+
+// A Codec encodes and decodes the payloads exchanged with the backend.
+type Codec interface {
+	// ContentType identifies the encoding, e.g. "application/json".
+	ContentType() string
+
+	// Marshal encodes the supplied value.
+	Marshal(v any) ([]byte, error)
+
+	// Unmarshal decodes the supplied data into the value pointed to by v.
+	Unmarshal(data []byte, v any) error
+}
+
+// Codecs supported by the backend.
+var (
+	JSON        Codec = jsonCodec{}
+	MessagePack Codec = msgpackCodec{}
+)
+
+var codecs = map[string]Codec{
+	ContentTypeJSON:        JSON,
+	ContentTypeMessagePack: MessagePack,
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string                { return ContentTypeJSON }
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+// msgpackCodec is typically smaller and cheaper to encode than JSON,
+// particularly for payloads dominated by numbers or binary content.
+type msgpackCodec struct{}
+
+func (msgpackCodec) ContentType() string                { return ContentTypeMessagePack }
+func (msgpackCodec) Marshal(v any) ([]byte, error)      { return msgpack.Marshal(v) }
+func (msgpackCodec) Unmarshal(data []byte, v any) error { return msgpack.Unmarshal(data, v) }
+
+// Negotiate returns the codec for the first of the preferred content types
+// that is also supported. JSON is used if no content types are preferred. An
+// error is returned if none of the preferred content types are supported.
+func Negotiate(supported []string, preferred ...string) (Codec, error) {
+	if len(preferred) == 0 {
+		preferred = []string{ContentTypeJSON}
+	}
+	for _, p := range preferred {
+		for _, s := range supported {
+			if p != s {
+				continue
+			}
+			if c, ok := codecs[p]; ok {
+				return c, nil
+			}
+		}
+	}
+	return nil, errors.Errorf(errUnsupportedContentTypes, preferred)
+}
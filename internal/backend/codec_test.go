@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// largeRecord returns a record with n tags.
+func largeRecord(n int) Record {
+	r := Record{Name: "bork-large", BorkValue: map[string]string{"bork": "42"}, Region: DefaultRegion, Tier: DefaultTier, Tags: make(map[string]string, n), Revision: 7}
+	for i := range n {
+		r.Tags[fmt.Sprintf("bork.crossplane.io/tag-%d", i)] = strings.Repeat("v", 32)
+	}
+	return r
+}
+
+// largeObject returns an object with n bytes of content.
+func largeObject(n int) Object {
+	return Object{Name: "object-large", Bucket: "bucket-large", Key: "logs/large.txt", Content: strings.Repeat("bork", n/4), Revision: 7}
+}
+
+func BenchmarkCodecs(b *testing.B) {
+	payloads := map[string]any{
+		"Record/10Tags":    largeRecord(10),
+		"Record/10000Tags": largeRecord(10000),
+		"Object/1KiB":      largeObject(1 << 10),
+		"Object/1MiB":      largeObject(1 << 20),
+	}
+
+	for _, c := range []Codec{JSON, MessagePack} {
+		for name, v := range payloads {
+			b.Run(c.ContentType()+"/"+name, func(b *testing.B) {
+				data, err := c.Marshal(v)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				for range b.N {
+					data, err := c.Marshal(v)
+					if err != nil {
+						b.Fatal(err)
+					}
+					switch v.(type) {
+					case Record:
+						err = c.Unmarshal(data, &Record{})
+					case Object:
+						err = c.Unmarshal(data, &Object{})
+					}
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(len(data)), "payload-bytes")
+			})
+		}
+	}
+}
+
+func BenchmarkClientGet(b *testing.B) {
+	for _, ct := range []string{ContentTypeJSON, ContentTypeMessagePack} {
+		b.Run(ct, func(b *testing.B) {
+			s := NewStore()
+			r, err := s.Create(context.Background(), largeRecord(1000))
+			if err != nil {
+				b.Fatal(err)
+			}
+			c, err := s.Connect(ct)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for range b.N {
+				if _, err := c.Get(context.Background(), r.Name); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const errDatabaseImmutableFmt = "cannot change %s of database %q from %q to %q: it can't be changed once the database is created"
+
+// A Database is a managed database instance. Its Engine and Size can't be
+// changed once it's created.
+type Database struct {
+	// Name uniquely identifies the database within the backend. It is
+	// assigned by the backend when the database is created.
+	Name string
+
+	// Engine the database runs, e.g. postgres. Immutable.
+	Engine string
+
+	// Size of the database's instance, e.g. small. Immutable.
+	Size string
+
+	// StorageGB is how much storage the database has.
+	StorageGB int
+
+	// BackupRetentionDays is how long backups of the database are kept.
+	BackupRetentionDays int
+
+	// MaintenanceWindow is when the backend may perform maintenance, e.g.
+	// sun:03:00-sun:04:00.
+	MaintenanceWindow string
+
+	// Tags attached to the database.
+	Tags map[string]string
+
+	// Endpoint clients connect to. It is assigned by the backend when the
+	// database is created.
+	Endpoint string
+
+	// Revision is assigned by the backend every time the database is
+	// written.
+	Revision int64
+}
+
+// GetDatabase returns the named database.
+func (s *Store) GetDatabase(_ context.Context, name string) (Database, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	d, ok := s.databases[name]
+	if !ok {
+		return Database{}, notFound{errors.Errorf(errDatabaseNotFoundFmt, name)}
+	}
+	return copyDatabase(d), nil
+}
+
+// CreateDatabase stores the supplied database, assigning it a new revision
+// and an endpoint. If the database has no name the backend generates a
+// unique one. It returns an error if a database with the same name already
+// exists.
+func (s *Store) CreateDatabase(_ context.Context, d Database) (Database, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if d.Name == "" {
+		d.Name = generateName("db")
+	}
+	if existing, ok := s.databases[d.Name]; ok {
+		return duplicate(s, copyDatabase(existing), alreadyExists{errors.Errorf(errDatabaseAlreadyExistsFmt, d.Name)})
+	}
+	d.Revision = s.revision.Add(1)
+	d.Endpoint = d.Name + ".db.bork.local"
+	s.databases[d.Name] = copyDatabase(d)
+	s.notify(EventCreated, KindDatabase, d.Name, d.Revision)
+	return d, nil
+}
+
+// UpdateDatabase overwrites the mutable fields of the supplied database,
+// assigning it a new revision. It returns an error if the database does not
+// exist, or if the supplied database's Engine or Size differ from those it
+// was created with.
+func (s *Store) UpdateDatabase(_ context.Context, d Database) (Database, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.databases[d.Name]
+	if !ok {
+		return Database{}, notFound{errors.Errorf(errDatabaseNotFoundFmt, d.Name)}
+	}
+	if d.Engine != existing.Engine {
+		return Database{}, badRequest{errors.Errorf(errDatabaseImmutableFmt, "engine", d.Name, existing.Engine, d.Engine)}
+	}
+	if d.Size != existing.Size {
+		return Database{}, badRequest{errors.Errorf(errDatabaseImmutableFmt, "size", d.Name, existing.Size, d.Size)}
+	}
+	d.Revision = s.revision.Add(1)
+	d.Endpoint = existing.Endpoint
+	s.databases[d.Name] = copyDatabase(d)
+	s.notify(EventUpdated, KindDatabase, d.Name, d.Revision)
+	return d, nil
+}
+
+// DeleteDatabase removes the named database. Deleting a database that does
+// not exist is not an error.
+func (s *Store) DeleteDatabase(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.databases[name]; !ok {
+		return nil
+	}
+	delete(s.databases, name)
+	s.notify(EventDeleted, KindDatabase, name, 0)
+	return nil
+}
+
+// copyDatabase ensures callers never share a Tags map with the store.
+func copyDatabase(d Database) Database {
+	d.Tags = copyTags(d.Tags)
+	return d
+}
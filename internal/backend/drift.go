@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// DriftCheckInterval is how often the drifter checks for records that are
+// due to drift.
+const DriftCheckInterval = time.Second
+
+// TagValueDrifted prefixes the values of tags and data value keys that were
+// mutated by the drifter.
+const TagValueDrifted = "drifted-"
+
+// DataKeyDrifted is the data value key the drifter adds to a record whose
+// data value is empty.
+const DataKeyDrifted = "drifted"
+
+// startDrifter starts the store's drifter if the supplied record drifts and
+// the drifter isn't already running. The drifter runs for the life of the
+// process. The caller must hold the store's write lock.
+func (s *Store) startDrifter(r Record) {
+	if r.DriftInterval <= 0 {
+		return
+	}
+	s.drifter.Do(func() { go s.drift() })
+}
+
+// drift mutates every record that hasn't been written for its drift interval.
+// Only ACTIVE records drift.
+func (s *Store) drift() {
+	t := time.NewTicker(DriftCheckInterval)
+	defer t.Stop()
+
+	for now := range t.C {
+		s.mu.Lock()
+		for _, r := range s.records.list() {
+			if r.DriftInterval <= 0 || r.State != RecordActive || now.Before(r.LastModified.Add(r.DriftInterval)) {
+				continue
+			}
+			s.rewrite(mutate(r), now)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// DriftRecords mutates every ACTIVE record now, whatever its drift interval,
+// as if it had drifted. It returns how many records drifted. Each of the
+// store's regions drifts its records too.
+func (s *Store) DriftRecords() int {
+	n := 0
+	s.mu.Lock()
+	now := time.Now()
+	for _, r := range s.records.list() {
+		if r.State != RecordActive {
+			continue
+		}
+		s.rewrite(mutate(r), now)
+		n++
+	}
+	s.mu.Unlock()
+	s.eachRegion(func(p *Store) { n += p.DriftRecords() })
+	return n
+}
+
+// mutate returns a copy of the supplied record with either a key of its data
+// value or one of the tags that the backend didn't add changed at random.
+func mutate(r Record) Record {
+	r = copyRecord(r)
+
+	tags := make([]string, 0, len(r.Tags))
+	for k := range r.Tags {
+		if _, ok := DefaultTags[k]; !ok {
+			tags = append(tags, k)
+		}
+	}
+	if len(tags) > 0 && rand.IntN(2) == 0 {
+		r.Tags[tags[rand.IntN(len(tags))]] = TagValueDrifted + strconv.Itoa(rand.IntN(1000))
+		return r
+	}
+
+	keys := slices.Sorted(maps.Keys(r.DataValue))
+	k := DataKeyDrifted
+	if len(keys) > 0 {
+		k = keys[rand.IntN(len(keys))]
+	}
+	if r.DataValue == nil {
+		r.DataValue = make(map[string]string, 1)
+	}
+	r.DataValue[k] = TagValueDrifted + strconv.Itoa(rand.IntN(1000))
+	return r
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+// A DuplicateCreatePolicy determines what the backend does when it's asked to
+// create a resource that already exists.
+type DuplicateCreatePolicy string
+
+// Duplicate create policies.
+const (
+	// DuplicateCreateReject fails the create with an error that satisfies
+	// IsAlreadyExists.
+	DuplicateCreateReject DuplicateCreatePolicy = "Reject"
+
+	// DuplicateCreateSucceed returns the existing resource, unchanged, as if
+	// it had just been created. It's how an idempotent API behaves.
+	DuplicateCreateSucceed DuplicateCreatePolicy = "Succeed"
+)
+
+// DuplicateCreatePolicies are the supported duplicate create policies.
+var DuplicateCreatePolicies = []DuplicateCreatePolicy{DuplicateCreateReject, DuplicateCreateSucceed}
+
+// SetDuplicateCreatePolicy sets what the store does when it's asked to create
+// a resource of any kind that already exists, which happens when a create
+// that succeeded is retried. The store rejects such creates by default. Each
+// of the store's regions handles duplicate creates the same way.
+func (s *Store) SetDuplicateCreatePolicy(p DuplicateCreatePolicy) {
+	defer s.eachRegion(func(r *Store) { r.SetDuplicateCreatePolicy(p) })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.duplicates = p
+}
+
+// duplicate returns the result of asking the supplied store to create a
+// resource that already exists: either the existing resource, or the
+// supplied error. The caller must hold the store's write lock.
+func duplicate[T any](s *Store, existing T, err error) (T, error) {
+	if s.duplicates == DuplicateCreateSucceed {
+		return existing, nil
+	}
+	var zero T
+	return zero, err
+}
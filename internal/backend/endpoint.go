@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errUnknownServiceFmt    = "service %q is not offered by the backend"
+	errRegionUnavailableFmt = "region %q is not available"
+	errEndpointImmutableFmt = "cannot change the %s of endpoint %q"
+)
+
+// privateDNSNameFmt formats the private DNS name of an endpoint from its name,
+// service, and region.
+const privateDNSNameFmt = "%s.%s.%s.private.bork.internal"
+
+// Services to which the backend can provision private endpoints.
+var Services = []string{"bork-api", "bork-metrics", "bork-storage"}
+
+// An EndpointState is the state of a private connection.
+type EndpointState string
+
+// Endpoint states. An endpoint must be accepted by its service before it is
+// provisioned and becomes available.
+const (
+	EndpointPendingAcceptance EndpointState = "PendingAcceptance"
+	EndpointAccepted          EndpointState = "Accepted"
+	EndpointAvailable         EndpointState = "Available"
+)
+
+// Delays with which an endpoint progresses through its states.
+const (
+	// EndpointAcceptanceDelay is how long after it is created an endpoint
+	// is accepted by its service.
+	EndpointAcceptanceDelay = 10 * time.Second
+
+	// EndpointProvisioningDelay is how long after it is accepted an
+	// endpoint becomes available.
+	EndpointProvisioningDelay = 20 * time.Second
+)
+
+// A ServiceEndpoint is a private connection to a backend service.
+type ServiceEndpoint struct {
+	// Name uniquely identifies the endpoint within the backend. It is
+	// assigned by the backend when the endpoint is created.
+	Name string
+
+	// Service to which the endpoint connects. It cannot be changed.
+	Service string
+
+	// Region in which the endpoint is provisioned. Defaults to
+	// DefaultRegion. It cannot be changed.
+	Region string
+
+	// PrivateDNSEnabled assigns the endpoint a private DNS name once it is
+	// available.
+	PrivateDNSEnabled bool
+
+	// Tags attached to the endpoint.
+	Tags map[string]string
+
+	// State of the endpoint. It is managed by the backend.
+	State EndpointState
+
+	// PrivateDNSName of the endpoint. It is assigned by the backend once the
+	// endpoint is available, if private DNS is enabled.
+	PrivateDNSName string
+
+	// CreatedAt is the time at which the endpoint was created.
+	CreatedAt time.Time
+
+	// Revision is assigned by the backend every time the endpoint is written.
+	// Changes to the endpoint's state don't change its revision.
+	Revision int64
+}
+
+// GetServiceEndpoint returns the named endpoint, first progressing its state
+// if it is due to change.
+func (s *Store) GetServiceEndpoint(_ context.Context, name string) (ServiceEndpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.endpoints[name]
+	if !ok {
+		return ServiceEndpoint{}, notFound{errors.Errorf(errEndpointNotFoundFmt, name)}
+	}
+	if p := progress(e, time.Now()); p.State != e.State || p.PrivateDNSName != e.PrivateDNSName {
+		e = p
+		s.endpoints[name] = e
+		s.notify(EventUpdated, KindServiceEndpoint, e.Name, e.Revision)
+	}
+	return copyEndpoint(e), nil
+}
+
+// CreateServiceEndpoint requests a private connection to a service, assigning
+// it a new revision. If the endpoint has no name the backend generates a
+// unique one. New endpoints are pending acceptance by their service. It
+// returns an error if an endpoint with the same name already exists, or if
+// the service or region is not offered.
+func (s *Store) CreateServiceEndpoint(_ context.Context, e ServiceEndpoint) (ServiceEndpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !slices.Contains(Services, e.Service) {
+		return ServiceEndpoint{}, errors.Errorf(errUnknownServiceFmt, e.Service)
+	}
+	if e.Region == "" {
+		e.Region = DefaultRegion
+	}
+	if r, ok := s.regions[e.Region]; !ok || !r.Available {
+		return ServiceEndpoint{}, errors.Errorf(errRegionUnavailableFmt, e.Region)
+	}
+	if e.Name == "" {
+		e.Name = generateName("endpoint")
+	}
+	if existing, ok := s.endpoints[e.Name]; ok {
+		return duplicate(s, copyEndpoint(existing), alreadyExists{errors.Errorf(errEndpointAlreadyExistsFmt, e.Name)})
+	}
+	e.State = EndpointPendingAcceptance
+	e.PrivateDNSName = ""
+	e.CreatedAt = time.Now()
+	e.Revision = s.revision.Add(1)
+	s.endpoints[e.Name] = copyEndpoint(e)
+	s.notify(EventCreated, KindServiceEndpoint, e.Name, e.Revision)
+	return e, nil
+}
+
+// UpdateServiceEndpoint overwrites the tags and private DNS setting of the
+// supplied endpoint, assigning it a new revision. It returns an error if the
+// endpoint does not exist, or if its service or region would change.
+func (s *Store) UpdateServiceEndpoint(_ context.Context, e ServiceEndpoint) (ServiceEndpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.endpoints[e.Name]
+	if !ok {
+		return ServiceEndpoint{}, notFound{errors.Errorf(errEndpointNotFoundFmt, e.Name)}
+	}
+	if e.Region == "" {
+		e.Region = DefaultRegion
+	}
+	if e.Service != existing.Service {
+		return ServiceEndpoint{}, errors.Errorf(errEndpointImmutableFmt, "service", e.Name)
+	}
+	if e.Region != existing.Region {
+		return ServiceEndpoint{}, errors.Errorf(errEndpointImmutableFmt, "region", e.Name)
+	}
+	existing.Tags = e.Tags
+	existing.PrivateDNSEnabled = e.PrivateDNSEnabled
+	existing = progress(existing, time.Now())
+	existing.Revision = s.revision.Add(1)
+	s.endpoints[e.Name] = copyEndpoint(existing)
+	s.notify(EventUpdated, KindServiceEndpoint, e.Name, existing.Revision)
+	return copyEndpoint(existing), nil
+}
+
+// DeleteServiceEndpoint removes the named endpoint. Deleting an endpoint that
+// does not exist is not an error.
+func (s *Store) DeleteServiceEndpoint(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.endpoints[name]; !ok {
+		return nil
+	}
+	delete(s.endpoints, name)
+	s.notify(EventDeleted, KindServiceEndpoint, name, 0)
+	return nil
+}
+
+// progress returns the supplied endpoint in the state it should be in at the
+// supplied time.
+func progress(e ServiceEndpoint, now time.Time) ServiceEndpoint {
+	accepted := e.CreatedAt.Add(EndpointAcceptanceDelay)
+	available := accepted.Add(EndpointProvisioningDelay)
+	switch {
+	case now.Before(accepted):
+		e.State = EndpointPendingAcceptance
+	case now.Before(available):
+		e.State = EndpointAccepted
+	default:
+		e.State = EndpointAvailable
+	}
+
+	e.PrivateDNSName = ""
+	if e.State == EndpointAvailable && e.PrivateDNSEnabled {
+		e.PrivateDNSName = fmt.Sprintf(privateDNSNameFmt, e.Name, e.Service, e.Region)
+	}
+	return e
+}
+
+func copyEndpoint(e ServiceEndpoint) ServiceEndpoint {
+	e.Tags = copyTags(e.Tags)
+	return e
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"github.com/pkg/errors"
+)
+
+// An ErrorCode classifies an error returned by the backend. Codes survive
+// being sent over HTTP or gRPC, so a client can classify an error the same
+// way whichever transport it uses.
+type ErrorCode string
+
+// Error codes.
+const (
+	ErrorCodeNotFound            ErrorCode = "NotFound"
+	ErrorCodeAlreadyExists       ErrorCode = "AlreadyExists"
+	ErrorCodeConflict            ErrorCode = "Conflict"
+	ErrorCodeDependencyViolation ErrorCode = "DependencyViolation"
+	ErrorCodeBadRequest          ErrorCode = "BadRequest"
+	ErrorCodeUnauthorized        ErrorCode = "Unauthorized"
+	ErrorCodeThrottled           ErrorCode = "Throttled"
+	ErrorCodeCredentialsExpired  ErrorCode = "CredentialsExpired"
+	ErrorCodeInternal            ErrorCode = "Internal"
+	ErrorCodeTimeout             ErrorCode = "Timeout"
+	ErrorCodeUnavailable         ErrorCode = "Unavailable"
+	ErrorCodeUnknown             ErrorCode = "Unknown"
+)
+
+type unauthorized struct{ error }
+
+func (unauthorized) Unauthorized() bool { return true }
+
+// IsUnauthorized returns true if the supplied error indicates the backend
+// rejected a client's credentials.
+func IsUnauthorized(err error) bool {
+	var u interface{ Unauthorized() bool }
+	return errors.As(err, &u) && u.Unauthorized()
+}
+
+type conflict struct{ error }
+
+func (conflict) Conflict() bool { return true }
+
+// IsConflict returns true if the supplied error indicates a request conflicts
+// with the current state of what it operates on, for example because it
+// updates a record that is being deleted. Unlike a request that already
+// exists, it may succeed if retried once that state changes.
+func IsConflict(err error) bool {
+	var c interface{ Conflict() bool }
+	return errors.As(err, &c) && c.Conflict()
+}
+
+type dependencyViolation struct{ error }
+
+func (dependencyViolation) DependencyViolation() bool { return true }
+
+// IsDependencyViolation returns true if the supplied error indicates a
+// resource can't be deleted because other resources depend on it, for example
+// because objects are stored in a bucket. It may succeed if retried once its
+// dependents are deleted.
+func IsDependencyViolation(err error) bool {
+	var d interface{ DependencyViolation() bool }
+	return errors.As(err, &d) && d.DependencyViolation()
+}
+
+type internal struct{ error }
+
+func (internal) Internal() bool { return true }
+
+// IsInternal returns true if the supplied error indicates the backend failed
+// to serve a request through no fault of the request.
+func IsInternal(err error) bool {
+	var i interface{ Internal() bool }
+	return errors.As(err, &i) && i.Internal()
+}
+
+type malformed struct{ error }
+
+func (malformed) Malformed() bool { return true }
+
+// IsMalformed returns true if the supplied error indicates a client couldn't
+// decode the backend's response, for example because the resource it
+// describes is corrupted. It's detected by the client, so it has no code.
+func IsMalformed(err error) bool {
+	var m interface{ Malformed() bool }
+	return errors.As(err, &m) && m.Malformed()
+}
+
+// ErrorCodes are the codes an error can be classified as.
+var ErrorCodes = []ErrorCode{
+	ErrorCodeNotFound,
+	ErrorCodeAlreadyExists,
+	ErrorCodeConflict,
+	ErrorCodeDependencyViolation,
+	ErrorCodeBadRequest,
+	ErrorCodeUnauthorized,
+	ErrorCodeThrottled,
+	ErrorCodeCredentialsExpired,
+	ErrorCodeInternal,
+	ErrorCodeTimeout,
+	ErrorCodeUnavailable,
+	ErrorCodeUnknown,
+}
+
+// NewError returns an error with the supplied message that is classified as
+// the supplied code, as if the backend returned it. A throttled error doesn't
+// say how long to wait before retrying.
+func NewError(code ErrorCode, msg string) error {
+	err := errors.New(msg)
+	switch code {
+	case ErrorCodeNotFound:
+		return notFound{err}
+	case ErrorCodeAlreadyExists:
+		return alreadyExists{err}
+	case ErrorCodeConflict:
+		return conflict{err}
+	case ErrorCodeDependencyViolation:
+		return dependencyViolation{err}
+	case ErrorCodeBadRequest:
+		return badRequest{err}
+	case ErrorCodeUnauthorized:
+		return unauthorized{err}
+	case ErrorCodeThrottled:
+		return throttled{error: err}
+	case ErrorCodeCredentialsExpired:
+		return credentialsExpired{err}
+	case ErrorCodeInternal:
+		return internal{err}
+	case ErrorCodeTimeout:
+		return timeout{err}
+	case ErrorCodeUnavailable:
+		return unavailable{err}
+	default:
+		return err
+	}
+}
+
+// Code returns the code of the supplied error, which must not be nil.
+func Code(err error) ErrorCode {
+	switch {
+	case IsNotFound(err):
+		return ErrorCodeNotFound
+	case IsAlreadyExists(err):
+		return ErrorCodeAlreadyExists
+	case IsConflict(err):
+		return ErrorCodeConflict
+	case IsDependencyViolation(err):
+		return ErrorCodeDependencyViolation
+	case isBadRequest(err):
+		return ErrorCodeBadRequest
+	case IsCredentialsExpired(err):
+		return ErrorCodeCredentialsExpired
+	case IsUnauthorized(err):
+		return ErrorCodeUnauthorized
+	case IsThrottled(err):
+		return ErrorCodeThrottled
+	case IsInternal(err):
+		return ErrorCodeInternal
+	case IsTimeout(err):
+		return ErrorCodeTimeout
+	case IsUnavailable(err):
+		return ErrorCodeUnavailable
+	default:
+		return ErrorCodeUnknown
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const errExportDestination = "export must have exactly one of a bucket or a URI destination"
+
+// FirstExportDelay is how long after an export is created that it first
+// runs. Subsequent runs are scheduled every Interval after the previous run.
+const FirstExportDelay = 30 * time.Second
+
+// An Export periodically exports the backend's usage data to a destination.
+type Export struct {
+	// Name uniquely identifies the export within the backend. It is assigned
+	// by the backend when the export is created.
+	Name string
+
+	// Interval between runs of the export.
+	Interval time.Duration
+
+	// Bucket to which usage data is exported, as an object whose key begins
+	// with Prefix. Exactly one of Bucket and URI must be set.
+	Bucket string
+
+	// Prefix of the keys of the objects the export writes to Bucket.
+	Prefix string
+
+	// URI to which usage data is exported. Exactly one of Bucket and URI must
+	// be set.
+	URI string
+
+	// CreatedAt is the time at which the export was created. It is set by the
+	// backend.
+	CreatedAt time.Time
+
+	// LastExportTime is the time at which the export last succeeded. It is
+	// zero if the export has never succeeded.
+	LastExportTime time.Time
+
+	// LastExportError is the error encountered by the most recent run of the
+	// export, if it failed.
+	LastExportError string
+
+	// Exports is the number of times the export has succeeded.
+	Exports int
+
+	// Revision is assigned by the backend every time the export is written.
+	// Runs of the export don't change its revision.
+	Revision int64
+}
+
+// GetExport returns the named export, first running it if it is due.
+func (s *Store) GetExport(_ context.Context, name string) (Export, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.exports[name]
+	if !ok {
+		return Export{}, notFound{errors.Errorf(errExportNotFoundFmt, name)}
+	}
+	if now := time.Now(); !now.Before(nextRun(e)) {
+		e = s.runExport(e, now)
+		s.exports[name] = e
+		s.notify(EventUpdated, KindExport, name, e.Revision)
+	}
+	return e, nil
+}
+
+// CreateExport stores the supplied export, assigning it a new revision. If
+// the export has no name the backend generates a unique one. It returns an
+// error if an export with the same name already exists.
+func (s *Store) CreateExport(_ context.Context, e Export) (Export, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if (e.Bucket == "") == (e.URI == "") {
+		return Export{}, errors.New(errExportDestination)
+	}
+	if e.Name == "" {
+		e.Name = generateName("export")
+	}
+	if existing, ok := s.exports[e.Name]; ok {
+		return duplicate(s, existing, alreadyExists{errors.Errorf(errExportAlreadyExistsFmt, e.Name)})
+	}
+	e.CreatedAt = time.Now()
+	e.LastExportTime = time.Time{}
+	e.LastExportError = ""
+	e.Exports = 0
+	e.Revision = s.revision.Add(1)
+	s.exports[e.Name] = e
+	s.notify(EventCreated, KindExport, e.Name, e.Revision)
+	return e, nil
+}
+
+// UpdateExport overwrites the configuration of the supplied export, assigning
+// it a new revision. The export's run history is preserved. It returns an
+// error if the export does not exist.
+func (s *Store) UpdateExport(_ context.Context, e Export) (Export, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.exports[e.Name]
+	if !ok {
+		return Export{}, notFound{errors.Errorf(errExportNotFoundFmt, e.Name)}
+	}
+	if (e.Bucket == "") == (e.URI == "") {
+		return Export{}, errors.New(errExportDestination)
+	}
+	e.CreatedAt = existing.CreatedAt
+	e.LastExportTime = existing.LastExportTime
+	e.LastExportError = existing.LastExportError
+	e.Exports = existing.Exports
+	e.Revision = s.revision.Add(1)
+	s.exports[e.Name] = e
+	s.notify(EventUpdated, KindExport, e.Name, e.Revision)
+	return e, nil
+}
+
+// DeleteExport removes the named export. Deleting an export that does not
+// exist is not an error. Usage data that was already exported is retained.
+func (s *Store) DeleteExport(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.exports[name]; !ok {
+		return nil
+	}
+	delete(s.exports, name)
+	s.notify(EventDeleted, KindExport, name, 0)
+	return nil
+}
+
+// nextRun returns the time at which the supplied export is next due to run.
+// An export that has never succeeded is retried every Interval after its
+// first run.
+func nextRun(e Export) time.Time {
+	first := e.CreatedAt.Add(FirstExportDelay)
+	if e.LastExportTime.IsZero() && e.LastExportError == "" {
+		return first
+	}
+	last := e.LastExportTime
+	if last.IsZero() {
+		last = first
+	}
+	return last.Add(e.Interval)
+}
+
+// runExport exports the backend's usage data to the supplied export's
+// destination. The caller must hold the store's write lock.
+func (s *Store) runExport(e Export, now time.Time) Export {
+	if e.Bucket != "" {
+		if _, ok := s.buckets[e.Bucket]; !ok {
+			e.LastExportError = errors.Errorf(errBucketNotFoundFmt, e.Bucket).Error()
+			return e
+		}
+		o := Object{
+			Name:     generateName("object"),
+			Bucket:   e.Bucket,
+			Key:      e.Prefix + now.UTC().Format(time.RFC3339) + ".csv",
+			Content:  s.usageReport(),
+			Revision: s.revision.Add(1),
+		}
+		s.objects[o.Name] = o
+		s.notify(EventCreated, KindObject, o.Name, o.Revision)
+	}
+
+	// Exports to a URI are simulated; they always succeed.
+	e.LastExportTime = now
+	e.LastExportError = ""
+	e.Exports++
+	return e
+}
+
+// usageReport returns a CSV report of the resources stored by the backend.
+// The caller must hold the store's lock.
+func (s *Store) usageReport() string {
+	usage := map[string]int{
+		KindRecord:          s.records.len(),
+		KindPlacement:       len(s.placements),
+		KindBucket:          len(s.buckets),
+		KindPlan:            len(s.plans),
+		KindKey:             len(s.keys),
+		KindObject:          len(s.objects),
+		KindExport:          len(s.exports),
+		KindServiceEndpoint: len(s.endpoints),
+	}
+	kinds := make([]string, 0, len(usage))
+	for k := range usage {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+
+	b := &strings.Builder{}
+	b.WriteString("kind,count\n")
+	for _, k := range kinds {
+		fmt.Fprintf(b, "%s,%d\n", k, usage[k])
+	}
+	return b.String()
+}
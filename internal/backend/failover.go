@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+const (
+	errRegionUnhealthyFmt = "bork API in region %q is unavailable"
+	errNoRegions          = "no regions to fail over between"
+)
+
+// Region returns the partition of the store that serves the named region,
+// creating it if needed. Each partition stores resources of its own, and is
+// throttled, hangs, pages lists, serves an API version, handles duplicate
+// creates and fails transactions like the store it partitions. Partitions aren't persisted.
+func (s *Store) Region(name string) *Store {
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+	if p, ok := s.partitions[name]; ok {
+		return p
+	}
+
+	p := NewStore()
+	p.region = name
+	if l := s.limiter.Load(); l != nil {
+		p.limiter.Store(rate.NewLimiter(l.Limit(), l.Burst()))
+	}
+	p.hang.Store(s.hang.Load())
+	p.opFault.Store(s.opFault.Load())
+	p.paging.Store(s.paging.Load())
+	p.apiVersion.Store(s.apiVersion.Load())
+	s.mu.RLock()
+	p.duplicates = s.duplicates
+	p.unknownFields = s.unknownFields
+	if s.txFault != nil {
+		f := *s.txFault
+		p.txFault = &f
+	}
+	s.mu.RUnlock()
+	p.unhealthy.Store(s.regionsUnhealthy[name])
+	if s.partitions == nil {
+		s.partitions = make(map[string]*Store)
+	}
+	s.partitions[name] = p
+	return p
+}
+
+// SetRegionHealthy marks the named region of the store healthy or unhealthy,
+// whether or not its partition has been created yet. Every operation
+// performed in an unhealthy region fails with an error that satisfies
+// IsUnavailable, as if the region's API were down.
+func (s *Store) SetRegionHealthy(name string, healthy bool) {
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+	if s.regionsUnhealthy == nil {
+		s.regionsUnhealthy = make(map[string]bool)
+	}
+	if healthy {
+		delete(s.regionsUnhealthy, name)
+	} else {
+		s.regionsUnhealthy[name] = true
+	}
+	if p, ok := s.partitions[name]; ok {
+		p.unhealthy.Store(!healthy)
+	}
+}
+
+// eachRegion calls the supplied function with every partition of the store.
+func (s *Store) eachRegion(fn func(p *Store)) {
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+	for _, p := range s.partitions {
+		fn(p)
+	}
+}
+
+// available returns an error if the store is the partition of an unhealthy
+// region.
+func (s *Store) available() error {
+	if !s.unhealthy.Load() {
+		return nil
+	}
+	return unavailable{errors.Errorf(errRegionUnhealthyFmt, s.region)}
+}
+
+type unavailable struct{ error }
+
+func (unavailable) Unavailable() bool { return true }
+
+// IsUnavailable returns true if the supplied error indicates the backend
+// couldn't serve a request at all, for example because its region is down.
+// Unlike an internal error, the request may succeed if it's sent elsewhere.
+func IsUnavailable(err error) bool {
+	var u interface{ Unavailable() bool }
+	return errors.As(err, &u) && u.Unavailable()
+}
+
+// Failover returns a client that performs each operation using the first of
+// the supplied clients, in order, that isn't unavailable. It fails over to
+// the next client only when an operation fails with an error that satisfies
+// IsUnavailable, and fails with that error if every client is unavailable.
+// The returned client watches every client's backend, and closing it closes
+// every client. Payloads are encoded using the first client's content type.
+func Failover(clients ...*Client) (*Client, error) {
+	if len(clients) == 0 {
+		return nil, errors.New(errNoRegions)
+	}
+	f := make(failover, len(clients))
+	for i, c := range clients {
+		f[i] = c.transport
+	}
+	return &Client{transport: f, codec: clients[0].codec, version: &servedVersion{}}, nil
+}
+
+// A failover transport delivers each request to the first of its transports
+// that's available.
+type failover []transport
+
+func (f failover) Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	var err error
+	for _, t := range f {
+		var resp []byte
+		resp, err = t.Do(ctx, op, c, req)
+		if !IsUnavailable(err) {
+			return resp, err
+		}
+	}
+	return nil, err
+}
+
+// Watch merges the changes made to every transport's backend, so that changes
+// made in a region that was failed over to are seen too.
+func (f failover) Watch(ctx context.Context, c Codec) <-chan Event {
+	out := make(chan Event, WatchBufferSize)
+	wg := &sync.WaitGroup{}
+	for _, t := range f {
+		wg.Add(1)
+		go func(in <-chan Event) {
+			defer wg.Done()
+			for e := range in {
+				select {
+				case out <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(t.Watch(ctx, c))
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+func (f failover) Close() error {
+	var err error
+	for _, t := range f {
+		if cerr := t.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+const errOperationFaultFmt = "bork API failed %s request with a simulated %s error"
+
+// An OperationFault fails a store's operations with an error of a code, so
+// that a backend that fails persistently, rather than one resource at a time,
+// can be simulated.
+type OperationFault struct {
+	// Code the operations fail with.
+	Code ErrorCode `json:"code"`
+
+	// Operations that fail, e.g. Create or CreateBucket. Every operation
+	// fails if it's empty.
+	Operations []string `json:"operations,omitempty"`
+}
+
+// SetOperationFault makes the store fail its operations per the supplied
+// fault. A nil fault clears any fault. An operation that fails isn't
+// performed. Each of the store's regions fails operations the same way.
+func (s *Store) SetOperationFault(f *OperationFault) {
+	defer s.eachRegion(func(p *Store) { p.SetOperationFault(f) })
+	if f == nil {
+		s.opFault.Store(nil)
+		return
+	}
+	cp := OperationFault{Code: f.Code, Operations: slices.Clone(f.Operations)}
+	s.opFault.Store(&cp)
+}
+
+// fault returns the error the named operation fails with, if the store is
+// failing it.
+func (s *Store) fault(op string) error {
+	f := s.opFault.Load()
+	if f == nil || (len(f.Operations) > 0 && !slices.Contains(f.Operations, op)) {
+		return nil
+	}
+	return NewError(f.Code, errors.Errorf(errOperationFaultFmt, op, f.Code).Error())
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	errReadStateFmt   = "cannot read backend state from %s"
+	errDecodeStateFmt = "cannot decode backend state from %s"
+	errEncodeState    = "cannot encode backend state"
+	errWriteStateFmt  = "cannot write backend state to %s"
+)
+
+// A snapshot is everything a store persists to its file. Tokens aren't
+// persisted, so tokens issued before a restart are treated as expired.
+type snapshot struct {
+	Revision     int64                      `json:"revision"`
+	Account      string                     `json:"account,omitempty"`
+	Records      map[string]Record          `json:"records,omitempty"`
+	Placements   map[string]Placement       `json:"placements,omitempty"`
+	Buckets      map[string]Bucket          `json:"buckets,omitempty"`
+	Plans        map[string]Plan            `json:"plans,omitempty"`
+	Keys         map[string]Key             `json:"keys,omitempty"`
+	Objects      map[string]Object          `json:"objects,omitempty"`
+	Regions      map[string]Region          `json:"regions,omitempty"`
+	Exports      map[string]Export          `json:"exports,omitempty"`
+	Endpoints    map[string]ServiceEndpoint `json:"endpoints,omitempty"`
+	Databases    map[string]Database        `json:"databases,omitempty"`
+	Certificates map[string]Certificate     `json:"certificates,omitempty"`
+	Topics       map[string]Topic           `json:"topics,omitempty"`
+	Policies     map[string]AccessPolicy    `json:"policies,omitempty"`
+	Schedules    map[string]Schedule        `json:"schedules,omitempty"`
+	Users        map[string]User            `json:"users,omitempty"`
+	Links        map[string]Link            `json:"links,omitempty"`
+	Transactions map[string][]string        `json:"transactions,omitempty"`
+
+	// Queues are persisted as of their latest write, which is visible as
+	// soon as the store is loaded.
+	Queues map[string]Queue `json:"queues,omitempty"`
+}
+
+// PersistTo persists the store to the file at the supplied path, so that what
+// it stores survives restarts. If the file exists the store's contents are
+// replaced with those of the file. The file is then rewritten every time the
+// store is written. Errors writing the file are passed to the supplied
+// function, which may be nil; the store's contents are unaffected.
+func (s *Store) PersistTo(path string, onError func(error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := os.ReadFile(filepath.Clean(path))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return errors.Wrapf(err, errReadStateFmt, path)
+	default:
+		snap := snapshot{}
+		if err := json.Unmarshal(b, &snap); err != nil {
+			return errors.Wrapf(err, errDecodeStateFmt, path)
+		}
+		s.restore(snap)
+	}
+
+	s.persist = func() {
+		if err := writeSnapshot(path, s.snapshot()); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+	s.persist()
+	return nil
+}
+
+// snapshot returns everything the store persists. The caller must hold the
+// store's lock.
+func (s *Store) snapshot() snapshot {
+	snap := snapshot{
+		Revision:     s.revision.Load(),
+		Account:      s.account,
+		Records:      s.records.all(),
+		Placements:   s.placements,
+		Buckets:      s.buckets,
+		Plans:        s.plans,
+		Keys:         s.keys,
+		Objects:      s.objects,
+		Regions:      s.regions,
+		Exports:      s.exports,
+		Endpoints:    s.endpoints,
+		Databases:    s.databases,
+		Certificates: s.certificates,
+		Topics:       s.topics,
+		Policies:     s.policies,
+		Schedules:    s.schedules,
+		Users:        s.users,
+		Links:        s.links,
+		Transactions: s.transactions,
+		Queues:       make(map[string]Queue, len(s.queues)),
+	}
+	for name, h := range s.queues {
+		if q, ok := h.latest(); ok {
+			snap.Queues[name] = q
+		}
+	}
+	return snap
+}
+
+// restore replaces the store's contents with the supplied snapshot. The
+// caller must hold the store's write lock.
+func (s *Store) restore(snap snapshot) {
+	s.revision.Store(snap.Revision)
+	if snap.Account != "" {
+		s.account = snap.Account
+	}
+	s.placements = orEmpty(snap.Placements)
+	s.buckets = orEmpty(snap.Buckets)
+	s.plans = orEmpty(snap.Plans)
+	s.keys = orEmpty(snap.Keys)
+	s.objects = orEmpty(snap.Objects)
+	s.exports = orEmpty(snap.Exports)
+	s.endpoints = orEmpty(snap.Endpoints)
+	s.databases = orEmpty(snap.Databases)
+	s.certificates = orEmpty(snap.Certificates)
+	s.topics = orEmpty(snap.Topics)
+	s.topicReads = make(map[string]int)
+	s.policies = orEmpty(snap.Policies)
+	s.schedules = orEmpty(snap.Schedules)
+	s.users = orEmpty(snap.Users)
+	s.links = orEmpty(snap.Links)
+	s.transactions = orEmpty(snap.Transactions)
+	s.malformed = make(map[string]int64)
+	if len(snap.Regions) > 0 {
+		s.regions = snap.Regions
+	}
+
+	s.queues = make(map[string]queueHistory, len(snap.Queues))
+	for name, q := range snap.Queues {
+		s.queues[name] = queueHistory{}.write(q, false, time.Time{})
+	}
+
+	records := orEmpty(snap.Records)
+	for name, r := range records {
+		// Records persisted before records had UIDs are given one.
+		if r.UID == "" {
+			r.UID = uuid.NewString()
+			records[name] = r
+		}
+		s.startDrifter(r)
+	}
+	s.records.replace(records)
+}
+
+func orEmpty[T any](m map[string]T) map[string]T {
+	if m == nil {
+		return make(map[string]T)
+	}
+	return m
+}
+
+// writeSnapshot writes the supplied snapshot to the file at the supplied
+// path. It writes a temporary file that it renames, so that the file is
+// never partially written.
+func writeSnapshot(path string, snap snapshot) error {
+	b, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, errEncodeState)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return errors.Wrapf(err, errWriteStateFmt, path)
+	}
+	return errors.Wrapf(os.Rename(tmp, path), errWriteStateFmt, path)
+}
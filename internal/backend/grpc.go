@@ -0,0 +1,370 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/crossplane/provider-bork/apis/backend/proto/v1alpha1"
+)
+
+const (
+	errNewGRPCClient   = "cannot create gRPC client"
+	errCloseGRPCClient = "cannot close gRPC client"
+	errParseURL        = "cannot parse endpoint URL"
+)
+
+// Keepalive parameters used by gRPC clients and servers. Clients ping idle
+// connections so that a dead server is detected before the next reconcile
+// tries to use it, rather than when it times out.
+const (
+	GRPCKeepaliveTime    = 30 * time.Second
+	GRPCKeepaliveTimeout = 10 * time.Second
+)
+
+func init() {
+	Register(BackendGRPC, func(ctx context.Context, o DialOptions) (*Client, error) {
+		if o.Endpoint == "" {
+			return nil, errors.Errorf(errBackendRequiresEndFmt, BackendGRPC)
+		}
+		target, secure, err := grpcTarget(o.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		creds := insecure.NewCredentials()
+		if secure {
+			creds = credentials.NewTLS(o.TLS)
+		}
+		return DialGRPC(ctx, target, creds, o.Token, o.ContentTypes...)
+	})
+}
+
+// grpcTarget returns the host and port to dial to reach the supplied
+// endpoint URL using gRPC, and whether TLS should be used. The port defaults
+// to that of the URL's scheme.
+func grpcTarget(u string) (string, bool, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", false, errors.Wrap(err, errParseURL)
+	}
+	secure := parsed.Scheme == "https"
+	port := parsed.Port()
+	if port == "" {
+		port = "80"
+		if secure {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(parsed.Hostname(), port), secure, nil
+}
+
+// NewGRPCServer returns a gRPC server that serves the bork API backed by the
+// supplied store. If token is not empty every call must present it, or a
+// token issued by the store, as a bearer token using the authorization
+// metadata key. The supplied options are passed to the underlying server,
+// e.g. to configure TLS.
+func NewGRPCServer(s *Store, token string, o ...grpc.ServerOption) *grpc.Server {
+	o = append(o, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             GRPCKeepaliveTime / 2,
+		PermitWithoutStream: true,
+	}))
+	a := &authenticator{store: s, want: token}
+	o = append(o, grpc.ChainUnaryInterceptor(a.unary), grpc.ChainStreamInterceptor(a.stream))
+	srv := grpc.NewServer(o...)
+	pb.RegisterBackendServiceServer(srv, &grpcServer{store: s})
+	return srv
+}
+
+// An authenticator rejects calls that don't present the bearer token it
+// wants, or a token issued by its store. Rejected calls are sent a bearer
+// challenge in the www-authenticate trailer.
+type authenticator struct {
+	store *Store
+	want  string
+}
+
+func (a *authenticator) authenticate(ctx context.Context) (metadata.MD, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if err := a.store.authenticate(bearer(metadataCarrier(md).Get("authorization")), a.want); err != nil {
+		return metadata.Pairs(HeaderWWWAuthenticate, challenge(err)), status.Error(codes.Unauthenticated, err.Error())
+	}
+	return nil, nil
+}
+
+func (a *authenticator) unary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+	if trailer, err := a.authenticate(ctx); err != nil {
+		_ = grpc.SetTrailer(ctx, trailer)
+		return nil, err
+	}
+	return h(ctx, req)
+}
+
+func (a *authenticator) stream(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, h grpc.StreamHandler) error {
+	if trailer, err := a.authenticate(ss.Context()); err != nil {
+		ss.SetTrailer(trailer)
+		return err
+	}
+	return h(srv, ss)
+}
+
+// A grpcServer serves the bork API over gRPC.
+type grpcServer struct {
+	pb.UnimplementedBackendServiceServer
+
+	store *Store
+}
+
+func (g *grpcServer) GetContentTypes(_ context.Context, _ *pb.GetContentTypesRequest) (*pb.GetContentTypesResponse, error) {
+	return &pb.GetContentTypesResponse{ContentTypes: g.store.ContentTypes()}, nil
+}
+
+func (g *grpcServer) Perform(ctx context.Context, req *pb.PerformRequest) (*pb.PerformResponse, error) {
+	c, ok := codecs[req.GetContentType()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unsupported content type")
+	}
+
+	// Continue the trace of the client that sent the request, if any.
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	resp, err := perform(ctx, g.store, req.GetOperation(), c, req.GetPayload())
+	switch {
+	case IsNotFound(err):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case IsAlreadyExists(err):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case IsConflict(err):
+		return nil, status.Error(codes.Aborted, err.Error())
+	case IsDependencyViolation(err):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case isBadRequest(err):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case IsThrottled(err):
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(HeaderRetryAfter, formatRetryAfter(RetryAfter(err))))
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case IsInternal(err):
+		return nil, status.Error(codes.Internal, err.Error())
+	case IsTimeout(err):
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	case IsUnavailable(err):
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pb.PerformResponse{Payload: resp}, nil
+}
+
+func (g *grpcServer) Watch(req *pb.WatchRequest, stream grpc.ServerStreamingServer[pb.WatchResponse]) error {
+	c, ok := codecs[req.GetContentType()]
+	if !ok {
+		c = JSON
+	}
+	for e := range g.store.Watch(stream.Context()) {
+		b, err := c.Marshal(e)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if err := stream.Send(&pb.WatchResponse{Payload: b}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DialGRPC returns a client of the bork API server at the supplied target,
+// e.g. bork.example.org:443, using the supplied transport credentials. The
+// client encodes payloads using the first of the preferred content types the
+// server accepts. If token is not empty it is presented to the server as a
+// bearer token.
+//
+// The client holds a single connection to the server, which is kept alive
+// while the client is open. Callers must close the client when they're done
+// with it.
+func DialGRPC(ctx context.Context, target string, creds credentials.TransportCredentials, token string, preferred ...string) (*Client, error) {
+	o := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                GRPCKeepaliveTime,
+			Timeout:             GRPCKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+	}
+	if token != "" {
+		o = append(o, grpc.WithPerRPCCredentials(bearerToken{token: token, secure: creds.Info().SecurityProtocol != "insecure"}))
+	}
+	cc, err := grpc.NewClient(target, o...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewGRPCClient)
+	}
+	t := &grpcTransport{conn: cc, client: pb.NewBackendServiceClient(cc)}
+
+	var trailer metadata.MD
+	resp, err := t.client.GetContentTypes(ctx, &pb.GetContentTypesRequest{}, grpc.Trailer(&trailer))
+	if err != nil {
+		_ = cc.Close()
+		return nil, errors.Wrap(fromStatus(err, trailer), errGetContentTypes)
+	}
+	c, err := Negotiate(resp.GetContentTypes(), preferred...)
+	if err != nil {
+		_ = cc.Close()
+		return nil, err
+	}
+	return &Client{transport: t, codec: c, version: &servedVersion{}}, nil
+}
+
+// A bearerToken presents a token as gRPC per-call credentials.
+type bearerToken struct {
+	token  string
+	secure bool
+}
+
+func (b bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + b.token}, nil
+}
+
+func (b bearerToken) RequireTransportSecurity() bool {
+	return b.secure
+}
+
+// A grpcTransport delivers requests to a bork API server over gRPC.
+type grpcTransport struct {
+	conn   *grpc.ClientConn
+	client pb.BackendServiceClient
+}
+
+func (t *grpcTransport) Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	var trailer metadata.MD
+	resp, err := t.client.Perform(ctx, &pb.PerformRequest{Operation: op, ContentType: c.ContentType(), Payload: req}, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, fromStatus(err, trailer)
+	}
+	return resp.GetPayload(), nil
+}
+
+// A metadataCarrier carries trace context in gRPC metadata.
+type metadataCarrier metadata.MD
+
+func (m metadataCarrier) Get(key string) string {
+	v := metadata.MD(m).Get(key)
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
+
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// fromStatus converts the supplied gRPC error such that IsNotFound and
+// IsAlreadyExists behave as they would for an in-process Store. The supplied
+// trailer, if any, is consulted for how long to wait before retrying a
+// throttled call, and for why credentials were rejected.
+func fromStatus(err error, trailer metadata.MD) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return errors.Wrap(err, errDoRequest)
+	}
+	msg := s.Message()
+	switch s.Code() {
+	case codes.NotFound:
+		return notFound{errors.New(msg)}
+	case codes.AlreadyExists:
+		return alreadyExists{errors.New(msg)}
+	case codes.Aborted:
+		return conflict{errors.New(msg)}
+	case codes.InvalidArgument:
+		return badRequest{errors.New(msg)}
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return fromChallenge(metadataCarrier(trailer).Get(HeaderWWWAuthenticate), msg)
+	case codes.ResourceExhausted:
+		return throttled{error: errors.New(msg), retryAfter: parseRetryAfter(metadataCarrier(trailer).Get(HeaderRetryAfter))}
+	case codes.Internal:
+		return internal{errors.New(msg)}
+	case codes.DeadlineExceeded:
+		return timeout{errors.New(msg)}
+	case codes.Unavailable:
+		return unavailable{errors.New(msg)}
+	case codes.FailedPrecondition:
+		return dependencyViolation{errors.New(msg)}
+	case codes.Unknown:
+		return errors.New(msg)
+	default:
+		return errors.Errorf(errUnexpectedStatus, s.Code(), msg)
+	}
+}
+
+// Watch streams events from the server. The channel is closed if the stream
+// can't be established, or when it ends.
+func (t *grpcTransport) Watch(ctx context.Context, c Codec) <-chan Event {
+	out := make(chan Event, WatchBufferSize)
+	go func() {
+		defer close(out)
+
+		stream, err := t.client.Watch(ctx, &pb.WatchRequest{ContentType: c.ContentType()})
+		if err != nil {
+			return
+		}
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			var e Event
+			if err := c.Unmarshal(resp.GetPayload(), &e); err != nil {
+				return
+			}
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (t *grpcTransport) Close() error {
+	return errors.Wrap(t.conn.Close(), errCloseGRPCClient)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const errHangFmt = "bork API did not respond to %s request in time"
+
+// A hang makes operations take longer than they should.
+type hang struct {
+	duration   time.Duration
+	operations []string
+}
+
+// SetHang simulates an API that stops responding. Once set, each of the named
+// operations, or every operation if none are named, waits for the supplied
+// duration before it's performed. An operation whose context is done while
+// it waits fails with an error that satisfies IsTimeout, without being
+// performed. A duration of zero or less stops the store hanging.
+//
+// Only operations hang. Negotiating a content type and watching the store
+// don't. Each of the store's regions hangs the same way.
+func (s *Store) SetHang(d time.Duration, operations ...string) {
+	defer s.eachRegion(func(p *Store) { p.SetHang(d, operations...) })
+	if d <= 0 {
+		s.hang.Store(nil)
+		return
+	}
+	s.hang.Store(&hang{duration: d, operations: operations})
+}
+
+// wait waits for as long as the named operation hangs, if the store is
+// hanging. It returns an error if the supplied context is done first.
+func (s *Store) wait(ctx context.Context, op string) error {
+	h := s.hang.Load()
+	if h == nil || (len(h.operations) > 0 && !slices.Contains(h.operations, op)) {
+		return nil
+	}
+	t := time.NewTimer(h.duration)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return timeout{errors.Wrapf(ctx.Err(), errHangFmt, op)}
+	}
+}
+
+type timeout struct{ error }
+
+func (timeout) Timeout() bool { return true }
+
+// IsTimeout returns true if the supplied error indicates a request wasn't
+// served before its deadline, either because the backend gave up waiting
+// for it or because the client did.
+func IsTimeout(err error) bool {
+	var t interface{ Timeout() bool }
+	return (errors.As(err, &t) && t.Timeout()) || errors.Is(err, context.DeadlineExceeded)
+}
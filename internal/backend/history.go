@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"maps"
+	"time"
+)
+
+// MaxRecordHistory is how many of the bork values most recently applied to a
+// record the backend keeps in its history.
+const MaxRecordHistory = 10
+
+// A RecordRevision is a bork value that was applied to a record.
+type RecordRevision struct {
+	// Revision of the record at which the bork value was applied.
+	Revision int64
+
+	// BorkValue that was applied.
+	BorkValue map[string]string
+
+	// AppliedAt is when the bork value was applied.
+	AppliedAt time.Time
+}
+
+// applied returns the supplied history of a record with the supplied record,
+// which was just written by its client, appended if its bork value differs
+// from the last one applied. Only the most recent MaxRecordHistory revisions
+// are kept.
+func applied(history []RecordRevision, r Record) []RecordRevision {
+	if n := len(history); n > 0 && maps.Equal(history[n-1].BorkValue, r.BorkValue) {
+		return history
+	}
+	history = append(history, RecordRevision{Revision: r.Revision, BorkValue: copyTags(r.BorkValue), AppliedAt: r.LastModified})
+	if len(history) > MaxRecordHistory {
+		history = history[len(history)-MaxRecordHistory:]
+	}
+	return history
+}
+
+func copyHistory(in []RecordRevision) []RecordRevision {
+	if in == nil {
+		return nil
+	}
+	out := make([]RecordRevision, len(in))
+	for i, rev := range in {
+		out[i] = rev
+		out[i].BorkValue = copyTags(rev.BorkValue)
+	}
+	return out
+}
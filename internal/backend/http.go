@@ -0,0 +1,335 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	errNewRequest       = "cannot create HTTP request"
+	errDoRequest        = "cannot send HTTP request"
+	errReadResponse     = "cannot read HTTP response"
+	errGetContentTypes  = "cannot get content types supported by the bork API server"
+	errUnexpectedStatus = "bork API server returned %s: %s"
+	errUnauthorized     = "bork API server rejected credentials: %s"
+)
+
+// Paths served by the bork API server.
+const (
+	pathContentTypes = "/v1/content-types"
+	pathOperations   = "/v1/operations/"
+	pathWatch        = "/v1/watch"
+)
+
+// MaxRequestBytes is the largest request body the bork API server accepts.
+const MaxRequestBytes = 64 << 20
+
+func init() {
+	Register(BackendHTTP, func(ctx context.Context, o DialOptions) (*Client, error) {
+		if o.Endpoint == "" {
+			return nil, errors.Errorf(errBackendRequiresEndFmt, BackendHTTP)
+		}
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = o.TLS
+		return Dial(ctx, o.Endpoint, &http.Client{Transport: t}, o.Token, o.ContentTypes...)
+	})
+}
+
+// NewHandler returns an HTTP handler that serves the bork API backed by the
+// supplied store. If token is not empty every request must present it, or a
+// token issued by the store, as a bearer token.
+//
+// Each operation is served by POSTing its request, encoded using any of the
+// store's content types, to /v1/operations/<operation>. The response is
+// encoded using the same content type. Changes to the store are streamed by
+// GET /v1/watch as length-prefixed events encoded using the accepted content
+// type.
+func NewHandler(s *Store, token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+pathContentTypes, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		_ = json.NewEncoder(w).Encode(s.ContentTypes())
+	})
+	mux.HandleFunc("POST "+pathOperations+"{operation}", func(w http.ResponseWriter, r *http.Request) {
+		serveOperation(w, r, s)
+	})
+	mux.HandleFunc("GET "+pathWatch, func(w http.ResponseWriter, r *http.Request) {
+		serveWatch(w, r, s)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := s.authenticate(bearer(r.Header.Get("Authorization")), token); err != nil {
+			w.Header().Set(HeaderWWWAuthenticate, challenge(err))
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func serveOperation(w http.ResponseWriter, r *http.Request, s *Store) {
+	c, ok := codecs[r.Header.Get("Content-Type")]
+	if !ok {
+		http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+		return
+	}
+	req, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Continue the trace of the client that sent the request, if any.
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	resp, err := perform(ctx, s, r.PathValue("operation"), c, req)
+	switch {
+	case IsNotFound(err):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case IsAlreadyExists(err):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case IsConflict(err):
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+	case IsDependencyViolation(err):
+		http.Error(w, err.Error(), http.StatusFailedDependency)
+	case isBadRequest(err):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case IsThrottled(err):
+		w.Header().Set(HeaderRetryAfter, formatRetryAfter(RetryAfter(err)))
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	case IsInternal(err):
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	case IsTimeout(err):
+		http.Error(w, err.Error(), http.StatusGatewayTimeout)
+	case IsUnavailable(err):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		w.Header().Set("Content-Type", c.ContentType())
+		_, _ = w.Write(resp)
+	}
+}
+
+func serveWatch(w http.ResponseWriter, r *http.Request, s *Store) {
+	c, ok := codecs[r.Header.Get("Accept")]
+	if !ok {
+		c = JSON
+	}
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", c.ContentType())
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+
+	for e := range s.Watch(r.Context()) {
+		b, err := c.Marshal(e)
+		if err != nil {
+			return
+		}
+		if err := writeFrame(w, b); err != nil {
+			return
+		}
+		f.Flush()
+	}
+}
+
+// writeFrame writes the supplied payload prefixed by its length.
+func writeFrame(w io.Writer, b []byte) error {
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(b)))
+	if _, err := w.Write(l[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// readFrame reads a payload prefixed by its length.
+func readFrame(r io.Reader) ([]byte, error) {
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(l[:])
+	if n > MaxRequestBytes {
+		return nil, errors.Errorf("frame of %d bytes is too large", n)
+	}
+	b := make([]byte, n)
+	_, err := io.ReadFull(r, b)
+	return b, err
+}
+
+// Dial returns a client of the bork API server at the supplied endpoint, e.g.
+// https://bork.example.org. The client encodes payloads using the first of
+// the preferred content types the server accepts. If token is not empty it is
+// presented to the server as a bearer token.
+func Dial(ctx context.Context, endpoint string, hc *http.Client, token string, preferred ...string) (*Client, error) {
+	t := &httpTransport{endpoint: strings.TrimSuffix(endpoint, "/"), client: hc, token: token}
+
+	body, err := t.request(ctx, http.MethodGet, pathContentTypes, "", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetContentTypes)
+	}
+	supported := []string{}
+	if err := json.Unmarshal(body, &supported); err != nil {
+		return nil, errors.Wrap(err, errGetContentTypes)
+	}
+
+	c, err := Negotiate(supported, preferred...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{transport: t, codec: c, version: &servedVersion{}}, nil
+}
+
+// An httpTransport delivers requests to a bork API server.
+type httpTransport struct {
+	endpoint string
+	client   *http.Client
+	token    string
+}
+
+func (t *httpTransport) Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	return t.request(ctx, http.MethodPost, pathOperations+op, c.ContentType(), req)
+}
+
+// request sends a request to the server, returning the body of a successful
+// response. Unsuccessful responses are returned as errors, such that
+// IsNotFound and IsAlreadyExists behave as they would for an in-process
+// Store.
+func (t *httpTransport) request(ctx context.Context, method, path, contentType string, body []byte) ([]byte, error) {
+	req, err := t.newRequest(ctx, method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, errDoRequest)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, errReadResponse)
+	}
+
+	msg := strings.TrimSpace(string(b))
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return b, nil
+	case http.StatusNotFound:
+		return nil, notFound{errors.New(msg)}
+	case http.StatusConflict:
+		return nil, alreadyExists{errors.New(msg)}
+	case http.StatusPreconditionFailed:
+		return nil, conflict{errors.New(msg)}
+	case http.StatusFailedDependency:
+		return nil, dependencyViolation{errors.New(msg)}
+	case http.StatusBadRequest:
+		return nil, badRequest{errors.New(msg)}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fromChallenge(resp.Header.Get(HeaderWWWAuthenticate), msg)
+	case http.StatusTooManyRequests:
+		return nil, throttled{error: errors.New(msg), retryAfter: parseRetryAfter(resp.Header.Get(HeaderRetryAfter))}
+	case http.StatusInternalServerError:
+		return nil, internal{errors.New(msg)}
+	case http.StatusGatewayTimeout:
+		return nil, timeout{errors.New(msg)}
+	case http.StatusServiceUnavailable:
+		return nil, unavailable{errors.New(msg)}
+	case http.StatusUnprocessableEntity:
+		return nil, errors.New(msg)
+	default:
+		return nil, errors.Errorf(errUnexpectedStatus, resp.Status, msg)
+	}
+}
+
+func (t *httpTransport) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, t.endpoint+path, body)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewRequest)
+	}
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return req, nil
+}
+
+// Watch streams events from the server. The channel is closed if the stream
+// can't be established, or when it ends.
+func (t *httpTransport) Watch(ctx context.Context, c Codec) <-chan Event {
+	out := make(chan Event, WatchBufferSize)
+	go func() {
+		defer close(out)
+
+		req, err := t.newRequest(ctx, http.MethodGet, pathWatch, nil)
+		if err != nil {
+			return
+		}
+		req.Header.Set("Accept", c.ContentType())
+		resp, err := t.client.Do(req)
+		if err != nil {
+			return
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return
+		}
+
+		for {
+			b, err := readFrame(resp.Body)
+			if err != nil {
+				return
+			}
+			var e Event
+			if err := c.Unmarshal(b, &e); err != nil {
+				return
+			}
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (t *httpTransport) Close() error {
+	t.client.CloseIdleConnections()
+	return nil
+}
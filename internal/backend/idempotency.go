@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// IdempotencyKeyTTL is how long a store remembers the idempotency key of a
+// create. A create that's retried with the same key within this long returns
+// the record the first create created, if it still exists.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// An idempotentCreate is a create whose idempotency key the store remembers.
+type idempotentCreate struct {
+	key     string
+	name    string
+	expires time.Time
+}
+
+// idempotencyKeys are the idempotency keys of the creates a store remembers.
+// It's safe for concurrent use.
+type idempotencyKeys struct {
+	mu      sync.Mutex
+	names   map[string]string
+	creates []idempotentCreate // Oldest first.
+}
+
+// lock locks the keys, first forgetting those that have expired.
+func (k *idempotencyKeys) lock(now time.Time) {
+	k.mu.Lock()
+	i := 0
+	for ; i < len(k.creates) && !now.Before(k.creates[i].expires); i++ {
+		if k.names[k.creates[i].key] == k.creates[i].name {
+			delete(k.names, k.creates[i].key)
+		}
+	}
+	k.creates = k.creates[i:]
+}
+
+func (k *idempotencyKeys) unlock() {
+	k.mu.Unlock()
+}
+
+// remember the supplied key of a create of the named record. The caller must
+// hold the keys' lock.
+func (k *idempotencyKeys) remember(key, name string, now time.Time) {
+	if k.names == nil {
+		k.names = make(map[string]string)
+	}
+	k.names[key] = name
+	k.creates = append(k.creates, idempotentCreate{key: key, name: name, expires: now.Add(IdempotencyKeyTTL)})
+}
+
+// replay returns the record created by an earlier create with the supplied
+// record's idempotency key, if it still exists. The caller must hold the
+// store's idempotency keys' lock.
+func (s *Store) replay(r Record) (Record, bool) {
+	name, ok := s.idempotency.names[r.IdempotencyKey]
+	if !ok {
+		return Record{}, false
+	}
+	existing, ok := s.record(name)
+	if !ok || existing.IdempotencyKey != r.IdempotencyKey {
+		return Record{}, false
+	}
+	existing = copyRecord(existing)
+	existing.Replayed = true
+	return existing, true
+}
+
+type idempotencyKey struct{}
+
+// A CreateReplay records whether a create made using a context was
+// deduplicated by the backend, because an earlier create with the same
+// idempotency key had already created its record. It is safe for concurrent
+// use.
+type CreateReplay struct {
+	key string
+
+	mu       sync.Mutex
+	replayed bool
+}
+
+// WithIdempotencyKey returns a context whose record creates present the
+// supplied idempotency key, unless the record being created has one. Whether
+// the backend deduplicated any of them is recorded in the returned replay.
+func WithIdempotencyKey(ctx context.Context, key string) (context.Context, *CreateReplay) {
+	r := &CreateReplay{key: key}
+	return context.WithValue(ctx, idempotencyKey{}, r), r
+}
+
+// Replayed returns true if the backend deduplicated a create, returning the
+// record an earlier create with the same idempotency key created.
+func (r *CreateReplay) Replayed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.replayed
+}
+
+func (r *CreateReplay) record(replayed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.replayed = r.replayed || replayed
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+)
+
+// An Identity is who the backend authenticated a caller as.
+type Identity struct {
+	// AccountID of the account the caller's credentials belong to. The
+	// backend simulates a single account, whose ID is chosen when the store
+	// is created.
+	AccountID string
+}
+
+// WhoAmI returns the identity of the caller. It's the cheapest way to check
+// that the backend accepts a caller's credentials.
+func (s *Store) WhoAmI(_ context.Context, _ struct{}) (Identity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Identity{AccountID: s.account}, nil
+}
+
+// newAccountID returns a random 12 digit account ID.
+func newAccountID() string {
+	return fmt.Sprintf("%012d", rand.Int64N(1_000_000_000_000))
+}
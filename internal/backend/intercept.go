@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+)
+
+// An Interceptor is called before a client performs each operation, with the
+// operation's name, e.g. Get or CreateBucket. If it returns an error the
+// operation fails with it, without being sent to the backend.
+type Interceptor func(ctx context.Context, op string) error
+
+// Intercept returns a client that shares this client's transport and codec,
+// but that calls the supplied interceptor before it performs each operation.
+// Closing the returned client closes this one.
+func (c *Client) Intercept(fn Interceptor) *Client {
+	return &Client{transport: interceptTransport{transport: c.transport, intercept: fn}, codec: c.codec, release: c.release, expired: c.expired, version: c.version}
+}
+
+// An interceptTransport calls an interceptor before delivering each request.
+type interceptTransport struct {
+	transport
+	intercept Interceptor
+}
+
+func (t interceptTransport) Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	if err := t.intercept(ctx, op); err != nil {
+		return nil, err
+	}
+	return t.transport.Do(ctx, op, c, req)
+}
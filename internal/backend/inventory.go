@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const errRemoveKindFmt = "cannot remove resources of kind %q"
+
+// Names returns the names of the stored resources of the supplied kind,
+// sorted. Records that are being torn down, queues that have been deleted and
+// certificates that have expired are omitted, even if they're still visible,
+// because they're already on their way out. Regions are provided by the backend, and are always
+// omitted.
+func (s *Store) Names(kind string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var names []string
+	switch kind {
+	case KindRecord:
+		for _, r := range s.records.list() {
+			if r.State != RecordDeleting {
+				names = append(names, r.Name)
+			}
+		}
+	case KindPlacement:
+		names = keys(s.placements)
+	case KindBucket:
+		names = keys(s.buckets)
+	case KindPlan:
+		names = keys(s.plans)
+	case KindKey:
+		names = keys(s.keys)
+	case KindObject:
+		names = keys(s.objects)
+	case KindExport:
+		names = keys(s.exports)
+	case KindServiceEndpoint:
+		names = keys(s.endpoints)
+	case KindDatabase:
+		names = keys(s.databases)
+	case KindTopic:
+		names = keys(s.topics)
+	case KindAccessPolicy:
+		names = keys(s.policies)
+	case KindSchedule:
+		names = keys(s.schedules)
+	case KindUser:
+		names = keys(s.users)
+	case KindLink:
+		s.linksMu.Lock()
+		names = keys(s.links)
+		s.linksMu.Unlock()
+	case KindCertificate:
+		now := time.Now()
+		for name, c := range s.certificates {
+			if now.Before(c.NotAfter) {
+				names = append(names, name)
+			}
+		}
+	case KindQueue:
+		for name, h := range s.queues {
+			if _, ok := h.latest(); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func keys[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Remove deletes the named stored resource of the supplied kind, as if its
+// client had deleted it. Regions are provided by the backend, and can't be
+// removed.
+func (s *Store) Remove(ctx context.Context, kind, name string) error {
+	del, ok := map[string]func(context.Context, string) error{
+		KindRecord:          s.Delete,
+		KindPlacement:       s.DeletePlacement,
+		KindBucket:          s.DeleteBucket,
+		KindPlan:            s.DeletePlan,
+		KindKey:             s.DeleteKey,
+		KindObject:          s.DeleteObject,
+		KindExport:          s.DeleteExport,
+		KindServiceEndpoint: s.DeleteServiceEndpoint,
+		KindDatabase:        s.DeleteDatabase,
+		KindCertificate:     s.DeleteCertificate,
+		KindQueue:           s.DeleteQueue,
+		KindTopic:           s.DeleteTopic,
+		KindAccessPolicy:    s.DeleteAccessPolicy,
+		KindSchedule:        s.DeleteSchedule,
+		KindUser:            s.DeleteUser,
+		KindLink:            s.DeleteLink,
+	}[kind]
+	if !ok {
+		return badRequest{errors.Errorf(errRemoveKindFmt, kind)}
+	}
+	return del(ctx, name)
+}
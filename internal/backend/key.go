@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// A Key is a credential used by a consumer to call the backend.
+type Key struct {
+	// Name uniquely identifies the key within the backend. It is assigned by
+	// the backend when the key is created.
+	Name string
+
+	// Description of the key's consumer.
+	Description string
+
+	// Plan to which the key is attached. A key that is not attached to a plan
+	// is not rate limited.
+	Plan string
+
+	// Secret presented by the consumer to authenticate. It is generated by
+	// the backend when the key is created, and cannot be changed.
+	Secret string
+
+	// Revision is assigned by the backend every time the key is written.
+	Revision int64
+}
+
+// GetKey returns the named key.
+func (s *Store) GetKey(_ context.Context, name string) (Key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	k, ok := s.keys[name]
+	if !ok {
+		return Key{}, notFound{errors.Errorf(errKeyNotFoundFmt, name)}
+	}
+	return k, nil
+}
+
+// CreateKey stores the supplied key, assigning it a new revision and secret.
+// If the key has no name the backend generates a unique one. It returns an
+// error if a key with the same name already exists, or if the key is attached
+// to a plan that does not exist.
+func (s *Store) CreateKey(_ context.Context, k Key) (Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if k.Name == "" {
+		k.Name = generateName("key")
+	}
+	if existing, ok := s.keys[k.Name]; ok {
+		return duplicate(s, existing, alreadyExists{errors.Errorf(errKeyAlreadyExistsFmt, k.Name)})
+	}
+	if _, ok := s.plans[k.Plan]; k.Plan != "" && !ok {
+		return Key{}, notFound{errors.Errorf(errPlanNotFoundFmt, k.Plan)}
+	}
+	k.Revision = s.revision.Add(1)
+	k.Secret = uuid.NewString()
+	s.keys[k.Name] = k
+	s.notify(EventCreated, KindKey, k.Name, k.Revision)
+	return k, nil
+}
+
+// UpdateKey overwrites the description and plan of the supplied key,
+// assigning it a new revision. It returns an error if the key does not exist,
+// or if it is attached to a plan that does not exist.
+func (s *Store) UpdateKey(_ context.Context, k Key) (Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.keys[k.Name]
+	if !ok {
+		return Key{}, notFound{errors.Errorf(errKeyNotFoundFmt, k.Name)}
+	}
+	if _, ok := s.plans[k.Plan]; k.Plan != "" && !ok {
+		return Key{}, notFound{errors.Errorf(errPlanNotFoundFmt, k.Plan)}
+	}
+	k.Revision = s.revision.Add(1)
+	k.Secret = existing.Secret
+	s.keys[k.Name] = k
+	s.notify(EventUpdated, KindKey, k.Name, k.Revision)
+	return k, nil
+}
+
+// DeleteKey removes the named key. Deleting a key that does not exist is not
+// an error.
+func (s *Store) DeleteKey(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.keys[name]; !ok {
+		return nil
+	}
+	delete(s.keys, name)
+	s.notify(EventDeleted, KindKey, name, 0)
+	return nil
+}
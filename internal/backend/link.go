@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A LinkDeletionPolicy determines what deleting one of a link's endpoints
+// does to the link.
+type LinkDeletionPolicy string
+
+// Link deletion policies.
+const (
+	// LinkCascade deletes the link along with either of its endpoints.
+	LinkCascade LinkDeletionPolicy = "Cascade"
+
+	// LinkOrphan leaves the link behind when either of its endpoints is
+	// deleted. The link is then dangling.
+	LinkOrphan LinkDeletionPolicy = "Orphan"
+
+	// LinkRestrict refuses to delete either of the link's endpoints until
+	// the link is deleted.
+	LinkRestrict LinkDeletionPolicy = "Restrict"
+)
+
+// A Link is a relationship between two records. Unlike other backend
+// resources it has no identity of its own: it's keyed by the pair of records
+// it links, modelling the many real APIs that attach one resource to another,
+// like a policy attached to a role.
+type Link struct {
+	// Name uniquely identifies the link within the backend. It is assigned
+	// by the backend when the link is created, and is always the LinkName
+	// of its source and target.
+	Name string
+
+	// Source and Target are the names of the records the link links.
+	Source string
+	Target string
+
+	// DeletionPolicy determines what deleting the link's source or target
+	// does to the link. Defaults to LinkCascade.
+	DeletionPolicy LinkDeletionPolicy
+
+	// Dangling is true if the link's source or target was deleted while the
+	// link's deletion policy was LinkOrphan.
+	Dangling bool
+
+	// Revision is assigned by the backend every time the link is written.
+	Revision int64
+}
+
+// LinkName returns the name of the link from the supplied source record to
+// the supplied target record.
+func LinkName(source, target string) string {
+	return source + "/" + target
+}
+
+// GetLink returns the named link.
+func (s *Store) GetLink(_ context.Context, name string) (Link, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.linksMu.Lock()
+	defer s.linksMu.Unlock()
+
+	l, ok := s.links[name]
+	if !ok {
+		return Link{}, notFound{errors.Errorf(errLinkNotFoundFmt, name)}
+	}
+	return l, nil
+}
+
+// CreateLink stores the supplied link, assigning it a new revision and naming
+// it for its source and target. It returns an error if the pair of records is
+// already linked, or if either record doesn't exist or is being deleted.
+func (s *Store) CreateLink(_ context.Context, l Link) (Link, error) {
+	// Holding the store's write lock excludes record deletes, which could
+	// otherwise delete an endpoint we've just found.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l.Name = LinkName(l.Source, l.Target)
+	if existing, ok := s.links[l.Name]; ok {
+		return duplicate(s, existing, alreadyExists{errors.Errorf(errLinkAlreadyExistsFmt, l.Name)})
+	}
+	if l.Source == "" || l.Target == "" || strings.Contains(l.Source, "/") || strings.Contains(l.Target, "/") {
+		return Link{}, badRequest{errors.Errorf(errLinkEndpointsFmt, l.Name)}
+	}
+	for _, name := range []string{l.Source, l.Target} {
+		if r, ok := s.records.get(name); !ok || r.State == RecordDeleting {
+			return Link{}, conflict{errors.Errorf(errLinkEndpointNotFoundFmt, l.Name, name)}
+		}
+	}
+	if l.DeletionPolicy == "" {
+		l.DeletionPolicy = LinkCascade
+	}
+	l.Dangling = false
+	l.Revision = s.revision.Add(1)
+	s.links[l.Name] = l
+	s.notify(EventCreated, KindLink, l.Name, l.Revision)
+	return l, nil
+}
+
+// UpdateLink overwrites the deletion policy of the supplied link, assigning it
+// a new revision. A link's source and target can't be changed. It returns an
+// error if the link does not exist.
+func (s *Store) UpdateLink(_ context.Context, l Link) (Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.links[l.Name]
+	if !ok {
+		return Link{}, notFound{errors.Errorf(errLinkNotFoundFmt, l.Name)}
+	}
+	existing.DeletionPolicy = l.DeletionPolicy
+	if existing.DeletionPolicy == "" {
+		existing.DeletionPolicy = LinkCascade
+	}
+	existing.Revision = s.revision.Add(1)
+	s.links[l.Name] = existing
+	s.notify(EventUpdated, KindLink, l.Name, existing.Revision)
+	return existing, nil
+}
+
+// DeleteLink removes the named link. Deleting a link that does not exist is
+// not an error.
+func (s *Store) DeleteLink(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.links[name]; !ok {
+		return nil
+	}
+	delete(s.links, name)
+	s.notify(EventDeleted, KindLink, name, 0)
+	return nil
+}
+
+// unlink applies the deletion policies of the links of the named record,
+// which is about to be deleted. It returns an error, and changes nothing, if
+// any of the record's links restrict its deletion. The caller must hold the
+// record's lock, or the store's write lock.
+func (s *Store) unlink(record string) error {
+	// Records are deleted holding only the store's read lock, so their
+	// links are guarded by a lock of their own.
+	s.linksMu.Lock()
+	defer s.linksMu.Unlock()
+
+	var linked []Link
+	for _, l := range s.links {
+		if l.Source == record || l.Target == record {
+			linked = append(linked, l)
+		}
+	}
+	slices.SortFunc(linked, func(a, b Link) int { return strings.Compare(a.Name, b.Name) })
+	for _, l := range linked {
+		if l.DeletionPolicy == LinkRestrict {
+			return dependencyViolation{errors.Errorf(errRecordLinkedFmt, record, l.Name)}
+		}
+	}
+	for _, l := range linked {
+		switch l.DeletionPolicy {
+		case LinkOrphan:
+			if l.Dangling {
+				continue
+			}
+			l.Dangling = true
+			l.Revision = s.revision.Add(1)
+			s.links[l.Name] = l
+			s.notify(EventUpdated, KindLink, l.Name, l.Revision)
+		default:
+			delete(s.links, l.Name)
+			s.notify(EventDeleted, KindLink, l.Name, 0)
+		}
+	}
+	return nil
+}
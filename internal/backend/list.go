@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"encoding/base64"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+const errPageTokenFmt = "invalid page token %q"
+
+// DefaultListPageSize is the most names a page of a list holds, unless the
+// store's page size is set.
+const DefaultListPageSize = 100
+
+// A ListRequest asks for a page of the names of the stored resources of a
+// kind.
+type ListRequest struct {
+	// Kind of the resources to list, e.g. KindRecord.
+	Kind string
+
+	// PageSize is the most names the page may hold. The store's page size
+	// is used if it's zero or larger.
+	PageSize int
+
+	// PageToken is the NextPageToken of the previous page, or empty for the
+	// first page.
+	PageToken string
+}
+
+// A ListPage is a page of the names of the stored resources of a kind.
+type ListPage struct {
+	// Names on the page, sorted.
+	Names []string
+
+	// NextPageToken is the token of the next page, or empty if this is the
+	// last page.
+	NextPageToken string
+}
+
+// paging determines how a store pages lists.
+type paging struct {
+	size          int
+	inconsistency float64
+}
+
+// SetListPaging sets the most names a page of a list holds, and the fraction
+// of pages, from 0 to 1, that are inconsistent with the page before them. An
+// inconsistent page starts before the end of the previous page, repeating
+// some of its names, as an eventually consistent API's pages might. A size of
+// zero or less restores DefaultListPageSize. Each of the store's regions pages
+// the same way.
+func (s *Store) SetListPaging(size int, inconsistency float64) {
+	defer s.eachRegion(func(p *Store) { p.SetListPaging(size, inconsistency) })
+	if size <= 0 {
+		size = DefaultListPageSize
+	}
+	s.paging.Store(&paging{size: size, inconsistency: inconsistency})
+}
+
+// List returns the requested page of the names of the stored resources of
+// the requested kind, per Names. Names are paged in order, so a resource
+// that's neither created nor deleted while the list is paged appears on at
+// least one page. It may appear on more than one if the store's pages are
+// inconsistent, so callers should deduplicate the names they list.
+func (s *Store) List(_ context.Context, req ListRequest) (ListPage, error) {
+	after := ""
+	if req.PageToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return ListPage{}, badRequest{errors.Wrapf(err, errPageTokenFmt, req.PageToken)}
+		}
+		after = string(b)
+	}
+
+	p := s.paging.Load()
+	if p == nil {
+		p = &paging{size: DefaultListPageSize}
+	}
+	size := p.size
+	if req.PageSize > 0 && req.PageSize < size {
+		size = req.PageSize
+	}
+
+	names := s.Names(req.Kind)
+	start := 0
+	if after != "" {
+		i, found := slices.BinarySearch(names, after)
+		if found {
+			i++
+		}
+		start = i
+		// An inconsistent page repeats some of the previous page's names,
+		// but always ends after it, so that every list ends.
+		if n := min(start, size-1); n > 0 && p.inconsistency > 0 && rand.Float64() < p.inconsistency {
+			start -= 1 + rand.IntN(n)
+		}
+	}
+	end := min(start+size, len(names))
+
+	page := ListPage{Names: names[start:end]}
+	if end < len(names) {
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(names[end-1]))
+	}
+	return page, nil
+}
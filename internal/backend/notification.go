@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errInvalidNotificationURLFmt = "notification URL must be an absolute http or https URL, got %q"
+	errInvalidNotificationTTLFmt = "notification subscription TTL must be positive, got %s"
+)
+
+// NotificationTimeout is how long the backend waits for a notification to be
+// received. Notifications that aren't received in time are dropped.
+const NotificationTimeout = 5 * time.Second
+
+// A NotificationRequest asks the backend to notify a URL of the changes made
+// to it.
+type NotificationRequest struct {
+	// URL to which each Event is POSTed, encoded as JSON.
+	URL string `json:"url"`
+
+	// TTL is how long the subscription lasts unless it's renewed by
+	// requesting it again.
+	TTL time.Duration `json:"ttl"`
+}
+
+// A NotificationSubscription notifies a URL of the changes made to the
+// backend until it expires.
+type NotificationSubscription struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// A notifier delivers events to a subscribed URL.
+type notifier struct {
+	expiresAt time.Time
+	expire    *time.Timer
+	cancel    context.CancelFunc
+}
+
+// SubscribeNotifications subscribes the requested URL to notifications of the
+// changes made to the backend, or renews its subscription. Each change is
+// POSTed to the URL as soon as it's made. Like a watcher, the URL may miss
+// changes: notifications that fail are dropped, as are those of a URL that
+// falls behind. Subscriptions aren't persisted.
+func (s *Store) SubscribeNotifications(_ context.Context, req NotificationRequest) (NotificationSubscription, error) {
+	u, err := url.Parse(req.URL)
+	if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") {
+		return NotificationSubscription{}, badRequest{errors.Errorf(errInvalidNotificationURLFmt, req.URL)}
+	}
+	if req.TTL <= 0 {
+		return NotificationSubscription{}, badRequest{errors.Errorf(errInvalidNotificationTTLFmt, req.TTL)}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.notifiers[req.URL]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		n = &notifier{cancel: cancel}
+		n.expire = time.AfterFunc(req.TTL, func() { s.unsubscribe(req.URL, n) })
+		s.notifiers[req.URL] = n
+		go s.deliver(ctx, req.URL)
+	}
+	n.expiresAt = time.Now().Add(req.TTL)
+	n.expire.Reset(req.TTL)
+	return NotificationSubscription{URL: req.URL, ExpiresAt: n.expiresAt}, nil
+}
+
+// UnsubscribeNotifications stops notifying the supplied URL.
+func (s *Store) UnsubscribeNotifications(_ context.Context, url string) error {
+	s.mu.Lock()
+	n := s.notifiers[url]
+	s.mu.Unlock()
+	if n != nil {
+		s.unsubscribe(url, n)
+	}
+	return nil
+}
+
+// unsubscribe stops the supplied notifier of the supplied URL, if it's still
+// subscribed.
+func (s *Store) unsubscribe(url string, n *notifier) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.notifiers[url] != n {
+		return
+	}
+	delete(s.notifiers, url)
+	n.expire.Stop()
+	n.cancel()
+}
+
+// deliver POSTs every change made to the store to the supplied URL until the
+// supplied context is done. It watches the store again if it falls behind.
+func (s *Store) deliver(ctx context.Context, url string) {
+	hc := &http.Client{Timeout: NotificationTimeout}
+	for ctx.Err() == nil {
+		for e := range s.Watch(ctx) {
+			b, err := json.Marshal(e)
+			if err != nil {
+				continue
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+			if err != nil {
+				continue
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if resp, err := hc.Do(req); err == nil {
+				_ = resp.Body.Close()
+			}
+		}
+	}
+}
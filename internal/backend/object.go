@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// An Object is a blob stored in a bucket.
+type Object struct {
+	// Name uniquely identifies the object within the backend. It is assigned
+	// by the backend when the object is created.
+	Name string
+
+	// Bucket in which the object is stored.
+	Bucket string
+
+	// Key of the object within its bucket.
+	Key string
+
+	// Content of the object.
+	Content string
+
+	// Revision is assigned by the backend every time the object is written.
+	Revision int64
+}
+
+// GetObject returns the named object.
+func (s *Store) GetObject(_ context.Context, name string) (Object, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	o, ok := s.objects[name]
+	if !ok {
+		return Object{}, notFound{errors.Errorf(errObjectNotFoundFmt, name)}
+	}
+	return o, nil
+}
+
+// CreateObject stores the supplied object, assigning it a new revision. If
+// the object has no name the backend generates a unique one. It returns an
+// error if an object with the same name already exists, or if its bucket does
+// not exist.
+func (s *Store) CreateObject(_ context.Context, o Object) (Object, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if o.Name == "" {
+		o.Name = generateName("object")
+	}
+	if existing, ok := s.objects[o.Name]; ok {
+		return duplicate(s, existing, alreadyExists{errors.Errorf(errObjectAlreadyExistsFmt, o.Name)})
+	}
+	if _, ok := s.buckets[o.Bucket]; !ok {
+		return Object{}, notFound{errors.Errorf(errBucketNotFoundFmt, o.Bucket)}
+	}
+	o.Revision = s.revision.Add(1)
+	s.objects[o.Name] = o
+	s.notify(EventCreated, KindObject, o.Name, o.Revision)
+	return o, nil
+}
+
+// UpdateObject overwrites the supplied object, assigning it a new revision.
+// It returns an error if the object or its bucket does not exist.
+func (s *Store) UpdateObject(_ context.Context, o Object) (Object, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.objects[o.Name]; !ok {
+		return Object{}, notFound{errors.Errorf(errObjectNotFoundFmt, o.Name)}
+	}
+	if _, ok := s.buckets[o.Bucket]; !ok {
+		return Object{}, notFound{errors.Errorf(errBucketNotFoundFmt, o.Bucket)}
+	}
+	o.Revision = s.revision.Add(1)
+	s.objects[o.Name] = o
+	s.notify(EventUpdated, KindObject, o.Name, o.Revision)
+	return o, nil
+}
+
+// DeleteObject removes the named object. Deleting an object that does not
+// exist is not an error.
+func (s *Store) DeleteObject(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.objects[name]; !ok {
+		return nil
+	}
+	delete(s.objects, name)
+	s.notify(EventDeleted, KindObject, name, 0)
+	return nil
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const errUnknownOperationFmt = "unknown operation %q"
+
+// An operation decodes a request encoded by the supplied codec, performs it
+// against the supplied store, and encodes the response.
+type operation func(ctx context.Context, s *Store, c Codec, req []byte) ([]byte, error)
+
+// operations the backend can perform, by name.
+var operations = map[string]operation{
+	"Head": op((*Store).Head),
+	"Get": func(ctx context.Context, s *Store, c Codec, req []byte) ([]byte, error) {
+		resp, err := op(skewed((*Store).Get))(ctx, s, c, req)
+		if err != nil {
+			return nil, err
+		}
+		var name string
+		if err := c.Unmarshal(req, &name); err != nil {
+			return nil, badRequest{errors.Wrap(err, errDecodeRequest)}
+		}
+		return s.malform(c, name, resp)
+	},
+	"Create": op(skewed((*Store).Create)),
+	"Commit": op((*Store).Commit),
+	"Update": op(skewed((*Store).Update)),
+	"Patch":  op(skewed((*Store).Patch)),
+	"Delete": op(del((*Store).Delete)),
+
+	"Activate": op(skewed((*Store).Activate)),
+	"GetByUID": op(skewed((*Store).GetByUID)),
+
+	"GetPlacement":    op((*Store).GetPlacement),
+	"CreatePlacement": op((*Store).CreatePlacement),
+	"UpdatePlacement": op((*Store).UpdatePlacement),
+	"DeletePlacement": op(del((*Store).DeletePlacement)),
+
+	"GetBucket":    op((*Store).GetBucket),
+	"CreateBucket": op((*Store).CreateBucket),
+	"UpdateBucket": op((*Store).UpdateBucket),
+	"DeleteBucket": op(del((*Store).DeleteBucket)),
+
+	"GetPlan":      op((*Store).GetPlan),
+	"GetPlanUsage": op((*Store).GetPlanUsage),
+	"CreatePlan":   op((*Store).CreatePlan),
+	"UpdatePlan":   op((*Store).UpdatePlan),
+	"DeletePlan":   op(del((*Store).DeletePlan)),
+
+	"GetKey":    op((*Store).GetKey),
+	"CreateKey": op((*Store).CreateKey),
+	"UpdateKey": op((*Store).UpdateKey),
+	"DeleteKey": op(del((*Store).DeleteKey)),
+
+	"GetObject":    op((*Store).GetObject),
+	"CreateObject": op((*Store).CreateObject),
+	"UpdateObject": op((*Store).UpdateObject),
+	"DeleteObject": op(del((*Store).DeleteObject)),
+
+	"GetExport":    op((*Store).GetExport),
+	"CreateExport": op((*Store).CreateExport),
+	"UpdateExport": op((*Store).UpdateExport),
+	"DeleteExport": op(del((*Store).DeleteExport)),
+
+	"GetServiceEndpoint":    op((*Store).GetServiceEndpoint),
+	"CreateServiceEndpoint": op((*Store).CreateServiceEndpoint),
+	"UpdateServiceEndpoint": op((*Store).UpdateServiceEndpoint),
+	"DeleteServiceEndpoint": op(del((*Store).DeleteServiceEndpoint)),
+
+	"GetQueue":    op((*Store).GetQueue),
+	"CreateQueue": op((*Store).CreateQueue),
+	"UpdateQueue": op((*Store).UpdateQueue),
+	"DeleteQueue": op(del((*Store).DeleteQueue)),
+
+	"GetDatabase":    op((*Store).GetDatabase),
+	"CreateDatabase": op((*Store).CreateDatabase),
+	"UpdateDatabase": op((*Store).UpdateDatabase),
+	"DeleteDatabase": op(del((*Store).DeleteDatabase)),
+
+	"GetCertificate":    op((*Store).GetCertificate),
+	"CreateCertificate": op((*Store).CreateCertificate),
+	"DeleteCertificate": op(del((*Store).DeleteCertificate)),
+
+	"GetTopic":    op((*Store).GetTopic),
+	"CreateTopic": op((*Store).CreateTopic),
+	"UpdateTopic": op((*Store).UpdateTopic),
+	"DeleteTopic": op(del((*Store).DeleteTopic)),
+
+	"GetAccessPolicy":    op((*Store).GetAccessPolicy),
+	"CreateAccessPolicy": op((*Store).CreateAccessPolicy),
+	"UpdateAccessPolicy": op((*Store).UpdateAccessPolicy),
+	"DeleteAccessPolicy": op(del((*Store).DeleteAccessPolicy)),
+
+	"GetSchedule":    op((*Store).GetSchedule),
+	"CreateSchedule": op((*Store).CreateSchedule),
+	"UpdateSchedule": op((*Store).UpdateSchedule),
+	"DeleteSchedule": op(del((*Store).DeleteSchedule)),
+
+	"GetUser":    op((*Store).GetUser),
+	"CreateUser": op((*Store).CreateUser),
+	"UpdateUser": op((*Store).UpdateUser),
+	"DeleteUser": op(del((*Store).DeleteUser)),
+
+	"GetLink":    op((*Store).GetLink),
+	"CreateLink": op((*Store).CreateLink),
+	"UpdateLink": op((*Store).UpdateLink),
+	"DeleteLink": op(del((*Store).DeleteLink)),
+
+	"IssueToken": op((*Store).IssueToken),
+	"WhoAmI":     op((*Store).WhoAmI),
+
+	"SubscribeNotifications":   op((*Store).SubscribeNotifications),
+	"UnsubscribeNotifications": op(del((*Store).UnsubscribeNotifications)),
+
+	"List": op((*Store).List),
+
+	"ListRegions": op(func(s *Store, ctx context.Context, _ struct{}) ([]Region, error) {
+		return s.ListRegions(ctx)
+	}),
+
+	"APIVersion": op(func(s *Store, _ context.Context, _ struct{}) (string, error) {
+		return s.APIVersion(), nil
+	}),
+}
+
+// Operations returns the names of the operations the backend performs,
+// sorted.
+func Operations() []string {
+	return slices.Sorted(maps.Keys(operations))
+}
+
+type badRequest struct{ error }
+
+func (badRequest) BadRequest() bool { return true }
+
+// isBadRequest returns true if the supplied error indicates a request could
+// not be decoded, named an unknown operation, or asked for something the
+// backend doesn't allow, like changing an immutable field.
+func isBadRequest(err error) bool {
+	var br interface{ BadRequest() bool }
+	return errors.As(err, &br) && br.BadRequest()
+}
+
+// perform performs the named operation against the supplied store.
+func perform(ctx context.Context, s *Store, name string, c Codec, req []byte) (resp []byte, err error) {
+	ctx, span := tracing.Start(ctx, "bork.backend/"+name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(tracing.AttrOperation.String(name)))
+	defer func() { tracing.End(span, err) }()
+
+	o, ok := operations[name]
+	if !ok {
+		return nil, badRequest{errors.Errorf(errUnknownOperationFmt, name)}
+	}
+	if err := s.available(); err != nil {
+		return nil, err
+	}
+	if err := s.throttle(name); err != nil {
+		return nil, err
+	}
+	if err := s.wait(ctx, name); err != nil {
+		return nil, err
+	}
+	if err := s.fault(name); err != nil {
+		return nil, err
+	}
+	return o(ctx, s, c, req)
+}
+
+// op adapts a store method for use as an operation.
+func op[Req, Resp any](fn func(*Store, context.Context, Req) (Resp, error)) operation {
+	return handle(fn)
+}
+
+// handle adapts a method of T that performs an operation to one that decodes
+// its request and encodes its response using the supplied codec.
+func handle[T, Req, Resp any](fn func(T, context.Context, Req) (Resp, error)) func(context.Context, T, Codec, []byte) ([]byte, error) {
+	return func(ctx context.Context, t T, c Codec, req []byte) ([]byte, error) {
+		var in Req
+		if err := c.Unmarshal(req, &in); err != nil {
+			return nil, badRequest{errors.Wrap(err, errDecodeRequest)}
+		}
+		resp, err := fn(t, ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		b, err := c.Marshal(resp)
+		if err != nil {
+			return nil, internal{errors.Wrap(err, errEncodeResponse)}
+		}
+		return b, nil
+	}
+}
+
+// del adapts a method that returns only an error for use with op or handle.
+func del[T any](fn func(T, context.Context, string) error) func(T, context.Context, string) (struct{}, error) {
+	return func(t T, ctx context.Context, name string) (struct{}, error) {
+		return struct{}{}, fn(t, ctx, name)
+	}
+}
+
+// A storeTransport delivers requests to an in-process Store.
+type storeTransport struct {
+	store *Store
+	token string
+}
+
+func (t storeTransport) Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	if err := t.store.authenticate(t.token, ""); err != nil {
+		return nil, err
+	}
+	return perform(ctx, t.store, op, c, req)
+}
+
+// Watch encodes and decodes each event using the supplied codec, just as a
+// remote backend would. The channel is closed if an event can't be decoded,
+// or immediately if the transport's token has expired.
+func (t storeTransport) Watch(ctx context.Context, c Codec) <-chan Event {
+	out := make(chan Event, WatchBufferSize)
+	if t.store.authenticate(t.token, "") != nil {
+		close(out)
+		return out
+	}
+	in := t.store.Watch(ctx)
+	go func() {
+		defer close(out)
+		for e := range in {
+			b, err := c.Marshal(e)
+			if err != nil {
+				return
+			}
+			var ev Event
+			if err := c.Unmarshal(b, &ev); err != nil {
+				return
+			}
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (t storeTransport) Close() error {
+	return nil
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errUnknownStrategyFmt = "unknown update strategy %q"
+	errPatchOpFmt         = "unknown JSON patch operation %q"
+	errPatchPathFmt       = "JSON patch path %q must name a key of the data value, e.g. /key"
+	errPatchMissingFmt    = "cannot %s data value key %q: it doesn't exist"
+	errPatchExistsFmt     = "cannot add data value key %q: it already exists"
+	errPatchTestFmt       = "JSON patch test of data value key %q failed: it has changed since it was observed"
+)
+
+// An UpdateStrategy determines how patching a record writes its data value.
+type UpdateStrategy string
+
+// Update strategies.
+const (
+	// UpdateStrategyReplace overwrites the record's data value, as Update
+	// does. It is the default.
+	UpdateStrategyReplace UpdateStrategy = "Replace"
+
+	// UpdateStrategyMerge merges the patch's data value into the record's,
+	// per MergeData.
+	UpdateStrategyMerge UpdateStrategy = "Merge"
+
+	// UpdateStrategyJSONPatch applies the patch's operations to the
+	// record's data value, per ApplyPatch. The patch's data value is
+	// ignored.
+	UpdateStrategyJSONPatch UpdateStrategy = "JSONPatch"
+)
+
+// JSON patch operations, per RFC 6902. Operations that move or copy values
+// aren't supported.
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+	PatchOpTest    = "test"
+)
+
+// A PatchOperation is a JSON patch operation on a record's data value. Its
+// path is a JSON pointer to a key of the data value, e.g. /key.
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value string `json:"value,omitempty"`
+}
+
+// A RecordPatch updates a record, writing its data value per its strategy.
+type RecordPatch struct {
+	// Record overwrites the named record, as Update does, except for its
+	// data value.
+	Record Record
+
+	// Strategy determines how the record's data value is written. Defaults
+	// to UpdateStrategyReplace.
+	Strategy UpdateStrategy
+
+	// Operations applied to the record's data value by
+	// UpdateStrategyJSONPatch.
+	Operations []PatchOperation
+}
+
+// Patch updates the supplied patch's record just as Update does, except that
+// its data value is written per the patch's strategy. It returns a conflict
+// if a JSON patch's test fails, in which case the record is unchanged.
+func (s *Store) Patch(_ context.Context, p RecordPatch) (Record, error) {
+	unlock := s.lockRecord(p.Record.Name)
+	defer unlock()
+
+	existing, err := s.updatable(p.Record.Name)
+	if err != nil {
+		return Record{}, err
+	}
+	r, err := patched(existing, p)
+	if err != nil {
+		return Record{}, err
+	}
+	if err := s.keepsUnknownFields(existing, r); err != nil {
+		return Record{}, err
+	}
+	return s.overwrite(existing, r), nil
+}
+
+// patched returns the supplied patch's record, with the data value that
+// applying the patch to the supplied existing record would write.
+func patched(existing Record, p RecordPatch) (Record, error) {
+	r := p.Record
+	var err error
+	switch p.Strategy {
+	case "", UpdateStrategyReplace:
+	case UpdateStrategyMerge:
+		r.DataValue = MergeData(existing.DataValue, r.DataValue)
+	case UpdateStrategyJSONPatch:
+		if r.DataValue, err = ApplyPatch(existing.DataValue, p.Operations); err != nil {
+			return Record{}, err
+		}
+	default:
+		return Record{}, badRequest{errors.Errorf(errUnknownStrategyFmt, p.Strategy)}
+	}
+	return r, nil
+}
+
+// MergeData returns the supplied data value with the supplied patch merged
+// into it, like a JSON merge patch per RFC 7386. Keys the patch doesn't have
+// are kept, and keys whose value in the patch is empty are removed.
+func MergeData(data, patch map[string]string) map[string]string {
+	out := maps.Clone(data)
+	if out == nil {
+		out = make(map[string]string, len(patch))
+	}
+	for k, v := range patch {
+		if v == "" {
+			delete(out, k)
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// ApplyPatch returns the supplied data value with the supplied JSON patch
+// operations applied to it, or an error if any of them can't be applied.
+// A failed test is a conflict; other errors are bad requests.
+func ApplyPatch(data map[string]string, ops []PatchOperation) (map[string]string, error) {
+	out := maps.Clone(data)
+	if out == nil {
+		out = make(map[string]string, len(ops))
+	}
+	for _, o := range ops {
+		k, ok := patchKey(o.Path)
+		if !ok {
+			return nil, badRequest{errors.Errorf(errPatchPathFmt, o.Path)}
+		}
+		v, exists := out[k]
+		switch o.Op {
+		case PatchOpAdd:
+			// Unlike RFC 6902 we don't let add replace a key that exists,
+			// so that two writers adding the same key conflict.
+			if exists {
+				return nil, conflict{errors.Errorf(errPatchExistsFmt, k)}
+			}
+			out[k] = o.Value
+		case PatchOpReplace:
+			if !exists {
+				return nil, conflict{errors.Errorf(errPatchMissingFmt, o.Op, k)}
+			}
+			out[k] = o.Value
+		case PatchOpRemove:
+			if !exists {
+				return nil, conflict{errors.Errorf(errPatchMissingFmt, o.Op, k)}
+			}
+			delete(out, k)
+		case PatchOpTest:
+			if !exists || v != o.Value {
+				return nil, conflict{errors.Errorf(errPatchTestFmt, k)}
+			}
+		default:
+			return nil, badRequest{errors.Errorf(errPatchOpFmt, o.Op)}
+		}
+	}
+	return out, nil
+}
+
+// DiffData returns the JSON patch operations that change the supplied
+// current data value to the supplied desired one. Every key that is replaced
+// or removed is first tested, so that the patch conflicts if the key has
+// changed since the current data value was observed. Operations are sorted
+// by key, so that the same values always produce the same patch.
+func DiffData(current, desired map[string]string) []PatchOperation {
+	keys := slices.Sorted(maps.Keys(current))
+	for k := range desired {
+		if _, ok := current[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var ops []PatchOperation
+	for _, k := range keys {
+		cv, inCurrent := current[k]
+		dv, inDesired := desired[k]
+		path := patchPath(k)
+		switch {
+		case !inCurrent:
+			ops = append(ops, PatchOperation{Op: PatchOpAdd, Path: path, Value: dv})
+		case !inDesired:
+			ops = append(ops, PatchOperation{Op: PatchOpTest, Path: path, Value: cv}, PatchOperation{Op: PatchOpRemove, Path: path})
+		case cv != dv:
+			ops = append(ops, PatchOperation{Op: PatchOpTest, Path: path, Value: cv}, PatchOperation{Op: PatchOpReplace, Path: path, Value: dv})
+		}
+	}
+	return ops
+}
+
+// pointerEscaper escapes a key for use in a JSON pointer, per RFC 6901.
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// pointerUnescaper reverses pointerEscaper.
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+func patchPath(key string) string {
+	return "/" + pointerEscaper.Replace(key)
+}
+
+// patchKey returns the data value key the supplied JSON pointer names.
+func patchKey(path string) (string, bool) {
+	k, ok := strings.CutPrefix(path, "/")
+	if !ok || strings.Contains(k, "/") {
+		return "", false
+	}
+	return pointerUnescaper.Replace(k), true
+}
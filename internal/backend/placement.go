@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// A Placement pins a set of bork records to a zone.
+type Placement struct {
+	// Name uniquely identifies the placement within the backend. It is
+	// assigned by the backend when the placement is created.
+	Name string
+
+	// Zone in which the targeted records are placed.
+	Zone string
+
+	// Targets are the names of the bork records that are placed.
+	Targets []string
+
+	// Revision is assigned by the backend every time the placement is
+	// written.
+	Revision int64
+}
+
+// GetPlacement returns the named placement.
+func (s *Store) GetPlacement(_ context.Context, name string) (Placement, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.placements[name]
+	if !ok {
+		return Placement{}, notFound{errors.Errorf(errPlacementNotFoundFmt, name)}
+	}
+	return copyPlacement(p), nil
+}
+
+// CreatePlacement stores the supplied placement, assigning it a new revision.
+// If the placement has no name the backend generates a unique one. It returns
+// an error if a placement with the same name already exists.
+func (s *Store) CreatePlacement(_ context.Context, p Placement) (Placement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p.Name == "" {
+		p.Name = generateName("placement")
+	}
+	if existing, ok := s.placements[p.Name]; ok {
+		return duplicate(s, copyPlacement(existing), alreadyExists{errors.Errorf(errPlacementAlreadyExistsFmt, p.Name)})
+	}
+	p.Revision = s.revision.Add(1)
+	s.placements[p.Name] = copyPlacement(p)
+	s.notify(EventCreated, KindPlacement, p.Name, p.Revision)
+	return p, nil
+}
+
+// UpdatePlacement overwrites the supplied placement, assigning it a new
+// revision. It returns an error if the placement does not exist.
+func (s *Store) UpdatePlacement(_ context.Context, p Placement) (Placement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.placements[p.Name]; !ok {
+		return Placement{}, notFound{errors.Errorf(errPlacementNotFoundFmt, p.Name)}
+	}
+	p.Revision = s.revision.Add(1)
+	s.placements[p.Name] = copyPlacement(p)
+	s.notify(EventUpdated, KindPlacement, p.Name, p.Revision)
+	return p, nil
+}
+
+// DeletePlacement removes the named placement. Deleting a placement that does
+// not exist is not an error.
+func (s *Store) DeletePlacement(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.placements[name]; !ok {
+		return nil
+	}
+	delete(s.placements, name)
+	s.notify(EventDeleted, KindPlacement, name, 0)
+	return nil
+}
+
+// copyPlacement ensures callers never share a Targets slice with the store.
+func copyPlacement(p Placement) Placement {
+	p.Targets = append([]string(nil), p.Targets...)
+	return p
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// A Plan limits the rate at which the keys attached to it may call the
+// backend.
+type Plan struct {
+	// Name uniquely identifies the plan within the backend. It is assigned by
+	// the backend when the plan is created.
+	Name string
+
+	// Tier is a human readable name for the plan, e.g. "gold".
+	Tier string
+
+	// RequestsPerSecond each attached key may sustain.
+	RequestsPerSecond int
+
+	// Burst of requests each attached key may make above its sustained rate.
+	Burst int
+
+	// Revision is assigned by the backend every time the plan is written.
+	Revision int64
+}
+
+// PlanUsage summarises how a plan is being consumed.
+type PlanUsage struct {
+	// Keys are the names of the keys attached to the plan, sorted by name.
+	Keys []string
+
+	// AllocatedRequestsPerSecond is the sum of the sustained rate allocated to
+	// every attached key.
+	AllocatedRequestsPerSecond int
+}
+
+// GetPlan returns the named plan.
+func (s *Store) GetPlan(_ context.Context, name string) (Plan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.plans[name]
+	if !ok {
+		return Plan{}, notFound{errors.Errorf(errPlanNotFoundFmt, name)}
+	}
+	return p, nil
+}
+
+// GetPlanUsage returns the usage statistics of the named plan.
+func (s *Store) GetPlanUsage(_ context.Context, name string) (PlanUsage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.plans[name]
+	if !ok {
+		return PlanUsage{}, notFound{errors.Errorf(errPlanNotFoundFmt, name)}
+	}
+
+	u := PlanUsage{}
+	for _, k := range s.keys {
+		if k.Plan == name {
+			u.Keys = append(u.Keys, k.Name)
+		}
+	}
+	sort.Strings(u.Keys)
+	u.AllocatedRequestsPerSecond = len(u.Keys) * p.RequestsPerSecond
+	return u, nil
+}
+
+// CreatePlan stores the supplied plan, assigning it a new revision. If the
+// plan has no name the backend generates a unique one. It returns an error if
+// a plan with the same name already exists.
+func (s *Store) CreatePlan(_ context.Context, p Plan) (Plan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p.Name == "" {
+		p.Name = generateName("plan")
+	}
+	if existing, ok := s.plans[p.Name]; ok {
+		return duplicate(s, existing, alreadyExists{errors.Errorf(errPlanAlreadyExistsFmt, p.Name)})
+	}
+	p.Revision = s.revision.Add(1)
+	s.plans[p.Name] = p
+	s.notify(EventCreated, KindPlan, p.Name, p.Revision)
+	return p, nil
+}
+
+// UpdatePlan overwrites the supplied plan, assigning it a new revision. It
+// returns an error if the plan does not exist.
+func (s *Store) UpdatePlan(_ context.Context, p Plan) (Plan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.plans[p.Name]; !ok {
+		return Plan{}, notFound{errors.Errorf(errPlanNotFoundFmt, p.Name)}
+	}
+	p.Revision = s.revision.Add(1)
+	s.plans[p.Name] = p
+	s.notify(EventUpdated, KindPlan, p.Name, p.Revision)
+	return p, nil
+}
+
+// DeletePlan removes the named plan. Keys attached to the plan are detached.
+// Deleting a plan that does not exist is not an error.
+func (s *Store) DeletePlan(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.plans[name]; !ok {
+		return nil
+	}
+	delete(s.plans, name)
+	s.notify(EventDeleted, KindPlan, name, 0)
+	for n, k := range s.keys {
+		if k.Plan != name {
+			continue
+		}
+		k.Plan = ""
+		k.Revision = s.revision.Add(1)
+		s.keys[n] = k
+		s.notify(EventUpdated, KindKey, n, k.Revision)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A Queue holds messages for consumers. Queues are eventually consistent:
+// reads return the queue as it was before any write made within its
+// consistency window, so a queue that was just created isn't found, a queue
+// that was just updated is stale, and a queue that was just deleted is still
+// found.
+type Queue struct {
+	// Name uniquely identifies the queue within the backend. It is assigned
+	// by the backend when the queue is created, unless one is supplied.
+	Name string
+
+	// Tags attached to the queue.
+	Tags map[string]string
+
+	// VisibilityTimeout is how long a received message is hidden from other
+	// consumers.
+	VisibilityTimeout time.Duration
+
+	// MessageRetention is how long a message is kept before it is discarded.
+	MessageRetention time.Duration
+
+	// ConsistencyWindow is how long after the queue is written that reads
+	// return the queue as it was before the write. Reads are consistent if it
+	// is zero.
+	ConsistencyWindow time.Duration
+
+	// Revision is assigned by the backend every time the queue is written.
+	Revision int64
+}
+
+// A queueVersion is a write to a queue, which reads return once it is
+// visible.
+type queueVersion struct {
+	queue     Queue
+	deleted   bool
+	visibleAt time.Time
+}
+
+// A queueHistory is the writes to a queue, oldest first. Only the newest
+// visible write, and those that are not yet visible, are retained.
+type queueHistory []queueVersion
+
+// latest returns the queue as of the most recent write, whether or not it is
+// visible.
+func (h queueHistory) latest() (Queue, bool) {
+	if len(h) == 0 || h[len(h)-1].deleted {
+		return Queue{}, false
+	}
+	return h[len(h)-1].queue, true
+}
+
+// visible returns the queue as of the most recent write that is visible at
+// the supplied time, and the history without the writes that it supersedes.
+func (h queueHistory) visible(now time.Time) (Queue, bool, queueHistory) {
+	i := -1
+	for j, v := range h {
+		if now.Before(v.visibleAt) {
+			break
+		}
+		i = j
+	}
+	if i < 0 {
+		return Queue{}, false, h
+	}
+	h = h[i:]
+	return h[0].queue, !h[0].deleted, h
+}
+
+// write returns the history with the supplied write added. The write is
+// visible once the consistency window of the queue it replaces has passed,
+// or that of the supplied queue if it is being created.
+func (h queueHistory) write(q Queue, deleted bool, now time.Time) queueHistory {
+	window := q.ConsistencyWindow
+	if prev, ok := h.latest(); ok {
+		window = prev.ConsistencyWindow
+	}
+	_, _, h = h.visible(now)
+	return append(h, queueVersion{queue: q, deleted: deleted, visibleAt: now.Add(window)})
+}
+
+// GetQueue returns the named queue, as it was before any write made within
+// its consistency window.
+func (s *Store) GetQueue(_ context.Context, name string) (Queue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok, h := s.queues[name].visible(time.Now())
+	switch {
+	case len(h) == 1 && h[0].deleted:
+		// The queue's deletion is visible, and nothing has been written
+		// since. Forget it.
+		delete(s.queues, name)
+	case len(h) > 0:
+		s.queues[name] = h
+	}
+	if !ok {
+		return Queue{}, notFound{errors.Errorf(errQueueNotFoundFmt, name)}
+	}
+	return copyQueue(q), nil
+}
+
+// CreateQueue stores the supplied queue, assigning it a new revision. If the
+// queue has no name the backend generates a unique one. It returns an error
+// if a queue with the same name already exists, even if the queue isn't yet
+// visible.
+func (s *Store) CreateQueue(_ context.Context, q Queue) (Queue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if q.Name == "" {
+		q.Name = generateName("queue")
+	}
+	if existing, ok := s.queues[q.Name].latest(); ok {
+		return duplicate(s, copyQueue(existing), alreadyExists{errors.Errorf(errQueueAlreadyExistsFmt, q.Name)})
+	}
+	q.Revision = s.revision.Add(1)
+	q = copyQueue(q)
+	s.queues[q.Name] = s.queues[q.Name].write(q, false, time.Now())
+	s.notify(EventCreated, KindQueue, q.Name, q.Revision)
+	return copyQueue(q), nil
+}
+
+// UpdateQueue overwrites the supplied queue, assigning it a new revision. It
+// returns an error if the queue does not exist, even if its deletion isn't
+// yet visible.
+func (s *Store) UpdateQueue(_ context.Context, q Queue) (Queue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queues[q.Name].latest(); !ok {
+		return Queue{}, notFound{errors.Errorf(errQueueNotFoundFmt, q.Name)}
+	}
+	q.Revision = s.revision.Add(1)
+	q = copyQueue(q)
+	s.queues[q.Name] = s.queues[q.Name].write(q, false, time.Now())
+	s.notify(EventUpdated, KindQueue, q.Name, q.Revision)
+	return copyQueue(q), nil
+}
+
+// DeleteQueue deletes the named queue. Deleting a queue that does not exist
+// is not an error.
+func (s *Store) DeleteQueue(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[name].latest()
+	if !ok {
+		return nil
+	}
+	s.queues[name] = s.queues[name].write(q, true, time.Now())
+	s.notify(EventDeleted, KindQueue, name, 0)
+	return nil
+}
+
+// copyQueue ensures callers never share a Tags map with the store.
+func copyQueue(q Queue) Queue {
+	q.Tags = copyTags(q.Tags)
+	return q
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errOpenRecordingFmt   = "cannot open recording %s"
+	errReadRecordingFmt   = "cannot read recording %s"
+	errDecodeRecordingFmt = "cannot decode interaction %d of recording %s"
+	errWriteRecording     = "cannot write interaction to recording"
+	errNotRecordedFmt     = "no response to %s request was recorded"
+)
+
+// An Interaction is a call a client made to a backend, as recorded by a
+// Recorder. Payloads encoded as JSON are recorded as JSON. Other payloads are
+// recorded as base64 encoded binary.
+type Interaction struct {
+	// Time at which the call was made.
+	Time time.Time `json:"time"`
+
+	// Operation the backend was asked to perform.
+	Operation string `json:"operation"`
+
+	// ContentType the request and response were encoded with.
+	ContentType string `json:"contentType"`
+
+	Request        json.RawMessage `json:"request,omitempty"`
+	RequestBinary  []byte          `json:"requestBinary,omitempty"`
+	Response       json.RawMessage `json:"response,omitempty"`
+	ResponseBinary []byte          `json:"responseBinary,omitempty"`
+
+	// Error returned by the call, if any.
+	Error *InteractionError `json:"error,omitempty"`
+
+	// Latency of the call, in nanoseconds.
+	Latency time.Duration `json:"latency"`
+}
+
+// An InteractionError is an error returned by a recorded call.
+type InteractionError struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+func newInteraction(op, contentType string, req, resp []byte, err error, start time.Time) Interaction {
+	i := Interaction{Time: start.UTC(), Operation: op, ContentType: contentType, Latency: time.Since(start)}
+	if contentType == ContentTypeJSON {
+		i.Request, i.Response = req, resp
+	} else {
+		i.RequestBinary, i.ResponseBinary = req, resp
+	}
+	if err != nil {
+		i.Error = &InteractionError{Code: Code(err), Message: err.Error()}
+	}
+	return i
+}
+
+func (i Interaction) request() []byte {
+	if i.ContentType == ContentTypeJSON {
+		return i.Request
+	}
+	return i.RequestBinary
+}
+
+// result returns the recorded response and error of the call.
+func (i Interaction) result() ([]byte, error) {
+	if i.Error != nil {
+		return nil, NewError(i.Error.Code, i.Error.Message)
+	}
+	if i.ContentType == ContentTypeJSON {
+		return i.Response, nil
+	}
+	return i.ResponseBinary, nil
+}
+
+// A Recorder records every call made by the clients that record to it to a
+// file, one JSON encoded Interaction per line.
+type Recorder struct {
+	mu      sync.Mutex
+	f       *os.File
+	onError func(error)
+}
+
+// NewRecorder returns a recorder that records to the file at the supplied
+// path, which is truncated. Errors writing the file are passed to the
+// supplied function, which may be nil; calls are unaffected.
+func NewRecorder(path string, onError func(error)) (*Recorder, error) {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, errOpenRecordingFmt, path)
+	}
+	return &Recorder{f: f, onError: onError}, nil
+}
+
+// Close closes the recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Close()
+}
+
+func (r *Recorder) record(i Interaction) {
+	b, err := json.Marshal(i)
+	if err == nil {
+		r.mu.Lock()
+		_, err = r.f.Write(append(b, '\n'))
+		r.mu.Unlock()
+	}
+	if err != nil && r.onError != nil {
+		r.onError(errors.Wrap(err, errWriteRecording))
+	}
+}
+
+// RecordTo arranges for every call the client makes, including by clients
+// leased from it, to be recorded by the supplied recorder. Watches aren't
+// recorded. It must be called before the client is used.
+func (c *Client) RecordTo(r *Recorder) {
+	c.transport = recordingTransport{transport: c.transport, recorder: r}
+}
+
+// A recordingTransport records the requests it delivers.
+type recordingTransport struct {
+	transport
+	recorder *Recorder
+}
+
+func (t recordingTransport) Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	start := time.Now()
+	resp, err := t.transport.Do(ctx, op, c, req)
+	t.recorder.record(newInteraction(op, c.ContentType(), req, resp, err, start))
+	return resp, err
+}
+
+// A Replay serves the responses of a recording in place of a backend.
+type Replay struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// LoadReplay returns a replay of the recording at the supplied path.
+func LoadReplay(path string) (*Replay, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrapf(err, errOpenRecordingFmt, path)
+	}
+	defer f.Close()
+
+	r := &Replay{}
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, MaxRequestBytes*2)
+	for sc.Scan() {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		i := Interaction{}
+		if err := json.Unmarshal(sc.Bytes(), &i); err != nil {
+			return nil, errors.Wrapf(err, errDecodeRecordingFmt, len(r.interactions)+1, path)
+		}
+		r.interactions = append(r.interactions, i)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, errors.Wrapf(err, errReadRecordingFmt, path)
+	}
+	r.replayed = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// Connect returns a client of the replay that encodes payloads using the
+// first of the preferred content types that was recorded.
+func (r *Replay) Connect(preferred ...string) (*Client, error) {
+	supported := []string{ContentTypeJSON}
+	for _, i := range r.interactions {
+		if !slices.Contains(supported, i.ContentType) {
+			supported = append(supported, i.ContentType)
+		}
+	}
+	c, err := Negotiate(supported, preferred...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{transport: replayTransport{replay: r}, codec: c, version: &servedVersion{}}, nil
+}
+
+// next returns the first interaction that hasn't been replayed, of the named
+// operation, with the supplied content type and request. If no such
+// interaction was recorded, it returns the first that hasn't been replayed of
+// the named operation with the supplied content type.
+func (r *Replay) next(op, contentType string, req []byte) (Interaction, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	first := -1
+	for n, i := range r.interactions {
+		if r.replayed[n] || i.Operation != op || i.ContentType != contentType {
+			continue
+		}
+		if bytes.Equal(i.request(), req) {
+			first = n
+			break
+		}
+		if first < 0 {
+			first = n
+		}
+	}
+	if first < 0 {
+		return Interaction{}, false
+	}
+	r.replayed[first] = true
+	return r.interactions[first], true
+}
+
+// A replayTransport serves responses from a replay.
+type replayTransport struct {
+	replay *Replay
+}
+
+// Do returns the recorded response to the supplied request. It fails if
+// every response to the operation has already been replayed.
+func (t replayTransport) Do(_ context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	i, ok := t.replay.next(op, c.ContentType(), req)
+	if !ok {
+		return nil, errors.Errorf(errNotRecordedFmt, op)
+	}
+	return i.result()
+}
+
+// Watch returns a channel that's closed when the supplied context is done.
+// Watches aren't recorded, so a replay never changes.
+func (t replayTransport) Watch(ctx context.Context, _ Codec) <-chan Event {
+	out := make(chan Event)
+	go func() {
+		<-ctx.Done()
+		close(out)
+	}()
+	return out
+}
+
+func (t replayTransport) Close() error {
+	return nil
+}
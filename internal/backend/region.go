@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"slices"
+	"sort"
+)
+
+// A Region is a location in which the backend can store records.
+type Region struct {
+	// Name uniquely identifies the region, e.g. "bork-central-1".
+	Name string
+
+	// Tiers of service offered in the region, sorted by name.
+	Tiers []string
+
+	// Versioning is true if buckets in the region support object versioning.
+	Versioning bool
+
+	// MaxRequestsPerSecond any one key may sustain in the region.
+	MaxRequestsPerSecond int
+
+	// Available is false if the region is not currently accepting new
+	// records.
+	Available bool
+}
+
+// DefaultRegions are the regions offered by a new Store.
+var DefaultRegions = []Region{
+	{Name: DefaultRegion, Tiers: []string{"premium", DefaultTier}, Versioning: true, MaxRequestsPerSecond: 1000, Available: true},
+	{Name: "bork-east-1", Tiers: []string{DefaultTier}, MaxRequestsPerSecond: 250, Available: true},
+	{Name: "bork-west-2", Tiers: []string{"archive", "premium", DefaultTier}, Versioning: true, MaxRequestsPerSecond: 500, Available: true},
+}
+
+// ListRegions returns the regions offered by the backend, sorted by name.
+func (s *Store) ListRegions(_ context.Context) ([]Region, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	regions := make([]Region, 0, len(s.regions))
+	for _, r := range s.regions {
+		regions = append(regions, copyRegion(r))
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i].Name < regions[j].Name })
+	return regions, nil
+}
+
+// SetRegion adds or replaces a region offered by the backend. It allows the
+// backend's operator to bring regions online or take them out of service.
+func (s *Store) SetRegion(_ context.Context, r Region) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.regions[r.Name] = copyRegion(r)
+	s.notify(EventUpdated, KindRegion, r.Name, 0)
+	return nil
+}
+
+func copyRegion(r Region) Region {
+	r.Tiers = slices.Clone(r.Tiers)
+	return r
+}
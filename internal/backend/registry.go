@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"crypto/tls"
+	"slices"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errUnknownBackendFmt     = "unknown backend %q; backends are %v"
+	errUnsupportedOpFmt      = "backend doesn't support operation %q"
+	errAlreadyRegisteredFmt  = "backend %q is already registered"
+	errBackendRequiresEndFmt = "backend %q requires an endpoint"
+)
+
+// Backends that are always registered.
+const (
+	// BackendMemory is the in-process store.
+	BackendMemory = "memory"
+
+	// BackendFile is the in-process store, persisted to a file when the
+	// provider starts. See Store.PersistTo.
+	BackendFile = "file"
+
+	// BackendHTTP is a bork API server, reached over HTTP.
+	BackendHTTP = "http"
+
+	// BackendGRPC is a bork API server, reached over gRPC.
+	BackendGRPC = "grpc"
+)
+
+// A Backend stores bork records. The in-process Store is a Backend, as is a
+// Client of any other backend. To simulate a backend of your own implement
+// Backend, then Register a Factory that returns a client Served by it.
+type Backend interface {
+	// Get returns the named record.
+	Get(ctx context.Context, name string) (Record, error)
+
+	// Create creates the supplied record, returning it as it was created.
+	Create(ctx context.Context, r Record) (Record, error)
+
+	// Update overwrites the supplied record, returning it as it was written.
+	Update(ctx context.Context, r Record) (Record, error)
+
+	// Delete removes the named record.
+	Delete(ctx context.Context, name string) error
+}
+
+// A Watcher is a Backend that reports the changes made to it.
+type Watcher interface {
+	// Watch returns a channel of the changes made to the backend, which is
+	// closed when the supplied context is done.
+	Watch(ctx context.Context) <-chan Event
+}
+
+var (
+	_ Backend = &Store{}
+	_ Backend = &Client{}
+	_ Watcher = &Store{}
+)
+
+// DialOptions configure a client of a backend.
+type DialOptions struct {
+	// Store is the provider's in-process store, used by in-process
+	// backends.
+	Store *Store
+
+	// Endpoint of a remote backend, e.g. https://bork.example.org.
+	Endpoint string
+
+	// TLS configures connections to the endpoint.
+	TLS *tls.Config
+
+	// Token presented to the backend, if not empty.
+	Token string
+
+	// ContentTypes the client prefers to encode payloads with, in order.
+	ContentTypes []string
+}
+
+// A Factory returns a client of a kind of backend.
+type Factory func(ctx context.Context, o DialOptions) (*Client, error)
+
+var registry = struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}{factories: map[string]Factory{}}
+
+func init() {
+	inProcess := func(_ context.Context, o DialOptions) (*Client, error) {
+		return o.Store.ConnectWithToken(o.Token, o.ContentTypes...)
+	}
+	Register(BackendMemory, inProcess)
+	Register(BackendFile, inProcess)
+}
+
+// Register the supplied factory of the named backend, so that it may be
+// Opened. Backends are registered when the provider is compiled, by init
+// functions. Register panics if the named backend is already registered.
+func Register(name string, f Factory) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	if _, ok := registry.factories[name]; ok {
+		panic(errors.Errorf(errAlreadyRegisteredFmt, name))
+	}
+	registry.factories[name] = f
+}
+
+// Backends returns the names of the registered backends, sorted by name.
+func Backends() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Open returns a client of the named backend.
+func Open(ctx context.Context, name string, o DialOptions) (*Client, error) {
+	registry.mu.RLock()
+	f, ok := registry.factories[name]
+	registry.mu.RUnlock()
+	if !ok {
+		return nil, errors.Errorf(errUnknownBackendFmt, name, Backends())
+	}
+	return f(ctx, o)
+}
+
+// Serve returns a client of the supplied backend that encodes payloads using
+// the first of the preferred content types, just as a client of a remote
+// backend would. The client supports only record operations: Head, Get,
+// Create, Update, Patch and Delete. Head and Patch are performed by getting
+// the record first, so a patch is applied atomically only if the backend
+// serializes its own writes. The client's Watch reports changes only if the
+// backend is a Watcher.
+func Serve(b Backend, preferred ...string) (*Client, error) {
+	c, err := Negotiate([]string{ContentTypeJSON, ContentTypeMessagePack}, preferred...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{transport: backendTransport{backend: b}, codec: c, version: &servedVersion{}}, nil
+}
+
+// recordOperations are the operations a backendTransport can perform, by
+// name.
+var recordOperations = map[string]func(context.Context, Backend, Codec, []byte) ([]byte, error){
+	"Head": handle(func(b Backend, ctx context.Context, name string) (int64, error) {
+		r, err := b.Get(ctx, name)
+		return r.Revision, err
+	}),
+	"Get":    handle(Backend.Get),
+	"Create": handle(Backend.Create),
+	"Update": handle(Backend.Update),
+	"Patch": handle(func(b Backend, ctx context.Context, p RecordPatch) (Record, error) {
+		existing, err := b.Get(ctx, p.Record.Name)
+		if err != nil {
+			return Record{}, err
+		}
+		r, err := patched(existing, p)
+		if err != nil {
+			return Record{}, err
+		}
+		return b.Update(ctx, r)
+	}),
+	"Delete": handle(del(Backend.Delete)),
+}
+
+// A backendTransport delivers requests to a Backend.
+type backendTransport struct {
+	backend Backend
+}
+
+func (t backendTransport) Do(ctx context.Context, op string, c Codec, req []byte) ([]byte, error) {
+	o, ok := recordOperations[op]
+	if !ok {
+		return nil, badRequest{errors.Errorf(errUnsupportedOpFmt, op)}
+	}
+	return o(ctx, t.backend, c, req)
+}
+
+// Watch returns the backend's changes if it's a Watcher. Otherwise it returns
+// a channel that is closed when the supplied context is done, so that
+// callers fall back to polling without resubscribing.
+func (t backendTransport) Watch(ctx context.Context, _ Codec) <-chan Event {
+	if w, ok := t.backend.(Watcher); ok {
+		return w.Watch(ctx)
+	}
+	out := make(chan Event)
+	go func() {
+		<-ctx.Done()
+		close(out)
+	}()
+	return out
+}
+
+func (t backendTransport) Close() error {
+	return nil
+}
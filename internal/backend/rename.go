@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errRenameUnnamed    = "cannot rename a bork record without a new name"
+	errUIDNotFoundFmt   = "bork record with UID %q not found"
+	errRenameDeletedFmt = "cannot rename bork record %q while it is being deleted"
+)
+
+// RenameRecord renames the named record, as someone other than its owner
+// would. The record keeps its UID, so that its owner can find it by UID, and
+// is assigned a new revision. It returns an error if the record doesn't
+// exist or is being deleted, or if a record with the new name exists.
+func (s *Store) RenameRecord(name, to string) (Record, error) {
+	if to == "" {
+		return Record{}, badRequest{errors.New(errRenameUnnamed)}
+	}
+
+	// A rename writes two records, which may be in different shards, so
+	// it holds the store's write lock.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.records.get(name)
+	if !ok {
+		return Record{}, notFound{errors.Errorf(errNotFoundFmt, name)}
+	}
+	if r.State == RecordDeleting {
+		return Record{}, conflict{errors.Errorf(errRenameDeletedFmt, name)}
+	}
+	if to == name {
+		return copyRecord(r), nil
+	}
+	if _, ok := s.records.get(to); ok {
+		return Record{}, alreadyExists{errors.Errorf(errAlreadyExistsFmt, to)}
+	}
+
+	s.records.remove(name)
+	delete(s.malformed, name)
+	s.notify(EventDeleted, KindRecord, name, 0)
+	r = copyRecord(r)
+	r.Name = to
+	return copyRecord(s.rewrite(r, time.Now())), nil
+}
+
+// GetByUID returns the record with the supplied UID, whatever its name. Its
+// owner can use it to find a record that was renamed.
+func (s *Store) GetByUID(_ context.Context, uid string) (Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, r := range s.records.list() {
+		if r.UID == uid {
+			return copyRecord(r), nil
+		}
+	}
+	return Record{}, notFound{errors.Errorf(errUIDNotFoundFmt, uid)}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// A Schedule is a backend resource whose settings may only be changed during
+// its maintenance window, modelling the many real APIs that apply changes to
+// databases and clusters during maintenance. The backend doesn't know about
+// maintenance windows: the provider is responsible for only updating a
+// schedule while its window is open.
+type Schedule struct {
+	// Name uniquely identifies the schedule within the backend. It is
+	// assigned by the backend when the schedule is created.
+	Name string
+
+	// Settings of the schedule.
+	Settings map[string]string
+
+	// Revision is assigned by the backend every time the schedule is
+	// written.
+	Revision int64
+}
+
+// GetSchedule returns the named schedule.
+func (s *Store) GetSchedule(_ context.Context, name string) (Schedule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sc, ok := s.schedules[name]
+	if !ok {
+		return Schedule{}, notFound{errors.Errorf(errScheduleNotFoundFmt, name)}
+	}
+	return copySchedule(sc), nil
+}
+
+// CreateSchedule stores the supplied schedule, assigning it a new revision.
+// If the schedule has no name the backend generates a unique one. It returns
+// an error if a schedule with the same name already exists.
+func (s *Store) CreateSchedule(_ context.Context, sc Schedule) (Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sc.Name == "" {
+		sc.Name = generateName("schedule")
+	}
+	if existing, ok := s.schedules[sc.Name]; ok {
+		return duplicate(s, copySchedule(existing), alreadyExists{errors.Errorf(errScheduleAlreadyExistsFmt, sc.Name)})
+	}
+	sc.Revision = s.revision.Add(1)
+	s.schedules[sc.Name] = copySchedule(sc)
+	s.notify(EventCreated, KindSchedule, sc.Name, sc.Revision)
+	return copySchedule(sc), nil
+}
+
+// UpdateSchedule overwrites the settings of the supplied schedule, assigning
+// it a new revision. It returns an error if the schedule does not exist.
+func (s *Store) UpdateSchedule(_ context.Context, sc Schedule) (Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.schedules[sc.Name]
+	if !ok {
+		return Schedule{}, notFound{errors.Errorf(errScheduleNotFoundFmt, sc.Name)}
+	}
+	existing.Revision = s.revision.Add(1)
+	existing.Settings = copyTags(sc.Settings)
+	s.schedules[sc.Name] = existing
+	s.notify(EventUpdated, KindSchedule, sc.Name, existing.Revision)
+	return copySchedule(existing), nil
+}
+
+// DeleteSchedule removes the named schedule. Deleting a schedule that does
+// not exist is not an error.
+func (s *Store) DeleteSchedule(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.schedules[name]; !ok {
+		return nil
+	}
+	delete(s.schedules, name)
+	s.notify(EventDeleted, KindSchedule, name, 0)
+	return nil
+}
+
+// copySchedule ensures callers never share a Settings map with the store.
+func copySchedule(sc Schedule) Schedule {
+	sc.Settings = copyTags(sc.Settings)
+	return sc
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// recordShards is the number of shards a store's records are split between.
+const recordShards = 64
+
+// A recordTable stores records in shards keyed by their names, each with a
+// lock of its own, so that reconciling records in different shards doesn't
+// contend for a lock. Its methods are safe for concurrent use.
+type recordTable struct {
+	shards [recordShards]recordShard
+}
+
+type recordShard struct {
+	// write serializes operations that read, then write, the shard's
+	// records. It's held in addition to the store's read lock.
+	write sync.Mutex
+
+	// mu guards records.
+	mu      sync.RWMutex
+	records map[string]Record
+}
+
+func newRecordTable() *recordTable {
+	t := &recordTable{}
+	for i := range t.shards {
+		t.shards[i].records = make(map[string]Record)
+	}
+	return t
+}
+
+// shard returns the shard the named record is stored in.
+func (t *recordTable) shard(name string) *recordShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return &t.shards[h.Sum32()%recordShards]
+}
+
+func (t *recordTable) get(name string) (Record, bool) {
+	sh := t.shard(name)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	r, ok := sh.records[name]
+	return r, ok
+}
+
+func (t *recordTable) put(r Record) {
+	sh := t.shard(r.Name)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	sh.records[r.Name] = r
+}
+
+func (t *recordTable) remove(name string) {
+	sh := t.shard(name)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	delete(sh.records, name)
+}
+
+func (t *recordTable) len() int {
+	n := 0
+	for i := range t.shards {
+		sh := &t.shards[i]
+		sh.mu.RLock()
+		n += len(sh.records)
+		sh.mu.RUnlock()
+	}
+	return n
+}
+
+// list returns every record. Shards are read one at a time, so records
+// written while they're listed may or may not be returned.
+func (t *recordTable) list() []Record {
+	out := make([]Record, 0, t.len())
+	for i := range t.shards {
+		sh := &t.shards[i]
+		sh.mu.RLock()
+		for _, r := range sh.records {
+			out = append(out, r)
+		}
+		sh.mu.RUnlock()
+	}
+	return out
+}
+
+// all returns every record, keyed by name.
+func (t *recordTable) all() map[string]Record {
+	out := make(map[string]Record, t.len())
+	for _, r := range t.list() {
+		out[r.Name] = r
+	}
+	return out
+}
+
+// replace replaces every record with the supplied records.
+func (t *recordTable) replace(records map[string]Record) {
+	for i := range t.shards {
+		sh := &t.shards[i]
+		sh.mu.Lock()
+		sh.records = make(map[string]Record)
+		sh.mu.Unlock()
+	}
+	for name, r := range records {
+		sh := t.shard(name)
+		sh.mu.Lock()
+		sh.records[name] = r
+		sh.mu.Unlock()
+	}
+}
+
+// lockRecord locks the named record for an operation that reads, then writes
+// it, and returns a function that unlocks it. Such operations hold the
+// store's read lock and the lock of the record's shard, so operations on
+// records in different shards proceed concurrently, while operations on the
+// whole store, which hold its write lock, exclude them all. A persisted store
+// rewrites everything it stores whenever it's written, so operations on its
+// records hold its write lock instead.
+func (s *Store) lockRecord(name string) (unlock func()) {
+	s.mu.RLock()
+	if s.persist != nil {
+		s.mu.RUnlock()
+		s.mu.Lock()
+		return s.mu.Unlock
+	}
+	sh := s.records.shard(name)
+	sh.write.Lock()
+	return func() {
+		sh.write.Unlock()
+		s.mu.RUnlock()
+	}
+}
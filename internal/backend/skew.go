@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"time"
+)
+
+// SetClockSkew simulates a backend whose clock differs from its clients'.
+// Once set, the timestamps of records returned to clients, i.e. when each
+// record and each of its revisions was written, are offset by the supplied
+// duration: positive durations put the backend's clock ahead of its
+// clients', and negative ones behind. Records are stored with their true
+// timestamps, so that records expire, are torn down and drift when they
+// should. A duration of zero stops the store skewing timestamps. Each of
+// the store's regions skews timestamps the same way.
+func (s *Store) SetClockSkew(d time.Duration) {
+	defer s.eachRegion(func(p *Store) { p.SetClockSkew(d) })
+	s.clockSkew.Store(int64(d))
+}
+
+// skew returns the supplied record with its timestamps offset by the store's
+// clock skew, if any.
+func (s *Store) skew(r Record) Record {
+	d := time.Duration(s.clockSkew.Load())
+	if d == 0 {
+		return r
+	}
+	if !r.LastModified.IsZero() {
+		r.LastModified = r.LastModified.Add(d)
+	}
+	for i := range r.History {
+		r.History[i].AppliedAt = r.History[i].AppliedAt.Add(d)
+	}
+	return r
+}
+
+// skewed adapts a store method that returns a record such that the record it
+// returns is skewed per Store.SetClockSkew.
+func skewed[Req any](fn func(*Store, context.Context, Req) (Record, error)) func(*Store, context.Context, Req) (Record, error) {
+	return func(s *Store, ctx context.Context, req Req) (Record, error) {
+		r, err := fn(s, ctx, req)
+		if err != nil {
+			return r, err
+		}
+		return s.skew(r), nil
+	}
+}
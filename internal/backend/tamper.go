@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TagValueCorrupted is the value of every key of the bork and data values of
+// a corrupted record.
+const TagValueCorrupted = "corrupted-"
+
+// TierCorrupted is the tier of a corrupted record. It isn't a tier the
+// backend offers.
+const TierCorrupted = "CORRUPTED"
+
+// A RecordMutation changes a record out-of-band, as someone other than its
+// owner would. Its values are merged into the record's per MergeData, so keys
+// whose value is empty are removed.
+type RecordMutation struct {
+	BorkValue map[string]string `json:"borkValue,omitempty"`
+	DataValue map[string]string `json:"dataValue,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+// MutateRecord applies the supplied mutation to the named record, assigning
+// it a new revision just as the drifter does. Unlike Update it doesn't
+// require the record to be ACTIVE, or change its state.
+func (s *Store) MutateRecord(name string, m RecordMutation) (Record, error) {
+	return s.tamper(name, func(r Record) Record {
+		if m.BorkValue != nil {
+			r.BorkValue = MergeData(r.BorkValue, m.BorkValue)
+		}
+		if m.DataValue != nil {
+			r.DataValue = MergeData(r.DataValue, m.DataValue)
+		}
+		if m.Tags != nil {
+			r.Tags = MergeData(r.Tags, m.Tags)
+		}
+		return r
+	})
+}
+
+// A CorruptionMode determines how a record is corrupted.
+type CorruptionMode string
+
+// Corruption modes.
+const (
+	// CorruptionGarbage replaces the values of the keys of the record's
+	// bork and data values with garbage, clears its ARN and sets its tier to
+	// TierCorrupted. It is the default.
+	CorruptionGarbage CorruptionMode = "Garbage"
+
+	// CorruptionMissingFields clears the record's bork and data values,
+	// region, tier and ARN, as if they were lost.
+	CorruptionMissingFields CorruptionMode = "MissingFields"
+
+	// CorruptionWrongTypes leaves the stored record intact, but serves it
+	// with fields of the wrong types until it's next written, so that
+	// clients can't decode it when they get it.
+	CorruptionWrongTypes CorruptionMode = "WrongTypes"
+)
+
+// CorruptionModes are the ways a record can be corrupted.
+var CorruptionModes = []CorruptionMode{CorruptionGarbage, CorruptionMissingFields, CorruptionWrongTypes}
+
+const errUnknownCorruptionFmt = "unknown corruption mode %q; modes are %v"
+
+// CorruptRecord corrupts the named record per the supplied mode, which
+// defaults to CorruptionGarbage, assigning it a new revision.
+func (s *Store) CorruptRecord(name string, mode CorruptionMode) (Record, error) {
+	switch mode {
+	case "", CorruptionGarbage:
+		return s.tamper(name, func(r Record) Record {
+			for _, v := range []map[string]string{r.BorkValue, r.DataValue} {
+				for k := range v {
+					v[k] = TagValueCorrupted + strconv.FormatInt(r.Revision, 36)
+				}
+			}
+			r.ARN = ""
+			r.Tier = TierCorrupted
+			return r
+		})
+	case CorruptionMissingFields:
+		return s.tamper(name, func(r Record) Record {
+			r.BorkValue, r.DataValue = nil, nil
+			r.Region, r.Tier, r.ARN = "", "", ""
+			return r
+		})
+	case CorruptionWrongTypes:
+		r, err := s.tamper(name, func(r Record) Record { return r })
+		if err != nil {
+			return Record{}, err
+		}
+		s.mu.Lock()
+		s.malformed[name] = r.Revision
+		s.mu.Unlock()
+		return r, nil
+	default:
+		return Record{}, badRequest{errors.Errorf(errUnknownCorruptionFmt, mode, CorruptionModes)}
+	}
+}
+
+// malform returns the supplied encoded response to a request to get the named
+// record. It's encoded with fields of the wrong types if the record was
+// corrupted by CorruptionWrongTypes and hasn't been written since.
+func (s *Store) malform(c Codec, name string, resp []byte) ([]byte, error) {
+	s.mu.RLock()
+	rev, ok := s.malformed[name]
+	s.mu.RUnlock()
+	if !ok {
+		return resp, nil
+	}
+
+	m := map[string]any{}
+	if err := c.Unmarshal(resp, &m); err != nil {
+		return nil, internal{errors.Wrap(err, errEncodeResponse)}
+	}
+	r := Record{}
+	if err := c.Unmarshal(resp, &r); err != nil {
+		return nil, internal{errors.Wrap(err, errEncodeResponse)}
+	}
+	if r.Revision != rev {
+		s.mu.Lock()
+		delete(s.malformed, name)
+		s.mu.Unlock()
+		return resp, nil
+	}
+	m["BorkValue"] = TierCorrupted
+	m["Revision"] = TagValueCorrupted + strconv.FormatInt(r.Revision, 36)
+	m["Tags"] = []int64{r.Revision}
+	b, err := c.Marshal(m)
+	if err != nil {
+		return nil, internal{errors.Wrap(err, errEncodeResponse)}
+	}
+	return b, nil
+}
+
+// tamper rewrites the named record using the supplied function, which is
+// passed a copy of the record.
+func (s *Store) tamper(name string, fn func(Record) Record) (Record, error) {
+	unlock := s.lockRecord(name)
+	defer unlock()
+
+	r, ok := s.records.get(name)
+	if !ok {
+		return Record{}, notFound{errors.Errorf(errNotFoundFmt, name)}
+	}
+	return copyRecord(s.rewrite(fn(copyRecord(r)), time.Now())), nil
+}
+
+// rewrite stores the supplied record as if it were written by someone other
+// than its owner, assigning it a new revision and generation, and returns it.
+// The caller must hold the record's lock, or the store's write lock.
+func (s *Store) rewrite(r Record, now time.Time) Record {
+	r.Revision = s.revision.Add(1)
+	r.Generation++
+	r.LastModified = now.UTC()
+	s.records.put(r)
+	s.notify(EventUpdated, KindRecord, r.Name, r.Revision)
+	return r
+}
+
+// Records returns every stored record, sorted by name, omitting those that
+// are being torn down per Names.
+func (s *Store) Records() []Record {
+	names := s.Names(KindRecord)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	records := make([]Record, 0, len(names))
+	for _, name := range names {
+		if r, ok := s.records.get(name); ok {
+			records = append(records, copyRecord(r))
+		}
+	}
+	return records
+}
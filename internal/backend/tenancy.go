@@ -0,0 +1,358 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// A TenancyMode determines how the simulated backend is partitioned between
+// tenants.
+type TenancyMode string
+
+// Tenancy modes.
+const (
+	// TenancyShared backends store the resources of every tenant in one
+	// store.
+	TenancyShared TenancyMode = "Shared"
+
+	// TenancyNamespace backends store the resources of each namespace in a
+	// store of its own, with its own account, throttle and drift. Cluster
+	// scoped callers use the shared store.
+	TenancyNamespace TenancyMode = "Namespace"
+)
+
+// TenancyModes are the supported tenancy modes.
+var TenancyModes = []TenancyMode{TenancyShared, TenancyNamespace}
+
+// Tenants partition the simulated backend between tenants. Each tenant is a
+// namespace, whose resources are stored in a store of its own when the
+// tenancy mode is TenancyNamespace, so that one tenant's drift, throttling or
+// failures don't affect another's resources. Otherwise every tenant uses the
+// shared store.
+type Tenants struct {
+	shared *Store
+
+	mu     sync.RWMutex
+	mode   TenancyMode
+	stores map[string]*Store
+
+	// Every store is throttled to the same rate, but each has a limiter of
+	// its own.
+	ratePerSecond float64
+	burst         int
+
+	// Every store hangs the same operations for the same duration.
+	hang       time.Duration
+	operations []string
+
+	// Every store fails the same operations the same way.
+	opFault *OperationFault
+
+	// Every store handles duplicate creates the same way.
+	duplicates DuplicateCreatePolicy
+
+	// Every store handles unknown fields the same way.
+	unknownFields UnknownFieldsMode
+
+	// Every store fails transactions the same way.
+	txFault *TransactionFault
+
+	// Every store pages lists the same way.
+	pageSize      int
+	inconsistency float64
+
+	// Every store serves the same API version.
+	apiVersion string
+
+	// Every store's clock is skewed the same way.
+	clockSkew time.Duration
+
+	// Every store's regions are unhealthy at the same time.
+	unhealthy map[string]bool
+}
+
+// DefaultTenants partition the simulated backend shared by all of the
+// provider's controllers. They share Default until their tenancy mode is set.
+var DefaultTenants = NewTenants(Default)
+
+// NewTenants returns tenants that share the supplied store.
+func NewTenants(shared *Store) *Tenants {
+	return &Tenants{shared: shared, mode: TenancyShared, stores: make(map[string]*Store), unhealthy: make(map[string]bool)}
+}
+
+// SetMode sets the tenancy mode. It should be set before any tenant's store
+// is used; changing it doesn't move resources between stores.
+func (t *Tenants) SetMode(m TenancyMode) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.mode = m
+}
+
+// Isolated returns true if each tenant has a store of its own.
+func (t *Tenants) Isolated() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.mode == TenancyNamespace
+}
+
+// Tenant returns the tenant that the supplied namespace belongs to. It's the
+// namespace when tenants are isolated, and otherwise "", the shared tenant.
+func (t *Tenants) Tenant(namespace string) string {
+	if !t.Isolated() {
+		return ""
+	}
+	return namespace
+}
+
+// For returns the store of the tenant the supplied namespace belongs to,
+// creating it if needed. The store of the "" namespace is the shared store.
+func (t *Tenants) For(namespace string) *Store {
+	tenant := t.Tenant(namespace)
+	if tenant == "" {
+		return t.shared
+	}
+
+	t.mu.RLock()
+	s, ok := t.stores[tenant]
+	t.mu.RUnlock()
+	if ok {
+		return s
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s, ok := t.stores[tenant]; ok {
+		return s
+	}
+	s = NewStore()
+	s.SetThrottle(t.ratePerSecond, t.burst)
+	s.SetHang(t.hang, t.operations...)
+	s.SetOperationFault(t.opFault)
+	s.SetDuplicateCreatePolicy(t.duplicates)
+	s.SetUnknownFieldsMode(t.unknownFields)
+	s.SetTransactionFault(t.txFault)
+	s.SetListPaging(t.pageSize, t.inconsistency)
+	s.SetAPIVersion(t.apiVersion)
+	s.SetClockSkew(t.clockSkew)
+	for region := range t.unhealthy {
+		s.SetRegionHealthy(region, false)
+	}
+	t.stores[tenant] = s
+	return s
+}
+
+// SetThrottle throttles the store of every tenant, including those that are
+// yet to be created, per Store.SetThrottle.
+func (t *Tenants) SetThrottle(ratePerSecond float64, burst int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.ratePerSecond, t.burst = ratePerSecond, burst
+	t.shared.SetThrottle(ratePerSecond, burst)
+	for _, s := range t.stores {
+		s.SetThrottle(ratePerSecond, burst)
+	}
+}
+
+// SetListPaging makes the store of every tenant page lists the same way,
+// including those that are yet to be created, per Store.SetListPaging.
+func (t *Tenants) SetListPaging(size int, inconsistency float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pageSize, t.inconsistency = size, inconsistency
+	t.shared.SetListPaging(size, inconsistency)
+	for _, s := range t.stores {
+		s.SetListPaging(size, inconsistency)
+	}
+}
+
+// SetAPIVersion makes the store of every tenant serve the same API version,
+// including those that are yet to be created, per Store.SetAPIVersion.
+func (t *Tenants) SetAPIVersion(v string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.apiVersion = v
+	t.shared.SetAPIVersion(v)
+	for _, s := range t.stores {
+		s.SetAPIVersion(v)
+	}
+}
+
+// SetClockSkew skews the clock of the store of every tenant, including those
+// that are yet to be created, per Store.SetClockSkew.
+func (t *Tenants) SetClockSkew(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.clockSkew = d
+	t.shared.SetClockSkew(d)
+	for _, s := range t.stores {
+		s.SetClockSkew(d)
+	}
+}
+
+// SetHang makes the store of every tenant hang, including those that are yet
+// to be created, per Store.SetHang.
+func (t *Tenants) SetHang(d time.Duration, operations ...string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.hang, t.operations = d, operations
+	t.shared.SetHang(d, operations...)
+	for _, s := range t.stores {
+		s.SetHang(d, operations...)
+	}
+}
+
+// SetOperationFault makes the store of every tenant fail operations,
+// including those that are yet to be created, per Store.SetOperationFault.
+func (t *Tenants) SetOperationFault(f *OperationFault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.opFault = f
+	t.shared.SetOperationFault(f)
+	for _, s := range t.stores {
+		s.SetOperationFault(f)
+	}
+}
+
+// DriftRecords drifts the records of the store of every tenant per
+// Store.DriftRecords. It returns how many records drifted.
+func (t *Tenants) DriftRecords() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	n := t.shared.DriftRecords()
+	for _, s := range t.stores {
+		n += s.DriftRecords()
+	}
+	return n
+}
+
+// SetDuplicateCreatePolicy sets the duplicate create policy of the store of
+// every tenant, including those that are yet to be created, per
+// Store.SetDuplicateCreatePolicy.
+func (t *Tenants) SetDuplicateCreatePolicy(p DuplicateCreatePolicy) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.duplicates = p
+	t.shared.SetDuplicateCreatePolicy(p)
+	for _, s := range t.stores {
+		s.SetDuplicateCreatePolicy(p)
+	}
+}
+
+// SetUnknownFieldsMode sets the unknown fields mode of the store of every
+// tenant, including those that are yet to be created, per
+// Store.SetUnknownFieldsMode.
+func (t *Tenants) SetUnknownFieldsMode(m UnknownFieldsMode) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.unknownFields = m
+	t.shared.SetUnknownFieldsMode(m)
+	for _, s := range t.stores {
+		s.SetUnknownFieldsMode(m)
+	}
+}
+
+// SetTransactionFault makes the store of every tenant, including those that
+// are yet to be created, fail transactions per Store.SetTransactionFault.
+// Each store counts the transactions it fails separately.
+func (t *Tenants) SetTransactionFault(f *TransactionFault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.txFault = f
+	t.shared.SetTransactionFault(f)
+	for _, s := range t.stores {
+		s.SetTransactionFault(f)
+	}
+}
+
+// SetRegionHealthy marks the named region of the store of every tenant,
+// including those that are yet to be created, healthy or unhealthy per
+// Store.SetRegionHealthy.
+func (t *Tenants) SetRegionHealthy(region string, healthy bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if healthy {
+		delete(t.unhealthy, region)
+	} else {
+		t.unhealthy[region] = true
+	}
+	t.shared.SetRegionHealthy(region, healthy)
+	for _, s := range t.stores {
+		s.SetRegionHealthy(region, healthy)
+	}
+}
+
+// UnhealthyRegions returns the regions that are unhealthy, sorted by name.
+func (t *Tenants) UnhealthyRegions() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	regions := make([]string, 0, len(t.unhealthy))
+	for region := range t.unhealthy {
+		regions = append(regions, region)
+	}
+	slices.Sort(regions)
+	return regions
+}
+
+// Tenants returns every tenant that has a store, sorted, including the shared
+// tenant "".
+func (t *Tenants) Tenants() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	tenants := append(make([]string, 0, len(t.stores)+1), "")
+	for tenant := range t.stores {
+		tenants = append(tenants, tenant)
+	}
+	slices.Sort(tenants)
+	return tenants
+}
+
+// List returns the page of the names of the stored resources of the supplied
+// kind in the store of the supplied tenant that starts at the supplied page
+// token, per Store.List, and the token of the next page.
+func (t *Tenants) List(ctx context.Context, tenant, kind, pageToken string) ([]string, string, error) {
+	s := t.shared
+	if tenant != "" {
+		t.mu.RLock()
+		ts, ok := t.stores[tenant]
+		t.mu.RUnlock()
+		if !ok {
+			return nil, "", nil
+		}
+		s = ts
+	}
+	p, err := s.List(ctx, ListRequest{Kind: kind, PageToken: pageToken})
+	return p.Names, p.NextPageToken, err
+}
+
+// Remove deletes the named stored resource of the supplied kind from the store
+// of the supplied tenant, per Store.Remove.
+func (t *Tenants) Remove(ctx context.Context, tenant, kind, name string) error {
+	if tenant == "" {
+		return t.shared.Remove(ctx, kind, name)
+	}
+	t.mu.RLock()
+	s, ok := t.stores[tenant]
+	t.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	return s.Remove(ctx, kind, name)
+}
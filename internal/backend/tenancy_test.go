@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestTenants(t *testing.T) {
+	type want struct {
+		tenants []string
+		records map[string][]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mode   TenancyMode
+		// namespaces each create a record named bork-<namespace>, or bork
+		// if it's cluster scoped.
+		namespaces []string
+		want       want
+	}{
+		"Shared": {
+			reason:     "Every namespace should share one store when tenants aren't isolated.",
+			mode:       TenancyShared,
+			namespaces: []string{"a", "b"},
+			want: want{
+				tenants: []string{""},
+				records: map[string][]string{"": {"bork-a", "bork-b"}},
+			},
+		},
+		"Namespace": {
+			reason:     "Each namespace should have a store of its own when tenants are isolated by namespace.",
+			mode:       TenancyNamespace,
+			namespaces: []string{"a", "b"},
+			want: want{
+				tenants: []string{"", "a", "b"},
+				records: map[string][]string{"": nil, "a": {"bork-a"}, "b": {"bork-b"}},
+			},
+		},
+		"NamespaceClusterScoped": {
+			reason:     "Cluster scoped callers should use the shared store when tenants are isolated by namespace.",
+			mode:       TenancyNamespace,
+			namespaces: []string{"", "a"},
+			want: want{
+				tenants: []string{"", "a"},
+				records: map[string][]string{"": {"bork"}, "a": {"bork-a"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			tn := NewTenants(NewStore())
+			tn.SetMode(tc.mode)
+
+			for _, ns := range tc.namespaces {
+				name := "bork"
+				if ns != "" {
+					name += "-" + ns
+				}
+				if _, err := tn.For(ns).Create(ctx, Record{Name: name, BorkValue: map[string]string{"bork": "1"}}); err != nil {
+					t.Fatalf("Create(...): %v", err)
+				}
+				if tn.For(ns) != tn.For(ns) {
+					t.Errorf("\n%s\nFor(%q): returned a different store each time", tc.reason, ns)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.tenants, tn.Tenants()); diff != "" {
+				t.Errorf("\n%s\nTenants(): -want, +got:\n%s", tc.reason, diff)
+			}
+			got := map[string][]string{}
+			for _, tenant := range tn.Tenants() {
+				names, _, err := tn.List(ctx, tenant, KindRecord, "")
+				if err != nil {
+					t.Fatalf("List(%q): %v", tenant, err)
+				}
+				got[tenant] = names
+			}
+			if diff := cmp.Diff(tc.want.records, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nList(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTenantsSettings(t *testing.T) {
+	const version = "2024-01-01"
+
+	cases := map[string]struct {
+		reason string
+		// existing namespaces have a store before the settings are made.
+		existing []string
+		// later namespaces have a store created after the settings are
+		// made.
+		later []string
+	}{
+		"ExistingStores": {
+			reason:   "Settings should apply to the stores tenants already have.",
+			existing: []string{"", "a"},
+		},
+		"LaterStores": {
+			reason: "Settings should apply to the stores of tenants that are yet to be created.",
+			later:  []string{"", "b"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			tn := NewTenants(NewStore())
+			tn.SetMode(TenancyNamespace)
+			for _, ns := range tc.existing {
+				tn.For(ns)
+			}
+
+			tn.SetAPIVersion(version)
+			tn.SetDuplicateCreatePolicy(DuplicateCreateSucceed)
+			tn.SetRegionHealthy(DefaultRegion, false)
+
+			for _, ns := range append(tc.existing, tc.later...) {
+				s := tn.For(ns)
+				if diff := cmp.Diff(version, s.APIVersion()); diff != "" {
+					t.Errorf("\n%s\nFor(%q).APIVersion(): -want, +got:\n%s", tc.reason, ns, diff)
+				}
+				r := Record{Name: "bork", BorkValue: map[string]string{"bork": "1"}}
+				if _, err := s.Create(ctx, r); err != nil {
+					t.Fatalf("Create(...): %v", err)
+				}
+				if _, err := s.Create(ctx, r); err != nil {
+					t.Errorf("\n%s\nFor(%q).Create(...): got error %v creating a duplicate, want none", tc.reason, ns, err)
+				}
+				c, err := s.Region(DefaultRegion).Connect()
+				if err != nil {
+					t.Fatalf("Connect(): %v", err)
+				}
+				if _, err := c.Get(ctx, "bork"); !IsUnavailable(err) {
+					t.Errorf("\n%s\nFor(%q).Region(%q).Get(...): got error %v, want an unavailable region", tc.reason, ns, DefaultRegion, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+const errThrottledFmt = "bork API is throttling %s requests: retry after %s"
+
+// HeaderRetryAfter is the HTTP header, and gRPC trailer, in which a throttled
+// request is told how many seconds to wait before it's retried.
+const HeaderRetryAfter = "Retry-After"
+
+// SetThrottle simulates an API that throttles its clients. Once set, the store
+// performs at most ratePerSecond operations per second, in bursts of at most
+// burst operations. Operations in excess of the rate fail with an error that
+// satisfies IsThrottled, hinting how long the client should wait before it
+// retries. A rate of zero or less stops throttling.
+//
+// Only operations are throttled. Negotiating a content type and watching the
+// store are not. Each of the store's regions is throttled to the same rate.
+func (s *Store) SetThrottle(ratePerSecond float64, burst int) {
+	defer s.eachRegion(func(p *Store) { p.SetThrottle(ratePerSecond, burst) })
+	if ratePerSecond <= 0 {
+		s.limiter.Store(nil)
+		return
+	}
+	s.limiter.Store(rate.NewLimiter(rate.Limit(ratePerSecond), max(burst, 1)))
+}
+
+// throttle returns an error if the store is throttling operations, and the
+// named operation exceeds its rate.
+func (s *Store) throttle(op string) error {
+	l := s.limiter.Load()
+	if l == nil {
+		return nil
+	}
+	r := l.Reserve()
+	d := r.Delay()
+	if d == 0 {
+		return nil
+	}
+	// Don't consume a token we weren't allowed to use.
+	r.Cancel()
+	return throttled{error: errors.Errorf(errThrottledFmt, op, d.Round(time.Millisecond)), retryAfter: d}
+}
+
+type throttled struct {
+	error
+	retryAfter time.Duration
+}
+
+func (t throttled) RetryAfter() time.Duration { return t.retryAfter }
+
+// IsThrottled returns true if the supplied error indicates the backend
+// throttled a request.
+func IsThrottled(err error) bool {
+	var t interface{ RetryAfter() time.Duration }
+	return errors.As(err, &t)
+}
+
+// RetryAfter returns how long the backend asked the client to wait before
+// retrying the throttled request that returned the supplied error. It returns
+// zero if the error does not indicate the request was throttled.
+func RetryAfter(err error) time.Duration {
+	var t interface{ RetryAfter() time.Duration }
+	if !errors.As(err, &t) {
+		return 0
+	}
+	return t.RetryAfter()
+}
+
+// formatRetryAfter formats the supplied delay as a whole number of seconds,
+// rounded up so that a client that honours it won't be throttled again.
+func formatRetryAfter(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
+
+// parseRetryAfter parses a delay formatted by formatRetryAfter. It returns
+// zero if the delay can't be parsed.
+func parseRetryAfter(v string) time.Duration {
+	s, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || s < 0 {
+		return 0
+	}
+	return time.Duration(s) * time.Second
+}
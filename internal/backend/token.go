@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	errInvalidToken    = "invalid or missing bearer token"
+	errTokenExpiredFmt = "bearer token expired at %s"
+	errUnknownToken    = "bearer token was not issued by this backend"
+	errInvalidTTLFmt   = "token TTL must be positive, got %s"
+)
+
+// issuedTokenPrefix prefixes the value of every token the store issues.
+const issuedTokenPrefix = "bork-expiring-"
+
+// A TokenRequest asks the backend to issue a token.
+type TokenRequest struct {
+	// TTL is how long the token is valid.
+	TTL time.Duration `json:"ttl"`
+}
+
+// A Token is a bearer token issued by the backend. It's accepted until it
+// expires, after which calls that present it fail with an error that
+// satisfies IsCredentialsExpired.
+type Token struct {
+	Value     string    `json:"value"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// IssueToken issues a token that expires after the requested TTL. Tokens that
+// expired are forgotten when a new one is issued.
+func (s *Store) IssueToken(_ context.Context, req TokenRequest) (Token, error) {
+	if req.TTL <= 0 {
+		return Token{}, badRequest{errors.Errorf(errInvalidTTLFmt, req.TTL)}
+	}
+
+	now := time.Now()
+	t := Token{Value: issuedTokenPrefix + uuid.NewString(), ExpiresAt: now.Add(req.TTL)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for v, exp := range s.tokens {
+		if now.After(exp) {
+			delete(s.tokens, v)
+		}
+	}
+	s.tokens[t.Value] = t.ExpiresAt
+	return t, nil
+}
+
+// authenticate returns an error unless a caller that presents the supplied
+// bearer token may call the store. Tokens issued by the store are accepted
+// until they expire, whichever token the server wants. A token that looks
+// like one the store issued but that it doesn't know of, e.g. because the
+// server restarted, is treated as expired so that its client gets a new one.
+// Other tokens must be the token the server wants, unless it wants none.
+func (s *Store) authenticate(presented, want string) error {
+	if strings.HasPrefix(presented, issuedTokenPrefix) {
+		s.mu.RLock()
+		exp, ok := s.tokens[presented]
+		s.mu.RUnlock()
+		switch {
+		case !ok:
+			return credentialsExpired{errors.New(errUnknownToken)}
+		case time.Now().After(exp):
+			return credentialsExpired{errors.Errorf(errTokenExpiredFmt, exp.UTC().Format(time.RFC3339))}
+		}
+		return nil
+	}
+	if want == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(want)) == 1 {
+		return nil
+	}
+	return unauthorized{errors.New(errInvalidToken)}
+}
+
+// bearer returns the token presented by the supplied Authorization header or
+// metadata value.
+func bearer(authorization string) string {
+	return strings.TrimPrefix(authorization, "Bearer ")
+}
+
+type credentialsExpired struct{ error }
+
+func (credentialsExpired) Unauthorized() bool       { return true }
+func (credentialsExpired) CredentialsExpired() bool { return true }
+
+// IsCredentialsExpired returns true if the supplied error indicates the
+// backend rejected a client's credentials because they expired. Such errors
+// also satisfy IsUnauthorized.
+func IsCredentialsExpired(err error) bool {
+	var e interface{ CredentialsExpired() bool }
+	return errors.As(err, &e) && e.CredentialsExpired()
+}
+
+// Bearer challenges sent with HTTP responses and gRPC trailers that reject a
+// call's credentials, per RFC 6750. A client that is sent the expired
+// challenge knows it can fix the call by getting a new token.
+const (
+	HeaderWWWAuthenticate = "WWW-Authenticate"
+
+	challengeInvalid = `Bearer`
+	challengeExpired = `Bearer error="invalid_token", error_description="token expired"`
+)
+
+// challenge returns the bearer challenge that describes the supplied
+// authentication error.
+func challenge(err error) string {
+	if IsCredentialsExpired(err) {
+		return challengeExpired
+	}
+	return challengeInvalid
+}
+
+// fromChallenge returns an error that satisfies IsUnauthorized, and
+// IsCredentialsExpired if the supplied challenge says the credentials
+// expired.
+func fromChallenge(c, msg string) error {
+	if c == challengeExpired {
+		return credentialsExpired{errors.Errorf(errUnauthorized, msg)}
+	}
+	return unauthorized{errors.Errorf(errUnauthorized, msg)}
+}
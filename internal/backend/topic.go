@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const errTopicPartitionsFmt = "topic %q must have at least one partition, not %d"
+
+// A Topic is a message topic that consumers connect to using credentials that
+// the backend rotates. It issues the topic a new password every RotateEvery
+// times the topic is read, modelling credentials that are rotated out from
+// under the provider, which must publish them again.
+type Topic struct {
+	// Name uniquely identifies the topic within the backend. It is assigned
+	// by the backend when the topic is created.
+	Name string
+
+	// Partitions the topic's messages are spread across.
+	Partitions int
+
+	// RetentionHours is how long the topic keeps messages.
+	RetentionHours int
+
+	// Tags attached to the topic.
+	Tags map[string]string
+
+	// RotateEvery is how many times the topic may be read before the backend
+	// rotates its password. Zero disables rotation.
+	RotateEvery int
+
+	// Endpoint consumers connect to. It is assigned by the backend when the
+	// topic is created.
+	Endpoint string
+
+	// Username consumers authenticate as. It is assigned by the backend when
+	// the topic is created.
+	Username string
+
+	// Password consumers authenticate with. It is generated by the backend
+	// when the topic is created, and every time it's rotated.
+	Password string
+
+	// CredentialsVersion is how many times the topic's password was rotated.
+	CredentialsVersion int64
+
+	// RotatedAt is when the topic's password was last rotated, or when the
+	// topic was created if it never was.
+	RotatedAt time.Time
+
+	// Revision is assigned by the backend every time the topic is written,
+	// including when its password is rotated.
+	Revision int64
+}
+
+// GetTopic returns the named topic. Reading a topic counts towards the
+// rotation of its password; the read that reaches the topic's RotateEvery
+// returns the new password.
+func (s *Store) GetTopic(_ context.Context, name string) (Topic, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.topics[name]
+	if !ok {
+		return Topic{}, notFound{errors.Errorf(errTopicNotFoundFmt, name)}
+	}
+	s.topicReads[name]++
+	if t.RotateEvery > 0 && s.topicReads[name] >= t.RotateEvery {
+		t.Revision = s.revision.Add(1)
+		t.Password = password()
+		t.CredentialsVersion++
+		t.RotatedAt = time.Now()
+		s.topics[name] = t
+		s.topicReads[name] = 0
+		s.notify(EventUpdated, KindTopic, name, t.Revision)
+	}
+	return copyTopic(t), nil
+}
+
+// CreateTopic stores the supplied topic, assigning it a new revision, an
+// endpoint and credentials. If the topic has no name the backend generates a
+// unique one. It returns an error if a topic with the same name already
+// exists.
+func (s *Store) CreateTopic(_ context.Context, t Topic) (Topic, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t.Partitions < 1 {
+		return Topic{}, badRequest{errors.Errorf(errTopicPartitionsFmt, t.Name, t.Partitions)}
+	}
+	if t.Name == "" {
+		t.Name = generateName("topic")
+	}
+	if existing, ok := s.topics[t.Name]; ok {
+		return duplicate(s, copyTopic(existing), alreadyExists{errors.Errorf(errTopicAlreadyExistsFmt, t.Name)})
+	}
+	t.Revision = s.revision.Add(1)
+	t.Endpoint = t.Name + ".topic.bork.local:9092"
+	t.Username = t.Name
+	t.Password = password()
+	t.CredentialsVersion = 0
+	t.RotatedAt = time.Now()
+	s.topics[t.Name] = copyTopic(t)
+	s.topicReads[t.Name] = 0
+	s.notify(EventCreated, KindTopic, t.Name, t.Revision)
+	return t, nil
+}
+
+// UpdateTopic overwrites the partitions, retention, tags and rotation of the
+// supplied topic, assigning it a new revision. Its endpoint and credentials
+// are unchanged. It returns an error if the topic does not exist.
+func (s *Store) UpdateTopic(_ context.Context, t Topic) (Topic, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.topics[t.Name]
+	if !ok {
+		return Topic{}, notFound{errors.Errorf(errTopicNotFoundFmt, t.Name)}
+	}
+	if t.Partitions < 1 {
+		return Topic{}, badRequest{errors.Errorf(errTopicPartitionsFmt, t.Name, t.Partitions)}
+	}
+	existing.Revision = s.revision.Add(1)
+	existing.Partitions = t.Partitions
+	existing.RetentionHours = t.RetentionHours
+	existing.Tags = copyTags(t.Tags)
+	existing.RotateEvery = t.RotateEvery
+	s.topics[t.Name] = existing
+	s.notify(EventUpdated, KindTopic, t.Name, existing.Revision)
+	return copyTopic(existing), nil
+}
+
+// DeleteTopic removes the named topic. Deleting a topic that does not exist
+// is not an error.
+func (s *Store) DeleteTopic(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.topics[name]; !ok {
+		return nil
+	}
+	delete(s.topics, name)
+	delete(s.topicReads, name)
+	s.notify(EventDeleted, KindTopic, name, 0)
+	return nil
+}
+
+// copyTopic ensures callers never share a Tags map with the store.
+func copyTopic(t Topic) Topic {
+	t.Tags = copyTags(t.Tags)
+	return t
+}
+
+// password returns a random password.
+func password() string {
+	b := make([]byte, 18)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
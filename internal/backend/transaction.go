@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errTransactionDuplicateFmt = "transaction %q creates bork record %q more than once"
+	errTransactionAbortedFmt   = "transaction %q aborted after staging %d of its %d records"
+	errTransactionLostFmt      = "transaction %q committed, but its response was lost"
+)
+
+// A Transaction creates records all together, or not at all.
+type Transaction struct {
+	// ID identifies the transaction. Committing a transaction with the ID of
+	// one that was already committed returns the records it created rather
+	// than creating them again, so a transaction that may or may not have
+	// been committed can safely be retried. Transactions without an ID are
+	// never deduplicated.
+	ID string `json:"id,omitempty"`
+
+	// Records the transaction creates.
+	Records []Record `json:"records"`
+}
+
+// A TransactionFaultMode determines how an injected fault fails a
+// transaction.
+type TransactionFaultMode string
+
+// Transaction fault modes.
+const (
+	// TransactionFaultAbort fails a transaction partway through staging its
+	// records, with an error that satisfies IsUnavailable. None of its
+	// records are created.
+	TransactionFaultAbort TransactionFaultMode = "Abort"
+
+	// TransactionFaultLoseResponse commits a transaction, then fails it with
+	// an error that satisfies IsTimeout, as if its response were lost. All of
+	// its records are created.
+	TransactionFaultLoseResponse TransactionFaultMode = "LoseResponse"
+)
+
+// TransactionFaultModes are the supported transaction fault modes.
+var TransactionFaultModes = []TransactionFaultMode{TransactionFaultAbort, TransactionFaultLoseResponse}
+
+// A TransactionFault fails the transactions a store commits, so that how a
+// client recovers from a transaction that partially failed can be verified.
+type TransactionFault struct {
+	// Mode determines how transactions fail.
+	Mode TransactionFaultMode `json:"mode"`
+
+	// After is how many of a transaction's records are staged before an
+	// Abort fault fails it. Transactions of no more records than this
+	// aren't failed.
+	After int `json:"after,omitempty"`
+
+	// Count is how many transactions fail before the fault clears itself.
+	// Every transaction fails if it's zero.
+	Count int `json:"count,omitempty"`
+}
+
+// SetTransactionFault makes the store fail the transactions it commits per
+// the supplied fault. A nil fault clears any fault. Each of the store's
+// regions fails transactions the same way, but counts them separately.
+func (s *Store) SetTransactionFault(f *TransactionFault) {
+	defer s.eachRegion(func(r *Store) { r.SetTransactionFault(f) })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txFault = nil
+	if f != nil {
+		cp := *f
+		s.txFault = &cp
+	}
+}
+
+// TransactionFault returns the fault the store fails transactions with, if
+// any. Its count is of the transactions it has yet to fail.
+func (s *Store) TransactionFault() *TransactionFault {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.txFault == nil {
+		return nil
+	}
+	cp := *s.txFault
+	return &cp
+}
+
+// Commit creates every record of the supplied transaction, or none of them.
+// It returns an error that satisfies IsAlreadyExists, and creates nothing, if
+// any of them exists or the transaction creates a record more than once. If
+// a transaction with the same ID was already committed it returns the
+// records that transaction created, as they are now, and creates nothing.
+func (s *Store) Commit(_ context.Context, tx Transaction) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if names, ok := s.transactions[tx.ID]; ok && tx.ID != "" {
+		out := make([]Record, 0, len(names))
+		for _, name := range names {
+			if r, ok := s.records.get(name); ok {
+				out = append(out, copyRecord(r))
+			}
+		}
+		return out, nil
+	}
+
+	// Stage every record before creating any of them.
+	staged := make([]Record, len(tx.Records))
+	names := make(map[string]bool, len(tx.Records))
+	for i, r := range tx.Records {
+		if f := s.txFault; f != nil && f.Mode == TransactionFaultAbort && i == f.After {
+			s.faulted()
+			return nil, unavailable{errors.Errorf(errTransactionAbortedFmt, tx.ID, i, len(tx.Records))}
+		}
+		if r.Name == "" {
+			r.Name = generateName("bork")
+		}
+		if names[r.Name] {
+			return nil, alreadyExists{errors.Errorf(errTransactionDuplicateFmt, tx.ID, r.Name)}
+		}
+		if _, ok := s.records.get(r.Name); ok {
+			return nil, alreadyExists{errors.Errorf(errAlreadyExistsFmt, r.Name)}
+		}
+		names[r.Name] = true
+		staged[i] = r
+	}
+
+	out := make([]Record, len(staged))
+	created := make([]string, len(staged))
+	for i, r := range staged {
+		out[i] = s.create(r)
+		created[i] = r.Name
+	}
+	if tx.ID != "" {
+		s.transactions[tx.ID] = created
+		if s.persist != nil {
+			s.persist()
+		}
+	}
+
+	if f := s.txFault; f != nil && f.Mode == TransactionFaultLoseResponse {
+		s.faulted()
+		return nil, timeout{errors.Errorf(errTransactionLostFmt, tx.ID)}
+	}
+	return out, nil
+}
+
+// faulted records that the store's transaction fault failed a transaction,
+// clearing it if it has failed as many as it should. The caller must hold the
+// store's write lock.
+func (s *Store) faulted() {
+	if s.txFault.Count == 0 {
+		return
+	}
+	s.txFault.Count--
+	if s.txFault.Count == 0 {
+		s.txFault = nil
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const errLostUnknownFieldsFmt = "update of bork record %q would lose unknown fields %s; send them as they were observed"
+
+// An UnknownFieldsMode determines whether the backend attaches fields that
+// its clients don't know about to records, simulating a newer version of the
+// bork API, and what it does when an update loses them.
+type UnknownFieldsMode string
+
+// Unknown fields modes.
+const (
+	// UnknownFieldsOff attaches no unknown fields.
+	UnknownFieldsOff UnknownFieldsMode = "Off"
+
+	// UnknownFieldsAttach attaches UnknownFields to every record that's
+	// created. An update that doesn't send them back loses them, as it
+	// would with an API that replaces the whole record.
+	UnknownFieldsAttach UnknownFieldsMode = "Attach"
+
+	// UnknownFieldsStrict attaches UnknownFields like UnknownFieldsAttach,
+	// but fails an update that would lose or change them with a bad
+	// request, so that a client that clobbers them can be caught.
+	UnknownFieldsStrict UnknownFieldsMode = "Strict"
+)
+
+// UnknownFieldsModes are the supported unknown fields modes.
+var UnknownFieldsModes = []UnknownFieldsMode{UnknownFieldsOff, UnknownFieldsAttach, UnknownFieldsStrict}
+
+// UnknownFields are the fields attached to records that are created while
+// unknown fields are attached. A client that doesn't know about them must
+// send them back, unchanged, when it updates a record.
+var UnknownFields = map[string]string{
+	"lifecycle.bork.crossplane.io/retention": "30d",
+	"schema.bork.crossplane.io/revision":     "2",
+}
+
+// SetUnknownFieldsMode sets whether the store attaches UnknownFields to the
+// records it creates, and whether it fails updates that lose them. The store
+// attaches no unknown fields by default. Each of the store's regions handles
+// unknown fields the same way.
+func (s *Store) SetUnknownFieldsMode(m UnknownFieldsMode) {
+	defer s.eachRegion(func(r *Store) { r.SetUnknownFieldsMode(m) })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unknownFields = m
+}
+
+// withUnknownFields returns the supplied record, which is being created, with
+// UnknownFields attached if the store attaches them. Unknown fields the record
+// already has are kept. The caller must hold the store's read lock.
+func (s *Store) withUnknownFields(r Record) Record {
+	if s.unknownFields != UnknownFieldsAttach && s.unknownFields != UnknownFieldsStrict {
+		return r
+	}
+	fields := maps.Clone(UnknownFields)
+	maps.Copy(fields, r.UnknownFields)
+	r.UnknownFields = fields
+	return r
+}
+
+// keepsUnknownFields returns an error if the store is strict about unknown
+// fields, and the supplied update of the supplied existing record would lose
+// or change any of its unknown fields. The caller must hold the store's read
+// lock.
+func (s *Store) keepsUnknownFields(existing, r Record) error {
+	if s.unknownFields != UnknownFieldsStrict {
+		return nil
+	}
+	var lost []string
+	for k, v := range existing.UnknownFields {
+		if got, ok := r.UnknownFields[k]; !ok || got != v {
+			lost = append(lost, k)
+		}
+	}
+	if len(lost) == 0 {
+		return nil
+	}
+	slices.Sort(lost)
+	return badRequest{errors.Errorf(errLostUnknownFieldsFmt, existing.Name, strings.Join(lost, ", "))}
+}
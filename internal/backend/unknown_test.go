@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestUnknownFieldsRoundTrip(t *testing.T) {
+	// keep sends the unknown fields back as they were observed.
+	keep := func(observed map[string]string) map[string]string { return observed }
+	// drop sends no unknown fields back, as an outdated client might.
+	drop := func(map[string]string) map[string]string { return nil }
+	// change sends the unknown fields back with one of them changed.
+	change := func(observed map[string]string) map[string]string {
+		changed := maps.Clone(observed)
+		changed["schema.bork.crossplane.io/revision"] = "1"
+		return changed
+	}
+
+	type want struct {
+		code    ErrorCode
+		unknown map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mode   UnknownFieldsMode
+		send   func(observed map[string]string) map[string]string
+		want   want
+	}{
+		"Off": {
+			reason: "A store that attaches no unknown fields should return none.",
+			mode:   UnknownFieldsOff,
+			send:   keep,
+			want:   want{},
+		},
+		"AttachKept": {
+			reason: "A lenient store should preserve unknown fields a client sends back.",
+			mode:   UnknownFieldsAttach,
+			send:   keep,
+			want:   want{unknown: UnknownFields},
+		},
+		"AttachDropped": {
+			reason: "A lenient store should accept an update that loses unknown fields.",
+			mode:   UnknownFieldsAttach,
+			send:   drop,
+			want:   want{},
+		},
+		"StrictKept": {
+			reason: "A strict store should preserve unknown fields a client sends back.",
+			mode:   UnknownFieldsStrict,
+			send:   keep,
+			want:   want{unknown: UnknownFields},
+		},
+		"StrictDropped": {
+			reason: "A strict store should reject an update that loses unknown fields, and keep them.",
+			mode:   UnknownFieldsStrict,
+			send:   drop,
+			want:   want{code: ErrorCodeBadRequest, unknown: UnknownFields},
+		},
+		"StrictChanged": {
+			reason: "A strict store should reject an update that changes unknown fields, and keep them.",
+			mode:   UnknownFieldsStrict,
+			send:   change,
+			want:   want{code: ErrorCodeBadRequest, unknown: UnknownFields},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := NewStore()
+			s.SetUnknownFieldsMode(tc.mode)
+			c, err := s.Connect()
+			if err != nil {
+				t.Fatalf("Connect(): %v", err)
+			}
+
+			created, err := c.Create(ctx, Record{Name: "bork", BorkValue: map[string]string{"bork": "1"}})
+			if err != nil {
+				t.Fatalf("Create(...): %v", err)
+			}
+
+			update := created
+			update.BorkValue = map[string]string{"bork": "2"}
+			update.UnknownFields = tc.send(created.UnknownFields)
+			var code ErrorCode
+			if _, err := c.Update(ctx, update); err != nil {
+				code = Code(err)
+			}
+			if diff := cmp.Diff(tc.want.code, code); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error code, +got error code:\n%s", tc.reason, diff)
+			}
+
+			got, err := c.Get(ctx, "bork")
+			if err != nil {
+				t.Fatalf("Get(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.unknown, got.UnknownFields, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want unknown fields, +got unknown fields:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// A User is an identity consumers authenticate as using a password. Unlike a
+// key's secret the password is generated by the provider, not the backend,
+// modelling the many real APIs that require their clients to choose a user's
+// password.
+type User struct {
+	// Name uniquely identifies the user within the backend. It is assigned
+	// by the backend when the user is created.
+	Name string
+
+	// DisplayName of the user.
+	DisplayName string
+
+	// Password the user authenticates with.
+	Password string
+
+	// PasswordVersion is incremented by the backend every time the user's
+	// password changes.
+	PasswordVersion int64
+
+	// Revision is assigned by the backend every time the user is written.
+	Revision int64
+}
+
+// GetUser returns the named user.
+func (s *Store) GetUser(_ context.Context, name string) (User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[name]
+	if !ok {
+		return User{}, notFound{errors.Errorf(errUserNotFoundFmt, name)}
+	}
+	return u, nil
+}
+
+// CreateUser stores the supplied user, assigning it a new revision. If the
+// user has no name the backend generates a unique one. It returns an error if
+// a user with the same name already exists, or if the user has no password.
+func (s *Store) CreateUser(_ context.Context, u User) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if u.Name == "" {
+		u.Name = generateName("user")
+	}
+	if existing, ok := s.users[u.Name]; ok {
+		return duplicate(s, existing, alreadyExists{errors.Errorf(errUserAlreadyExistsFmt, u.Name)})
+	}
+	if u.Password == "" {
+		return User{}, badRequest{errors.Errorf(errUserNoPasswordFmt, u.Name)}
+	}
+	u.Revision = s.revision.Add(1)
+	u.PasswordVersion = 1
+	s.users[u.Name] = u
+	s.notify(EventCreated, KindUser, u.Name, u.Revision)
+	return u, nil
+}
+
+// UpdateUser overwrites the display name of the supplied user, assigning it a
+// new revision. Its password is changed too if the supplied user has one,
+// incrementing its password version. It returns an error if the user does not
+// exist.
+func (s *Store) UpdateUser(_ context.Context, u User) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.users[u.Name]
+	if !ok {
+		return User{}, notFound{errors.Errorf(errUserNotFoundFmt, u.Name)}
+	}
+	existing.Revision = s.revision.Add(1)
+	existing.DisplayName = u.DisplayName
+	if u.Password != "" && u.Password != existing.Password {
+		existing.Password = u.Password
+		existing.PasswordVersion++
+	}
+	s.users[u.Name] = existing
+	s.notify(EventUpdated, KindUser, u.Name, existing.Revision)
+	return existing, nil
+}
+
+// DeleteUser removes the named user. Deleting a user that does not exist is
+// not an error.
+func (s *Store) DeleteUser(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[name]; !ok {
+		return nil
+	}
+	delete(s.users, name)
+	s.notify(EventDeleted, KindUser, name, 0)
+	return nil
+}
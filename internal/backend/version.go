@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errInvalidAPIVersionFmt = "invalid bork API version %q: versions are dates, e.g. %s"
+
+	deprecatedOlderFmt = "bork API version %s expected by the client is deprecated; the backend serves %s"
+	deprecatedNewerFmt = "bork API version %s served by the backend is deprecated; the client expects %s"
+)
+
+// ClientAPIVersion is the version of the bork API that clients expect their
+// backend to serve.
+const ClientAPIVersion = "2025-06-01"
+
+// DefaultAPIVersion is the version of the bork API a store serves, unless its
+// version is set.
+const DefaultAPIVersion = ClientAPIVersion
+
+// apiVersionLayout is the layout of a bork API version. Versions are dates, so
+// a later version sorts after an earlier one.
+const apiVersionLayout = "2006-01-02"
+
+// ValidateAPIVersion returns an error if the supplied string isn't a bork API
+// version.
+func ValidateAPIVersion(v string) error {
+	if _, err := time.Parse(apiVersionLayout, v); err != nil {
+		return errors.Errorf(errInvalidAPIVersionFmt, v, DefaultAPIVersion)
+	}
+	return nil
+}
+
+// SetAPIVersion sets the version of the bork API the store serves, simulating
+// a backend that's older or newer than its clients. Operations are performed
+// the same way whatever the version; clients that expect a different version
+// report that it's deprecated. An empty version restores DefaultAPIVersion.
+// Each of the store's regions serves the same version.
+func (s *Store) SetAPIVersion(v string) {
+	defer s.eachRegion(func(p *Store) { p.SetAPIVersion(v) })
+	if v == "" {
+		v = DefaultAPIVersion
+	}
+	s.apiVersion.Store(&v)
+}
+
+// APIVersion returns the version of the bork API the store serves.
+func (s *Store) APIVersion() string {
+	if v := s.apiVersion.Load(); v != nil {
+		return *v
+	}
+	return DefaultAPIVersion
+}
+
+// APIVersion returns the version of the bork API the client's backend serves.
+func (c *Client) APIVersion(ctx context.Context) (string, error) {
+	return call[string](ctx, c, "APIVersion", struct{}{})
+}
+
+// A servedVersion is the version of the bork API served by a client's
+// backend. It's determined the first time it's needed, and shared by every
+// client leased from the client.
+type servedVersion struct {
+	mu       sync.Mutex
+	resolved bool
+
+	// version is empty if the backend doesn't report its version, as with
+	// backends that support only record operations.
+	version string
+}
+
+// served returns the version of the bork API the client's backend serves, or
+// an empty string if it can't be determined. A backend that can't be asked
+// for its version, for example because it predates the APIVersion operation
+// or is a replay that didn't record it, is assumed to serve the version the
+// client expects until the client is replaced.
+func (c *Client) served(ctx context.Context) string {
+	c.version.mu.Lock()
+	defer c.version.mu.Unlock()
+	if !c.version.resolved {
+		c.version.version, _ = c.APIVersion(ctx)
+		c.version.resolved = true
+	}
+	return c.version.version
+}
+
+type versionCheckKey struct{}
+
+// An APIVersionCheck records whether the calls made using a context were
+// served by a backend whose API version differs from ClientAPIVersion. It is
+// safe for concurrent use.
+type APIVersionCheck struct {
+	mu      sync.Mutex
+	checked bool
+	served  string
+}
+
+// WithAPIVersionCheck returns a context that records, in the returned check,
+// the API version of the backend that serves each call made using it.
+func WithAPIVersionCheck(ctx context.Context) (context.Context, *APIVersionCheck) {
+	v := &APIVersionCheck{}
+	return context.WithValue(ctx, versionCheckKey{}, v), v
+}
+
+// Checked returns true if the API version of the backend serving any call
+// was determined.
+func (v *APIVersionCheck) Checked() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.checked
+}
+
+// Deprecated returns the API version served by a backend whose version
+// differs from ClientAPIVersion, and a message explaining which of the two is
+// deprecated. It returns false if every call was served by a backend of the
+// expected version.
+func (v *APIVersionCheck) Deprecated() (served, message string, deprecated bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	switch {
+	case v.served == "" || v.served == ClientAPIVersion:
+		return "", "", false
+	case v.served > ClientAPIVersion:
+		return v.served, fmt.Sprintf(deprecatedOlderFmt, ClientAPIVersion, v.served), true
+	default:
+		return v.served, fmt.Sprintf(deprecatedNewerFmt, v.served, ClientAPIVersion), true
+	}
+}
+
+func (v *APIVersionCheck) record(served string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.checked = true
+	if served != "" && served != ClientAPIVersion {
+		v.served = served
+	}
+}
+
+// checkAPIVersion records the API version of the client's backend in the
+// supplied context's APIVersionCheck, if it has one. It's called once the
+// backend has served a call, including one for a resource that wasn't found.
+func (c *Client) checkAPIVersion(ctx context.Context) {
+	v, ok := ctx.Value(versionCheckKey{}).(*APIVersionCheck)
+	if !ok || c.version == nil {
+		return
+	}
+	v.record(c.served(ctx))
+}
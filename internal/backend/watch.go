@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+)
+
+// Kinds of resource stored by the backend.
+const (
+	KindRecord          = "record"
+	KindPlacement       = "placement"
+	KindBucket          = "bucket"
+	KindPlan            = "plan"
+	KindKey             = "key"
+	KindObject          = "object"
+	KindRegion          = "region"
+	KindExport          = "export"
+	KindServiceEndpoint = "serviceendpoint"
+	KindQueue           = "queue"
+	KindDatabase        = "database"
+	KindCertificate     = "certificate"
+	KindTopic           = "topic"
+	KindAccessPolicy    = "accesspolicy"
+	KindSchedule        = "schedule"
+	KindUser            = "user"
+	KindLink            = "link"
+)
+
+// Kinds are the kinds of resource clients store in the backend. Regions are
+// provided by the backend, so they aren't among them.
+var Kinds = []string{
+	KindRecord,
+	KindPlacement,
+	KindBucket,
+	KindPlan,
+	KindKey,
+	KindObject,
+	KindExport,
+	KindServiceEndpoint,
+	KindQueue,
+	KindDatabase,
+	KindCertificate,
+	KindTopic,
+	KindAccessPolicy,
+	KindSchedule,
+	KindUser,
+	KindLink,
+}
+
+// An EventType is the type of change an Event describes.
+type EventType string
+
+// Types of change.
+const (
+	EventCreated EventType = "Created"
+	EventUpdated EventType = "Updated"
+	EventDeleted EventType = "Deleted"
+)
+
+// An Event describes a change to a resource stored by the backend.
+type Event struct {
+	// Type of change.
+	Type EventType
+
+	// Kind of the resource that changed.
+	Kind string
+
+	// Name of the resource that changed.
+	Name string
+
+	// Revision of the resource after the change. Deleted resources have no
+	// revision.
+	Revision int64
+}
+
+// WatchBufferSize is the number of events buffered for each watcher. A
+// watcher that falls further behind than this is dropped.
+const WatchBufferSize = 256
+
+// Watch returns a channel of the changes made to the backend after it was
+// called. The channel is closed when the supplied context is done, or when
+// the watcher falls too far behind. Watchers must expect to miss events, and
+// should resync when their channel is closed.
+func (s *Store) Watch(ctx context.Context) <-chan Event {
+	ch := make(chan Event, WatchBufferSize)
+
+	s.watchersMu.Lock()
+	s.watchers[ch] = struct{}{}
+	s.watchersMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.watchersMu.Lock()
+		defer s.watchersMu.Unlock()
+		s.unwatch(ch)
+	}()
+
+	return ch
+}
+
+// notify sends the supplied event to every watcher, first persisting the
+// store if it is persisted. The caller must hold the store's write lock, or
+// the lock of the record the event is about.
+func (s *Store) notify(t EventType, kind, name string, revision int64) {
+	if s.persist != nil {
+		s.persist()
+	}
+	e := Event{Type: t, Kind: kind, Name: name, Revision: revision}
+
+	s.watchersMu.Lock()
+	defer s.watchersMu.Unlock()
+	for ch := range s.watchers {
+		select {
+		case ch <- e:
+		default:
+			// Never block a write on a slow watcher.
+			s.unwatch(ch)
+		}
+	}
+}
+
+// unwatch closes the supplied watcher's channel, if it is still open. The
+// caller must hold the store's watchers lock.
+func (s *Store) unwatch(ch chan Event) {
+	if _, ok := s.watchers[ch]; !ok {
+		return
+	}
+	delete(s.watchers, ch)
+	close(ch)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package changelogsink is an embedded change log service, which records the
+// change log entries the provider sends it to a file and serves them over
+// HTTP. It allows change logs to be verified without the change log sidecar.
+package changelogsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/crossplane/crossplane-runtime/v2/apis/changelogs/proto/v1alpha1"
+)
+
+const (
+	errOpenFmt    = "cannot open change log file %s"
+	errEncode     = "cannot encode change log entry"
+	errWrite      = "cannot write change log entry"
+	errListenFmt  = "cannot listen on %s"
+	errRemoveFmt  = "cannot remove stale socket %s"
+	errServeGRPC  = "cannot serve change log service"
+	errServeHTTP  = "cannot serve change log entries"
+	errEncodeList = "cannot encode change log entries"
+)
+
+// DefaultMaxEntries is how many of the most recent entries a sink serves over
+// HTTP. Every entry is recorded to its file.
+const DefaultMaxEntries = 1000
+
+// PathEntries is the HTTP path the entries are served on.
+const PathEntries = "/changelogs"
+
+// A Sink records change log entries. It implements the change log service.
+type Sink struct {
+	v1alpha1.UnimplementedChangeLogServiceServer
+
+	mu      sync.Mutex
+	file    *os.File
+	entries []json.RawMessage
+	max     int
+}
+
+// New returns a sink that appends the entries it receives to the file at the
+// supplied path, one JSON entry per line, creating the file if necessary.
+func New(path string) (*Sink, error) {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, errOpenFmt, path)
+	}
+	return &Sink{file: f, max: DefaultMaxEntries}, nil
+}
+
+// SendChangeLog records the supplied change log entry.
+func (s *Sink) SendChangeLog(_ context.Context, req *v1alpha1.SendChangeLogRequest) (*v1alpha1.SendChangeLogResponse, error) {
+	b, err := protojson.Marshal(req.GetEntry())
+	if err != nil {
+		return nil, errors.Wrap(err, errEncode)
+	}
+	// protojson deliberately varies its whitespace, so we compact each entry
+	// to keep it on one line.
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, b); err != nil {
+		return nil, errors.Wrap(err, errEncode)
+	}
+	b = buf.Bytes()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(b, '\n')); err != nil {
+		return nil, errors.Wrap(err, errWrite)
+	}
+	s.entries = append(s.entries, b)
+	if len(s.entries) > s.max {
+		s.entries = s.entries[len(s.entries)-s.max:]
+	}
+	return &v1alpha1.SendChangeLogResponse{}, nil
+}
+
+// Entries returns the most recent entries, oldest first.
+func (s *Sink) Entries() []json.RawMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]json.RawMessage(nil), s.entries...)
+}
+
+// ServeHTTP serves the most recent entries as a JSON array, oldest first.
+func (s *Sink) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	b, err := json.Marshal(s.Entries())
+	if err != nil {
+		http.Error(w, errors.Wrap(err, errEncodeList).Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+// A Server serves a sink's change log service on a unix socket, and its
+// entries over HTTP.
+type Server struct {
+	sink    *Sink
+	socket  string
+	address string
+}
+
+// NewServer returns a server that serves the supplied sink's change log
+// service on the unix socket at the supplied path, and its entries over HTTP
+// at the supplied address. A stale socket is removed when it starts.
+func NewServer(s *Sink, socket, address string) *Server {
+	return &Server{sink: s, socket: socket, address: address}
+}
+
+// NeedLeaderElection returns false. Every replica of the provider sends
+// change logs to its own sink.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the sink until the supplied context is done.
+func (s *Server) Start(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(s.socket), 0o750); err != nil {
+		return errors.Wrapf(err, errListenFmt, s.socket)
+	}
+	if err := os.Remove(s.socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrapf(err, errRemoveFmt, s.socket)
+	}
+	l, err := net.Listen("unix", s.socket)
+	if err != nil {
+		return errors.Wrapf(err, errListenFmt, s.socket)
+	}
+
+	gs := grpc.NewServer()
+	v1alpha1.RegisterChangeLogServiceServer(gs, s.sink)
+
+	mux := http.NewServeMux()
+	mux.Handle(PathEntries, s.sink)
+	hs := &http.Server{Addr: s.address, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	errs := make(chan error, 2)
+	go func() { errs <- errors.Wrap(gs.Serve(l), errServeGRPC) }()
+	go func() {
+		if err := hs.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- errors.Wrap(err, errServeHTTP)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+	}
+	gs.Stop()
+	_ = hs.Close()
+
+	s.sink.mu.Lock()
+	defer s.sink.mu.Unlock()
+	_ = s.sink.file.Close()
+	return err
+}
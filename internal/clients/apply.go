@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	errApplyStatus   = "cannot apply status"
+	errApplySpec     = "cannot apply late initialized fields"
+	errConvertObject = "cannot convert object to unstructured"
+)
+
+// FieldOwner is the field manager with which the provider server-side applies
+// the status and late initialized fields of managed resources.
+const FieldOwner = "provider-bork"
+
+// ApplyStatus returns the supplied manager, except that its client writes the
+// status of objects using server-side apply as FieldOwner, rather than
+// updating it. Applies don't specify a resource version, so they don't
+// conflict with other writers of an object. The provider owns every field of
+// the status it applies, and takes ownership of any it doesn't.
+func ApplyStatus(mgr ctrl.Manager) ctrl.Manager {
+	return &applyManager{Manager: mgr, client: &applyClient{Client: mgr.GetClient()}}
+}
+
+type applyManager struct {
+	ctrl.Manager
+	client client.Client
+}
+
+func (m *applyManager) GetClient() client.Client {
+	return m.client
+}
+
+type applyClient struct {
+	client.Client
+}
+
+func (c *applyClient) Status() client.SubResourceWriter {
+	return &applyStatusWriter{SubResourceWriter: c.Client.Status(), client: c.Client}
+}
+
+type applyStatusWriter struct {
+	client.SubResourceWriter
+	client client.Client
+}
+
+// Update applies the status of the supplied object, then updates the object
+// with the API server's response.
+func (w *applyStatusWriter) Update(ctx context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+	u, err := toUnstructured(obj, w.client.Scheme())
+	if err != nil {
+		return errors.Wrap(err, errApplyStatus)
+	}
+	status := map[string]any{}
+	if s, ok := u.Object["status"].(map[string]any); ok {
+		status = s
+	}
+	apply := intent(u)
+	apply.Object["status"] = status
+
+	if err := w.SubResourceWriter.Patch(ctx, apply, client.Apply, client.FieldOwner(FieldOwner), client.ForceOwnership); err != nil {
+		return errors.Wrap(err, errApplyStatus)
+	}
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(apply.Object, obj), errApplyStatus)
+}
+
+// ApplySpec server-side applies the supplied fields of the spec of the
+// supplied object as FieldOwner, e.g. those it late initialized. The supplied
+// object's generation and resource version are updated to the API server's,
+// but its spec and status aren't, so that the status it will write isn't reset
+// to the API server's. Ownership of fields other managers own isn't taken;
+// applying them fails with a conflict.
+func ApplySpec(ctx context.Context, kube client.Client, obj client.Object, spec map[string]any) error {
+	u, err := toUnstructured(obj, kube.Scheme())
+	if err != nil {
+		return errors.Wrap(err, errApplySpec)
+	}
+	apply := intent(u)
+	apply.Object["spec"] = spec
+	if err := kube.Patch(ctx, apply, client.Apply, client.FieldOwner(FieldOwner)); err != nil {
+		return errors.Wrap(err, errApplySpec)
+	}
+	obj.SetGeneration(apply.GetGeneration())
+	obj.SetResourceVersion(apply.GetResourceVersion())
+	return nil
+}
+
+// intent returns an object that identifies the supplied object, to which the
+// fields to apply can be added.
+func intent(u *unstructured.Unstructured) *unstructured.Unstructured {
+	apply := &unstructured.Unstructured{}
+	apply.SetGroupVersionKind(u.GroupVersionKind())
+	apply.SetNamespace(u.GetNamespace())
+	apply.SetName(u.GetName())
+	return apply
+}
+
+func toUnstructured(obj client.Object, s *runtime.Scheme) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(obj, s)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvertObject)
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvertObject)
+	}
+	u := &unstructured.Unstructured{Object: m}
+	u.SetGroupVersionKind(gvk)
+	return u, nil
+}
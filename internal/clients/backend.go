@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"sync/atomic"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// A defaultBackend is the backend that provider configs without an endpoint
+// connect to.
+type defaultBackend struct {
+	name     string
+	endpoint string
+}
+
+var fallback atomic.Pointer[defaultBackend]
+
+// UseBackend connects the provider configs that don't specify an endpoint,
+// and are dialed from now on, to the named registered backend, at the
+// supplied endpoint if it's remote. They connect to the in-process store by
+// default.
+func UseBackend(name, endpoint string) {
+	fallback.Store(&defaultBackend{name: name, endpoint: endpoint})
+}
+
+// inProcess returns true if provider configs without an endpoint connect to
+// the in-process store.
+func inProcess() bool {
+	name, _ := backendFor()
+	return name == backend.BackendMemory || name == backend.BackendFile
+}
+
+// backendFor returns the name and endpoint of the backend that provider
+// configs without an endpoint connect to.
+func backendFor() (string, string) {
+	if b := fallback.Load(); b != nil {
+		return b.name, b.endpoint
+	}
+	return backend.BackendMemory, ""
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clients connects Bork managed resources to the backend described by
+// their provider configuration.
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+const (
+	errNotModernManaged   = "managed resource does not reference a typed provider config"
+	errNoPCRef            = "managed resource does not reference a provider config"
+	errGetPC              = "cannot get ProviderConfig"
+	errGetCPC             = "cannot get ClusterProviderConfig"
+	errUnsupportedKind    = "unsupported provider config kind: %s"
+	errNewClient          = "cannot create backend client"
+	errNewRegionClientFmt = "cannot create backend client of region %q"
+	errGetCreds           = "cannot get credentials"
+	errParseCABundle      = "cannot parse endpoint CA bundle: no PEM encoded certificates found"
+)
+
+// A ProviderConfigKey identifies a ProviderConfig or ClusterProviderConfig.
+// ClusterProviderConfigs have no namespace.
+type ProviderConfigKey struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// GetProviderConfig returns the spec of the provider config referenced by the
+// supplied managed resource, resolved as described by ProviderConfigCandidates.
+func GetProviderConfig(ctx context.Context, kube client.Reader, mg resource.Managed) (*apisv1alpha1.ProviderConfigSpec, error) {
+	_, spec, err := ResolveProviderConfig(ctx, kube, mg)
+	return spec, err
+}
+
+// ProviderConfigCandidates returns the provider configs the supplied reference
+// of a managed resource in the supplied namespace may resolve to, in the order
+// they're tried. A reference to a ProviderConfig is resolved to the
+// ProviderConfig of that name in the managed resource's namespace, falling
+// back to the ClusterProviderConfig of that name if the namespace has no such
+// ProviderConfig. This allows a namespace to override a cluster-wide default.
+// A reference to a ClusterProviderConfig is only ever resolved to a
+// ClusterProviderConfig. A reference to any other kind has no candidates.
+func ProviderConfigCandidates(namespace string, ref xpv1.ProviderConfigReference) []ProviderConfigKey {
+	switch ref.Kind {
+	case apisv1alpha1.ProviderConfigKind:
+		return []ProviderConfigKey{
+			{Kind: apisv1alpha1.ProviderConfigKind, Namespace: namespace, Name: ref.Name},
+			{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: ref.Name},
+		}
+	case apisv1alpha1.ClusterProviderConfigKind:
+		return []ProviderConfigKey{{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: ref.Name}}
+	default:
+		return nil
+	}
+}
+
+// ResolveProviderConfig returns the key and spec of the provider config
+// referenced by the supplied managed resource: the first of its
+// ProviderConfigCandidates that exists. If none exist the error is that of the
+// first candidate, i.e. the provider config the resource asked for rather
+// than a fallback.
+func ResolveProviderConfig(ctx context.Context, kube client.Reader, mg resource.Managed) (ProviderConfigKey, *apisv1alpha1.ProviderConfigSpec, error) {
+	m, ok := mg.(resource.ModernManaged)
+	if !ok {
+		return ProviderConfigKey{}, nil, errors.New(errNotModernManaged)
+	}
+	ref := m.GetProviderConfigReference()
+	if ref == nil {
+		return ProviderConfigKey{}, nil, errors.New(errNoPCRef)
+	}
+
+	candidates := ProviderConfigCandidates(m.GetNamespace(), *ref)
+	if len(candidates) == 0 {
+		return ProviderConfigKey{}, nil, errors.Errorf(errUnsupportedKind, ref.Kind)
+	}
+	var first error
+	for _, key := range candidates {
+		spec, err := getProviderConfig(ctx, kube, key)
+		if err == nil {
+			return key, spec, nil
+		}
+		if !kerrors.IsNotFound(errors.Cause(err)) {
+			return ProviderConfigKey{}, nil, err
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	return ProviderConfigKey{}, nil, first
+}
+
+func getProviderConfig(ctx context.Context, kube client.Reader, key ProviderConfigKey) (*apisv1alpha1.ProviderConfigSpec, error) {
+	if key.Kind == apisv1alpha1.ClusterProviderConfigKind {
+		cpc := &apisv1alpha1.ClusterProviderConfig{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: key.Name}, cpc); err != nil {
+			return nil, errors.Wrap(err, errGetCPC)
+		}
+		return &cpc.Spec, nil
+	}
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: key.Namespace, Name: key.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	return &pc.Spec, nil
+}
+
+// Connect returns a client of the backend configured by the supplied managed
+// resource's provider config, tracking that the managed resource uses the
+// provider config. The supplied store is used unless the provider config
+// specifies an endpoint.
+func Connect(ctx context.Context, kube client.Client, store *backend.Store, mg resource.Managed) (*backend.Client, error) {
+	key, pc, err := ResolveProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	if err := TrackUsage(ctx, kube, mg, key); err != nil {
+		return nil, err
+	}
+	return ConnectWith(ctx, kube, store, pc)
+}
+
+// ConnectWith returns a client of the backend configured by the supplied
+// provider config. The supplied store is used unless the provider config
+// specifies an endpoint.
+func ConnectWith(ctx context.Context, kube client.Client, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec) (*backend.Client, error) {
+	token, err := getToken(ctx, kube, store, pc)
+	if err != nil {
+		return nil, err
+	}
+	return dial(ctx, store, pc, token)
+}
+
+// getToken returns the bearer token the supplied provider config presents to
+// its endpoint, if any. Expiring credentials are also presented to the
+// supplied store.
+func getToken(ctx context.Context, kube client.Client, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec) (string, error) {
+	if pc.Credentials.Source == apisv1alpha1.CredentialsSourceExpiring {
+		return getExpiringToken(ctx, kube, store, pc)
+	}
+	if (pc.Endpoint == nil && inProcess()) || pc.Credentials.Source == xpv1.CredentialsSourceNone {
+		return "", nil
+	}
+	b, err := resource.CommonCredentialExtractor(ctx, pc.Credentials.Source, kube, pc.Credentials.CommonCredentialSelectors)
+	if err != nil {
+		return "", errors.Wrap(err, errGetCreds)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// dial returns a client of the backend configured by the supplied provider
+// config, presenting the supplied bearer token to its endpoint. If the
+// backend reports that the token expired, when dialing or later, the token is
+// forgotten so that a new one is issued the next time the provider config is
+// connected to. The connection is counted as open until the client is first
+// closed.
+func dial(ctx context.Context, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec, token string) (*backend.Client, error) {
+	svc, err := dialBackend(ctx, store, pc, token)
+	if backend.IsCredentialsExpired(err) {
+		tokens.forget(token)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		svc.OnCredentialsExpired(func() { tokens.forget(token) })
+	}
+	if r := recorder.Load(); r != nil {
+		svc.RecordTo(r)
+	}
+	metrics.BackendConnectionsDialed.Inc()
+	metrics.BackendConnectionsOpen.Inc()
+	var once sync.Once
+	return svc.Lease(func() error {
+		var err error
+		once.Do(func() {
+			metrics.BackendConnectionsOpen.Dec()
+			err = svc.Close()
+		})
+		return err
+	}), nil
+}
+
+// dialBackend returns a client of the backend configured by the supplied
+// provider config, presenting the supplied bearer token to it. A provider
+// config with an endpoint connects to it using its transport. Any other
+// provider config connects to the backend the provider uses by default.
+func dialBackend(ctx context.Context, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec, token string) (*backend.Client, error) {
+	preferred := make([]string, len(pc.ContentTypes))
+	for i, ct := range pc.ContentTypes {
+		preferred[i] = string(ct)
+	}
+
+	if r := replay.Load(); r != nil {
+		svc, err := r.Connect(preferred...)
+		return svc, errors.Wrap(err, errNewClient)
+	}
+	if pc.Endpoint == nil && len(pc.Regions) > 0 && inProcess() {
+		return dialRegions(store, pc.Regions, token, preferred)
+	}
+
+	name, endpoint := backendFor()
+	o := backend.DialOptions{
+		Store:        store,
+		Endpoint:     endpoint,
+		TLS:          &tls.Config{MinVersion: tls.VersionTLS12},
+		Token:        token,
+		ContentTypes: preferred,
+	}
+	if pc.Endpoint != nil {
+		cfg, err := newTLSConfig(pc.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		name, o.Endpoint, o.TLS = backend.BackendHTTP, pc.Endpoint.URL, cfg
+		if pc.Endpoint.Transport == apisv1alpha1.TransportGRPC {
+			name = backend.BackendGRPC
+		}
+	}
+	svc, err := backend.Open(ctx, name, o)
+	return svc, errors.Wrap(err, errNewClient)
+}
+
+// dialRegions returns a client of the supplied regions of the supplied store
+// that fails over from each region to the next, in order, while it's
+// unhealthy.
+func dialRegions(store *backend.Store, regions []string, token string, preferred []string) (*backend.Client, error) {
+	clients := make([]*backend.Client, len(regions))
+	for i, r := range regions {
+		svc, err := store.Region(r).ConnectWithToken(token, preferred...)
+		if err != nil {
+			return nil, errors.Wrapf(err, errNewRegionClientFmt, r)
+		}
+		clients[i] = svc
+	}
+	svc, err := backend.Failover(clients...)
+	return svc, errors.Wrap(err, errNewClient)
+}
+
+// newTLSConfig returns a TLS configuration that trusts the supplied
+// endpoint's certificate authorities.
+func newTLSConfig(e *apisv1alpha1.Endpoint) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: e.InsecureSkipTLSVerify,
+	}
+	if len(e.CABundle) > 0 {
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(e.CABundle) {
+			return nil, errors.New(errParseCABundle)
+		}
+	}
+	return cfg, nil
+}
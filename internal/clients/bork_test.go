@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+)
+
+// TestResolveProviderConfig resolves every kind of reference when the
+// ProviderConfig it names, the ClusterProviderConfig of the same name, both or
+// neither exist. When neither exists the error is that of the provider config
+// the reference named, not the fallback. Each provider config has a different credentials source, so
+// that which one was resolved is evident from its spec.
+func TestResolveProviderConfig(t *testing.T) {
+	const (
+		namespace = "bork"
+		pcName    = "default"
+	)
+	pc := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pcName},
+		Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret}},
+	}
+	elsewhere := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "elsewhere", Name: pcName},
+		Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity}},
+	}
+	cpc := &apisv1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: pcName},
+		Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone}},
+	}
+
+	cases := map[string]struct {
+		kind     string
+		existing []client.Object
+		want     ProviderConfigKey
+		source   xpv1.CredentialsSource
+		notFound string
+		err      bool
+	}{
+		"ProviderConfigPreferred": {
+			kind:     apisv1alpha1.ProviderConfigKind,
+			existing: []client.Object{pc, cpc},
+			want:     ProviderConfigKey{Kind: apisv1alpha1.ProviderConfigKind, Namespace: namespace, Name: pcName},
+			source:   xpv1.CredentialsSourceSecret,
+		},
+		"ProviderConfigFallsBackToClusterProviderConfig": {
+			kind:     apisv1alpha1.ProviderConfigKind,
+			existing: []client.Object{cpc},
+			want:     ProviderConfigKey{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: pcName},
+			source:   xpv1.CredentialsSourceNone,
+		},
+		"ProviderConfigInAnotherNamespaceIgnored": {
+			kind:     apisv1alpha1.ProviderConfigKind,
+			existing: []client.Object{elsewhere, cpc},
+			want:     ProviderConfigKey{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: pcName},
+			source:   xpv1.CredentialsSourceNone,
+		},
+		"ProviderConfigNotFound": {
+			kind:     apisv1alpha1.ProviderConfigKind,
+			existing: []client.Object{elsewhere},
+			notFound: errGetPC,
+		},
+		"ClusterProviderConfigNeverFallsBack": {
+			kind:     apisv1alpha1.ClusterProviderConfigKind,
+			existing: []client.Object{pc},
+			notFound: errGetCPC,
+		},
+		"ClusterProviderConfigPreferred": {
+			kind:     apisv1alpha1.ClusterProviderConfigKind,
+			existing: []client.Object{pc, cpc},
+			want:     ProviderConfigKey{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: pcName},
+			source:   xpv1.CredentialsSourceNone,
+		},
+		"UnsupportedKind": {
+			kind:     "BorkConfig",
+			existing: []client.Object{pc, cpc},
+			err:      true,
+		},
+	}
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.existing...).Build()
+			mg := &v1alpha1.BorkResource{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bork"}}
+			mg.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: tc.kind, Name: pc.GetName()})
+
+			key, spec, err := ResolveProviderConfig(context.Background(), kube, mg)
+			switch {
+			case tc.notFound != "":
+				if !kerrors.IsNotFound(errors.Cause(err)) || !strings.HasPrefix(err.Error(), tc.notFound) {
+					t.Fatalf("ResolveProviderConfig(): got error %v, want %q not found", err, tc.notFound)
+				}
+				return
+			case tc.err:
+				if err == nil {
+					t.Fatalf("ResolveProviderConfig(): got key %v, want an error", key)
+				}
+				return
+			case err != nil:
+				t.Fatalf("ResolveProviderConfig(): %v", err)
+			}
+			if key != tc.want {
+				t.Errorf("ResolveProviderConfig(): got key %v, want %v", key, tc.want)
+			}
+			if spec.Credentials.Source != tc.source {
+				t.Errorf("ResolveProviderConfig(): got credentials source %q, want %q", spec.Credentials.Source, tc.source)
+			}
+		})
+	}
+}
+
+// TestProviderConfigCandidates checks that candidates are tried in the
+// documented order.
+func TestProviderConfigCandidates(t *testing.T) {
+	cases := map[string]struct {
+		ref  xpv1.ProviderConfigReference
+		want []ProviderConfigKey
+	}{
+		"ProviderConfig": {
+			ref: xpv1.ProviderConfigReference{Kind: apisv1alpha1.ProviderConfigKind, Name: "default"},
+			want: []ProviderConfigKey{
+				{Kind: apisv1alpha1.ProviderConfigKind, Namespace: "bork", Name: "default"},
+				{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"},
+			},
+		},
+		"ClusterProviderConfig": {
+			ref:  xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"},
+			want: []ProviderConfigKey{{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"}},
+		},
+		"UnsupportedKind": {
+			ref: xpv1.ProviderConfigReference{Kind: "BorkConfig", Name: "default"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ProviderConfigCandidates("bork", tc.ref)
+			if len(got) != len(tc.want) {
+				t.Fatalf("ProviderConfigCandidates(): got %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("ProviderConfigCandidates(): got %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
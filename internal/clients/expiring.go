@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const errIssueToken = "cannot issue expiring token"
+
+// DefaultTokenTTL is how long tokens issued to provider configs with Expiring
+// credentials are valid, unless the provider config says otherwise.
+const DefaultTokenTTL = 5 * time.Minute
+
+// tokens issued to provider configs with Expiring credentials.
+var tokens = &tokenCache{issued: make(map[string]string)}
+
+// A tokenCache caches the tokens issued to provider configs with Expiring
+// credentials, keyed by a hash of the provider config and the credentials
+// presented to get the token. A token is used until the backend reports that
+// it expired, not until the backend said it would expire, so that the
+// provider exercises detecting and recovering from expired credentials.
+type tokenCache struct {
+	mu     sync.Mutex
+	issued map[string]string
+}
+
+func (c *tokenCache) get(k string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.issued[k]
+	return t, ok
+}
+
+func (c *tokenCache) set(k, token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.issued[k] = token
+}
+
+// forget forgets the supplied token, so that the next connection using the
+// provider config it was issued to is issued a new one.
+func (c *tokenCache) forget(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, t := range c.issued {
+		if t == token {
+			delete(c.issued, k)
+		}
+	}
+}
+
+// getExpiringToken returns the token issued to the supplied provider config,
+// asking its backend to issue one if none was.
+func getExpiringToken(ctx context.Context, kube client.Client, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec) (string, error) {
+	var creds string
+	if pc.Credentials.SecretRef != nil {
+		b, err := resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, kube, pc.Credentials.CommonCredentialSelectors)
+		if err != nil {
+			return "", errors.Wrap(err, errGetCreds)
+		}
+		creds = strings.TrimSpace(string(b))
+	}
+
+	k, err := hash(pc, creds)
+	if err != nil {
+		return "", err
+	}
+	if t, ok := tokens.get(k); ok {
+		return t, nil
+	}
+
+	ttl := DefaultTokenTTL
+	if pc.Credentials.ExpiresAfter != nil {
+		ttl = pc.Credentials.ExpiresAfter.Duration
+	}
+
+	svc, err := dial(ctx, store, pc, creds)
+	if err != nil {
+		return "", err
+	}
+	t, err := svc.IssueToken(ctx, ttl)
+	_ = svc.Close()
+	if err != nil {
+		return "", errors.Wrap(err, errIssueToken)
+	}
+	tokens.set(k, t.Value)
+	return t.Value, nil
+}
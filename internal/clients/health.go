@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const (
+	errListPCs      = "cannot list ProviderConfigs"
+	errListCPCs     = "cannot list ClusterProviderConfigs"
+	errUnreachable  = "cannot connect to the bork backend of %s"
+	errProbeTimeout = "timed out"
+	errNotLeader    = "not the leader"
+	errWhoAmI       = "cannot get identity from the bork backend"
+)
+
+// DefaultProbeTimeout is how long a readiness check waits to connect to the
+// backend of each provider config.
+const DefaultProbeTimeout = 5 * time.Second
+
+// String returns the key as Kind/name, or Kind/namespace/name for a
+// namespaced ProviderConfig.
+func (k ProviderConfigKey) String() string {
+	if k.Namespace == "" {
+		return k.Kind + "/" + k.Name
+	}
+	return k.Kind + "/" + k.Namespace + "/" + k.Name
+}
+
+// LeaderReadyz returns a readiness check that fails until the supplied
+// channel, which is closed when the provider is elected leader, is closed.
+// Only the leader of a highly available deployment is then ready, so its
+// readiness shows which replica is reconciling, and when another takes over.
+func LeaderReadyz(elected <-chan struct{}) healthz.Checker {
+	return func(_ *http.Request) error {
+		select {
+		case <-elected:
+			return nil
+		default:
+			return errors.New(errNotLeader)
+		}
+	}
+}
+
+// BackendReadyz returns a readiness check that connects to the backend of
+// every ProviderConfig and ClusterProviderConfig, using its credentials. The
+// check fails if any backend can't be reached or rejects its credentials,
+// reporting each provider config that failed. Provider configs that don't
+// specify an endpoint use the store of the supplied tenants that their
+// namespace belongs to.
+func BackendReadyz(kube client.Client, tenants *backend.Tenants, timeout time.Duration) healthz.Checker {
+	return func(r *http.Request) error {
+		pcs, err := listProviderConfigs(r.Context(), kube)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		var (
+			mu     sync.Mutex
+			failed []string
+			wg     sync.WaitGroup
+		)
+		for key, pc := range pcs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := probe(ctx, kube, tenants.For(key.Namespace), pc); err != nil {
+					mu.Lock()
+					failed = append(failed, errors.Wrapf(err, errUnreachable, key).Error())
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		if len(failed) == 0 {
+			return nil
+		}
+		sort.Strings(failed)
+		return errors.New(strings.Join(failed, "; "))
+	}
+}
+
+// probe connects to the backend configured by the supplied provider config,
+// then disconnects. Connecting negotiates a content type with the backend,
+// which fails if the backend is unreachable or rejects our credentials.
+func probe(ctx context.Context, kube client.Client, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec) error {
+	svc, err := ConnectWith(ctx, kube, store, pc)
+	if ctx.Err() != nil {
+		return errors.New(errProbeTimeout)
+	}
+	if err != nil {
+		return err
+	}
+	return svc.Close()
+}
+
+// Identify connects to the backend configured by the supplied provider config,
+// using its credentials, and returns who the backend authenticated the
+// provider as. Expiring credentials that the backend rejects as expired are
+// renewed, once.
+func Identify(ctx context.Context, kube client.Client, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec) (*apisv1alpha1.ProviderIdentity, error) {
+	id, err := identify(ctx, kube, store, pc)
+	if backend.IsCredentialsExpired(err) {
+		id, err = identify(ctx, kube, store, pc)
+	}
+	return id, err
+}
+
+func identify(ctx context.Context, kube client.Client, store *backend.Store, pc *apisv1alpha1.ProviderConfigSpec) (*apisv1alpha1.ProviderIdentity, error) {
+	svc, err := ConnectWith(ctx, kube, store, pc)
+	if err != nil {
+		return nil, err
+	}
+	id, err := svc.WhoAmI(ctx)
+	_ = svc.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, errWhoAmI)
+	}
+	return &apisv1alpha1.ProviderIdentity{AccountID: id.AccountID, ContentType: svc.ContentType()}, nil
+}
+
+// listProviderConfigs returns the spec of every ProviderConfig and
+// ClusterProviderConfig, by key.
+func listProviderConfigs(ctx context.Context, kube client.Client) (map[ProviderConfigKey]*apisv1alpha1.ProviderConfigSpec, error) {
+	out := make(map[ProviderConfigKey]*apisv1alpha1.ProviderConfigSpec)
+
+	pcs := &apisv1alpha1.ProviderConfigList{}
+	if err := kube.List(ctx, pcs); err != nil {
+		return nil, errors.Wrap(err, errListPCs)
+	}
+	for i := range pcs.Items {
+		pc := &pcs.Items[i]
+		out[ProviderConfigKey{Kind: apisv1alpha1.ProviderConfigKind, Namespace: pc.GetNamespace(), Name: pc.GetName()}] = &pc.Spec
+	}
+
+	cpcs := &apisv1alpha1.ClusterProviderConfigList{}
+	if err := kube.List(ctx, cpcs); err != nil {
+		return nil, errors.Wrap(err, errListCPCs)
+	}
+	for i := range cpcs.Items {
+		pc := &cpcs.Items[i]
+		out[ProviderConfigKey{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: pc.GetName()}] = &pc.Spec
+	}
+
+	return out, nil
+}
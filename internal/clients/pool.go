@@ -0,0 +1,282 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+const (
+	errAddPool   = "cannot add client pool to controller manager"
+	errHashPC    = "cannot hash provider config"
+	errClosePool = "cannot close pooled backend clients"
+)
+
+// DefaultPoolTTL is how long a pooled client is shared before it's replaced.
+const DefaultPoolTTL = 10 * time.Minute
+
+// DefaultPool pools clients of the simulated backend shared by all of the
+// provider's controllers.
+var DefaultPool = NewPool(backend.DefaultTenants, DefaultPoolTTL)
+
+// A Pool shares backend clients between the reconciles of managed resources
+// that use the same provider config, so that each reconcile doesn't pay to
+// dial the backend and negotiate a content type.
+//
+// Clients are keyed by provider config, the backend tenant of the managed
+// resources using it, and a hash of its spec and credentials. Changing either causes the next reconcile to dial a new
+// client. Clients are replaced once they're older than the pool's TTL, so
+// that credentials that resolve to a different value without the provider
+// config changing are eventually picked up. A replaced client is closed once
+// every lease of it has been released.
+type Pool struct {
+	tenants *backend.Tenants
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	clients map[poolKey]*pooled
+}
+
+type poolKey struct {
+	ProviderConfigKey
+	tenant string
+	hash   string
+}
+
+type pooled struct {
+	client  *backend.Client
+	created time.Time
+	leases  int
+
+	// stale clients are no longer in the pool, and are closed when their
+	// last lease is released.
+	stale bool
+}
+
+// NewPool returns a pool of clients of the backends configured by provider
+// configs. Provider configs that don't specify an endpoint use the store of
+// the supplied tenants that each managed resource belongs to. A TTL of zero
+// disables pooling; every call to Connect dials a new client.
+func NewPool(tenants *backend.Tenants, ttl time.Duration) *Pool {
+	return &Pool{
+		tenants: tenants,
+		ttl:     ttl,
+		clients: make(map[poolKey]*pooled),
+	}
+}
+
+// Store returns the store of the tenant the managed resources in the supplied
+// namespace belong to, which the pool's clients of provider configs that
+// don't specify an endpoint use.
+func (p *Pool) Store(namespace string) *backend.Store {
+	return p.tenants.For(namespace)
+}
+
+// Setup configures the pool's TTL, and adds the pool to the supplied
+// controller manager such that its clients are closed when the manager stops.
+func (p *Pool) Setup(mgr ctrl.Manager, ttl time.Duration) error {
+	p.mu.Lock()
+	p.ttl = ttl
+	p.mu.Unlock()
+
+	return errors.Wrap(mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return p.Close()
+	})), errAddPool)
+}
+
+// Connect returns a lease of a client of the backend configured by the
+// supplied managed resource's provider config, dialing the backend only if
+// the pool holds no client for the provider config. Like Connect, it tracks
+// that the managed resource uses the provider config. Callers must close the
+// returned client to release their lease.
+func (p *Pool) Connect(ctx context.Context, kube client.Client, mg resource.Managed) (*backend.Client, error) {
+	key, pc, err := ResolveProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	if err := TrackUsage(ctx, kube, mg, key); err != nil {
+		return nil, err
+	}
+	store := p.tenants.For(mg.GetNamespace())
+	token, err := getToken(ctx, kube, store, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	ttl := p.ttl
+	p.mu.Unlock()
+	if ttl <= 0 {
+		return dial(ctx, store, pc, token)
+	}
+
+	h, err := hash(pc, token)
+	if err != nil {
+		return nil, err
+	}
+	k := poolKey{ProviderConfigKey: key, tenant: p.tenants.Tenant(mg.GetNamespace()), hash: h}
+
+	if svc := p.lease(k); svc != nil {
+		return svc, nil
+	}
+
+	// Dial without holding the lock, so that a slow backend doesn't block
+	// callers that use other provider configs.
+	svc, err := dial(ctx, store, pc, token)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another caller may have dialed the same backend while we were. Use
+	// its client so that only one remains in the pool.
+	if e, ok := p.clients[k]; ok && !p.expired(e) {
+		_ = svc.Close()
+		return p.leaseOf(k, e), nil
+	}
+
+	// Any other client of this provider config and tenant was dialed using
+	// an old spec or old credentials.
+	for ok, e := range p.clients {
+		if ok.ProviderConfigKey == key && ok.tenant == k.tenant {
+			p.evict(ok, e)
+		}
+	}
+
+	e := &pooled{client: svc, created: time.Now()}
+	p.clients[k] = e
+	return p.leaseOf(k, e), nil
+}
+
+// lease returns a lease of the pooled client with the supplied key, or nil if
+// the pool holds no such client. Clients that have outlived the pool's TTL are
+// evicted rather than leased.
+func (p *Pool) lease(k poolKey) *backend.Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e, ok := p.clients[k]
+	if !ok {
+		return nil
+	}
+	if p.expired(e) {
+		p.evict(k, e)
+		return nil
+	}
+	return p.leaseOf(k, e)
+}
+
+// leaseOf returns a lease of the supplied pooled client. The caller must hold
+// the pool's lock.
+func (p *Pool) leaseOf(k poolKey, e *pooled) *backend.Client {
+	e.leases++
+	metrics.BackendConnectionsLeased.Inc()
+	var once sync.Once
+	return e.client.Lease(func() error {
+		var err error
+		once.Do(func() { err = p.release(k, e) })
+		return err
+	})
+}
+
+// release releases a lease of the supplied pooled client, closing it if it's
+// stale and this was its last lease.
+func (p *Pool) release(k poolKey, e *pooled) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e.leases--
+	metrics.BackendConnectionsLeased.Dec()
+	if !e.stale && p.expired(e) {
+		p.evict(k, e)
+		return nil
+	}
+	if e.stale && e.leases == 0 {
+		return e.client.Close()
+	}
+	return nil
+}
+
+// evict removes the supplied client from the pool, closing it if it has no
+// leases. The caller must hold the pool's lock.
+func (p *Pool) evict(k poolKey, e *pooled) {
+	if p.clients[k] == e {
+		delete(p.clients, k)
+	}
+	e.stale = true
+	if e.leases == 0 {
+		_ = e.client.Close()
+	}
+}
+
+// expired returns true if the supplied client has outlived the pool's TTL.
+// The caller must hold the pool's lock.
+func (p *Pool) expired(e *pooled) bool {
+	return time.Since(e.created) > p.ttl
+}
+
+// Close evicts every client from the pool. Clients that are leased are closed
+// when their last lease is released.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var err error
+	for k, e := range p.clients {
+		delete(p.clients, k)
+		e.stale = true
+		if e.leases > 0 {
+			continue
+		}
+		if cerr := e.client.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return errors.Wrap(err, errClosePool)
+}
+
+// hash returns a hash of the supplied provider config spec and credentials.
+func hash(pc *apisv1alpha1.ProviderConfigSpec, token string) (string, error) {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		return "", errors.Wrap(err, errHashPC)
+	}
+	h := sha256.New()
+	_, _ = h.Write(b)
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(token))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
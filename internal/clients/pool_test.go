@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// TestPoolChurn connects and disconnects many managed resources concurrently
+// while their provider config's spec changes and pooled clients expire, so
+// that clients are constantly dialed, evicted and closed. Every connection
+// must be closed, and every lease released, once the pool is closed. Run it
+// with -race.
+func TestPoolChurn(t *testing.T) {
+	const (
+		resources = 50
+		connects  = 20
+		changes   = 10
+	)
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	cpc := &apisv1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone}},
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(cpc).Build()
+
+	open := testutil.ToFloat64(metrics.BackendConnectionsOpen)
+	leased := testutil.ToFloat64(metrics.BackendConnectionsLeased)
+	dialed := testutil.ToFloat64(metrics.BackendConnectionsDialed)
+
+	ctx := context.Background()
+	p := NewPool(backend.NewTenants(backend.NewStore()), time.Millisecond)
+
+	wg := &sync.WaitGroup{}
+	for i := range resources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mg := &v1alpha1.BorkBucket{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("bork-%d", i), UID: types.UID(fmt.Sprintf("uid-%d", i))}}
+			mg.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"})
+			for range connects {
+				svc, err := p.Connect(ctx, kube, mg)
+				if err != nil {
+					t.Errorf("Connect(%s): %v", mg.GetName(), err)
+					return
+				}
+				if _, err := svc.WhoAmI(ctx); err != nil {
+					t.Errorf("WhoAmI(%s): %v", mg.GetName(), err)
+				}
+				if err := svc.Close(); err != nil {
+					t.Errorf("Close(%s): %v", mg.GetName(), err)
+				}
+			}
+		}()
+	}
+
+	// Changing the provider config's spec evicts the clients dialed using
+	// its old spec.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range changes {
+			pc := &apisv1alpha1.ClusterProviderConfig{}
+			if err := kube.Get(ctx, client.ObjectKeyFromObject(cpc), pc); err != nil {
+				t.Errorf("Get(%s): %v", cpc.GetName(), err)
+				return
+			}
+			pc.Spec.ContentTypes = []apisv1alpha1.ContentType{[]apisv1alpha1.ContentType{backend.ContentTypeJSON, backend.ContentTypeMessagePack}[i%2]}
+			if err := kube.Update(ctx, pc); err != nil {
+				t.Errorf("Update(%s): %v", cpc.GetName(), err)
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	wg.Wait()
+
+	if got := testutil.ToFloat64(metrics.BackendConnectionsLeased) - leased; got != 0 {
+		t.Errorf("leases unreleased after every client was closed: got %v, want 0", got)
+	}
+	if err := p.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+	if got := testutil.ToFloat64(metrics.BackendConnectionsOpen) - open; got != 0 {
+		t.Errorf("connections open after the pool was closed: got %v, want 0", got)
+	}
+	if got := testutil.ToFloat64(metrics.BackendConnectionsDialed) - dialed; got < 2 {
+		t.Errorf("connections dialed: got %v, want clients to have been replaced", got)
+	}
+}
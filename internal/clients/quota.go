@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+const (
+	errListPCUs         = "cannot list ProviderConfigUsages"
+	errQuotaExceededFmt = "%s allows at most %d managed resources, and %d use it"
+)
+
+type quotaExceeded struct{ error }
+
+// IsQuotaExceeded returns true if the supplied error indicates a managed
+// resource exceeds its provider config's quota.
+func IsQuotaExceeded(err error) bool {
+	return errors.As(err, &quotaExceeded{})
+}
+
+// CheckQuota returns an error that satisfies IsQuotaExceeded if the supplied
+// managed resource isn't among the first managed resources to use its
+// provider config, as many as its quota allows. The managed resource must
+// already use the provider config, so that it's counted. When the backend is
+// partitioned by namespace only the managed resources in the supplied
+// resource's namespace count against a ClusterProviderConfig's quota.
+func CheckQuota(ctx context.Context, kube client.Reader, mg resource.Managed) error {
+	key, pc, err := ResolveProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return err
+	}
+	if pc.Quota == nil {
+		return nil
+	}
+	scope := key
+	if scope.Namespace == "" {
+		scope.Namespace = backend.DefaultTenants.Tenant(mg.GetNamespace())
+	}
+	usages, err := ListUsages(ctx, kube, scope)
+	if err != nil {
+		return err
+	}
+
+	// Usages are named after the UID of the managed resource that uses the
+	// provider config. A resource that isn't yet counted is last in line.
+	i := len(usages)
+	for j := range usages {
+		if usages[j].GetName() == string(mg.GetUID()) {
+			i = j
+			break
+		}
+	}
+	if int64(i) < pc.Quota.MaxResources {
+		return nil
+	}
+	return quotaExceeded{errors.Errorf(errQuotaExceededFmt, key, pc.Quota.MaxResources, len(usages))}
+}
+
+// ListUsages returns the usages of the provider config with the supplied key,
+// in the order managed resources started using it. The usages of a
+// ProviderConfig are in its namespace, while those of a
+// ClusterProviderConfig may be in any namespace.
+func ListUsages(ctx context.Context, kube client.Reader, key ProviderConfigKey) ([]apisv1alpha1.ProviderConfigUsage, error) {
+	l := &apisv1alpha1.ProviderConfigUsageList{}
+	opts := []client.ListOption{client.MatchingLabels{xpv1.LabelKeyProviderKind: key.Kind, xpv1.LabelKeyProviderName: key.Name}}
+	if key.Namespace != "" {
+		opts = append(opts, client.InNamespace(key.Namespace))
+	}
+	if err := kube.List(ctx, l, opts...); err != nil {
+		return nil, errors.Wrap(err, errListPCUs)
+	}
+	sort.SliceStable(l.Items, func(i, j int) bool {
+		ti, tj := l.Items[i].GetCreationTimestamp(), l.Items[j].GetCreationTimestamp()
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return l.Items[i].GetName() < l.Items[j].GetName()
+	})
+	return l.Items, nil
+}
+
+// A QuotaRecorder periodically records how many more managed resources may use
+// each provider config that has a quota.
+type QuotaRecorder struct {
+	client   client.Client
+	log      logging.Logger
+	gauge    *prometheus.GaugeVec
+	interval time.Duration
+}
+
+// NewQuotaRecorder returns a recorder that records the remaining quota of
+// every provider config every interval, using the supplied gauge.
+func NewQuotaRecorder(c client.Client, log logging.Logger, gauge *prometheus.GaugeVec, interval time.Duration) *QuotaRecorder {
+	return &QuotaRecorder{client: c, log: log, gauge: gauge, interval: interval}
+}
+
+// Record records the remaining quota of every provider config, for every
+// tenant that uses it. Provider configs that don't have a quota aren't
+// recorded.
+func (r *QuotaRecorder) Record(ctx context.Context) error {
+	pcs, err := listProviderConfigs(ctx, r.client)
+	if err != nil {
+		return err
+	}
+
+	remaining := make(map[quotaLabels]float64, len(pcs))
+	for key, pc := range pcs {
+		if pc.Quota == nil {
+			continue
+		}
+		usages, err := ListUsages(ctx, r.client, key)
+		if err != nil {
+			return err
+		}
+		used := make(map[string]int64)
+		for _, u := range usages {
+			used[backend.DefaultTenants.Tenant(u.GetNamespace())]++
+		}
+		if len(used) == 0 {
+			used[backend.DefaultTenants.Tenant(key.Namespace)] = 0
+		}
+		for tenant, n := range used {
+			remaining[quotaLabels{pc: key.String(), tenant: tenant}] = float64(max(pc.Quota.MaxResources-n, 0))
+		}
+	}
+
+	// Reset the gauge so that provider configs that were deleted, or whose
+	// quota was removed, are no longer recorded.
+	r.gauge.Reset()
+	for l, n := range remaining {
+		r.gauge.With(prometheus.Labels{metrics.LabelProviderConfig: l.pc, metrics.LabelTenant: l.tenant}).Set(n)
+	}
+	return nil
+}
+
+type quotaLabels struct {
+	pc     string
+	tenant string
+}
+
+// Start records the remaining quota of every provider config every interval,
+// until the supplied context is done. Failures to record are logged, and
+// retried at the next interval.
+func (r *QuotaRecorder) Start(ctx context.Context) error {
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := r.Record(ctx); err != nil {
+				r.log.Debug("Cannot record provider config quotas", "error", err)
+			}
+		}
+	}
+}
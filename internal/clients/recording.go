@@ -0,0 +1,41 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"sync/atomic"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+var (
+	recorder atomic.Pointer[backend.Recorder]
+	replay   atomic.Pointer[backend.Replay]
+)
+
+// RecordTo records every call made by the clients dialed from now on to the
+// supplied recorder.
+func RecordTo(r *backend.Recorder) {
+	recorder.Store(r)
+}
+
+// ReplayFrom serves every call made by the clients dialed from now on from
+// the supplied replay, in place of the backend their provider config
+// describes.
+func ReplayFrom(r *backend.Replay) {
+	replay.Store(r)
+}
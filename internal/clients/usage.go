@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+)
+
+const errTrackPCUsage = "cannot track ProviderConfig usage"
+
+// TrackUsage records that the supplied managed resource uses the provider
+// config with the supplied key, by creating or updating a
+// ProviderConfigUsage. A provider config can't be deleted while managed
+// resources use it. The key is that of the provider config the managed
+// resource's reference resolved to, which is a ClusterProviderConfig when a
+// reference to a ProviderConfig falls back to one.
+func TrackUsage(ctx context.Context, kube client.Client, mg resource.Managed, key ProviderConfigKey) error {
+	m, ok := mg.(resource.ModernManaged)
+	if !ok {
+		return errors.New(errNotModernManaged)
+	}
+	t := resource.NewProviderConfigUsageTracker(kube, &apisv1alpha1.ProviderConfigUsage{})
+	return errors.Wrap(t.Track(ctx, resolvedManaged{ModernManaged: m, key: key}), errTrackPCUsage)
+}
+
+// A resolvedManaged is a managed resource whose provider config reference is
+// the provider config it resolved to.
+type resolvedManaged struct {
+	resource.ModernManaged
+	key ProviderConfigKey
+}
+
+func (m resolvedManaged) GetProviderConfigReference() *xpv1.ProviderConfigReference {
+	return &xpv1.ProviderConfigReference{Kind: m.key.Kind, Name: m.key.Name}
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package composition generates a composite resource definition (XRD) and a
+// Composition that compose bork managed resources, so that composition flows
+// can be smoke tested against the provider.
+package composition
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+const (
+	errUnknownKindFmt = "cannot compose unknown kind %q; composable kinds are %s"
+	errNoKinds        = "at least one kind must be composed"
+	errRequiresFmt    = "cannot compose %s without %s"
+	errConvertFmt     = "cannot convert %s to unstructured"
+)
+
+// The composite resource version, and the functions the Composition's
+// pipeline calls.
+const (
+	Version = "v1alpha1"
+
+	FunctionPatchAndTransform = "function-patch-and-transform"
+	FunctionAutoReady         = "function-auto-ready"
+)
+
+// Default options.
+const (
+	DefaultGroup = "example.bork.crossplane.io"
+	DefaultKind  = "XBork"
+
+	DefaultPatchAndTransformPackage = "xpkg.crossplane.io/crossplane-contrib/function-patch-and-transform:v0.8.2"
+	DefaultAutoReadyPackage         = "xpkg.crossplane.io/crossplane-contrib/function-auto-ready:v0.5.0"
+)
+
+// Options configure the generated manifests.
+type Options struct {
+	// Group and Kind of the composite resource.
+	Group string
+	Kind  string
+
+	// Kinds of bork managed resources to compose.
+	Kinds []string
+
+	// Packages of the functions the Composition's pipeline calls.
+	PatchAndTransformPackage string
+	AutoReadyPackage         string
+}
+
+// A composable kind of bork managed resource.
+type composable struct {
+	// base returns the resource's base, before it's patched.
+	base func() runtime.Object
+
+	// patches from the composite resource to the composed resource, and back.
+	patches []map[string]any
+
+	// requires names the kinds that must be composed along with this one.
+	requires []string
+}
+
+// composables are the kinds of bork managed resources the Composition can
+// compose, keyed by kind.
+var composables = map[string]composable{
+	v1alpha1.BorkResourceKind: {
+		base: func() runtime.Object {
+			return &v1alpha1.BorkResource{Spec: v1alpha1.BorkResourceSpec{ForProvider: v1alpha1.BorkResourceParameters{BorkValue: map[string]string{"bork": "2"}, DataValue: map[string]string{"bork": "1"}}}}
+		},
+		patches: []map[string]any{
+			fromComposite("spec.parameters.borkValue", "spec.forProvider.borkValue"),
+			fromComposite("spec.parameters.dataValue", "spec.forProvider.dataValue"),
+			fromComposite("spec.parameters.team", "spec.forProvider.tags[team]"),
+			toComposite("status.atProvider.dataValue", "status.dataValue"),
+		},
+	},
+	v1alpha1.BorkBucketKind: {
+		base: func() runtime.Object {
+			return &v1alpha1.BorkBucket{Spec: v1alpha1.BorkBucketSpec{ForProvider: v1alpha1.BorkBucketParameters{
+				Versioning: &v1alpha1.VersioningConfiguration{Enabled: true},
+			}}}
+		},
+		patches: []map[string]any{
+			fromComposite("spec.parameters.team", "spec.forProvider.tags[team]"),
+			toComposite("metadata.annotations[crossplane.io/external-name]", "status.bucketName"),
+		},
+	},
+	v1alpha1.BorkObjectKind: {
+		base: func() runtime.Object {
+			return &v1alpha1.BorkObject{Spec: v1alpha1.BorkObjectSpec{ForProvider: v1alpha1.BorkObjectParameters{
+				// The bucket's name is generated, so we select the bucket
+				// composed by the same composite resource.
+				BucketSelector: &xpv1.NamespacedSelector{MatchControllerRef: ptr.To(true)},
+				Key:            "borkgen/doh.txt",
+				Content:        "doh!",
+			}}}
+		},
+		patches: []map[string]any{
+			fromComposite("spec.parameters.content", "spec.forProvider.content"),
+		},
+		requires: []string{v1alpha1.BorkBucketKind},
+	},
+	v1alpha1.BorkQueueKind: {
+		base: func() runtime.Object {
+			return &v1alpha1.BorkQueue{Spec: v1alpha1.BorkQueueSpec{ForProvider: v1alpha1.BorkQueueParameters{VisibilityTimeoutSeconds: ptr.To[int64](60)}}}
+		},
+		patches: []map[string]any{
+			fromComposite("spec.parameters.team", "spec.forProvider.tags[team]"),
+		},
+	},
+}
+
+// ComposableKinds returns the kinds of bork managed resources the Composition
+// can compose, sorted by name.
+func ComposableKinds() []string {
+	kinds := make([]string, 0, len(composables))
+	for k := range composables {
+		kinds = append(kinds, k)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// Plural returns the plural of the composite resource kind.
+func (o Options) Plural() string {
+	return strings.ToLower(o.Kind) + "s"
+}
+
+// Validate returns an error if the options can't be generated.
+func (o Options) Validate() error {
+	if len(o.Kinds) == 0 {
+		return errors.New(errNoKinds)
+	}
+	for _, k := range o.Kinds {
+		c, ok := composables[k]
+		if !ok {
+			return errors.Errorf(errUnknownKindFmt, k, strings.Join(ComposableKinds(), ", "))
+		}
+		for _, r := range c.requires {
+			if !slices.Contains(o.Kinds, r) {
+				return errors.Errorf(errRequiresFmt, k, r)
+			}
+		}
+	}
+	return nil
+}
+
+// Definition returns a namespaced composite resource definition. Crossplane v2
+// composes namespaced managed resources, like bork's, only from namespaced
+// composite resources, which take the place of claims.
+func Definition(o Options) *unstructured.Unstructured {
+	spec := object(map[string]any{
+		"parameters": object(map[string]any{
+			"borkValue": stringMap("The value the composed BorkResource borks its record with.", map[string]any{"bork": "2"}),
+			"dataValue": stringMap("The value the composed BorkResource writes to its record when it's created.", map[string]any{"bork": "1"}),
+			"team":      str("The team tag of composed resources that can be tagged."),
+			"content":   str("The content of the composed BorkObject."),
+		}),
+	})
+	status := object(map[string]any{
+		"dataValue":  stringMap("The data value last observed in the composed BorkResource's record.", nil),
+		"bucketName": str("The name of the composed BorkBucket's bucket."),
+	})
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiextensions.crossplane.io/v2",
+		"kind":       "CompositeResourceDefinition",
+		"metadata":   map[string]any{"name": o.Plural() + "." + o.Group},
+		"spec": map[string]any{
+			"group": o.Group,
+			"names": map[string]any{
+				"kind":       o.Kind,
+				"plural":     o.Plural(),
+				"categories": []any{"crossplane", "composite", "bork"},
+			},
+			"scope":                 "Namespaced",
+			"defaultCompositionRef": map[string]any{"name": o.Plural() + "." + o.Group},
+			"versions": []any{map[string]any{
+				"name":          Version,
+				"served":        true,
+				"referenceable": true,
+				"schema": map[string]any{
+					"openAPIV3Schema": object(map[string]any{"spec": spec, "status": status}),
+				},
+			}},
+		},
+	}}
+}
+
+// Composition returns a Composition that composes the configured kinds using
+// a pipeline of functions.
+func Composition(o Options) (*unstructured.Unstructured, error) {
+	resources := make([]any, 0, len(o.Kinds))
+	for _, k := range o.Kinds {
+		c := composables[k]
+		base, err := toBase(c.base())
+		if err != nil {
+			return nil, errors.Wrapf(err, errConvertFmt, k)
+		}
+		base["apiVersion"] = v1alpha1.SchemeGroupVersion.String()
+		base["kind"] = k
+		patches := make([]any, len(c.patches))
+		for i := range c.patches {
+			patches[i] = c.patches[i]
+		}
+		resources = append(resources, map[string]any{
+			"name":    strings.ToLower(strings.TrimPrefix(k, "Bork")),
+			"base":    base,
+			"patches": patches,
+		})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiextensions.crossplane.io/v1",
+		"kind":       "Composition",
+		"metadata":   map[string]any{"name": o.Plural() + "." + o.Group},
+		"spec": map[string]any{
+			"compositeTypeRef": map[string]any{"apiVersion": o.Group + "/" + Version, "kind": o.Kind},
+			"mode":             "Pipeline",
+			"pipeline": []any{
+				map[string]any{
+					"step":        "patch-and-transform",
+					"functionRef": map[string]any{"name": FunctionPatchAndTransform},
+					"input": map[string]any{
+						"apiVersion": "pt.fn.crossplane.io/v1beta1",
+						"kind":       "Resources",
+						"resources":  resources,
+					},
+				},
+				map[string]any{
+					"step":        "automatically-detect-ready-composed-resources",
+					"functionRef": map[string]any{"name": FunctionAutoReady},
+				},
+			},
+		},
+	}}, nil
+}
+
+// Functions returns the functions the Composition's pipeline calls.
+func Functions(o Options) []*unstructured.Unstructured {
+	fn := func(name, pkg string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "pkg.crossplane.io/v1",
+			"kind":       "Function",
+			"metadata":   map[string]any{"name": name},
+			"spec":       map[string]any{"package": pkg},
+		}}
+	}
+	return []*unstructured.Unstructured{
+		fn(FunctionPatchAndTransform, o.PatchAndTransformPackage),
+		fn(FunctionAutoReady, o.AutoReadyPackage),
+	}
+}
+
+// Composite returns an example composite resource.
+func Composite(o Options) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": o.Group + "/" + Version,
+		"kind":       o.Kind,
+		"metadata":   map[string]any{"name": "doh-" + strings.ToLower(o.Kind), "namespace": "default"},
+		"spec": map[string]any{
+			"parameters": map[string]any{
+				"borkValue": map[string]any{"bork": "2"},
+				"dataValue": map[string]any{"bork": "1"},
+				"team":      "bork",
+				"content":   "doh!",
+			},
+		},
+	}}
+}
+
+// toBase converts the supplied managed resource to the base of a composed
+// resource. Bases don't have metadata or status.
+func toBase(obj runtime.Object) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(u, "metadata")
+	delete(u, "status")
+	return u, nil
+}
+
+func fromComposite(from, to string) map[string]any {
+	return map[string]any{"type": "FromCompositeFieldPath", "fromFieldPath": from, "toFieldPath": to}
+}
+
+func toComposite(from, to string) map[string]any {
+	return map[string]any{"type": "ToCompositeFieldPath", "fromFieldPath": from, "toFieldPath": to}
+}
+
+func object(properties map[string]any) map[string]any {
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+func stringMap(description string, def map[string]any) map[string]any {
+	s := map[string]any{"type": "object", "description": description, "additionalProperties": map[string]any{"type": "string"}}
+	if def != nil {
+		s["default"] = def
+	}
+	return s
+}
+
+func str(description string) map[string]any {
+	return map[string]any{"type": "string", "description": description}
+}
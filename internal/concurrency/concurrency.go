@@ -0,0 +1,276 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package concurrency limits how many managed resources of each kind, and
+// that use each provider config, are reconciled at once. Unlike a
+// controller's MaxConcurrentReconciles, which is fixed when the controller
+// starts, limits can be changed at runtime by editing a ConfigMap or a
+// provider config.
+package concurrency
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+const (
+	errLimitFmt    = "limit of %s must be a whole number of at least 1, not %q"
+	errGetLimits   = "cannot get concurrency limits ConfigMap"
+	errSetupLimits = "cannot setup concurrency limits controller"
+	errAcquire     = "cannot wait for a worker"
+)
+
+// Default limits the controllers of every kind.
+var Default = NewLimits()
+
+// Limits are the concurrency limits of each kind of managed resource.
+type Limits struct {
+	mu       sync.Mutex
+	workers  int
+	defaults map[string]int
+	limits   map[string]*limit
+	configs  map[string]*semaphore
+}
+
+// NewLimits returns limits under which every kind may use all of its
+// controller's workers.
+func NewLimits() *Limits {
+	return &Limits{workers: 1, defaults: map[string]int{}, limits: map[string]*limit{}, configs: map[string]*semaphore{}}
+}
+
+// Parse parses limits keyed by kind, e.g. BorkResource=4.
+func Parse(m map[string]string) (map[string]int, error) {
+	limits := make(map[string]int, len(m))
+	for kind, v := range m {
+		n, err := parseLimit(kind, v)
+		if err != nil {
+			return nil, err
+		}
+		limits[kind] = n
+	}
+	return limits, nil
+}
+
+func parseLimit(kind, v string) (int, error) {
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 {
+		return 0, errors.Errorf(errLimitFmt, kind, v)
+	}
+	return n, nil
+}
+
+// Configure the number of workers each controller runs, and the default limit
+// of each kind. A limit can't exceed the number of workers; a kind without a
+// default limit may use all of them.
+func (l *Limits) Configure(workers int, defaults map[string]int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.workers = max(workers, 1)
+	l.defaults = defaults
+	for kind, lim := range l.limits {
+		lim.set(l.clamp(l.defaults[kind]))
+	}
+}
+
+// Workers returns the number of workers each controller runs.
+func (l *Limits) Workers() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.workers
+}
+
+// Set the limits of the supplied kinds. Kinds that aren't supplied revert to
+// their default limit.
+func (l *Limits) Set(limits map[string]int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for kind, lim := range l.limits {
+		n, ok := limits[kind]
+		if !ok {
+			n = l.defaults[kind]
+		}
+		lim.set(l.clamp(n))
+	}
+}
+
+// Limit returns the current limit of the supplied kind.
+func (l *Limits) Limit(kind string) int {
+	return l.get(kind).current()
+}
+
+// Reconciler wraps the supplied reconciler such that no more reconciles of
+// the supplied kind than its limit run at once. Reconciles beyond the limit
+// wait, holding their worker, until another finishes or the limit is raised.
+func (l *Limits) Reconciler(kind string, r reconcile.Reconciler) reconcile.Reconciler {
+	lim := l.get(kind)
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if err := lim.acquire(ctx); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errAcquire)
+		}
+		defer lim.release()
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// Setup watches the ConfigMap with the supplied namespace and name, setting
+// the limits of the kinds its data names, e.g. BorkResource: "4", whenever it
+// changes. Invalid limits are logged and ignored. Limits revert to their
+// defaults if the ConfigMap is deleted. The manager's cache should only cache
+// the ConfigMap, not every ConfigMap it can read.
+func (l *Limits) Setup(mgr ctrl.Manager, log logging.Logger, namespace, name string) error {
+	log = log.WithValues("controller", "concurrency", "configmap", namespace+"/"+name)
+	kube := mgr.GetClient()
+
+	r := reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		cm := &corev1.ConfigMap{}
+		err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm)
+		if kerrors.IsNotFound(err) {
+			l.Set(nil)
+			log.Info("Reverted to default concurrency limits")
+			return reconcile.Result{}, nil
+		}
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errGetLimits)
+		}
+
+		limits := make(map[string]int, len(cm.Data))
+		for kind, v := range cm.Data {
+			n, err := parseLimit(kind, v)
+			if err != nil {
+				log.Info("Ignoring invalid concurrency limit", "error", err)
+				continue
+			}
+			limits[kind] = n
+		}
+		l.Set(limits)
+		log.Info("Set concurrency limits", "limits", limits, "workers", l.Workers())
+		return reconcile.Result{}, nil
+	})
+
+	return errors.Wrap(ctrl.NewControllerManagedBy(mgr).
+		Named("concurrency").
+		For(&corev1.ConfigMap{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(o client.Object) bool {
+			return o.GetNamespace() == namespace && o.GetName() == name
+		}))).
+		Complete(r), errSetupLimits)
+}
+
+// get returns the limit of the supplied kind, creating it if necessary.
+func (l *Limits) get(kind string) *limit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if lim, ok := l.limits[kind]; ok {
+		return lim
+	}
+	lim := newLimit(kind, l.clamp(l.defaults[kind]))
+	l.limits[kind] = lim
+	return lim
+}
+
+// clamp returns the supplied limit, or all workers if it is unset or higher.
+// The caller must hold the lock.
+func (l *Limits) clamp(n int) int {
+	if n < 1 || n > l.workers {
+		return l.workers
+	}
+	return n
+}
+
+// A limit is a semaphore whose size can be changed while it's held.
+type limit struct {
+	kind string
+
+	mu      sync.Mutex
+	active  int
+	max     int
+	changed chan struct{}
+}
+
+func newLimit(kind string, n int) *limit {
+	lim := &limit{kind: kind, max: n, changed: make(chan struct{})}
+	lim.record()
+	return lim
+}
+
+func (lim *limit) current() int {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	return lim.max
+}
+
+func (lim *limit) set(n int) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.max = n
+	lim.broadcast()
+}
+
+func (lim *limit) acquire(ctx context.Context) error {
+	for {
+		lim.mu.Lock()
+		if lim.active < lim.max {
+			lim.active++
+			lim.record()
+			lim.mu.Unlock()
+			return nil
+		}
+		changed := lim.changed
+		lim.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (lim *limit) release() {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.active--
+	lim.broadcast()
+}
+
+// broadcast wakes every waiting reconcile, and records the limit's metrics.
+// The caller must hold the lock.
+func (lim *limit) broadcast() {
+	close(lim.changed)
+	lim.changed = make(chan struct{})
+	lim.record()
+}
+
+// record records the limit's metrics. The caller must hold the lock.
+func (lim *limit) record() {
+	metrics.ReconcileWorkersActive.WithLabelValues(lim.kind).Set(float64(lim.active))
+	metrics.ReconcileWorkersLimit.WithLabelValues(lim.kind).Set(float64(lim.max))
+	metrics.ReconcileWorkerSaturation.WithLabelValues(lim.kind).Set(float64(lim.active) / float64(lim.max))
+}
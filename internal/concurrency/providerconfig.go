@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+const errNewManagedFmt = "cannot create managed resource of kind %s"
+
+// DeferInterval is roughly how long a reconcile that was deferred because its
+// provider config was at its concurrency limit waits to be retried. Each wait
+// is jittered so that deferred reconciles don't all retry at once.
+const DeferInterval = time.Second
+
+// ProviderConfigReconciler wraps the supplied reconciler of the supplied kind
+// of managed resource such that no more managed resources that use a provider
+// config are reconciled at once, across every kind, than its concurrency
+// limit. Unlike reconciles beyond a kind's limit, reconciles beyond a provider
+// config's limit don't wait; they're requeued after about DeferInterval, so
+// that their worker is free to reconcile managed resources that use other
+// provider configs. Managed resources that can't be read, or whose provider
+// config can't be resolved, are reconciled without limit so that the wrapped
+// reconciler reports why.
+func (l *Limits) ProviderConfigReconciler(mgr ctrl.Manager, of resource.ManagedKind, r reconcile.Reconciler) reconcile.Reconciler {
+	kube := mgr.GetClient()
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		obj, err := mgr.GetScheme().New(schema.GroupVersionKind(of))
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, errNewManagedFmt, of)
+		}
+		mg, ok := obj.(resource.Managed)
+		if !ok {
+			return reconcile.Result{}, errors.Errorf(errNewManagedFmt, of)
+		}
+		if err := kube.Get(ctx, req.NamespacedName, mg); err != nil {
+			return r.Reconcile(ctx, req)
+		}
+		key, pc, err := clients.ResolveProviderConfig(ctx, kube, mg)
+		if err != nil {
+			return r.Reconcile(ctx, req)
+		}
+
+		n := 0
+		if pc.Concurrency != nil {
+			n = int(pc.Concurrency.MaxConcurrentReconciles)
+		}
+		sem := l.providerConfig(key.String())
+		if !sem.tryAcquire(n) {
+			metrics.ProviderConfigReconcilesDeferred.WithLabelValues(key.String()).Inc()
+			return reconcile.Result{RequeueAfter: wait.Jitter(DeferInterval, 1)}, nil
+		}
+		defer sem.release()
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// providerConfig returns the semaphore of the supplied provider config,
+// creating it if necessary.
+func (l *Limits) providerConfig(pc string) *semaphore {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if sem, ok := l.configs[pc]; ok {
+		return sem
+	}
+	sem := &semaphore{pc: pc}
+	l.configs[pc] = sem
+	return sem
+}
+
+// A semaphore counts the reconciles of managed resources that use a provider
+// config. Its size is that of the provider config's limit as of the most
+// recent reconcile, so that the limit can be changed by editing the provider
+// config.
+type semaphore struct {
+	pc string
+
+	mu     sync.Mutex
+	active int
+	max    int
+}
+
+// tryAcquire the semaphore, setting its size to the supplied limit. A limit
+// less than 1 is no limit. It returns false if the semaphore is full.
+func (s *semaphore) tryAcquire(n int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.max = n
+	if s.max > 0 && s.active >= s.max {
+		s.record()
+		return false
+	}
+	s.active++
+	s.record()
+	return true
+}
+
+func (s *semaphore) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.active--
+	s.record()
+}
+
+// record records the semaphore's metrics. The caller must hold the lock.
+func (s *semaphore) record() {
+	metrics.ProviderConfigReconcilesActive.WithLabelValues(s.pc).Set(float64(s.active))
+	if s.max < 1 {
+		metrics.ProviderConfigReconcilesLimit.DeleteLabelValues(s.pc)
+		return
+	}
+	metrics.ProviderConfigReconcilesLimit.WithLabelValues(s.pc).Set(float64(s.max))
+}
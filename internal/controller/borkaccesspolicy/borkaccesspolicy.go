@@ -0,0 +1,267 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkaccesspolicy
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkAccessPolicy = "managed resource is not a BorkAccessPolicy custom resource"
+
+	errGetPolicy    = "cannot get access policy"
+	errCreatePolicy = "cannot create access policy"
+	errUpdatePolicy = "cannot update access policy"
+	errDeletePolicy = "cannot delete access policy"
+	errDiffPolicy   = "cannot compare access policy documents"
+)
+
+// SetupGated adds a controller that reconciles BorkAccessPolicy managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkAccessPolicy controller"))
+		}
+	}, v1alpha1.BorkAccessPolicyGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkAccessPolicyGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkAccessPolicyKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkAccessPolicyList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithTagPropagation(),
+		)),
+		// The backend assigns each policy's external name when it is created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkAccessPolicyList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkAccessPolicyList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkAccessPolicyList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkAccessPolicyList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindAccessPolicy, func() resource.ManagedList { return &v1alpha1.BorkAccessPolicyList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkAccessPolicyList")
+		}
+	}
+
+	leak.Default.Register(backend.KindAccessPolicy, func() resource.ManagedList { return &v1alpha1.BorkAccessPolicyList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkAccessPolicyGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkAccessPolicy{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkAccessPolicyKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkAccessPolicy{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindAccessPolicy, func() resource.ManagedList { return &v1alpha1.BorkAccessPolicyList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkAccessPolicyGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkAccessPolicyGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkAccessPolicyGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkAccessPolicyKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles access policies in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkAccessPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkAccessPolicy)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p, err := c.service.GetAccessPolicy(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicy)
+	}
+	cr.Status.AtProvider = generateObservation(p)
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	// The backend never returns the document it was sent, only a normalized
+	// one, so the documents must be compared semantically. Comparing them
+	// as strings would find a diff on every observation, and update the
+	// policy forever.
+	d, err := diffPolicies(cr.Spec.ForProvider.Policy, p.Document)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDiffPolicy)
+	}
+	d += cmp.Diff(cr.Spec.ForProvider.Tags, p.Tags, cmpopts.EquateEmpty())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: d == "",
+		Diff:             d,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkAccessPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkAccessPolicy)
+	}
+
+	p, err := c.service.CreateAccessPolicy(ctx, generatePolicy(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicy)
+	}
+	meta.SetExternalName(cr, p.Name)
+	cr.Status.AtProvider = generateObservation(p)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkAccessPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkAccessPolicy)
+	}
+
+	p := generatePolicy(cr.Spec.ForProvider)
+	p.Name = meta.GetExternalName(cr)
+	p, err := c.service.UpdateAccessPolicy(ctx, p)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
+	}
+	cr.Status.AtProvider = generateObservation(p)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkAccessPolicy)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkAccessPolicy)
+	}
+
+	if err := c.service.DeleteAccessPolicy(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeletePolicy)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+func generatePolicy(p v1alpha1.BorkAccessPolicyParameters) backend.AccessPolicy {
+	return backend.AccessPolicy{
+		Document: p.Policy,
+		Tags:     p.Tags,
+	}
+}
+
+func generateObservation(p backend.AccessPolicy) v1alpha1.BorkAccessPolicyObservation {
+	return v1alpha1.BorkAccessPolicyObservation{
+		Policy:   p.Document,
+		Tags:     p.Tags,
+		Revision: p.Revision,
+	}
+}
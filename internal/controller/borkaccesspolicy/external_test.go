@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkaccesspolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the access policy the fake backend stores.
+const existingName = "policy-existing"
+
+// Policy documents. The backend normalizes what it's sent, so the document it
+// stores for readDocument isn't the same string, but is equivalent.
+const (
+	readDocument  = `{"Statement": {"Action": ["bork:Get", "bork:List"], "Resource": "*"}}`
+	writeDocument = `{"Statement": {"Action": "bork:Update", "Resource": "*"}}`
+)
+
+// newBorkAccessPolicy returns a BorkAccessPolicy whose spec is the supplied
+// policy document.
+func newBorkAccessPolicy(document string) *v1alpha1.BorkAccessPolicy {
+	return &v1alpha1.BorkAccessPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec:       v1alpha1.BorkAccessPolicySpec{ForProvider: v1alpha1.BorkAccessPolicyParameters{Policy: document}},
+	}
+}
+
+// newExternal returns an external client of a fake backend storing the
+// supplied access policies.
+func newExternal(t *testing.T, policies ...backend.AccessPolicy) *external {
+	t.Helper()
+	f := borkfake.New()
+	for _, p := range policies {
+		if _, err := f.Store.CreateAccessPolicy(context.Background(), p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &external{service: f.Client}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o    managed.ExternalObservation
+		diff bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		policies []backend.AccessPolicy
+		document string
+		want     want
+	}{
+		"Normalized": {
+			reason:   "A policy whose document the backend normalized is up to date, because it's equivalent to the spec's.",
+			policies: []backend.AccessPolicy{{Name: existingName, Document: readDocument}},
+			document: `{"Version": "2025-01-01", "Statement": [{"Effect": "Allow", "Resource": ["*"], "Action": ["bork:List", "bork:Get"]}]}`,
+			want:     want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NotUpToDate": {
+			reason:   "A policy whose document isn't equivalent to the spec's is out of date.",
+			policies: []backend.AccessPolicy{{Name: existingName, Document: readDocument}},
+			document: writeDocument,
+			want:     want{o: managed.ExternalObservation{ResourceExists: true}, diff: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkAccessPolicy(tc.document)
+			meta.SetExternalName(cr, existingName)
+			e := newExternal(t, tc.policies...)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if got := o.Diff != ""; got != tc.want.diff {
+				t.Errorf("\n%s\nObserve(...): got diff %q, want a diff: %t", tc.reason, o.Diff, tc.want.diff)
+			}
+		})
+	}
+}
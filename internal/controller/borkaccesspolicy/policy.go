@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkaccesspolicy
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const (
+	errParseDesiredPolicy  = "cannot parse desired policy document"
+	errParseObservedPolicy = "cannot parse observed policy document"
+)
+
+// equivalentOptions compare the canonical forms of two policy documents.
+// Numbers are compared as the float64s they're decoded to.
+var equivalentOptions = []cmp.Option{
+	cmpopts.EquateEmpty(),
+}
+
+// diffPolicies returns a human-readable diff of the desired and observed
+// policy documents, or an empty string if they're semantically equivalent.
+// Documents are equivalent if they're the same once both are decoded and
+// canonicalized, so key order, whitespace, the defaults the backend fills in
+// and the way it abbreviates lists never cause a diff.
+func diffPolicies(desired, observed string) (string, error) {
+	d, err := canonicalPolicy(desired)
+	if err != nil {
+		return "", errors.Wrap(err, errParseDesiredPolicy)
+	}
+	o, err := canonicalPolicy(observed)
+	if err != nil {
+		return "", errors.Wrap(err, errParseObservedPolicy)
+	}
+	return cmp.Diff(d, o, equivalentOptions...), nil
+}
+
+// canonicalPolicy decodes the supplied policy document to its canonical
+// form. It fills in the defaults the backend does, always represents
+// statements, actions and resources as lists, and sorts actions and
+// resources, whose order doesn't matter.
+func canonicalPolicy(document string) (map[string]any, error) {
+	doc := map[string]any{}
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, err
+	}
+	if _, ok := doc[backend.PolicyFieldVersion]; !ok {
+		doc[backend.PolicyFieldVersion] = backend.DefaultPolicyVersion
+	}
+
+	var statements []any
+	switch s := doc[backend.PolicyFieldStatement].(type) {
+	case map[string]any:
+		statements = []any{s}
+	case []any:
+		statements = s
+	default:
+		return doc, nil
+	}
+	for _, s := range statements {
+		st, ok := s.(map[string]any)
+		if !ok {
+			continue
+		}
+		if _, ok := st[backend.PolicyFieldEffect]; !ok {
+			st[backend.PolicyFieldEffect] = backend.DefaultPolicyEffect
+		}
+		for _, f := range []string{backend.PolicyFieldAction, backend.PolicyFieldResource} {
+			if v, ok := st[f]; ok {
+				st[f] = canonicalList(v)
+			}
+		}
+	}
+	doc[backend.PolicyFieldStatement] = statements
+	return doc, nil
+}
+
+// canonicalList returns the supplied action or resource as a sorted list, if
+// it's a string or a list of strings. Anything else is returned unchanged.
+func canonicalList(v any) any {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		l := make([]string, 0, len(v))
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return v
+			}
+			l = append(l, s)
+		}
+		slices.Sort(l)
+		return l
+	}
+	return v
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkbucket
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkBucket = "managed resource is not a BorkBucket custom resource"
+
+	errGetBucket    = "cannot get bucket"
+	errCreateBucket = "cannot create bucket"
+	errUpdateBucket = "cannot update bucket"
+	errDeleteBucket = "cannot delete bucket"
+)
+
+// SetupGated adds a controller that reconciles BorkBucket managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkBucket controller"))
+		}
+	}, v1alpha1.BorkBucketGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkBucketGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkBucketKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkBucketList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithTagPropagation(),
+		)),
+		// The backend assigns each bucket's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkBucketList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkBucketList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkBucketList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkBucketList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindBucket, func() resource.ManagedList { return &v1alpha1.BorkBucketList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkBucketList")
+		}
+	}
+
+	leak.Default.Register(backend.KindBucket, func() resource.ManagedList { return &v1alpha1.BorkBucketList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkBucketGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkBucket{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkBucketKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkBucket{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindBucket, func() resource.ManagedList { return &v1alpha1.BorkBucketList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkBucketGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkBucketGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkBucketGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkBucketKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles buckets in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkBucket)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkBucket)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	b, err := c.service.GetBucket(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetBucket)
+	}
+	cr.Status.AtProvider = generateObservation(b)
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	d := diff(generateBucket(cr.Spec.ForProvider), b)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkBucket)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkBucket)
+	}
+
+	b, err := c.service.CreateBucket(ctx, generateBucket(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBucket)
+	}
+	meta.SetExternalName(cr, b.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkBucket)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkBucket)
+	}
+
+	b := generateBucket(cr.Spec.ForProvider)
+	b.Name = meta.GetExternalName(cr)
+	if _, err := c.service.UpdateBucket(ctx, b); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBucket)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkBucket)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkBucket)
+	}
+
+	if err := c.service.DeleteBucket(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteBucket)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// generateBucket returns the backend bucket described by the supplied
+// parameters.
+func generateBucket(p v1alpha1.BorkBucketParameters) backend.Bucket {
+	b := backend.Bucket{
+		Tags:       p.Tags,
+		Versioning: p.Versioning != nil && p.Versioning.Enabled,
+	}
+	for _, r := range p.LifecycleRules {
+		b.LifecycleRules = append(b.LifecycleRules, backend.LifecycleRule{
+			ID:             r.ID,
+			Prefix:         r.Prefix,
+			ExpirationDays: r.ExpirationDays,
+			Enabled:        ptr.Deref(r.Enabled, true),
+		})
+	}
+	return b
+}
+
+func generateObservation(b backend.Bucket) v1alpha1.BorkBucketObservation {
+	o := v1alpha1.BorkBucketObservation{
+		Tags:       b.Tags,
+		Versioning: b.Versioning,
+		Revision:   b.Revision,
+	}
+	for _, r := range b.LifecycleRules {
+		o.LifecycleRules = append(o.LifecycleRules, v1alpha1.LifecycleRule{
+			ID:             r.ID,
+			Prefix:         r.Prefix,
+			ExpirationDays: r.ExpirationDays,
+			Enabled:        ptr.To(r.Enabled),
+		})
+	}
+	return o
+}
+
+// bucketCompareOptions compare the fields of a bucket that are under our
+// control. Lifecycle rules are keyed by ID, so their order is insignificant,
+// and an empty map or slice is equivalent to an omitted one.
+var bucketCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.Bucket{}, "Name", "Revision"),
+	cmpopts.SortSlices(func(a, b backend.LifecycleRule) bool { return a.ID < b.ID }),
+	cmpopts.EquateEmpty(),
+}
+
+// diff returns a human-readable diff of the desired and observed buckets, or
+// an empty string if the observed bucket is up to date.
+func diff(desired, observed backend.Bucket) string {
+	return cmp.Diff(desired, observed, bucketCompareOptions...)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkbucket
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the bucket the fake backend stores.
+const existingName = "bucket-existing"
+
+// newBorkBucket returns a versioned BorkBucket with two lifecycle rules, one
+// of which doesn't say whether it's enabled.
+func newBorkBucket() *v1alpha1.BorkBucket {
+	return &v1alpha1.BorkBucket{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkBucketSpec{ForProvider: v1alpha1.BorkBucketParameters{
+			LifecycleRules: []v1alpha1.LifecycleRule{
+				{ID: "logs", Prefix: "logs/", ExpirationDays: 7},
+				{ID: "tmp", Prefix: "tmp/", ExpirationDays: 1, Enabled: ptr.To(false)},
+			},
+			Versioning: &v1alpha1.VersioningConfiguration{Enabled: true},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	logs := backend.LifecycleRule{ID: "logs", Prefix: "logs/", ExpirationDays: 7, Enabled: true}
+	tmp := backend.LifecycleRule{ID: "tmp", Prefix: "tmp/", ExpirationDays: 1}
+
+	cases := map[string]struct {
+		reason   string
+		bucket   backend.Bucket
+		upToDate bool
+	}{
+		"RulesReordered": {
+			reason:   "Lifecycle rules are keyed by ID, so a bucket whose rules are in another order is up to date.",
+			bucket:   backend.Bucket{LifecycleRules: []backend.LifecycleRule{tmp, logs}, Versioning: true},
+			upToDate: true,
+		},
+		"RuleDisabled": {
+			reason: "A rule that doesn't say whether it's enabled is enabled, so a bucket whose rule is disabled is out of date.",
+			bucket: backend.Bucket{LifecycleRules: []backend.LifecycleRule{{ID: "logs", Prefix: "logs/", ExpirationDays: 7}, tmp}, Versioning: true},
+		},
+		"VersioningSuspended": {
+			reason: "A bucket whose versioning is suspended is out of date.",
+			bucket: backend.Bucket{LifecycleRules: []backend.LifecycleRule{logs, tmp}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := borkfake.New()
+			tc.bucket.Name = existingName
+			if _, err := f.Store.CreateBucket(context.Background(), tc.bucket); err != nil {
+				t.Fatal(err)
+			}
+			cr := newBorkBucket()
+			meta.SetExternalName(cr, existingName)
+
+			o, err := (&external{service: f.Client}).Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			if o.ResourceUpToDate != tc.upToDate {
+				t.Errorf("\n%s\nObserve(...): got up to date %t, want %t:\n%s", tc.reason, o.ResourceUpToDate, tc.upToDate, o.Diff)
+			}
+		})
+	}
+}
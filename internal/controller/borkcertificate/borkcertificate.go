@@ -0,0 +1,321 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkcertificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkCertificate = "managed resource is not a BorkCertificate custom resource"
+
+	errGetCertificate    = "cannot get certificate"
+	errCreateCertificate = "cannot issue certificate"
+	errDeleteCertificate = "cannot delete certificate"
+)
+
+// Reasons of the events recorded as a certificate approaches expiry, and
+// expires.
+const (
+	reasonExpiringSoon event.Reason = "CertificateExpiringSoon"
+	reasonExpired      event.Reason = "CertificateExpired"
+)
+
+// ExpiryWarningFraction is the fraction of a certificate's TTL before it
+// expires that its BorkCertificate records a warning event every time it's
+// observed.
+const ExpiryWarningFraction = 0.2
+
+// ConnectionDetailSerialNumber is the connection detail a BorkCertificate
+// publishes its certificate's serial number as.
+const ConnectionDetailSerialNumber = "serialNumber"
+
+// SetupGated adds a controller that reconciles BorkCertificate managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkCertificate controller"))
+		}
+	}, v1alpha1.BorkCertificateGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkCertificateGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkCertificateKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkCertificateList{} },
+			&connector{
+				kube:   mgr.GetClient(),
+				pool:   clients.DefaultPool,
+				record: recorder,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithConnectionDetailTemplates(),
+		)),
+		// The backend assigns each certificate's external name when it is
+		// issued.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithPollIntervalHook(pollInterval),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkCertificateList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkCertificateList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkCertificateList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkCertificateList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindCertificate, func() resource.ManagedList { return &v1alpha1.BorkCertificateList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkCertificateList")
+		}
+	}
+
+	leak.Default.Register(backend.KindCertificate, func() resource.ManagedList { return &v1alpha1.BorkCertificateList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkCertificateGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkCertificate{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkCertificateKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkCertificate{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindCertificate, func() resource.ManagedList { return &v1alpha1.BorkCertificateList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkCertificateGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkCertificateGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkCertificateGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkCertificateKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	pool   *clients.Pool
+	record event.Recorder
+}
+
+// Connect produces an ExternalClient that reconciles certificates in the
+// simulated backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc, record: c.record}, nil
+}
+
+// An ExternalClient observes, then either creates or deletes an external
+// resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+	record  event.Recorder
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkCertificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkCertificate)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The backend removes a certificate once it expires. Reporting that it
+	// doesn't exist causes the managed reconciler to issue a new one.
+	cert, err := c.service.GetCertificate(ctx, name)
+	if backend.IsNotFound(err) {
+		if expired(cr, time.Now()) && !meta.WasDeleted(cr) {
+			c.record.Event(cr, event.Warning(reasonExpired, errors.Errorf("certificate %q expired at %s; issuing a new one", name, cr.Status.AtProvider.NotAfter.Format(time.RFC3339))))
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCertificate)
+	}
+	cr.Status.AtProvider = generateObservation(cert, cr.Status.AtProvider.Renewals)
+
+	if d := time.Until(cert.NotAfter); d <= warnBefore(cert.TTL) {
+		c.record.Event(cr, event.Warning(reasonExpiringSoon, errors.Errorf("certificate %q expires in %s", name, d.Round(time.Second))))
+	}
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	// Nothing about a certificate can be changed once it's issued, so it's
+	// always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{ConnectionDetailSerialNumber: []byte(cert.SerialNumber)},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkCertificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkCertificate)
+	}
+
+	cert, err := c.service.CreateCertificate(ctx, backend.Certificate{
+		CommonName: cr.Spec.ForProvider.CommonName,
+		DNSNames:   cr.Spec.ForProvider.DNSNames,
+		TTL:        cr.Spec.ForProvider.TTL.Duration,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCertificate)
+	}
+
+	// A BorkCertificate that already observed a certificate is renewing an
+	// expired one.
+	renewals := cr.Status.AtProvider.Renewals
+	if cr.Status.AtProvider.NotAfter != nil {
+		renewals++
+	}
+	meta.SetExternalName(cr, cert.Name)
+	cr.Status.AtProvider = generateObservation(cert, renewals)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{ConnectionDetailSerialNumber: []byte(cert.SerialNumber)},
+	}, nil
+}
+
+// Update does nothing. A certificate's parameters can't be changed once it's
+// issued.
+func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkCertificate)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkCertificate)
+	}
+
+	if err := c.service.DeleteCertificate(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteCertificate)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// pollInterval returns the supplied poll interval, shortened so that the
+// supplied BorkCertificate is polled as soon as its certificate is about to
+// expire, and again once it has expired.
+func pollInterval(mg resource.Managed, d time.Duration) time.Duration {
+	cr, ok := mg.(*v1alpha1.BorkCertificate)
+	if !ok || cr.Status.AtProvider.NotAfter == nil {
+		return d
+	}
+	until := time.Until(cr.Status.AtProvider.NotAfter.Time)
+	if w := until - warnBefore(cr.Spec.ForProvider.TTL.Duration); w > 0 {
+		return min(d, w)
+	}
+	if until > 0 {
+		// Poll just after the certificate expires, so that it's certainly
+		// been removed.
+		return min(d, until+time.Second)
+	}
+	return d
+}
+
+// warnBefore returns how long before a certificate with the supplied TTL
+// expires that it is about to expire.
+func warnBefore(ttl time.Duration) time.Duration {
+	return time.Duration(float64(ttl) * ExpiryWarningFraction)
+}
+
+// expired returns true if the supplied BorkCertificate last observed a
+// certificate that had expired by the supplied time.
+func expired(cr *v1alpha1.BorkCertificate, now time.Time) bool {
+	na := cr.Status.AtProvider.NotAfter
+	return na != nil && !now.Before(na.Time)
+}
+
+func generateObservation(cert backend.Certificate, renewals int64) v1alpha1.BorkCertificateObservation {
+	return v1alpha1.BorkCertificateObservation{
+		SerialNumber: cert.SerialNumber,
+		CommonName:   cert.CommonName,
+		DNSNames:     cert.DNSNames,
+		NotBefore:    ptr.To(metav1.NewTime(cert.NotBefore)),
+		NotAfter:     ptr.To(metav1.NewTime(cert.NotAfter)),
+		Renewals:     renewals,
+		Revision:     cert.Revision,
+	}
+}
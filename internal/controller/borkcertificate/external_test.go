@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkcertificate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the certificate the fake backend issues.
+const existingName = "cert-existing"
+
+// An eventRecorder records the reasons of the events it's asked to record.
+type eventRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+// newBorkCertificate returns a BorkCertificate for bork.example.org whose
+// certificates are valid for an hour.
+func newBorkCertificate() *v1alpha1.BorkCertificate {
+	return &v1alpha1.BorkCertificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkCertificateSpec{ForProvider: v1alpha1.BorkCertificateParameters{
+			CommonName: "bork.example.org",
+			TTL:        metav1.Duration{Duration: time.Hour},
+		}},
+	}
+}
+
+// newExternal returns an external client of a fake backend that has issued
+// the supplied certificates, and the certificates as they were issued.
+func newExternal(t *testing.T, certs ...backend.Certificate) (*borkfake.Client, *external, *eventRecorder, []backend.Certificate) {
+	t.Helper()
+	f := borkfake.New()
+	issued := make([]backend.Certificate, 0, len(certs))
+	for _, c := range certs {
+		c, err := f.Store.CreateCertificate(context.Background(), c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		issued = append(issued, c)
+	}
+	r := &eventRecorder{}
+	return f, &external{service: f.Client, record: r}, r, issued
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		exists bool
+		serial bool
+		events []event.Reason
+	}
+
+	cases := map[string]struct {
+		reason string
+		certs  []backend.Certificate
+		cr     func(cr *v1alpha1.BorkCertificate)
+		want   want
+	}{
+		"Issued": {
+			reason: "A certificate that hasn't expired is up to date, and its serial number is a connection detail.",
+			certs:  []backend.Certificate{{Name: existingName, CommonName: "bork.example.org", TTL: time.Hour}},
+			want:   want{exists: true, serial: true},
+		},
+		"Expired": {
+			reason: "A certificate that expired was removed, so a new one must be issued.",
+			certs:  []backend.Certificate{{Name: existingName, CommonName: "bork.example.org", TTL: time.Nanosecond}},
+			cr: func(cr *v1alpha1.BorkCertificate) {
+				cr.Status.AtProvider.NotAfter = ptr.To(metav1.NewTime(time.Now().Add(-time.Minute)))
+			},
+			want: want{events: []event.Reason{reasonExpired}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkCertificate()
+			meta.SetExternalName(cr, existingName)
+			if tc.cr != nil {
+				tc.cr(cr)
+			}
+			_, e, r, issued := newExternal(t, tc.certs...)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			want := managed.ExternalObservation{ResourceExists: tc.want.exists, ResourceUpToDate: tc.want.exists}
+			if tc.want.serial {
+				want.ConnectionDetails = managed.ConnectionDetails{ConnectionDetailSerialNumber: []byte(issued[0].SerialNumber)}
+			}
+			if diff := cmp.Diff(want, o); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.reasons); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		renewals int64
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     func(cr *v1alpha1.BorkCertificate)
+		want   want
+	}{
+		"Issued": {
+			reason: "A certificate is issued per the spec, and named by the external name.",
+		},
+		"Renewed": {
+			reason: "A BorkCertificate that observed a certificate before is renewing it.",
+			cr: func(cr *v1alpha1.BorkCertificate) {
+				cr.Status.AtProvider.NotAfter = ptr.To(metav1.NewTime(time.Now().Add(-time.Minute)))
+				cr.Status.AtProvider.Renewals = 1
+			},
+			want: want{renewals: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkCertificate()
+			if tc.cr != nil {
+				tc.cr(cr)
+			}
+			f, e, _, _ := newExternal(t)
+
+			c, err := e.Create(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nCreate(...): %v", tc.reason, err)
+			}
+			cert, err := f.Store.GetCertificate(context.Background(), meta.GetExternalName(cr))
+			if err != nil {
+				t.Fatalf("\n%s\nCreate(...): certificate %q doesn't exist: %v", tc.reason, meta.GetExternalName(cr), err)
+			}
+			if cert.CommonName != cr.Spec.ForProvider.CommonName {
+				t.Errorf("\n%s\nCreate(...): got common name %q, want %q", tc.reason, cert.CommonName, cr.Spec.ForProvider.CommonName)
+			}
+			if diff := cmp.Diff(managed.ConnectionDetails{ConnectionDetailSerialNumber: []byte(cert.SerialNumber)}, c.ConnectionDetails); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want connection details, +got connection details:\n%s", tc.reason, diff)
+			}
+			if cr.Status.AtProvider.Renewals != tc.want.renewals {
+				t.Errorf("\n%s\nCreate(...): got %d renewals, want %d", tc.reason, cr.Status.AtProvider.Renewals, tc.want.renewals)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := newBorkCertificate()
+	meta.SetExternalName(cr, existingName)
+	cr.Spec.ForProvider.CommonName = "woof.example.org"
+	f, e, _, _ := newExternal(t, backend.Certificate{Name: existingName, CommonName: "bork.example.org", TTL: time.Hour})
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Errorf("Update(...): %v", err)
+	}
+	if calls := f.Calls(); len(calls) != 0 {
+		t.Errorf("Update(...): got calls %v, want none: a certificate can't be changed once it's issued", calls)
+	}
+}
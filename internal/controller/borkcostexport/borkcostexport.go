@@ -0,0 +1,294 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkcostexport
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkCostExport = "managed resource is not a BorkCostExport custom resource"
+
+	errGetExport    = "cannot get export"
+	errCreateExport = "cannot create export"
+	errUpdateExport = "cannot update export"
+	errDeleteExport = "cannot delete export"
+
+	msgAwaitingFirstExport = "waiting for the first export to succeed"
+)
+
+// SetupGated adds a controller that reconciles BorkCostExport managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkCostExport controller"))
+		}
+	}, v1alpha1.BorkCostExportGroupVersionKind, v1alpha1.BorkBucketGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkCostExportGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkCostExportKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkCostExportList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+		)),
+		// The backend assigns each export's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkCostExportList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkCostExportList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkCostExportList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkCostExportList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindExport, func() resource.ManagedList { return &v1alpha1.BorkCostExportList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkCostExportList")
+		}
+	}
+
+	leak.Default.Register(backend.KindExport, func() resource.ManagedList { return &v1alpha1.BorkCostExportList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkCostExportGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkCostExport{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkCostExportKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkCostExport{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindExport, func() resource.ManagedList { return &v1alpha1.BorkCostExportList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkCostExportGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkCostExportGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkCostExportGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkCostExportKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles exports in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkCostExport)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkCostExport)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	e, err := c.service.GetExport(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetExport)
+	}
+	cr.Status.AtProvider = generateObservation(e)
+
+	// An export isn't ready until it has proven it can deliver usage data to
+	// its destination.
+	switch {
+	case !e.LastExportTime.IsZero():
+		cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+	case e.LastExportError != "":
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(e.LastExportError).WithObservedGeneration(cr.GetGeneration()))
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(msgAwaitingFirstExport).WithObservedGeneration(cr.GetGeneration()))
+	}
+
+	d := diff(generateExport(cr.Spec.ForProvider), e)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkCostExport)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkCostExport)
+	}
+
+	e, err := c.service.CreateExport(ctx, generateExport(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateExport)
+	}
+	meta.SetExternalName(cr, e.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkCostExport)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkCostExport)
+	}
+
+	e := generateExport(cr.Spec.ForProvider)
+	e.Name = meta.GetExternalName(cr)
+	if _, err := c.service.UpdateExport(ctx, e); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateExport)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkCostExport)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkCostExport)
+	}
+
+	if err := c.service.DeleteExport(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteExport)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// exportCompareOptions compare the fields of an export that are under our
+// control. The backend records the outcome of each export.
+var exportCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.Export{}, "Name", "CreatedAt", "LastExportTime", "LastExportError", "Exports", "Revision"),
+}
+
+// diff returns a human-readable diff of the desired and observed exports, or
+// an empty string if the observed export is up to date.
+func diff(desired, observed backend.Export) string {
+	return cmp.Diff(desired, observed, exportCompareOptions...)
+}
+
+// generateExport returns the backend export described by the supplied
+// parameters. BucketName is resolved from BucketRef or BucketSelector before
+// the external client is called.
+func generateExport(p v1alpha1.BorkCostExportParameters) backend.Export {
+	return backend.Export{
+		Interval: p.Interval.Duration,
+		Bucket:   ptr.Deref(p.BucketName, ""),
+		Prefix:   p.Prefix,
+		URI:      ptr.Deref(p.URI, ""),
+	}
+}
+
+func generateObservation(e backend.Export) v1alpha1.BorkCostExportObservation {
+	o := v1alpha1.BorkCostExportObservation{
+		LastExportError: e.LastExportError,
+		Exports:         e.Exports,
+		Revision:        e.Revision,
+	}
+	if !e.LastExportTime.IsZero() {
+		o.LastExportTime = ptr.To(metav1.NewTime(e.LastExportTime))
+	}
+	return o
+}
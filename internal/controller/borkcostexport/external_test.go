@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkcostexport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+func TestObserveAwaitingFirstExport(t *testing.T) {
+	f := borkfake.New()
+	e, err := f.Store.CreateExport(context.Background(), backend.Export{Interval: time.Hour, URI: "https://bork.example.org/usage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := &v1alpha1.BorkCostExport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkCostExportSpec{ForProvider: v1alpha1.BorkCostExportParameters{
+			Interval: metav1.Duration{Duration: time.Hour},
+			URI:      ptr.To("https://bork.example.org/usage"),
+		}},
+	}
+	meta.SetExternalName(cr, e.Name)
+
+	o, err := (&external{service: f.Client}).Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): got an out of date export, want one that matches its spec:\n%s", o.Diff)
+	}
+
+	// An export isn't available until it has first delivered usage data.
+	want := xpv1.Unavailable().WithMessage(msgAwaitingFirstExport)
+	got := cr.GetCondition(xpv1.TypeReady)
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+		t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+	}
+}
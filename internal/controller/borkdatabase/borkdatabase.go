@@ -0,0 +1,404 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkdatabase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkDatabase = "managed resource is not a BorkDatabase custom resource"
+
+	errGetDatabase    = "cannot get database"
+	errCreateDatabase = "cannot create database"
+	errUpdateDatabase = "cannot update database"
+	errDeleteDatabase = "cannot delete database"
+	errImmutableFmt   = "cannot change immutable fields %s; delete and recreate the BorkDatabase to change them"
+)
+
+// TypeImmutableFieldChanged resources have a desired state that changes
+// fields of their external resource that can't be changed once it's created.
+const TypeImmutableFieldChanged xpv1.ConditionType = "ImmutableFieldChanged"
+
+// Reasons an immutable field has or has not changed.
+const (
+	ReasonImmutableFieldChanged xpv1.ConditionReason = "ImmutableFieldChanged"
+	ReasonNoImmutableChange     xpv1.ConditionReason = "NoImmutableFieldChanged"
+)
+
+// Fields of a database, as they appear in a BorkDatabase's spec.
+const (
+	FieldEngine              = "engine"
+	FieldSize                = "size"
+	FieldStorageGB           = "storageGB"
+	FieldBackupRetentionDays = "backupRetentionDays"
+	FieldMaintenanceWindow   = "maintenanceWindow"
+	FieldTags                = "tags"
+)
+
+// ConnectionDetailEndpoint is the connection detail a BorkDatabase publishes
+// its endpoint as.
+const ConnectionDetailEndpoint = "endpoint"
+
+// SetupGated adds a controller that reconciles BorkDatabase managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkDatabase controller"))
+		}
+	}, v1alpha1.BorkDatabaseGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkDatabaseGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkDatabaseKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkDatabaseList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithConnectionDetailTemplates(),
+			middleware.WithTagPropagation(),
+		)),
+		// The backend assigns each database's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkDatabaseList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkDatabaseList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkDatabaseList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkDatabaseList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindDatabase, func() resource.ManagedList { return &v1alpha1.BorkDatabaseList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkDatabaseList")
+		}
+	}
+
+	leak.Default.Register(backend.KindDatabase, func() resource.ManagedList { return &v1alpha1.BorkDatabaseList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkDatabaseGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkDatabase{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkDatabaseKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkDatabase{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindDatabase, func() resource.ManagedList { return &v1alpha1.BorkDatabaseList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkDatabaseGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkDatabaseGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkDatabaseGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkDatabaseKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles databases in the
+// simulated backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+
+	// observed is the database as of the last observation, which Update
+	// uses to keep the fields it can't change.
+	observed backend.Database
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkDatabase)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d, err := c.service.GetDatabase(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDatabase)
+	}
+	c.observed = d
+	cr.Status.AtProvider = generateObservation(d)
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	diffs := diff(generateDatabase(cr.Spec.ForProvider), d)
+	cr.Status.FieldDiffs = diffs
+
+	// Only record that an immutable field was restored; resources that never
+	// changed one don't need the extra condition.
+	if len(immutable(diffs)) == 0 && cr.GetCondition(TypeImmutableFieldChanged).Status == corev1.ConditionTrue {
+		cr.SetConditions(noImmutableFieldChanged())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  len(diffs) == 0,
+		Diff:              format(diffs),
+		ConnectionDetails: managed.ConnectionDetails{ConnectionDetailEndpoint: []byte(d.Endpoint)},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkDatabase)
+	}
+
+	d, err := c.service.CreateDatabase(ctx, generateDatabase(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDatabase)
+	}
+	meta.SetExternalName(cr, d.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{ConnectionDetailEndpoint: []byte(d.Endpoint)},
+	}, nil
+}
+
+// Update updates the database's mutable fields. If the desired state also
+// changes immutable fields it keeps their observed values, then rejects the
+// change with an ImmutableFieldChanged condition and an error, so the
+// database's mutable fields still converge.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkDatabase)
+	}
+
+	d := generateDatabase(cr.Spec.ForProvider)
+	d.Name = meta.GetExternalName(cr)
+	changed := immutable(diff(d, c.observed))
+	d.Engine, d.Size = c.observed.Engine, c.observed.Size
+
+	if _, err := c.service.UpdateDatabase(ctx, d); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDatabase)
+	}
+
+	if len(changed) > 0 {
+		cr.SetConditions(immutableFieldChanged(changed))
+		return managed.ExternalUpdate{}, errors.Errorf(errImmutableFmt, fields(changed))
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkDatabase)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkDatabase)
+	}
+
+	if err := c.service.DeleteDatabase(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteDatabase)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// generateDatabase returns the backend database described by the supplied
+// parameters.
+func generateDatabase(p v1alpha1.BorkDatabaseParameters) backend.Database {
+	return backend.Database{
+		Engine:              p.Engine,
+		Size:                p.Size,
+		StorageGB:           p.StorageGB,
+		BackupRetentionDays: ptr.Deref(p.BackupRetentionDays, 0),
+		MaintenanceWindow:   p.MaintenanceWindow,
+		Tags:                p.Tags,
+	}
+}
+
+func generateObservation(d backend.Database) v1alpha1.BorkDatabaseObservation {
+	return v1alpha1.BorkDatabaseObservation{
+		Engine:              d.Engine,
+		Size:                d.Size,
+		StorageGB:           d.StorageGB,
+		BackupRetentionDays: d.BackupRetentionDays,
+		MaintenanceWindow:   d.MaintenanceWindow,
+		Tags:                d.Tags,
+		Endpoint:            d.Endpoint,
+		Revision:            d.Revision,
+	}
+}
+
+// diff returns the fields of the desired database that differ from the
+// observed database, sorted by field.
+func diff(desired, observed backend.Database) []v1alpha1.FieldDiff {
+	var diffs []v1alpha1.FieldDiff
+	add := func(field, desired, observed string, immutable bool) {
+		if desired != observed {
+			diffs = append(diffs, v1alpha1.FieldDiff{Field: field, Desired: desired, Observed: observed, Immutable: immutable})
+		}
+	}
+	add(FieldBackupRetentionDays, strconv.Itoa(desired.BackupRetentionDays), strconv.Itoa(observed.BackupRetentionDays), false)
+	add(FieldEngine, desired.Engine, observed.Engine, true)
+	add(FieldMaintenanceWindow, desired.MaintenanceWindow, observed.MaintenanceWindow, false)
+	add(FieldSize, desired.Size, observed.Size, true)
+	add(FieldStorageGB, strconv.Itoa(desired.StorageGB), strconv.Itoa(observed.StorageGB), false)
+	add(FieldTags, tags(desired.Tags), tags(observed.Tags), false)
+	return diffs
+}
+
+// tags formats the supplied tags with their keys sorted, so that equal tags
+// are formatted equally. An empty map is formatted as an omitted one.
+func tags(t map[string]string) string {
+	if len(t) == 0 {
+		return ""
+	}
+	return fmt.Sprint(t)
+}
+
+// immutable returns the diffs of immutable fields.
+func immutable(diffs []v1alpha1.FieldDiff) []v1alpha1.FieldDiff {
+	var im []v1alpha1.FieldDiff
+	for _, d := range diffs {
+		if d.Immutable {
+			im = append(im, d)
+		}
+	}
+	return im
+}
+
+// format formats the supplied diffs as one line per field, or an empty
+// string if there are none.
+func format(diffs []v1alpha1.FieldDiff) string {
+	lines := make([]string, len(diffs))
+	for i, d := range diffs {
+		lines[i] = fmt.Sprintf("%s: %q -> %q", d.Field, d.Observed, d.Desired)
+		if d.Immutable {
+			lines[i] += " (immutable)"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fields returns the comma separated fields of the supplied diffs.
+func fields(diffs []v1alpha1.FieldDiff) string {
+	f := make([]string, len(diffs))
+	for i, d := range diffs {
+		f[i] = d.Field
+	}
+	return strings.Join(f, ", ")
+}
+
+func immutableFieldChanged(diffs []v1alpha1.FieldDiff) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeImmutableFieldChanged,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonImmutableFieldChanged,
+		Message:            fmt.Sprintf(errImmutableFmt, fields(diffs)) + ":\n" + format(diffs),
+	}
+}
+
+func noImmutableFieldChanged() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeImmutableFieldChanged,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoImmutableChange,
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkdatabase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the database the fake backend stores.
+const existingName = "db-existing"
+
+// newBorkDatabase returns a BorkDatabase whose spec is a small postgres
+// database with 10GB of storage.
+func newBorkDatabase() *v1alpha1.BorkDatabase {
+	cr := &v1alpha1.BorkDatabase{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkDatabaseSpec{ForProvider: v1alpha1.BorkDatabaseParameters{
+			Engine:    "postgres",
+			Size:      "small",
+			StorageGB: 10,
+		}},
+	}
+	meta.SetExternalName(cr, existingName)
+	return cr
+}
+
+// newExternal returns an external client of a fake backend storing the
+// supplied database.
+func newExternal(t *testing.T, d backend.Database) (*borkfake.Client, *external) {
+	t.Helper()
+	f := borkfake.New()
+	d.Name = existingName
+	if _, err := f.Store.CreateDatabase(context.Background(), d); err != nil {
+		t.Fatal(err)
+	}
+	return f, &external{service: f.Client}
+}
+
+func TestObserveFieldDiffs(t *testing.T) {
+	cr := newBorkDatabase()
+	_, e := newExternal(t, backend.Database{Engine: "mysql", Size: "small", StorageGB: 20})
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	want := []v1alpha1.FieldDiff{
+		{Field: FieldEngine, Desired: "postgres", Observed: "mysql", Immutable: true},
+		{Field: FieldStorageGB, Desired: "10", Observed: "20"},
+	}
+	if diff := cmp.Diff(want, cr.Status.FieldDiffs); diff != "" {
+		t.Errorf("Observe(...): -want field diffs, +got field diffs:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err       error
+		immutable corev1.ConditionStatus
+	}
+
+	cases := map[string]struct {
+		reason string
+		size   string
+		want   want
+	}{
+		"MutableFieldChanged": {
+			reason: "The database's mutable fields are updated per the spec.",
+			size:   "small",
+			want:   want{immutable: corev1.ConditionUnknown},
+		},
+		"ImmutableFieldChanged": {
+			reason: "A change to an immutable field is rejected, but the database's mutable fields are still updated.",
+			size:   "large",
+			want:   want{err: errors.Errorf(errImmutableFmt, FieldSize), immutable: corev1.ConditionTrue},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkDatabase()
+			cr.Spec.ForProvider.Size = tc.size
+			f, e := newExternal(t, backend.Database{Engine: "postgres", Size: "small", StorageGB: 20})
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			d, err := f.Store.GetDatabase(context.Background(), existingName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Size != "small" || d.StorageGB != 10 {
+				t.Errorf("\n%s\nUpdate(...): got a %s database with %dGB, want a small database with 10GB", tc.reason, d.Size, d.StorageGB)
+			}
+			if got := cr.GetCondition(TypeImmutableFieldChanged).Status; got != tc.want.immutable {
+				t.Errorf("\n%s\nUpdate(...): got %s condition status %q, want %q", tc.reason, TypeImmutableFieldChanged, got, tc.want.immutable)
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package borkfleet contains the controller that reconciles BorkFleets by
+// creating, updating and deleting the BorkResources they own.
+package borkfleet
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+
+	"github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/controller/borkresource"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errGetFleet      = "cannot get BorkFleet"
+	errListChildren  = "cannot list the BorkFleet's BorkResources"
+	errCreateChild   = "cannot create BorkResource"
+	errUpdateChild   = "cannot update BorkResource"
+	errDeleteChild   = "cannot delete BorkResource"
+	errOwnChild      = "cannot make BorkFleet the controller of its BorkResource"
+	errUpdateStatus  = "cannot update BorkFleet status"
+	errNotControlled = "BorkResource %q exists but isn't controlled by the BorkFleet"
+	errResolvePC     = "cannot resolve the provider config of the BorkFleet's BorkResources"
+	errConnect       = "cannot connect to the backend of the BorkFleet's BorkResources"
+	errHeadRecord    = "cannot get bork record"
+	errCommit        = "cannot create the bork records of the BorkFleet's BorkResources"
+
+	errGenerateRecordFmt = "cannot generate the bork record of BorkResource %q"
+)
+
+// Event reasons.
+const (
+	reasonCreatedChild event.Reason = "CreatedBorkResource"
+	reasonDeletedChild event.Reason = "DeletedBorkResource"
+	reasonCannotSync   event.Reason = "CannotSyncBorkResources"
+	reasonCommitted    event.Reason = "CreatedBorkRecords"
+)
+
+// maxCommitAttempts is how many times a transaction is committed before its
+// error is returned, while the backend is unavailable or times out.
+const maxCommitAttempts = 3
+
+// labelIndex is the label of a BorkResource that records its index within
+// its BorkFleet.
+const labelIndex = "bork.crossplane.io/fleet-index"
+
+// SetupGated adds a controller that reconciles BorkFleets with safe-start
+// support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkFleet controller"))
+		}
+	}, v1alpha1.BorkFleetGroupVersionKind, v1alpha1.BorkResourceGroupVersionKind)
+	return nil
+}
+
+// Setup adds a controller that reconciles BorkFleets.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := "fleet/" + v1alpha1.BorkFleetGroupKind
+
+	r := &Reconciler{
+		client:     mgr.GetClient(),
+		configMaps: mgr.GetAPIReader(),
+		pool:       clients.DefaultPool,
+		log:        o.Logger.WithValues("controller", name),
+		record:     event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkFleetKind, o)).
+		For(&v1alpha1.BorkFleet{}).
+		Owns(&v1alpha1.BorkResource{}).
+		Complete(shard.Default.Reconciler(concurrency.Default.Reconciler(v1alpha1.BorkFleetKind, ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))))
+}
+
+// A Reconciler reconciles BorkFleets.
+type Reconciler struct {
+	client client.Client
+	log    logging.Logger
+	record event.Recorder
+
+	// configMaps reads the ConfigMaps the valueFrom of a transactional
+	// fleet's BorkResources selects, and pool's stores are those their
+	// records are created in, as they are by the BorkResource controller.
+	configMaps client.Reader
+	pool       *clients.Pool
+}
+
+// Reconcile a BorkFleet by creating the BorkResources it's missing, updating
+// those that don't match its template, and deleting those beyond its
+// replicas. A BorkFleet's BorkResources are named for it and their index, and
+// are controlled by it, so Kubernetes garbage collects them when it's
+// deleted.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	fleet := &v1alpha1.BorkFleet{}
+	if err := r.client.Get(ctx, req.NamespacedName, fleet); err != nil {
+		return reconcile.Result{}, errors.Wrap(client.IgnoreNotFound(err), errGetFleet)
+	}
+	if !fleet.GetDeletionTimestamp().IsZero() {
+		// The garbage collector deletes our BorkResources.
+		return reconcile.Result{}, nil
+	}
+
+	children := &v1alpha1.BorkResourceList{}
+	if err := r.client.List(ctx, children, client.InNamespace(fleet.GetNamespace()), client.MatchingLabels{v1alpha1.LabelFleet: fleet.GetName()}); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListChildren)
+	}
+
+	err := r.sync(ctx, fleet, children.Items)
+	if err != nil {
+		log.Debug("Cannot sync BorkResources", "error", err)
+		r.record.Event(fleet, event.Warning(reasonCannotSync, err))
+	}
+
+	// Our BorkResources may have changed while we synced them, in which case
+	// we'll be requeued by their watch. We report readiness as we listed it.
+	fleet.Status.Replicas, fleet.Status.ReadyReplicas = 0, 0
+	for i := range children.Items {
+		c := &children.Items[i]
+		if i := index(c); !owned(fleet, c) || i < 0 || i >= fleet.Spec.Replicas || !c.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		fleet.Status.Replicas++
+		if c.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue {
+			fleet.Status.ReadyReplicas++
+		}
+	}
+
+	switch {
+	case err != nil:
+		fleet.SetConditions(xpv1.ReconcileError(err))
+	default:
+		fleet.SetConditions(xpv1.ReconcileSuccess())
+		fleet.Status.ObservedGeneration = fleet.GetGeneration()
+	}
+	switch {
+	case fleet.Status.ReadyReplicas == fleet.Spec.Replicas:
+		fleet.SetConditions(xpv1.Available())
+	case fleet.Status.Replicas < fleet.Spec.Replicas:
+		fleet.SetConditions(xpv1.Creating().WithMessage(readiness(fleet)))
+	default:
+		fleet.SetConditions(xpv1.Unavailable().WithMessage(readiness(fleet)))
+	}
+
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, fleet), errUpdateStatus)
+}
+
+// sync creates, updates and deletes the supplied BorkFleet's BorkResources so
+// that they match its spec. It returns the first error it encounters, having
+// attempted to sync every BorkResource, except that a transactional fleet
+// creates none of its missing BorkResources if it can't create their records.
+func (r *Reconciler) sync(ctx context.Context, fleet *v1alpha1.BorkFleet, children []v1alpha1.BorkResource) error {
+	existing := make(map[string]*v1alpha1.BorkResource, len(children))
+	var first error
+	for i := range children {
+		c := &children[i]
+		if !owned(fleet, c) {
+			continue
+		}
+		if i := index(c); i < 0 || i >= fleet.Spec.Replicas {
+			if err := r.client.Delete(ctx, c); client.IgnoreNotFound(err) != nil && first == nil {
+				first = errors.Wrap(err, errDeleteChild)
+			}
+			if c.GetDeletionTimestamp().IsZero() {
+				r.record.Event(fleet, event.Normal(reasonDeletedChild, fmt.Sprintf("Deleted BorkResource %s", c.GetName())))
+			}
+			continue
+		}
+		existing[c.GetName()] = c
+	}
+
+	var missing []*v1alpha1.BorkResource
+	for i := range fleet.Spec.Replicas {
+		want, err := r.desired(fleet, i)
+		if err != nil {
+			return err
+		}
+		got, ok := existing[want.GetName()]
+		if !ok {
+			missing = append(missing, want)
+			continue
+		}
+		preserveLateInitialized(&want.Spec.ForProvider, got.Spec.ForProvider)
+		if upToDate(got, want) {
+			continue
+		}
+		maps.Copy(got.Labels, want.GetLabels())
+		got.Spec.ProviderConfigReference = want.Spec.ProviderConfigReference
+		got.Spec.ManagementPolicies = want.Spec.ManagementPolicies
+		got.Spec.ForProvider = want.Spec.ForProvider
+		if err := r.client.Update(ctx, got); err != nil && first == nil {
+			first = errors.Wrap(err, errUpdateChild)
+		}
+	}
+
+	if len(missing) > 0 && fleet.Spec.Transactional {
+		// None of the missing BorkResources are created unless all of their
+		// records are.
+		if err := r.commit(ctx, fleet, missing); err != nil {
+			return err
+		}
+	}
+	for _, want := range missing {
+		err := r.client.Create(ctx, want)
+		if kerrors.IsAlreadyExists(err) {
+			err = errors.Errorf(errNotControlled, want.GetName())
+		}
+		if err != nil {
+			if first == nil {
+				first = errors.Wrap(err, errCreateChild)
+			}
+			continue
+		}
+		r.record.Event(fleet, event.Normal(reasonCreatedChild, fmt.Sprintf("Created BorkResource %s", want.GetName())))
+	}
+	return first
+}
+
+// commit creates the backend records of the supplied missing BorkResources of
+// the supplied BorkFleet in a single transaction, and names each BorkResource
+// for its record so that it adopts it. Each record is the one the
+// BorkResource controller would create. Records are named for the fleet's UID
+// and their index, so a record that a previous transaction created, but whose
+// BorkResource wasn't then created, is adopted rather than created again. A
+// transaction that fails because the backend is unavailable or times out may
+// or may not have been committed, so it's retried with the same ID, which the
+// backend commits at most once.
+func (r *Reconciler) commit(ctx context.Context, fleet *v1alpha1.BorkFleet, missing []*v1alpha1.BorkResource) error {
+	_, pc, err := clients.ResolveProviderConfig(ctx, r.client, missing[0])
+	if err != nil {
+		return errors.Wrap(err, errResolvePC)
+	}
+	svc, err := clients.ConnectWith(ctx, r.client, r.pool.Store(fleet.GetNamespace()), pc)
+	if err != nil {
+		return errors.Wrap(err, errConnect)
+	}
+	defer func() { _ = svc.Close() }()
+
+	tx := backend.Transaction{}
+	indexes := make([]string, 0, len(missing))
+	for _, cr := range missing {
+		name := fmt.Sprintf("%s-%d", fleet.GetUID(), index(cr))
+		meta.SetExternalName(cr, name)
+		_, err := svc.Head(ctx, name)
+		if err == nil {
+			continue
+		}
+		if !backend.IsNotFound(err) {
+			return errors.Wrap(err, errHeadRecord)
+		}
+		rec, err := borkresource.GenerateRecord(ctx, r.client, r.configMaps, cr)
+		if err != nil {
+			return errors.Wrapf(err, errGenerateRecordFmt, cr.GetName())
+		}
+		rec.Name = name
+		tx.Records = append(tx.Records, rec)
+		indexes = append(indexes, strconv.Itoa(index(cr)))
+	}
+	if len(tx.Records) == 0 {
+		return nil
+	}
+	tx.ID = fmt.Sprintf("%s/%s", fleet.GetUID(), strings.Join(indexes, ","))
+
+	for range maxCommitAttempts {
+		_, err = svc.Commit(ctx, tx)
+		if !backend.IsUnavailable(err) && !backend.IsTimeout(err) {
+			break
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, errCommit)
+	}
+	r.record.Event(fleet, event.Normal(reasonCommitted, fmt.Sprintf("Created %d bork records in transaction %s", len(tx.Records), tx.ID)))
+	return nil
+}
+
+// desired returns the supplied BorkFleet's BorkResource at the supplied index.
+func (r *Reconciler) desired(fleet *v1alpha1.BorkFleet, i int) (*v1alpha1.BorkResource, error) {
+	t := fleet.Spec.Template
+	labels := make(map[string]string, len(t.Labels)+2)
+	maps.Copy(labels, t.Labels)
+	labels[v1alpha1.LabelFleet] = fleet.GetName()
+	labels[labelIndex] = strconv.Itoa(i)
+
+	cr := &v1alpha1.BorkResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: fleet.GetNamespace(),
+			Name:      fmt.Sprintf("%s-%d", fleet.GetName(), i),
+			Labels:    labels,
+		},
+	}
+	cr.Spec.ProviderConfigReference = t.ProviderConfigReference.DeepCopy()
+	cr.Spec.ManagementPolicies = t.ManagementPolicies
+	t.ForProvider.DeepCopyInto(&cr.Spec.ForProvider)
+	return cr, errors.Wrap(controllerutil.SetControllerReference(fleet, cr, r.client.Scheme()), errOwnChild)
+}
+
+// upToDate returns true if the supplied BorkResource matches the desired
+// BorkResource. Labels that aren't desired are ignored, as are management
+// policies and provider config references that are defaulted.
+func upToDate(got, want *v1alpha1.BorkResource) bool {
+	for k, v := range want.GetLabels() {
+		if got.GetLabels()[k] != v {
+			return false
+		}
+	}
+	if want.Spec.ProviderConfigReference != nil && !reflect.DeepEqual(got.Spec.ProviderConfigReference, want.Spec.ProviderConfigReference) {
+		return false
+	}
+	if len(want.Spec.ManagementPolicies) > 0 && !reflect.DeepEqual(got.Spec.ManagementPolicies, want.Spec.ManagementPolicies) {
+		return false
+	}
+	return reflect.DeepEqual(got.Spec.ForProvider, want.Spec.ForProvider)
+}
+
+// preserveLateInitialized sets the desired parameters that the BorkResource
+// controller late initializes, and that the BorkFleet's template doesn't set,
+// to those of the existing BorkResource, so that the BorkFleet doesn't undo
+// late initialization.
+func preserveLateInitialized(want *v1alpha1.BorkResourceParameters, got v1alpha1.BorkResourceParameters) {
+	if want.Region == nil {
+		want.Region = got.Region
+	}
+	if want.Tier == nil {
+		want.Tier = got.Tier
+	}
+	for k, v := range got.Tags {
+		if _, ok := want.Tags[k]; ok {
+			continue
+		}
+		if want.Tags == nil {
+			want.Tags = make(map[string]string, len(got.Tags))
+		}
+		want.Tags[k] = v
+	}
+}
+
+// owned returns true if the supplied BorkResource is controlled by the
+// supplied BorkFleet.
+func owned(fleet *v1alpha1.BorkFleet, cr *v1alpha1.BorkResource) bool {
+	ref := metav1.GetControllerOf(cr)
+	return ref != nil && ref.UID == fleet.GetUID()
+}
+
+// index returns the supplied BorkResource's index within its BorkFleet, or -1
+// if it doesn't have one.
+func index(cr *v1alpha1.BorkResource) int {
+	i, err := strconv.Atoi(cr.GetLabels()[labelIndex])
+	if err != nil {
+		return -1
+	}
+	return i
+}
+
+func readiness(fleet *v1alpha1.BorkFleet) string {
+	return fmt.Sprintf("%d of %d BorkResources are ready", fleet.Status.ReadyReplicas, fleet.Spec.Replicas)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkfleet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+// fleetUID is the UID of the BorkFleet, for which its records are named.
+const fleetUID = "fleet-uid"
+
+// newBorkFleet returns a transactional BorkFleet of three BorkResources whose
+// records require activation.
+func newBorkFleet() *v1alpha1.BorkFleet {
+	return &v1alpha1.BorkFleet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork", UID: fleetUID},
+		Spec: v1alpha1.BorkFleetSpec{
+			Replicas:      3,
+			Transactional: true,
+			Template: v1alpha1.BorkFleetTemplate{
+				ProviderConfigReference: &xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"},
+				ForProvider: v1alpha1.BorkResourceParameters{
+					BorkValue:  map[string]string{"bork": "bork"},
+					Activation: &v1alpha1.Activation{},
+				},
+			},
+		},
+	}
+}
+
+// A fixture is a Reconciler of a fake API server that stores a BorkFleet, and
+// the backend store its records are created in.
+type fixture struct {
+	store *backend.Store
+	kube  client.Client
+	r     *Reconciler
+}
+
+func newFixture(t *testing.T, fleet *v1alpha1.BorkFleet, store *backend.Store) fixture {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(fleet, &apisv1alpha1.ClusterProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone}},
+		}).
+		WithStatusSubresource(&v1alpha1.BorkFleet{}).
+		Build()
+	r := &Reconciler{
+		client:     kube,
+		configMaps: kube,
+		pool:       clients.NewPool(backend.NewTenants(store), 0),
+		log:        logging.NewNopLogger(),
+		record:     event.NewNopRecorder(),
+	}
+	return fixture{store: store, kube: kube, r: r}
+}
+
+// reconcile reconciles the BorkFleet, and returns it as it was persisted.
+func (f fixture) reconcile(t *testing.T) *v1alpha1.BorkFleet {
+	t.Helper()
+	nn := types.NamespacedName{Namespace: "default", Name: "bork"}
+	if _, err := f.r.Reconcile(context.Background(), reconcile.Request{NamespacedName: nn}); err != nil {
+		t.Fatal(err)
+	}
+	fleet := &v1alpha1.BorkFleet{}
+	if err := f.kube.Get(context.Background(), nn, fleet); err != nil {
+		t.Fatal(err)
+	}
+	return fleet
+}
+
+// children returns the external names of the BorkFleet's BorkResources, by
+// name.
+func (f fixture) children(t *testing.T) map[string]string {
+	t.Helper()
+	l := &v1alpha1.BorkResourceList{}
+	if err := f.kube.List(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]string, len(l.Items))
+	for i := range l.Items {
+		names[l.Items[i].GetName()] = meta.GetExternalName(&l.Items[i])
+	}
+	return names
+}
+
+// records returns whether each of the BorkFleet's records requires
+// activation, by name, for those the store holds.
+func (f fixture) records(t *testing.T, replicas int) map[string]bool {
+	t.Helper()
+	records := map[string]bool{}
+	for i := range replicas {
+		r, err := f.store.Get(context.Background(), fmt.Sprintf("%s-%d", fleetUID, i))
+		if backend.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records[r.Name] = r.RequiresActivation
+	}
+	return records
+}
+
+// committed are the BorkResources, and whether their records require
+// activation, of a BorkFleet whose transaction was committed.
+var (
+	committedChildren = map[string]string{"bork-0": fleetUID + "-0", "bork-1": fleetUID + "-1", "bork-2": fleetUID + "-2"}
+	committedRecords  = map[string]bool{fleetUID + "-0": true, fleetUID + "-1": true, fleetUID + "-2": true}
+)
+
+func TestReconcileTransactional(t *testing.T) {
+	type want struct {
+		children map[string]string
+		records  map[string]bool
+		synced   corev1.ConditionStatus
+	}
+
+	cases := map[string]struct {
+		reason string
+		fleet  func(fleet *v1alpha1.BorkFleet)
+		setup  func(s *backend.Store) error
+		want   want
+	}{
+		"Committed": {
+			reason: "Every record is created in one transaction as the BorkResource controller would create it, then adopted by its BorkResource.",
+			want:   want{children: committedChildren, records: committedRecords, synced: corev1.ConditionTrue},
+		},
+		"PartiallyFailed": {
+			reason: "A transaction that fails after staging some of its records creates none of them, so none of the BorkResources are created.",
+			setup: func(s *backend.Store) error {
+				s.SetTransactionFault(&backend.TransactionFault{Mode: backend.TransactionFaultAbort, After: 1})
+				return nil
+			},
+			want: want{children: map[string]string{}, records: map[string]bool{}, synced: corev1.ConditionFalse},
+		},
+		"RetriedAfterAbort": {
+			reason: "A transaction that's aborted is retried, and creates every record once it's committed.",
+			setup: func(s *backend.Store) error {
+				s.SetTransactionFault(&backend.TransactionFault{Mode: backend.TransactionFaultAbort, After: 1, Count: maxCommitAttempts - 1})
+				return nil
+			},
+			want: want{children: committedChildren, records: committedRecords, synced: corev1.ConditionTrue},
+		},
+		"RetriedAfterLostResponse": {
+			reason: "A transaction whose response is lost is retried with the same ID, which the backend commits only once.",
+			setup: func(s *backend.Store) error {
+				s.SetTransactionFault(&backend.TransactionFault{Mode: backend.TransactionFaultLoseResponse, Count: 1})
+				return nil
+			},
+			want: want{children: committedChildren, records: committedRecords, synced: corev1.ConditionTrue},
+		},
+		"AdoptedFromEarlierTransaction": {
+			reason: "A record an earlier transaction created, whose BorkResource wasn't then created, is adopted rather than created again.",
+			setup: func(s *backend.Store) error {
+				_, err := s.Create(context.Background(), backend.Record{Name: fleetUID + "-0", BorkValue: map[string]string{"bork": "bork"}, RequiresActivation: true})
+				return err
+			},
+			want: want{children: committedChildren, records: committedRecords, synced: corev1.ConditionTrue},
+		},
+		"Invalid": {
+			reason: "A template the BorkResource controller would refuse to create a record for creates none of them.",
+			fleet: func(fleet *v1alpha1.BorkFleet) {
+				fleet.Spec.Template.ForProvider.PayloadSizeKB = ptr.To(int64(v1alpha1.MaxPayloadSizeKB + 1))
+			},
+			want: want{children: map[string]string{}, records: map[string]bool{}, synced: corev1.ConditionFalse},
+		},
+		"Hooks": {
+			reason: "A template with provisioning hooks, which must run as each record is created, creates none of them.",
+			fleet: func(fleet *v1alpha1.BorkFleet) {
+				fleet.Spec.Template.ForProvider.Hooks = []v1alpha1.ProvisioningHook{{Name: "bork"}}
+			},
+			want: want{children: map[string]string{}, records: map[string]bool{}, synced: corev1.ConditionFalse},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fleet := newBorkFleet()
+			if tc.fleet != nil {
+				tc.fleet(fleet)
+			}
+			store := backend.NewStore()
+			if tc.setup != nil {
+				if err := tc.setup(store); err != nil {
+					t.Fatal(err)
+				}
+			}
+			f := newFixture(t, fleet, store)
+
+			got := f.reconcile(t)
+			if diff := cmp.Diff(tc.want.children, f.children(t)); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want BorkResources, +got BorkResources:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.records, f.records(t, fleet.Spec.Replicas)); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want records, +got records:\n%s", tc.reason, diff)
+			}
+			if s := got.GetCondition(xpv1.TypeSynced).Status; s != tc.want.synced {
+				t.Errorf("\n%s\nReconcile(...): got Synced condition %q (%s), want %q", tc.reason, s, got.GetCondition(xpv1.TypeSynced).Message, tc.want.synced)
+			}
+
+			// Reconciling again changes nothing: the BorkResources exist, so
+			// no transaction is committed.
+			f.store.SetTransactionFault(nil)
+			before := f.children(t)
+			f.reconcile(t)
+			if tc.want.synced == corev1.ConditionTrue {
+				if diff := cmp.Diff(before, f.children(t)); diff != "" {
+					t.Errorf("\n%s\nReconcile(...): reconciling again: -want BorkResources, +got BorkResources:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
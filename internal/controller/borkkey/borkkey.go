@@ -0,0 +1,277 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkkey
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkKey = "managed resource is not a BorkKey custom resource"
+
+	errGetKey    = "cannot get key"
+	errCreateKey = "cannot create key"
+	errUpdateKey = "cannot update key"
+	errDeleteKey = "cannot delete key"
+)
+
+// SetupGated adds a controller that reconciles BorkKey managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkKey controller"))
+		}
+	}, v1alpha1.BorkKeyGroupVersionKind, v1alpha1.BorkThrottlePlanGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkKeyGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkKeyKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkKeyList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithConnectionDetailTemplates(),
+		)),
+		// The backend assigns each key's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkKeyList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkKeyList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkKeyList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkKeyList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindKey, func() resource.ManagedList { return &v1alpha1.BorkKeyList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkKeyList")
+		}
+	}
+
+	leak.Default.Register(backend.KindKey, func() resource.ManagedList { return &v1alpha1.BorkKeyList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkKeyGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkKey{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkKeyKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkKey{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindKey, func() resource.ManagedList { return &v1alpha1.BorkKeyList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkKeyGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkKeyGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkKeyGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkKeyKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles keys in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkKey)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	k, err := c.service.GetKey(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetKey)
+	}
+	cr.Status.AtProvider = v1alpha1.BorkKeyObservation{PlanID: k.Plan}
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	d := diff(generateKey(cr.Spec.ForProvider), k)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: connectionDetails(k),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkKey)
+	}
+
+	k, err := c.service.CreateKey(ctx, generateKey(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateKey)
+	}
+	meta.SetExternalName(cr, k.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: connectionDetails(k),
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkKey)
+	}
+
+	k := generateKey(cr.Spec.ForProvider)
+	k.Name = meta.GetExternalName(cr)
+	k, err := c.service.UpdateKey(ctx, k)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateKey)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: connectionDetails(k),
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkKey)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkKey)
+	}
+
+	if err := c.service.DeleteKey(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteKey)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// keyCompareOptions compare the fields of a key that are under our control.
+// The secret is generated by the backend.
+var keyCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.Key{}, "Name", "Secret", "Revision"),
+}
+
+// diff returns a human-readable diff of the desired and observed keys, or an
+// empty string if the observed key is up to date.
+func diff(desired, observed backend.Key) string {
+	return cmp.Diff(desired, observed, keyCompareOptions...)
+}
+
+// generateKey returns the backend key described by the supplied parameters.
+func generateKey(p v1alpha1.BorkKeyParameters) backend.Key {
+	return backend.Key{
+		Description: p.Description,
+		Plan:        ptr.Deref(p.PlanID, ""),
+	}
+}
+
+// connectionDetails returns the details a consumer needs to authenticate as
+// the supplied key.
+func connectionDetails(k backend.Key) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		"keyId":  []byte(k.Name),
+		"secret": []byte(k.Secret),
+	}
+}
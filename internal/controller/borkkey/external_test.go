@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkkey
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// Names of the key and the throttle plans the fake backend stores.
+const (
+	existingName = "key-existing"
+	goldPlan     = "plan-gold"
+	silverPlan   = "plan-silver"
+)
+
+// newExternal returns an external client of a fake backend storing the gold
+// and silver plans.
+func newExternal(t *testing.T) (*borkfake.Client, *external) {
+	t.Helper()
+	f := borkfake.New()
+	for _, p := range []backend.Plan{{Name: goldPlan, Tier: "gold", RequestsPerSecond: 10}, {Name: silverPlan, Tier: "silver", RequestsPerSecond: 5}} {
+		if _, err := f.Store.CreatePlan(context.Background(), p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f, &external{service: f.Client}
+}
+
+// newBorkKey returns a BorkKey attached to the supplied plan.
+func newBorkKey(plan string) *v1alpha1.BorkKey {
+	return &v1alpha1.BorkKey{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec:       v1alpha1.BorkKeySpec{ForProvider: v1alpha1.BorkKeyParameters{PlanID: ptr.To(plan)}},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		plan     string
+		notFound bool
+	}{
+		"Attached": {
+			reason: "A key is created attached to its plan, and its secret is published.",
+			plan:   goldPlan,
+		},
+		"PlanNotFound": {
+			reason:   "A key can't be attached to a plan that doesn't exist.",
+			plan:     "plan-missing",
+			notFound: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, e := newExternal(t)
+			cr := newBorkKey(tc.plan)
+
+			c, err := e.Create(context.Background(), cr)
+			if got := backend.IsNotFound(err); got != tc.notFound {
+				t.Fatalf("\n%s\nCreate(...): got error %v, want a not found error: %t", tc.reason, err, tc.notFound)
+			}
+			if tc.notFound {
+				return
+			}
+			k, err := f.Store.GetKey(context.Background(), meta.GetExternalName(cr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if k.Plan != tc.plan {
+				t.Errorf("\n%s\nCreate(...): got plan %q, want %q", tc.reason, k.Plan, tc.plan)
+			}
+			if k.Secret == "" || string(c.ConnectionDetails["secret"]) != k.Secret {
+				t.Errorf("\n%s\nCreate(...): got secret %q, want the key's secret %q", tc.reason, c.ConnectionDetails["secret"], k.Secret)
+			}
+		})
+	}
+}
+
+func TestUpdateKeepsSecret(t *testing.T) {
+	f, e := newExternal(t)
+	k, err := f.Store.CreateKey(context.Background(), backend.Key{Name: existingName, Plan: goldPlan})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := newBorkKey(silverPlan)
+	meta.SetExternalName(cr, existingName)
+
+	u, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	got, err := f.Store.GetKey(context.Background(), existingName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Plan != silverPlan {
+		t.Errorf("Update(...): got plan %q, want %q", got.Plan, silverPlan)
+	}
+	if got.Secret != k.Secret || string(u.ConnectionDetails["secret"]) != k.Secret {
+		t.Errorf("Update(...): got secret %q, want the secret the key was created with %q: moving a key to another plan doesn't rotate it", got.Secret, k.Secret)
+	}
+}
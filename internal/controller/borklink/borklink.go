@@ -0,0 +1,285 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borklink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkLink = "managed resource is not a BorkLink custom resource"
+
+	errGetLink    = "cannot get link"
+	errCreateLink = "cannot create link"
+	errUpdateLink = "cannot update link"
+	errDeleteLink = "cannot delete link"
+
+	msgDanglingFmt = "link is dangling: bork record %q or %q was deleted"
+)
+
+// SetupGated adds a controller that reconciles BorkLink managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkLink controller"))
+		}
+	}, v1alpha1.BorkLinkGroupVersionKind, v1alpha1.BorkResourceGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkLinkGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkLinkKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkLinkList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+		)),
+		// The backend names each link for the pair of records it links
+		// when it is created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkLinkList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkLinkList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkLinkList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkLinkList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindLink, func() resource.ManagedList { return &v1alpha1.BorkLinkList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkLinkList")
+		}
+	}
+
+	leak.Default.Register(backend.KindLink, func() resource.ManagedList { return &v1alpha1.BorkLinkList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkLinkGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkLink{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkLinkKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkLink{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindLink, func() resource.ManagedList { return &v1alpha1.BorkLinkList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkLinkGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkLinkGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkLinkGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkLinkKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles links in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkLink)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkLink)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	l, err := c.service.GetLink(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetLink)
+	}
+	cr.Status.AtProvider.Source = l.Source
+	cr.Status.AtProvider.Target = l.Target
+	cr.Status.AtProvider.Dangling = l.Dangling
+	cr.Status.AtProvider.Revision = l.Revision
+
+	// A dangling link exists, but no longer relates anything.
+	if l.Dangling {
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(msgDanglingFmt, l.Source, l.Target)).WithObservedGeneration(cr.GetGeneration()))
+	} else {
+		cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+	}
+
+	d := diff(generateLink(cr.Spec.ForProvider), l)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkLink)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkLink)
+	}
+
+	l, err := c.service.CreateLink(ctx, generateLink(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateLink)
+	}
+	meta.SetExternalName(cr, l.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkLink)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkLink)
+	}
+
+	l := generateLink(cr.Spec.ForProvider)
+	l.Name = meta.GetExternalName(cr)
+	if _, err := c.service.UpdateLink(ctx, l); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLink)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkLink)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkLink)
+	}
+
+	if err := c.service.DeleteLink(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteLink)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// linkCompareOptions compare the fields of a link that are under our control.
+// A link's source and target are its identity, so they can't differ from the
+// ones it was created with.
+var linkCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.Link{}, "Name", "Source", "Target", "Dangling", "Revision"),
+}
+
+// diff returns a human-readable diff of the desired and observed links, or an
+// empty string if the observed link is up to date.
+func diff(desired, observed backend.Link) string {
+	return cmp.Diff(desired, observed, linkCompareOptions...)
+}
+
+// generateLink returns the backend link described by the supplied parameters.
+// SourceName and TargetName are resolved from their references or selectors
+// before the external client is called.
+func generateLink(p v1alpha1.BorkLinkParameters) backend.Link {
+	policy := backend.LinkDeletionPolicy(p.EndpointDeletionPolicy)
+	if policy == "" {
+		policy = backend.LinkCascade
+	}
+	return backend.Link{
+		Source:         ptr.Deref(p.SourceName, ""),
+		Target:         ptr.Deref(p.TargetName, ""),
+		DeletionPolicy: policy,
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borklink
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// Names of the records the fake backend stores, which links link.
+const (
+	sourceName = "bork-source"
+	targetName = "bork-target"
+)
+
+// existingName is the name of the link from the source to the target record.
+var existingName = backend.LinkName(sourceName, targetName)
+
+// newBorkLink returns a BorkLink from the source to the target record whose
+// link is orphaned when either record is deleted.
+func newBorkLink() *v1alpha1.BorkLink {
+	return &v1alpha1.BorkLink{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkLinkSpec{ForProvider: v1alpha1.BorkLinkParameters{
+			SourceName:             ptr.To(sourceName),
+			TargetName:             ptr.To(targetName),
+			EndpointDeletionPolicy: v1alpha1.EndpointDeletionOrphan,
+		}},
+	}
+}
+
+// newExternal returns an external client of a fake backend storing the
+// source and target records, and the supplied links between them.
+func newExternal(t *testing.T, links ...backend.Link) (*borkfake.Client, *external) {
+	t.Helper()
+	f := borkfake.New()
+	for _, name := range []string{sourceName, targetName} {
+		if _, err := f.Store.Create(context.Background(), backend.Record{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, l := range links {
+		if _, err := f.Store.CreateLink(context.Background(), l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f, &external{service: f.Client}
+}
+
+// link returns the link from the source to the target record with the
+// supplied deletion policy.
+func link(p backend.LinkDeletionPolicy) backend.Link {
+	return backend.Link{Source: sourceName, Target: targetName, DeletionPolicy: p}
+}
+
+// condition returns what's compared of a condition: its type, status, reason
+// and message.
+func condition(c xpv1.Condition) xpv1.Condition {
+	return xpv1.Condition{Type: c.Type, Status: c.Status, Reason: c.Reason, Message: c.Message}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o         managed.ExternalObservation
+		condition xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		links  []backend.Link
+		setup  func(*backend.Store) error
+		want   want
+	}{
+		"UpToDate": {
+			reason: "A link whose deletion policy matches the spec's is up to date, and available.",
+			links:  []backend.Link{link(backend.LinkOrphan)},
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				condition: condition(xpv1.Available()),
+			},
+		},
+		"Dangling": {
+			reason: "A link whose source record was deleted is dangling, so it exists but isn't available.",
+			links:  []backend.Link{link(backend.LinkOrphan)},
+			setup:  func(s *backend.Store) error { return s.Delete(context.Background(), sourceName) },
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				condition: condition(xpv1.Unavailable().WithMessage(fmt.Sprintf(msgDanglingFmt, sourceName, targetName))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkLink()
+			meta.SetExternalName(cr, existingName)
+			f, e := newExternal(t, tc.links...)
+			if tc.setup != nil {
+				if err := tc.setup(f.Store); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, condition(cr.GetCondition(tc.want.condition.Type))); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cr := newBorkLink()
+	f, e := newExternal(t)
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	if got := meta.GetExternalName(cr); got != existingName {
+		t.Errorf("Create(...): a link is named for its source and target: got external name %q, want %q", got, existingName)
+	}
+	l, err := f.Store.GetLink(context.Background(), existingName)
+	if err != nil {
+		t.Fatalf("Create(...): link %q doesn't exist: %v", existingName, err)
+	}
+	if l.DeletionPolicy != backend.LinkOrphan {
+		t.Errorf("Create(...): got deletion policy %q, want %q", l.DeletionPolicy, backend.LinkOrphan)
+	}
+}
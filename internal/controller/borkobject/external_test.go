@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// bucketName is the name of the bucket the fake backend stores.
+const bucketName = "bucket-existing"
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		err error
+		obs v1alpha1.BorkObjectObservation
+	}
+
+	cases := map[string]struct {
+		reason string
+		bucket string
+		want   want
+	}{
+		"InBucket": {
+			reason: "The object is created in the bucket its spec names, and observed there.",
+			bucket: bucketName,
+			want:   want{obs: v1alpha1.BorkObjectObservation{BucketName: bucketName, Size: len("bork")}},
+		},
+		"BucketNotFound": {
+			reason: "An object can't be created in a bucket that doesn't exist.",
+			bucket: "bucket-missing",
+			want:   want{err: errors.Wrap(backend.NewError(backend.ErrorCodeNotFound, `bucket "bucket-missing" not found`), errCreateObject)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := borkfake.New()
+			if _, err := f.Store.CreateBucket(context.Background(), backend.Bucket{Name: bucketName}); err != nil {
+				t.Fatal(err)
+			}
+			e := &external{service: f.Client}
+			cr := &v1alpha1.BorkObject{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+				Spec: v1alpha1.BorkObjectSpec{ForProvider: v1alpha1.BorkObjectParameters{
+					BucketName: ptr.To(tc.bucket),
+					Key:        "bork.txt",
+					Content:    "bork",
+				}},
+			}
+
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil || !o.ResourceUpToDate {
+				t.Fatalf("\n%s\nObserve(...): got %+v, %v, want an up to date object", tc.reason, o, err)
+			}
+			if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider, cmpopts.IgnoreFields(v1alpha1.BorkObjectObservation{}, "Revision")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
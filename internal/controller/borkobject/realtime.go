@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkobject
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/features"
+)
+
+const errIndexBucketRef = "cannot index BorkObjects by the BorkBucket they reference"
+
+// indexBucketRef is the field index of BorkObjects by the namespace and name
+// of the BorkBucket their spec.forProvider.bucketRef references.
+const indexBucketRef = "spec.forProvider.bucketRef.name"
+
+// indexBucketRefs indexes BorkObjects by the namespace and name of the
+// BorkBucket they reference. A BorkObject that selects its bucket references
+// it once the selector has been resolved.
+func indexBucketRefs(o client.Object) []string {
+	cr, ok := o.(*v1alpha1.BorkObject)
+	if !ok || cr.Spec.ForProvider.BucketRef == nil {
+		return nil
+	}
+	ref := cr.Spec.ForProvider.BucketRef
+	ns := ref.Namespace
+	if ns == "" {
+		ns = cr.GetNamespace()
+	}
+	return []string{types.NamespacedName{Namespace: ns, Name: ref.Name}.String()}
+}
+
+// setupIndex adds the field index that enqueueObjectsFor lists BorkObjects
+// with.
+func setupIndex(ctx context.Context, fi client.FieldIndexer) error {
+	return errors.Wrap(fi.IndexField(ctx, &v1alpha1.BorkObject{}, indexBucketRef, indexBucketRefs), errIndexBucketRef)
+}
+
+// enqueueObjectsFor returns a function that maps a BorkBucket to the
+// BorkObjects that reference it while realtime compositions are enabled, so
+// that they're reconciled as soon as their bucket changes rather than when
+// they're next polled.
+func enqueueObjectsFor(kube client.Reader) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		if !features.Default.Enabled(features.EnableAlphaRealtimeCompositions) {
+			return nil
+		}
+		l := &v1alpha1.BorkObjectList{}
+		key := types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}.String()
+		if err := kube.List(ctx, l, client.MatchingFields{indexBucketRef: key}); err != nil {
+			return nil
+		}
+
+		reqs := make([]reconcile.Request, 0, len(l.Items))
+		for _, obj := range l.Items {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}})
+		}
+		return reqs
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkobjecttemplate
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkObjectTemplate = "managed resource is not a BorkObjectTemplate custom resource"
+	errTrackUsage            = "cannot track provider config usage"
+
+	errGetObject      = "cannot get Kubernetes object"
+	errApplyObject    = "cannot apply Kubernetes object"
+	errDeleteObject   = "cannot delete Kubernetes object"
+	errObserveObject  = "cannot encode observed Kubernetes object"
+	errCompareObjects = "cannot compare desired and observed Kubernetes objects"
+)
+
+// SetupGated adds a controller that reconciles BorkObjectTemplate managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkObjectTemplate controller"))
+		}
+	}, v1alpha1.BorkObjectTemplateGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkObjectTemplateGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	// A BorkObjectTemplate's external resource is a Kubernetes object rather
+	// than a backend resource, so the middleware that deals with the
+	// backend's credentials, quotas and throttling doesn't apply.
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkObjectTemplateKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkObjectTemplateList{} },
+			&connector{
+				kube: mgr.GetClient(),
+			},
+			middleware.ForKubernetesObjects(),
+		)),
+		// The external name is the name of the manifest's object, which is
+		// set when the object is created or adopted.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkObjectTemplateList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkObjectTemplateList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkObjectTemplateList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkObjectTemplateList")
+		}
+	}
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkObjectTemplateGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkObjectTemplate{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkObjectTemplateKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkObjectTemplate{}).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkObjectTemplateGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkObjectTemplateGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkObjectTemplateGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkObjectTemplateKind, ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+}
+
+// Connect produces an ExternalClient that reconciles Kubernetes objects in the
+// provider's own cluster. The provider config's credentials aren't used;
+// objects are applied with the provider's service account. The provider
+// config is still tracked as used, so that it can't be deleted from under
+// the managed resource.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	key, _, err := clients.ResolveProviderConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	if err := clients.TrackUsage(ctx, c.kube, mg, key); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+	return &external{kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkObjectTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkObjectTemplate)
+	}
+
+	desired, err := parseManifest(c.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	observed := &unstructured.Unstructured{}
+	observed.SetGroupVersionKind(desired.GroupVersionKind())
+	err = c.kube.Get(ctx, types.NamespacedName{Namespace: desired.GetNamespace(), Name: desired.GetName()}, observed)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObject)
+	}
+
+	raw, err := json.Marshal(observed.Object)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveObject)
+	}
+	cr.Status.AtProvider = v1alpha1.BorkObjectTemplateObservation{
+		Manifest:        runtime.RawExtension{Raw: raw},
+		UID:             string(observed.GetUID()),
+		ResourceVersion: observed.GetResourceVersion(),
+	}
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	// An object that exists before its BorkObjectTemplate is adopted. Its
+	// name is persisted as the external name, like that of a created one.
+	adopted := false
+	if meta.GetExternalName(cr) != desired.GetName() {
+		meta.SetExternalName(cr, desired.GetName())
+		adopted = true
+	}
+
+	d, err := diff(desired, raw)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompareObjects)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        d == "",
+		ResourceLateInitialized: adopted,
+		Diff:                    d,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkObjectTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkObjectTemplate)
+	}
+
+	desired, err := parseManifest(c.kube, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := c.apply(ctx, desired); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, desired.GetName())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkObjectTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkObjectTemplate)
+	}
+
+	desired, err := parseManifest(c.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, c.apply(ctx, desired)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkObjectTemplate)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkObjectTemplate)
+	}
+
+	desired, err := parseManifest(c.kube, cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := c.kube.Delete(ctx, desired); resource.IgnoreNotFound(err) != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteObject)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(_ context.Context) error {
+	return nil
+}
+
+// apply server-side applies the supplied object as the provider, creating it
+// if it doesn't exist. The provider owns the fields of the manifest, taking
+// ownership of any another field manager owns, but no others.
+func (c *external) apply(ctx context.Context, u *unstructured.Unstructured) error {
+	return errors.Wrap(c.kube.Patch(ctx, u, client.Apply, client.FieldOwner(clients.FieldOwner), client.ForceOwnership), errApplyObject)
+}
+
+// diff returns a human-readable diff of the fields the desired object
+// specifies and those of the observed object, which is supplied as JSON, or
+// an empty string if the observed object is up to date. Fields the desired
+// object doesn't specify, like those defaulted by the API server, are
+// ignored. Both objects are decoded from JSON the same way, so that their
+// numbers compare equal.
+func diff(desired *unstructured.Unstructured, observed []byte) (string, error) {
+	raw, err := json.Marshal(specified(desired))
+	if err != nil {
+		return "", err
+	}
+	d, o := map[string]any{}, map[string]any{}
+	if err := json.Unmarshal(raw, &d); err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(observed, &o); err != nil {
+		return "", err
+	}
+	return cmp.Diff(d, prune(o, d)), nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkobjecttemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+// existingName is the name of the ConfigMap the BorkObjectTemplate
+// materializes.
+const existingName = "bork-config"
+
+// manifest is a ConfigMap whose bork key is "bork".
+const manifest = `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "bork-config"}, "data": {"bork": "bork"}}`
+
+// existing returns the ConfigMap the manifest describes, with the supplied
+// value of its bork key.
+func existing(v string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: existingName},
+		Data:       map[string]string{"bork": v},
+	}
+}
+
+// newBorkObjectTemplate returns a BorkObjectTemplate whose spec is the
+// supplied manifest.
+func newBorkObjectTemplate(m string) *v1alpha1.BorkObjectTemplate {
+	return &v1alpha1.BorkObjectTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec:       v1alpha1.BorkObjectTemplateSpec{ForProvider: v1alpha1.BorkObjectTemplateParameters{Manifest: runtime.RawExtension{Raw: []byte(m)}}},
+	}
+}
+
+// newExternal returns an external client of a fake API server storing the
+// supplied objects, which knows ConfigMaps are namespaced.
+func newExternal(t *testing.T, objs ...client.Object) *external {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	m := apimeta.NewDefaultRESTMapper(nil)
+	m.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), apimeta.RESTScopeNamespace)
+	kube := fake.NewClientBuilder().WithScheme(s).WithRESTMapper(m).WithObjects(objs...).WithInterceptorFuncs(applyAsCreateOrMerge).Build()
+	return &external{kube: kube}
+}
+
+// applyAsCreateOrMerge makes the fake API server, which doesn't support
+// server-side apply, create an object that's applied if it doesn't exist, or
+// merge the fields that are applied if it does.
+var applyAsCreateOrMerge = interceptor.Funcs{
+	Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+		if patch.Type() != types.ApplyPatchType {
+			return c.Patch(ctx, obj, patch, opts...)
+		}
+		err := c.Patch(ctx, obj, client.Merge)
+		if kerrors.IsNotFound(err) {
+			return c.Create(ctx, obj)
+		}
+		return err
+	},
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		err          error
+		externalName string
+	}
+
+	cases := map[string]struct {
+		reason   string
+		objs     []client.Object
+		manifest string
+		want     want
+	}{
+		"ManifestUnnamed": {
+			reason:   "A manifest without a name can't be observed.",
+			manifest: `{"apiVersion": "v1", "kind": "ConfigMap"}`,
+			want:     want{err: errors.New(errManifestName)},
+		},
+		"ManifestOtherNamespace": {
+			reason:   "A manifest of an object in another namespace can't be observed.",
+			manifest: `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "bork-config", "namespace": "woof"}}`,
+			want:     want{err: errors.Errorf(errManifestNamespaceFmt, "woof", "default")},
+		},
+		"Adopted": {
+			reason:   "An object that exists before its BorkObjectTemplate is adopted, and its name persisted as the external name.",
+			objs:     []client.Object{existing("bork")},
+			manifest: manifest,
+			want:     want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, externalName: existingName},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkObjectTemplate(tc.manifest)
+			e := newExternal(t, tc.objs...)
+
+			o, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if got := meta.GetExternalName(cr); got != tc.want.externalName {
+				t.Errorf("\n%s\nObserve(...): got external name %q, want %q", tc.reason, got, tc.want.externalName)
+			}
+		})
+	}
+}
+
+func TestDeleteAlreadyDeleted(t *testing.T) {
+	cr := newBorkObjectTemplate(manifest)
+	meta.SetExternalName(cr, existingName)
+	e := newExternal(t)
+
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): deleting a BorkObjectTemplate whose object doesn't exist isn't an error: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkobjecttemplate
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+const (
+	errParseManifest        = "cannot parse manifest"
+	errManifestName         = "manifest must have a metadata.name"
+	errManifestScope        = "cannot determine whether the manifest's kind is namespaced"
+	errManifestNamespaceFmt = "manifest namespace %q must be the BorkObjectTemplate's namespace %q"
+)
+
+// parseManifest returns the object described by the supplied
+// BorkObjectTemplate's manifest. A namespaced object defaults to the
+// BorkObjectTemplate's namespace, and mustn't be in any other, so that a
+// BorkObjectTemplate can only materialize objects in its own namespace.
+func parseManifest(kube client.Client, cr *v1alpha1.BorkObjectTemplate) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(cr.Spec.ForProvider.Manifest.Raw); err != nil {
+		return nil, errors.Wrap(err, errParseManifest)
+	}
+	if u.GetName() == "" {
+		return nil, errors.New(errManifestName)
+	}
+
+	namespaced, err := kube.IsObjectNamespaced(u)
+	if err != nil {
+		return nil, errors.Wrap(err, errManifestScope)
+	}
+	switch ns := u.GetNamespace(); {
+	case !namespaced:
+		u.SetNamespace("")
+	case ns == "":
+		u.SetNamespace(cr.GetNamespace())
+	case ns != cr.GetNamespace():
+		return nil, errors.Errorf(errManifestNamespaceFmt, ns, cr.GetNamespace())
+	}
+	return u, nil
+}
+
+// specified returns the fields of the supplied object that its manifest
+// specifies, and the provider applies. Of its metadata, only its labels and
+// annotations are applied; the rest identifies the object.
+func specified(u *unstructured.Unstructured) map[string]any {
+	fields := make(map[string]any, len(u.Object))
+	for k, v := range u.Object {
+		switch k {
+		case "apiVersion", "kind":
+		case "metadata":
+			md := map[string]any{}
+			if m, ok := v.(map[string]any); ok {
+				for _, f := range []string{"labels", "annotations"} {
+					if fv, ok := m[f]; ok {
+						md[f] = fv
+					}
+				}
+			}
+			if len(md) > 0 {
+				fields[k] = md
+			}
+		default:
+			fields[k] = v
+		}
+	}
+	return fields
+}
+
+// prune returns the fields of the observed value that the desired value
+// specifies. Lists are pruned element by element, if they're the same
+// length; a list of another length differs from the desired list anyway.
+func prune(observed, desired any) any {
+	switch d := desired.(type) {
+	case map[string]any:
+		o, ok := observed.(map[string]any)
+		if !ok {
+			return observed
+		}
+		p := make(map[string]any, len(d))
+		for k, dv := range d {
+			if ov, ok := o[k]; ok {
+				p[k] = prune(ov, dv)
+			}
+		}
+		return p
+	case []any:
+		o, ok := observed.([]any)
+		if !ok || len(o) != len(d) {
+			return observed
+		}
+		p := make([]any, len(o))
+		for i := range o {
+			p[i] = prune(o[i], d[i])
+		}
+		return p
+	}
+	return observed
+}
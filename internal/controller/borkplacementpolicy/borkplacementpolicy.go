@@ -0,0 +1,343 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkplacementpolicy
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkPlacementPolicy = "managed resource is not a BorkPlacementPolicy custom resource"
+
+	errSelector        = "cannot parse target selector"
+	errListTargets     = "cannot list target BorkResources"
+	errGetPlacement    = "cannot get placement"
+	errCreatePlacement = "cannot create placement"
+	errUpdatePlacement = "cannot update placement"
+	errDeletePlacement = "cannot delete placement"
+)
+
+// SetupGated adds a controller that reconciles BorkPlacementPolicy managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkPlacementPolicy controller"))
+		}
+	}, v1alpha1.BorkPlacementPolicyGroupVersionKind, v1alpha1.BorkResourceGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkPlacementPolicyGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkPlacementPolicyKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkPlacementPolicyList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+		)),
+		// The backend assigns each placement's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkPlacementPolicyList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkPlacementPolicyList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkPlacementPolicyList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkPlacementPolicyList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindPlacement, func() resource.ManagedList { return &v1alpha1.BorkPlacementPolicyList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkPlacementPolicyList")
+		}
+	}
+
+	leak.Default.Register(backend.KindPlacement, func() resource.ManagedList { return &v1alpha1.BorkPlacementPolicyList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkPlacementPolicyGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkPlacementPolicy{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkPlacementPolicyKind, o)).
+		For(&v1alpha1.BorkPlacementPolicy{}, builder.WithPredicates(resource.DesiredStateChanged(), shard.Default.Predicate())).
+		WatchesRawSource(subscription.Default.Source(backend.KindPlacement, func() resource.ManagedList { return &v1alpha1.BorkPlacementPolicyList{} })).
+		// Re-resolve a policy's targets whenever a BorkResource it might
+		// select is created, deleted, relabelled, or assigned an external
+		// name. A BorkResource may be sharded differently from the policies
+		// that select it, so its events aren't filtered by shard.
+		Watches(&v1alpha1.BorkResource{}, handler.EnqueueRequestsFromMapFunc(enqueuePoliciesFor(mgr.GetClient()))).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkPlacementPolicyGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkPlacementPolicyGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkPlacementPolicyGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkPlacementPolicyKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// enqueuePoliciesFor returns a function that maps a BorkResource to the
+// BorkPlacementPolicies in its namespace that select it. Controller-runtime
+// calls the function with both the old and new object when a BorkResource is
+// updated, so policies that stop selecting a BorkResource are enqueued too.
+func enqueuePoliciesFor(kube client.Client) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		l := &v1alpha1.BorkPlacementPolicyList{}
+		if err := kube.List(ctx, l, client.InNamespace(o.GetNamespace())); err != nil {
+			return nil
+		}
+
+		var reqs []reconcile.Request
+		for _, p := range l.Items {
+			s, err := metav1.LabelSelectorAsSelector(&p.Spec.ForProvider.TargetSelector)
+			if err != nil || !s.Matches(labels.Set(o.GetLabels())) {
+				continue
+			}
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: p.GetNamespace(), Name: p.GetName()}})
+		}
+		return reqs
+	}
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles placements in the
+// simulated backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube    client.Client
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkPlacementPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkPlacementPolicy)
+	}
+
+	targets, err := c.resolveTargets(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.Targets = targets
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p, err := c.service.GetPlacement(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPlacement)
+	}
+	cr.Status.AtProvider.Zone = p.Zone
+	cr.Status.AtProvider.Placed = p.Targets
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	d := diff(backend.Placement{Zone: cr.Spec.ForProvider.Zone, Targets: placeable(targets)}, p)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkPlacementPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkPlacementPolicy)
+	}
+
+	p, err := c.service.CreatePlacement(ctx, backend.Placement{
+		Zone:    cr.Spec.ForProvider.Zone,
+		Targets: placeable(cr.Status.AtProvider.Targets),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePlacement)
+	}
+	meta.SetExternalName(cr, p.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkPlacementPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkPlacementPolicy)
+	}
+
+	if _, err := c.service.UpdatePlacement(ctx, backend.Placement{
+		Name:    meta.GetExternalName(cr),
+		Zone:    cr.Spec.ForProvider.Zone,
+		Targets: placeable(cr.Status.AtProvider.Targets),
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePlacement)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkPlacementPolicy)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkPlacementPolicy)
+	}
+
+	if err := c.service.DeletePlacement(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeletePlacement)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// resolveTargets returns the BorkResources selected by the supplied policy,
+// sorted by name.
+func (c *external) resolveTargets(ctx context.Context, cr *v1alpha1.BorkPlacementPolicy) ([]v1alpha1.PlacementTarget, error) {
+	s, err := metav1.LabelSelectorAsSelector(&cr.Spec.ForProvider.TargetSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, errSelector)
+	}
+
+	l := &v1alpha1.BorkResourceList{}
+	if err := c.kube.List(ctx, l, client.InNamespace(cr.GetNamespace()), client.MatchingLabelsSelector{Selector: s}); err != nil {
+		return nil, errors.Wrap(err, errListTargets)
+	}
+
+	targets := make([]v1alpha1.PlacementTarget, 0, len(l.Items))
+	for i := range l.Items {
+		targets = append(targets, v1alpha1.PlacementTarget{
+			Name:         l.Items[i].GetName(),
+			ExternalName: meta.GetExternalName(&l.Items[i]),
+		})
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Name < targets[j].Name })
+	return targets, nil
+}
+
+// placementCompareOptions compare the fields of a placement that are under
+// our control. An empty list of targets is equivalent to an omitted one.
+var placementCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.Placement{}, "Name", "Revision"),
+	cmpopts.EquateEmpty(),
+}
+
+// diff returns a human-readable diff of the desired and observed placements,
+// or an empty string if the observed placement is up to date.
+func diff(desired, observed backend.Placement) string {
+	return cmp.Diff(desired, observed, placementCompareOptions...)
+}
+
+// placeable returns the sorted external names of the supplied targets that
+// exist in the backend.
+func placeable(targets []v1alpha1.PlacementTarget) []string {
+	names := make([]string, 0, len(targets))
+	for _, t := range targets {
+		if t.ExternalName != "" {
+			names = append(names, t.ExternalName)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkplacementpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the placement the fake backend stores.
+const existingName = "placement-existing"
+
+// borkResource returns a BorkResource with the supplied labels, whose record
+// is named by the supplied external name.
+func borkResource(name, externalName string, labels map[string]string) *v1alpha1.BorkResource {
+	cr := &v1alpha1.BorkResource{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: labels}}
+	meta.SetExternalName(cr, externalName)
+	return cr
+}
+
+// targets returns the BorkResources the fake API server stores. Those
+// labelled app=bork are selected, but only those with an external name are
+// placed.
+func targets() []client.Object {
+	return []client.Object{
+		borkResource("bork-b", "record-b", map[string]string{"app": "bork"}),
+		borkResource("bork-a", "record-a", map[string]string{"app": "bork"}),
+		borkResource("bork-pending", "", map[string]string{"app": "bork"}),
+		borkResource("woof", "record-woof", map[string]string{"app": "woof"}),
+	}
+}
+
+// newBorkPlacementPolicy returns a BorkPlacementPolicy that places the
+// BorkResources labelled app=bork in zone a.
+func newBorkPlacementPolicy() *v1alpha1.BorkPlacementPolicy {
+	return &v1alpha1.BorkPlacementPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkPlacementPolicySpec{ForProvider: v1alpha1.BorkPlacementPolicyParameters{
+			Zone:           "a",
+			TargetSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "bork"}},
+		}},
+	}
+}
+
+// newExternal returns an external client of a fake backend storing the
+// supplied placements, and of a fake API server storing the targets.
+func newExternal(t *testing.T, placements ...backend.Placement) (*borkfake.Client, *external) {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(targets()...).Build()
+
+	f := borkfake.New()
+	for _, p := range placements {
+		if _, err := f.Store.CreatePlacement(context.Background(), p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f, &external{kube: kube, service: f.Client}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o    managed.ExternalObservation
+		diff bool
+	}
+
+	cases := map[string]struct {
+		reason     string
+		placements []backend.Placement
+		want       want
+	}{
+		"UpToDate": {
+			reason:     "A placement of the selected records in the spec's zone is up to date.",
+			placements: []backend.Placement{{Name: existingName, Zone: "a", Targets: []string{"record-a", "record-b"}}},
+			want:       want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+		"TargetsChanged": {
+			reason:     "A placement that doesn't place every selected record is out of date.",
+			placements: []backend.Placement{{Name: existingName, Zone: "a", Targets: []string{"record-a"}}},
+			want:       want{o: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: managed.ConnectionDetails{}}, diff: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkPlacementPolicy()
+			meta.SetExternalName(cr, existingName)
+			_, e := newExternal(t, tc.placements...)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if got := o.Diff != ""; got != tc.want.diff {
+				t.Errorf("\n%s\nObserve(...): got diff %q, want a diff: %t", tc.reason, o.Diff, tc.want.diff)
+			}
+			want := []v1alpha1.PlacementTarget{{Name: "bork-a", ExternalName: "record-a"}, {Name: "bork-b", ExternalName: "record-b"}, {Name: "bork-pending"}}
+			if diff := cmp.Diff(want, cr.Status.AtProvider.Targets); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want targets, +got targets:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := newBorkPlacementPolicy()
+	meta.SetExternalName(cr, existingName)
+	f, e := newExternal(t, backend.Placement{Name: existingName, Zone: "a", Targets: []string{"record-a"}})
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	p, err := f.Store.GetPlacement(context.Background(), existingName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"record-a", "record-b"}, p.Targets); diff != "" {
+		t.Errorf("Update(...): the placement places the observed targets: -want targets, +got targets:\n%s", diff)
+	}
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkqueue
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkQueue = "managed resource is not a BorkQueue custom resource"
+
+	errGetQueue    = "cannot get queue"
+	errCreateQueue = "cannot create queue"
+	errUpdateQueue = "cannot update queue"
+	errDeleteQueue = "cannot delete queue"
+)
+
+// SetupGated adds a controller that reconciles BorkQueue managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkQueue controller"))
+		}
+	}, v1alpha1.BorkQueueGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkQueueGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkQueueKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkQueueList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithTagPropagation(),
+		)),
+		// The backend assigns each queue's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkQueueList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkQueueList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkQueueList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkQueueList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindQueue, func() resource.ManagedList { return &v1alpha1.BorkQueueList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkQueueList")
+		}
+	}
+
+	leak.Default.Register(backend.KindQueue, func() resource.ManagedList { return &v1alpha1.BorkQueueList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkQueueGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkQueue{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkQueueKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkQueue{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindQueue, func() resource.ManagedList { return &v1alpha1.BorkQueueList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkQueueGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkQueueGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkQueueGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkQueueKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles queues in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkQueue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkQueue)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The backend is eventually consistent, so a queue that was just created
+	// may not be found yet. The managed reconciler waits out its creation
+	// grace period before it believes a queue it created doesn't exist.
+	q, err := c.service.GetQueue(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetQueue)
+	}
+	cr.Status.AtProvider = generateObservation(q)
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	// A queue that was just updated may be stale, in which case it's updated
+	// again.
+	d := diff(generateQueue(cr.Spec.ForProvider), q)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkQueue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkQueue)
+	}
+
+	// Ask for the queue we already created, if any, so that creating it again
+	// doesn't leak a queue if it still isn't found once the creation grace
+	// period has passed. Depending on its duplicate create policy the backend
+	// either returns the queue we already created, or fails because it
+	// exists. Either way it's the queue we want, and it'll be updated to
+	// match our spec once it's visible.
+	q := generateQueue(cr.Spec.ForProvider)
+	q.Name = meta.GetExternalName(cr)
+	created, err := c.service.CreateQueue(ctx, q)
+	switch {
+	case backend.IsAlreadyExists(err) && q.Name != "":
+	case err != nil:
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateQueue)
+	default:
+		meta.SetExternalName(cr, created.Name)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkQueue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkQueue)
+	}
+
+	q := generateQueue(cr.Spec.ForProvider)
+	q.Name = meta.GetExternalName(cr)
+	if _, err := c.service.UpdateQueue(ctx, q); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateQueue)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkQueue)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkQueue)
+	}
+
+	if err := c.service.DeleteQueue(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteQueue)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// generateQueue returns the backend queue described by the supplied
+// parameters.
+func generateQueue(p v1alpha1.BorkQueueParameters) backend.Queue {
+	q := backend.Queue{
+		Tags:              p.Tags,
+		VisibilityTimeout: time.Duration(ptr.Deref(p.VisibilityTimeoutSeconds, 0)) * time.Second,
+		MessageRetention:  time.Duration(ptr.Deref(p.MessageRetentionSeconds, 0)) * time.Second,
+	}
+	if p.ConsistencyWindow != nil {
+		q.ConsistencyWindow = p.ConsistencyWindow.Duration
+	}
+	return q
+}
+
+func generateObservation(q backend.Queue) v1alpha1.BorkQueueObservation {
+	return v1alpha1.BorkQueueObservation{
+		Tags:                     q.Tags,
+		VisibilityTimeoutSeconds: int64(q.VisibilityTimeout / time.Second),
+		MessageRetentionSeconds:  int64(q.MessageRetention / time.Second),
+		ConsistencyWindow:        &metav1.Duration{Duration: q.ConsistencyWindow},
+		Revision:                 q.Revision,
+	}
+}
+
+// queueCompareOptions compare the fields of a queue that are under our
+// control. An empty map is equivalent to an omitted one.
+var queueCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.Queue{}, "Name", "Revision"),
+	cmpopts.EquateEmpty(),
+}
+
+// diff returns a human-readable diff of the desired and observed queues, or
+// an empty string if the observed queue is up to date.
+func diff(desired, observed backend.Queue) string {
+	return cmp.Diff(desired, observed, queueCompareOptions...)
+}
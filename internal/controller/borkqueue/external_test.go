@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the queue the fake backend stores.
+const existingName = "queue-existing"
+
+// newExternal returns an external client of a fake backend storing a queue
+// that won't be visible for an hour.
+func newExternal(t *testing.T) *external {
+	t.Helper()
+	f := borkfake.New()
+	if _, err := f.Store.CreateQueue(context.Background(), backend.Queue{Name: existingName, ConsistencyWindow: time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	return &external{service: f.Client}
+}
+
+// newBorkQueue returns a BorkQueue whose queue was already created.
+func newBorkQueue() *v1alpha1.BorkQueue {
+	cr := &v1alpha1.BorkQueue{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"}}
+	meta.SetExternalName(cr, existingName)
+	return cr
+}
+
+func TestObserveNotYetVisible(t *testing.T) {
+	o, err := newExternal(t).Observe(context.Background(), newBorkQueue())
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if o.ResourceExists {
+		t.Errorf("Observe(...): got a queue that exists, want one that isn't found until its consistency window passes")
+	}
+}
+
+func TestCreateAlreadyCreated(t *testing.T) {
+	// Creating the queue again, because it isn't found yet, must not fail,
+	// and must keep the name of the queue that was already created.
+	cr := newBorkQueue()
+	if _, err := newExternal(t).Create(context.Background(), cr); err != nil {
+		t.Errorf("Create(...): %v", err)
+	}
+	if got := meta.GetExternalName(cr); got != existingName {
+		t.Errorf("Create(...): got external name %q, want %q", got, existingName)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkregion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// unavailable is a region the fake backend offers in addition to its default
+// regions, but which isn't accepting new records.
+var unavailable = backend.Region{Name: "bork-south-3", Tiers: []string{"archive"}, MaxRequestsPerSecond: 100}
+
+// newBorkRegion returns a BorkRegion observing the supplied parameters.
+func newBorkRegion(p v1alpha1.BorkRegionParameters) *v1alpha1.BorkRegion {
+	return &v1alpha1.BorkRegion{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec:       v1alpha1.BorkRegionSpec{ForProvider: p},
+	}
+}
+
+// newExternal returns an external client of a fake backend offering its
+// default regions and an unavailable one.
+func newExternal(t *testing.T) (*borkfake.Client, *external) {
+	t.Helper()
+	f := borkfake.New()
+	if err := f.Store.SetRegion(context.Background(), unavailable); err != nil {
+		t.Fatal(err)
+	}
+	return f, &external{service: f.Client}
+}
+
+func TestObserve(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		params  v1alpha1.BorkRegionParameters
+		deleted bool
+		regions []string
+	}{
+		"Deleted": {
+			reason:  "A deleted BorkRegion ceases to exist without listing regions.",
+			deleted: true,
+		},
+		"Available": {
+			reason:  "Only available regions are observed by default.",
+			regions: []string{backend.DefaultRegion, "bork-east-1", "bork-west-2"},
+		},
+		"IncludeUnavailable": {
+			reason:  "Unavailable regions are observed when asked for.",
+			params:  v1alpha1.BorkRegionParameters{IncludeUnavailable: true},
+			regions: []string{backend.DefaultRegion, "bork-east-1", "bork-south-3", "bork-west-2"},
+		},
+		"Tier": {
+			reason:  "Only regions offering the requested tier are observed.",
+			params:  v1alpha1.BorkRegionParameters{Tier: ptr.To("archive")},
+			regions: []string{"bork-west-2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkRegion(tc.params)
+			if tc.deleted {
+				cr.SetDeletionTimestamp(ptr.To(metav1.Now()))
+			}
+			_, e := newExternal(t)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			if o.ResourceExists == tc.deleted || o.ResourceUpToDate == tc.deleted {
+				t.Errorf("\n%s\nObserve(...): got %+v, want a BorkRegion that exists and is up to date unless it was deleted", tc.reason, o)
+			}
+			var regions []string
+			for _, r := range cr.Status.AtProvider.Regions {
+				regions = append(regions, r.Name)
+			}
+			if diff := cmp.Diff(tc.regions, regions); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want regions, +got regions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreateUpdateDelete(t *testing.T) {
+	cr := newBorkRegion(v1alpha1.BorkRegionParameters{})
+	f, e := newExternal(t)
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("Create(...): %v", err)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Errorf("Update(...): %v", err)
+	}
+	if _, err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): %v", err)
+	}
+	if calls := f.Calls(); len(calls) != 0 {
+		t.Errorf("Create, Update, Delete(...): got calls %v, want none: a BorkRegion only observes regions", calls)
+	}
+}
@@ -19,38 +19,94 @@ package borkresource
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
-
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
 
 	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
 )
 
 const (
 	errNotBorkResource = "managed resource is not a BorkResource custom resource"
-	errTrackPCUsage    = "cannot track ProviderConfig usage"
 	errGetPC           = "cannot get ProviderConfig"
 	errGetCPC          = "cannot get ClusterProviderConfig"
 	errGetCreds        = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+
+	errHeadRecord   = "cannot get revision of bork record"
+	errGetRecord    = "cannot get bork record"
+	errCreateRecord = "cannot create bork record"
+	errUpdateRecord = "cannot update bork record"
+	errDeleteRecord = "cannot delete bork record"
+
+	errActivateRecord = "cannot activate bork record"
+	errGetSecretValue = "cannot get secret value"
+	errLateInitialize = "cannot late initialize BorkResource"
+	errInvalidParams  = "invalid BorkResource parameters"
+	errHooksOnBehalf  = "a BorkResource with provisioning hooks can't have its bork record created on its behalf"
+
+	errParseRollbackFmt    = "cannot parse %s annotation %q"
+	errRollbackRevisionFmt = "cannot roll back to revision %d: it isn't in the bork record's history"
 )
 
-// A NoOpService does nothing.
-type NoOpService struct{}
+// ConnectionSecretKeySecretValue is the key of the connection secret to
+// which a BorkResource's secret value is published.
+const ConnectionSecretKeySecretValue = "secretValue"
+
+// ConnectionSecretKeyID is the key of the connection secret to which the UID
+// of a BorkResource's record is published.
+const ConnectionSecretKeyID = "id"
+
+// Event reasons recorded as a bork record that requires activation is
+// provisioned.
+const (
+	reasonPendingActivation event.Reason = "PendingActivation"
+	reasonActivating        event.Reason = "Activating"
+	reasonActivated         event.Reason = "Activated"
+)
 
-var newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+// reasonRolledBack is the reason of the event recorded when a bork record is
+// rolled back to a revision in its history.
+const reasonRolledBack event.Reason = "RolledBack"
 
 // SetupGated adds a controller that reconciles BorkResource managed resources with safe-start support.
 func SetupGated(mgr ctrl.Manager, o controller.Options) error {
@@ -64,23 +120,37 @@ func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.BorkResourceGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	// Most BorkResources are stable, so we poll them less often the longer
+	// they stay up to date.
+	backoff := middleware.NewPollBackoff(middleware.DefaultBackoffThreshold, middleware.DefaultMaxPollInterval)
 
 	opts := []managed.ReconcilerOption{
-		managed.WithExternalConnector(&connector{
-			kube:         mgr.GetClient(),
-			newServiceFn: newNoOpService,
-		}),
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkResourceKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkResourceList{} },
+			&connector{
+				kube:       mgr.GetClient(),
+				configMaps: mgr.GetAPIReader(),
+				pool:       clients.DefaultPool,
+				record:     recorder,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithPollBackoff(backoff),
+			middleware.WithDeduplicatedCreates(),
+			middleware.WithConnectionDetailTemplates(),
+			middleware.WithTagPropagation(),
+		)),
+		// The backend assigns each record's external name when it is
+		// created, so we don't want the default initializer to set it to the
+		// name of the managed resource.
+		managed.WithInitializers(),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-	}
-
-	if o.Features.Enabled(feature.EnableBetaManagementPolicies) {
-		opts = append(opts, managed.WithManagementPolicies())
-	}
-
-	if o.Features.Enabled(feature.EnableAlphaChangeLogs) {
-		opts = append(opts, managed.WithChangeLogger(o.ChangeLogOptions.ChangeLogger))
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithPollIntervalHook(untilThawed(untilReady(backoff.Hook(pollInterval)))),
+		managed.WithRecorder(recorder),
 	}
 
 	if o.MetricOptions != nil {
@@ -96,36 +166,101 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		}
 	}
 
-	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkResourceGroupVersionKind), opts...)
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkResourceList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkResourceList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindRecord, func() resource.ManagedList { return &v1alpha1.BorkResourceList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkResourceList")
+		}
+	}
+
+	leak.Default.Register(backend.KindRecord, func() resource.ManagedList { return &v1alpha1.BorkResourceList{} })
+
+	r := features.Default.NewReconciler(clients.ApplyStatus(mgr), resource.ManagedKind(v1alpha1.BorkResourceGroupVersionKind), o, opts...)
+
+	if err := setupIndex(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkResource{}); err != nil {
+		return err
+	}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.BorkResource{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkResourceKind, o)).
+		// The predicates filter only BorkResource events. ConfigMaps and
+		// Secrets have no generation, so filtering their events by desired
+		// state would drop every change to their data.
+		For(&v1alpha1.BorkResource{}, builder.WithPredicates(resource.DesiredStateChanged(), shard.Default.Predicate())).
+		WatchesRawSource(subscription.Default.Source(backend.KindRecord, func() resource.ManagedList { return &v1alpha1.BorkResourceList{} })).
+		// Reconcile the BorkResources whose valueFrom selects a ConfigMap or
+		// Secret whenever it changes. The cache may only hold the ConfigMaps
+		// the provider is configured by, so a change to any other ConfigMap
+		// is read when the BorkResources that select it are next polled.
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(enqueueValueFrom(mgr.GetClient(), kindConfigMap))).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(enqueueValueFrom(mgr.GetClient(), kindSecret))).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkResourceGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkResourceGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkResourceGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkResourceKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
-	kube         client.Client
-	newServiceFn func(creds []byte) (interface{}, error)
+	kube       client.Client
+	configMaps client.Reader
+	pool       *clients.Pool
+	record     event.Recorder
 }
 
-// Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// Connect produces an ExternalClient that talks to the backend using the
+// content types preferred by the BorkResource's provider config.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	return &external{kube: c.kube}, nil
+	cr, ok := mg.(*v1alpha1.BorkResource)
+	if !ok {
+		return nil, errors.New(errNotBorkResource)
+	}
+	key, pc, err := clients.ResolveProviderConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.pool.Connect(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, configMaps: c.configMaps, service: svc, record: c.record, providerConfig: providerConfigInfo(cr, key, pc), capabilities: pc.Capabilities}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	kube client.Client
+	kube    client.Client
+	service *backend.Client
+	record  event.Recorder
+
+	// configMaps reads the ConfigMaps valueFrom selects. It bypasses the
+	// cache, which may only hold the ConfigMaps the provider is configured
+	// by.
+	configMaps client.Reader
+
+	// observed is the record as last observed. The managed reconciler may
+	// replace our status with the one persisted by the API server between
+	// observing and updating, e.g. when it adds its finalizer, so Update
+	// uses this rather than our status to tell what it's updating.
+	observed *v1alpha1.BorkResourceObservation
+
+	// providerConfig describes the provider config the BorkResource's
+	// providerConfigRef resolved to when it was connected, and capabilities
+	// are that provider config's.
+	providerConfig *v1alpha1.ProviderConfigInfo
+	capabilities   *apisv1alpha1.Capabilities
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -134,15 +269,181 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotBorkResource)
 	}
 
-	// the resource is always considered "ready"
-	cr.Status.SetConditions(xpv1.Available())
+	defer c.recordProviderConfig(cr)
+
+	// A BorkResource that asks for more than its provider config allows must
+	// not be created or updated, but may still be deleted.
+	if !meta.WasDeleted(cr) {
+		if err := checkCapabilities(cr.Spec.ForProvider, c.capabilities); err != nil {
+			if cr.GetCondition(TypeCapabilityDenied).Status != corev1.ConditionTrue {
+				c.record.Event(cr, event.Warning(reasonCapabilityDenied, err))
+			}
+			cr.SetConditions(CapabilityDenied(err))
+			return managed.ExternalObservation{}, err
+		}
+		if cr.GetCondition(TypeCapabilityDenied).Status == corev1.ConditionTrue {
+			cr.SetConditions(CapabilityAllowed())
+		}
+	}
+
+	// Only the changes planned by the current reconcile are recorded. Update
+	// and Delete record them if this is a dry run, as does Observe for a
+	// record that doesn't exist.
+	cr.Status.AtProvider.PlannedChanges = nil
+
+	// The external name is assigned by the backend when the record is
+	// created. A BorkResource that doesn't have one yet has never been
+	// created, unless it is importing an existing record by name.
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return c.notExists(ctx, cr)
+	}
+
+	// A BorkResource that is being deleted doesn't need its secret value, and
+	// mustn't be stuck if its Secret was deleted first.
+	secret, err := c.secretValue(ctx, cr)
+	if err != nil && !meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, err
+	}
+	values, err := c.valueFrom(ctx, cr)
+	if err != nil && !meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, err
+	}
+	want := desired(cr.Spec.ForProvider, values)
+
+	// Ask the backend for the record's current revision first. If the record
+	// hasn't changed since we last observed it the observation persisted in
+	// our status is still accurate, and we can skip reading the full record.
+	// A sync request always reads the full record, as does a BorkResource
+	// with a secret value, which is never persisted in our status.
+	rev, err := c.service.Head(ctx, name)
+	if backend.IsNotFound(err) {
+		// A record that was renamed is observed by its new name once
+		// we've adopted it.
+		adopted, err := c.renamed(ctx, cr, name)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if adopted {
+			return c.Observe(ctx, cr)
+		}
+		return c.notExists(ctx, cr)
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errHeadRecord)
+	}
+
+	previous := backend.RecordState(cr.Status.AtProvider.State)
+	var observed backend.Record
+	if rev != cr.Status.AtProvider.Revision || middleware.SyncRequested(ctx) || secret != "" {
+		r, err := c.service.Get(ctx, name)
+		if backend.IsNotFound(err) {
+			return c.notExists(ctx, cr)
+		}
+		if err != nil && !backend.IsMalformed(err) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetRecord)
+		}
+		// A corrupted record is repaired if we're allowed to, and is
+		// otherwise observed as it is, unless it can't be decoded at all.
+		switch problem := corruption(r, err); {
+		case problem != "" && repairable(cr):
+			if r, err = c.repair(ctx, cr, want, problem, secret); err != nil {
+				return managed.ExternalObservation{}, err
+			}
+		case problem != "":
+			cr.Status.SetConditions(Corrupted(problem))
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errGetRecord)
+			}
+		case cr.Status.GetCondition(TypeCorrupted).Status == corev1.ConditionTrue:
+			cr.Status.SetConditions(Intact())
+		}
+		// A record whose UID isn't the one we last observed was deleted and
+		// recreated by someone else. We manage the new record from now on,
+		// but say that it was replaced. BorkResources observed before records
+		// had UIDs have their record's name as their ID.
+		if was := cr.Status.AtProvider.ID; was != "" && was != name && r.UID != was {
+			cr.Status.SetConditions(Replaced(name, was, r.UID))
+			c.record.Event(cr, event.Warning(reasonReplaced, errors.Errorf(msgReplacedFmt, name, was, r.UID)))
+		}
+		r = normalize(r, time.Now(), false)
+		cr.Status.AtProvider = generateObservation(r)
+		observed = r
+	}
+
+	// A record that requires activation doesn't exist as far as the managed
+	// reconciler is concerned until it is ACTIVE. Once its creation grace
+	// period has passed the managed reconciler calls Create again, which
+	// activates a PENDING record. A BorkResource that was deleted must still
+	// see its record, so that the record is deleted too.
+	switch state := backend.RecordState(cr.Status.AtProvider.State); {
+	case state == backend.RecordPending && !meta.WasDeleted(cr):
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage("bork record is awaiting activation"))
+		return c.notExists(ctx, cr)
+	case state == backend.RecordActivating && !meta.WasDeleted(cr):
+		cr.Status.SetConditions(xpv1.Creating().WithMessage("bork record is activating"))
+		return c.notExists(ctx, cr)
+	case state == backend.RecordActive:
+		if previous == backend.RecordPending || previous == backend.RecordActivating {
+			c.record.Event(cr, event.Normal(reasonActivated, "Bork record is active"))
+		}
+	}
+
+	// Nor does a record whose post-create hooks haven't all succeeded, so
+	// that the managed reconciler calls Create again to run them.
+	if !meta.WasDeleted(cr) && hooksPending(cr, v1alpha1.HookPhasePostCreate) {
+		cr.Status.SetConditions(xpv1.Creating().WithMessage("bork record's post-create hooks haven't all succeeded"))
+		return c.notExists(ctx, cr)
+	}
 
-	return managed.ExternalObservation{
+	// A record that is being deleted still exists, so that the managed
+	// reconciler keeps polling until the backend has removed it. There's
+	// nothing to update in the meantime.
+	if cr.Status.AtProvider.State == string(backend.RecordDeleting) {
+		cr.Status.SetConditions(xpv1.Deleting().WithObservedGeneration(cr.GetGeneration()))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	// We only late initialize if the BorkResource's management policies allow
+	// it, so that our spec otherwise remains the one the user wrote. We apply
+	// only the fields we late initialized rather than have the managed
+	// reconciler update the whole BorkResource, so that late initializing
+	// neither conflicts with nor overwrites other writers of its spec.
+	c.observed = cr.Status.AtProvider.DeepCopy()
+
+	if middleware.Allows(cr.GetManagementPolicies(), xpv1.ManagementActionLateInitialize) {
+		if filled := lateInitialize(&cr.Spec.ForProvider, cr.Status.AtProvider); filled != nil {
+			if err := clients.ApplySpec(ctx, c.kube, cr, map[string]any{"forProvider": filled}); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errLateInitialize)
+			}
+		}
+	}
+
+	cr.Status.SetConditions(readiness(cr, want, time.Now()).WithObservedGeneration(cr.GetGeneration()))
+	if cr.Status.GetCondition(TypeFrozen).Status == corev1.ConditionTrue && frozenFor(cr, time.Now()) == 0 {
+		cr.Status.SetConditions(Thawed())
+	}
+
+	p, err := rollback(cr, ignore(want, cr.Status.AtProvider), cr.Status.AtProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	o := managed.ExternalObservation{
 		ResourceExists: true,
-		// the resource is up to date if the DataValue matches the BorkValue
-		ResourceUpToDate:  cr.Spec.ForProvider.DataValue == cr.Spec.ForProvider.BorkValue,
-		ConnectionDetails: managed.ConnectionDetails{},
-	}, nil
+		// the resource is up to date if the backend record matches our spec,
+		// and has been borked
+		ResourceUpToDate:  isRecordUpToDate(p, cr.Status.AtProvider) && observed.SecretValue == secret,
+		ConnectionDetails: connectionDetails(observed),
+	}
+	// Diffing is much more expensive than comparing, and most observations
+	// find the record up to date. The diff never includes the secret value.
+	if !o.ResourceUpToDate {
+		o.Diff = diff(p, cr.Status.AtProvider)
+		if observed.SecretValue != secret {
+			o.Diff += "secretValue: (redacted) differs\n"
+		}
+	}
+	return o, nil
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -150,11 +451,73 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotBorkResource)
 	}
+	defer c.recordProviderConfig(cr)
+
+	// A record whose post-create hooks haven't all succeeded already exists.
+	// Creating it runs the hooks that remain.
+	if name := meta.GetExternalName(cr); name != "" && cr.Status.AtProvider.State == string(backend.RecordActive) && hooksPending(cr, v1alpha1.HookPhasePostCreate) {
+		if err := c.runHooks(ctx, cr, v1alpha1.HookPhasePostCreate); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		r, err := c.service.Get(ctx, name)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetRecord)
+		}
+		return managed.ExternalCreation{ConnectionDetails: connectionDetails(r)}, nil
+	}
+
+	// A record that requires activation is created in two steps: first it's
+	// created, then it's activated. Creating a record that already exists
+	// takes the second step.
+	if name := meta.GetExternalName(cr); name != "" && cr.Spec.ForProvider.Activation != nil {
+		r, err := c.service.Activate(ctx, name)
+		if err == nil {
+			if backend.RecordState(cr.Status.AtProvider.State) == backend.RecordPending {
+				c.record.Event(cr, event.Normal(reasonActivating, "Activating bork record"))
+			}
+			cr.Status.AtProvider = generateObservation(normalize(r, time.Now(), false))
+			return managed.ExternalCreation{}, nil
+		}
+		if !backend.IsNotFound(err) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errActivateRecord)
+		}
+		// The record doesn't exist anymore. Create it again.
+	}
 
-	fmt.Printf("Creating: %+v", cr)
+	if err := validate(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	startHooks(cr)
+	if err := c.runHooks(ctx, cr, v1alpha1.HookPhasePreCreate); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	rec, err := c.generate(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	rec.UID = uuid.NewString()
+	r, err := c.service.Create(ctx, rec)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRecord)
+	}
+	meta.SetExternalName(cr, r.Name)
+	// A create that's deduplicated returns a record that was written
+	// earlier, with a UID other than ours.
+	r = normalize(r, time.Now(), r.UID == rec.UID)
+	cr.Status.AtProvider = generateObservation(r)
+	if r.State == backend.RecordPending {
+		c.record.Event(cr, event.Normal(reasonPendingActivation, "Created bork record; it will be activated once the creation grace period has passed"))
+	}
+	// The post-create hooks of a record that requires activation run once
+	// it's active.
+	if r.State == backend.RecordActive {
+		if err := c.runHooks(ctx, cr, v1alpha1.HookPhasePostCreate); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
 
 	return managed.ExternalCreation{
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: connectionDetails(r),
 	}, nil
 }
 
@@ -163,23 +526,73 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotBorkResource)
 	}
+	defer c.recordProviderConfig(cr)
 
-	if cr.Spec.ForProvider.DataValue == cr.Spec.ForProvider.BorkValue {
-		// nothing to do, DataValue already matches BorkValue
+	secret, err := c.secretValue(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	values, err := c.valueFrom(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	observed := cr.Status.AtProvider
+	if c.observed != nil {
+		observed = *c.observed
+	}
+
+	// Ignored fields keep their observed values, so they're never updated.
+	p, err := rollback(cr, ignore(desired(cr.Spec.ForProvider, values), observed), observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// A sync request rewrites the record even if it appears to be up to date,
+	// as does a BorkResource with a secret value, because we can't tell
+	// whether the record's secret value is up to date from our status.
+	if !middleware.SyncRequested(ctx) && secret == "" && isRecordUpToDate(p, observed) {
 		return managed.ExternalUpdate{}, nil
 	}
+	if err := validate(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 
-	// set DataValue to equal BorkValue and perform the update
-	cr.Spec.ForProvider.DataValue = cr.Spec.ForProvider.BorkValue
+	if middleware.DryRun(cr) {
+		want := updatedRecord(p, observed)
+		want.SecretValue = secret
+		cr.Status.AtProvider.PlannedChanges = plan(v1alpha1.PlannedActionUpdate, observedRecord(observed), want)
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// A record can't be modified until its freeze window has passed, so we
+	// defer updating it until then. We're polled again as soon as it has.
+	if d := frozenFor(cr, time.Now()); d > 0 {
+		cr.Status.SetConditions(Frozen(d))
+		return managed.ExternalUpdate{}, nil
+	}
 
-	if err := c.kube.Update(ctx, cr); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update BorkResource")
+	// Updating the record borks it, writing our BorkValue to its data value
+	// per our update strategy. Only the record is borked; our spec is never
+	// written, so that updating honours management policies that don't allow
+	// late initialization.
+	rec := updatedRecord(p, observed)
+	rec.Name = meta.GetExternalName(cr)
+	rec.SecretValue = secret
+	r, err := c.service.Patch(ctx, recordPatch(rec, p, observed))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRecord)
+	}
+	r = normalize(r, time.Now(), true)
+	cr.Status.AtProvider = generateObservation(r)
+	if rev, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyRollbackToRevision]; ok && !maps.Equal(observed.BorkValue, r.BorkValue) {
+		c.record.Event(cr, event.Normal(reasonRolledBack, "Rolled bork record back to the borkValue of revision "+rev))
 	}
 
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: connectionDetails(r),
 	}, nil
 }
 
@@ -189,11 +602,604 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotBorkResource)
 	}
 
-	fmt.Printf("Deleting: %+v", cr)
+	// Deleting a dry run doesn't delete its record, so the BorkResource
+	// remains until it's no longer a dry run.
+	if middleware.DryRun(cr) {
+		cr.Status.AtProvider.PlannedChanges = []v1alpha1.PlannedChange{{Action: v1alpha1.PlannedActionDelete}}
+		return managed.ExternalDelete{}, nil
+	}
+
+	if err := c.service.Delete(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteRecord)
+	}
 
 	return managed.ExternalDelete{}, nil
 }
 
-func (c *external) Disconnect(ctx context.Context) error {
+// notExists returns the observation of a record that doesn't exist, or isn't
+// yet active, so that the managed reconciler calls Create. The managed
+// reconciler discards changes Create makes to our status, so a dry run
+// instead records the changes Create would make here, and reports that its
+// record exists and is up to date so that Create isn't called. A dry run that
+// was deleted doesn't exist, so that it can be deleted.
+func (c *external) notExists(ctx context.Context, cr *v1alpha1.BorkResource) (managed.ExternalObservation, error) {
+	if !middleware.DryRun(cr) || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err := c.planCreate(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.SetConditions(xpv1.Unavailable().WithMessage("bork record would be created, but this is a dry run").WithObservedGeneration(cr.GetGeneration()))
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// planCreate records the changes creating the supplied BorkResource's record
+// would make. Creating a record that awaits activation would activate it.
+func (c *external) planCreate(ctx context.Context, cr *v1alpha1.BorkResource) error {
+	if meta.GetExternalName(cr) != "" && cr.Spec.ForProvider.Activation != nil && cr.Status.AtProvider.State != "" {
+		cr.Status.AtProvider.PlannedChanges = []v1alpha1.PlannedChange{{
+			Action: v1alpha1.PlannedActionUpdate,
+			Field:  "state",
+			From:   cr.Status.AtProvider.State,
+			To:     string(backend.RecordActive),
+		}}
+		return nil
+	}
+	want, err := c.generate(ctx, cr)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.PlannedChanges = plan(v1alpha1.PlannedActionCreate, backend.Record{}, want)
 	return nil
 }
+
+// generate returns the record creating the supplied BorkResource creates,
+// with the secret value and the values its valueFrom selects.
+func (c *external) generate(ctx context.Context, cr *v1alpha1.BorkResource) (backend.Record, error) {
+	secret, err := c.secretValue(ctx, cr)
+	if err != nil {
+		return backend.Record{}, err
+	}
+	values, err := c.valueFrom(ctx, cr)
+	if err != nil {
+		return backend.Record{}, err
+	}
+	r := generateRecord(desired(cr.Spec.ForProvider, values))
+	r.SecretValue = secret
+	return r, nil
+}
+
+// GenerateRecord returns the record creating the supplied BorkResource
+// creates, for a caller that creates it on the BorkResource's behalf, such as
+// a BorkFleet that creates the records of its BorkResources in one
+// transaction. The BorkResource adopts the record once it's named by its
+// external name. Its Secret and ConfigMaps are read using the supplied
+// clients. A BorkResource with provisioning hooks can't be created on its
+// behalf, because its hooks must run as it's created.
+func GenerateRecord(ctx context.Context, kube client.Client, configMaps client.Reader, cr *v1alpha1.BorkResource) (backend.Record, error) {
+	if len(cr.Spec.ForProvider.Hooks) > 0 {
+		return backend.Record{}, errors.New(errHooksOnBehalf)
+	}
+	if err := validate(cr); err != nil {
+		return backend.Record{}, err
+	}
+	c := &external{kube: kube, configMaps: configMaps}
+	r, err := c.generate(ctx, cr)
+	if err != nil {
+		return backend.Record{}, err
+	}
+	r.UID = uuid.NewString()
+	return r, nil
+}
+
+// secretValue returns the secret value selected by the supplied
+// BorkResource, if any. The value is extracted from a Secret in the
+// BorkResource's namespace, just as credentials are extracted from the
+// Secret a provider config selects.
+func (c *external) secretValue(ctx context.Context, cr *v1alpha1.BorkResource) (string, error) {
+	sel := cr.Spec.ForProvider.SecretValue
+	if sel == nil {
+		return "", nil
+	}
+	b, err := resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, c.kube, xpv1.CommonCredentialSelectors{
+		SecretRef: sel.ToSecretKeySelector(cr.GetNamespace()),
+	})
+	return string(b), errors.Wrap(err, errGetSecretValue)
+}
+
+// connectionDetails returns the details of the supplied record that are
+// published to its BorkResource's connection secret.
+func connectionDetails(r backend.Record) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if r.UID != "" {
+		cd[ConnectionSecretKeyID] = []byte(r.UID)
+	}
+	if r.SecretValue != "" {
+		cd[ConnectionSecretKeySecretValue] = []byte(r.SecretValue)
+	}
+	return cd
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// pollInterval returns the poll interval requested by the supplied
+// BorkResource's pollIntervalSeconds parameter or, if that is unset, its poll
+// interval annotation. It returns the supplied default if the BorkResource
+// requests no valid poll interval.
+func pollInterval(mg resource.Managed, d time.Duration) time.Duration {
+	if cr, ok := mg.(*v1alpha1.BorkResource); ok && ptr.Deref(cr.Spec.ForProvider.PollIntervalSeconds, 0) > 0 {
+		return time.Duration(*cr.Spec.ForProvider.PollIntervalSeconds) * time.Second
+	}
+	v, ok := mg.GetAnnotations()[v1alpha1.AnnotationKeyPollInterval]
+	if !ok {
+		return d
+	}
+	pi, err := time.ParseDuration(v)
+	if err != nil || pi <= 0 {
+		return d
+	}
+	return pi
+}
+
+// readiness returns the Ready condition of the supplied BorkResource, whose
+// record exists and whose desired parameters are supplied, at the supplied
+// time, as determined by its readiness probe.
+func readiness(cr *v1alpha1.BorkResource, want v1alpha1.BorkResourceParameters, now time.Time) xpv1.Condition {
+	p := cr.Spec.ForProvider.ReadinessProbe
+	if p == nil {
+		return xpv1.Available()
+	}
+	switch p.Type {
+	case v1alpha1.ReadinessProbeValueMatches:
+		if !isDataUpToDate(want, cr.Status.AtProvider.DataValue) {
+			return xpv1.Unavailable().WithMessage(fmt.Sprintf("bork record's data value %v doesn't match its borkValue %v", cr.Status.AtProvider.DataValue, want.BorkValue))
+		}
+	case v1alpha1.ReadinessProbeAfterSeconds:
+		if d := untilReadyAfter(cr, now); d > 0 {
+			return xpv1.Unavailable().WithMessage(fmt.Sprintf("bork record will be ready in %s", d.Round(time.Second)))
+		}
+	case v1alpha1.ReadinessProbeNever:
+		return xpv1.Unavailable().WithMessage("bork record is never ready")
+	}
+	return xpv1.Available()
+}
+
+// untilReadyAfter returns how long after the supplied time the supplied
+// BorkResource's AfterSeconds readiness probe passes, or zero if it has passed
+// or the BorkResource doesn't have one. The probe is timed from when the
+// provider created the record, or from when the BorkResource was created if
+// it adopted its record.
+func untilReadyAfter(cr *v1alpha1.BorkResource, now time.Time) time.Duration {
+	p := cr.Spec.ForProvider.ReadinessProbe
+	if p == nil || p.Type != v1alpha1.ReadinessProbeAfterSeconds {
+		return 0
+	}
+	created := meta.GetExternalCreateSucceeded(cr)
+	if created.IsZero() {
+		created = cr.GetCreationTimestamp().Time
+	}
+	return max(created.Add(time.Duration(ptr.Deref(p.Seconds, 0))*time.Second).Sub(now), 0)
+}
+
+// untilReady wraps the supplied poll interval hook such that a BorkResource
+// whose AfterSeconds readiness probe hasn't passed is polled again as soon as
+// it does, rather than at its next poll.
+func untilReady(h managed.PollIntervalHook) managed.PollIntervalHook {
+	return func(mg resource.Managed, d time.Duration) time.Duration {
+		d = h(mg, d)
+		cr, ok := mg.(*v1alpha1.BorkResource)
+		if !ok {
+			return d
+		}
+		if r := untilReadyAfter(cr, time.Now()); r > 0 && r < d {
+			return r
+		}
+		return d
+	}
+}
+
+func generateObservation(r backend.Record) v1alpha1.BorkResourceObservation {
+	o := v1alpha1.BorkResourceObservation{
+		ID:             r.UID,
+		ARN:            r.ARN,
+		State:          string(r.State),
+		Generation:     r.Generation,
+		LastModified:   ptr.To(metav1.NewTime(r.LastModified)),
+		BorkValue:      r.BorkValue,
+		DataValue:      r.DataValue,
+		Region:         r.Region,
+		Tier:           r.Tier,
+		Tags:           r.Tags,
+		HasSecretValue: r.SecretValue != "",
+		Payload:        r.Payload,
+		UnknownFields:  r.UnknownFields,
+		Revision:       r.Revision,
+	}
+	if r.DriftInterval > 0 {
+		o.DriftInterval = &metav1.Duration{Duration: r.DriftInterval}
+	}
+	if r.TeardownDelay > 0 {
+		o.TeardownDelay = &metav1.Duration{Duration: r.TeardownDelay}
+	}
+	if !r.ActivatedAt.IsZero() {
+		o.ActivatedAt = ptr.To(metav1.NewTime(r.ActivatedAt))
+	}
+	for _, h := range r.History {
+		o.History = append(o.History, v1alpha1.BorkValueRevision{Revision: h.Revision, BorkValue: h.BorkValue, AppliedAt: metav1.NewTime(h.AppliedAt)})
+	}
+	return o
+}
+
+// validate returns an error if the supplied BorkResource's parameters break
+// its CRD's validation rules, which the API server may not have enforced. The
+// error is classified as a bad request, as if the backend rejected them.
+func validate(cr *v1alpha1.BorkResource) error {
+	errs := cr.Spec.ForProvider.Validate(field.NewPath("spec", "forProvider"))
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Wrap(backend.NewError(backend.ErrorCodeBadRequest, errs.ToAggregate().Error()), errInvalidParams)
+}
+
+// generateRecord returns the backend record described by the supplied
+// parameters. Unset optional parameters are left for the backend to default.
+func generateRecord(p v1alpha1.BorkResourceParameters) backend.Record {
+	r := backend.Record{
+		BorkValue:     p.BorkValue,
+		DataValue:     p.DataValue,
+		Region:        ptr.Deref(p.Region, ""),
+		Tier:          ptr.Deref(p.Tier, ""),
+		Tags:          p.Tags,
+		DriftInterval: durationOf(p.DriftInterval),
+		TeardownDelay: durationOf(p.TeardownDelay),
+		Payload:       payload(p),
+	}
+	if p.Activation != nil {
+		r.RequiresActivation = true
+		r.ActivationDelay = durationOf(p.Activation.Delay)
+	}
+	return r
+}
+
+// updatedRecord returns the borked backend record described by the supplied
+// parameters, whose data value is what writing their BorkValue to the
+// observed data value per their update strategy results in. Unset optional
+// parameters keep the values observed in the backend, as do tags that the
+// parameters don't set and unknown fields, so that fields the BorkResource
+// doesn't manage aren't reset when it isn't allowed to late initialize them.
+func updatedRecord(p v1alpha1.BorkResourceParameters, o v1alpha1.BorkResourceObservation) backend.Record {
+	r := generateRecord(p)
+	r.DataValue = desiredData(p, o.DataValue)
+	r.UnknownFields = o.UnknownFields
+	if p.Region == nil {
+		r.Region = o.Region
+	}
+	if p.Tier == nil {
+		r.Tier = o.Tier
+	}
+	if len(o.Tags) > 0 {
+		r.Tags = make(map[string]string, len(o.Tags)+len(p.Tags))
+		for k, v := range o.Tags {
+			r.Tags[k] = v
+		}
+		for k, v := range p.Tags {
+			r.Tags[k] = v
+		}
+	}
+	return r
+}
+
+// recordPatch returns the patch that writes the supplied updated record,
+// whose data value is the one the supplied parameters' update strategy
+// results in. A merge sends only the BorkValue, and a JSON patch only the
+// changes to the observed data value, so that the backend computes the
+// result. A record whose data value is ignored is replaced with the observed
+// data value.
+func recordPatch(r backend.Record, p v1alpha1.BorkResourceParameters, o v1alpha1.BorkResourceObservation) backend.RecordPatch {
+	switch {
+	case ignores(p, ignoreDataValue):
+		return backend.RecordPatch{Record: r, Strategy: backend.UpdateStrategyReplace}
+	case p.UpdateStrategy == v1alpha1.UpdateStrategyMerge:
+		r.DataValue = p.BorkValue
+		return backend.RecordPatch{Record: r, Strategy: backend.UpdateStrategyMerge}
+	case p.UpdateStrategy == v1alpha1.UpdateStrategyJSONPatch:
+		r.DataValue = nil
+		return backend.RecordPatch{Record: r, Strategy: backend.UpdateStrategyJSONPatch, Operations: backend.DiffData(o.DataValue, p.BorkValue)}
+	default:
+		return backend.RecordPatch{Record: r, Strategy: backend.UpdateStrategyReplace}
+	}
+}
+
+// desiredData returns the data value that writing the supplied parameters'
+// BorkValue to the supplied observed data value results in, per their update
+// strategy. An ignored data value is always the observed one.
+func desiredData(p v1alpha1.BorkResourceParameters, observed map[string]string) map[string]string {
+	if ignores(p, ignoreDataValue) {
+		return maps.Clone(observed)
+	}
+	if p.UpdateStrategy == v1alpha1.UpdateStrategyMerge {
+		return backend.MergeData(observed, p.BorkValue)
+	}
+	return maps.Clone(p.BorkValue)
+}
+
+// isDataUpToDate returns true if the supplied observed data value is what
+// writing the supplied parameters' BorkValue to it results in. A merged data
+// value may have keys the BorkValue doesn't.
+func isDataUpToDate(p v1alpha1.BorkResourceParameters, observed map[string]string) bool {
+	return maps.Equal(desiredData(p, observed), observed)
+}
+
+// observedRecord returns the backend record described by the supplied
+// observation. Its secret value is a placeholder if the record has one.
+func observedRecord(o v1alpha1.BorkResourceObservation) backend.Record {
+	r := backend.Record{
+		BorkValue:     o.BorkValue,
+		DataValue:     o.DataValue,
+		Region:        o.Region,
+		Tier:          o.Tier,
+		Tags:          o.Tags,
+		DriftInterval: durationOf(o.DriftInterval),
+		TeardownDelay: durationOf(o.TeardownDelay),
+		Payload:       o.Payload,
+	}
+	if o.HasSecretValue {
+		r.SecretValue = redacted
+	}
+	return r
+}
+
+// redacted replaces secret values in planned changes.
+const redacted = "(redacted)"
+
+// plan returns the changes to the fields of the supplied current record that
+// the supplied action would make to write the desired record. Every field the
+// desired record sets is changed by a create. Only whether the record has a
+// secret value is planned, never the value itself.
+func plan(action v1alpha1.PlannedAction, current, desired backend.Record) []v1alpha1.PlannedChange {
+	var changes []v1alpha1.PlannedChange
+	field := func(name, from, to string) {
+		if action == v1alpha1.PlannedActionCreate {
+			from = ""
+		}
+		if from != to {
+			changes = append(changes, v1alpha1.PlannedChange{Action: action, Field: name, From: from, To: to})
+		}
+	}
+	duration := func(d time.Duration) string {
+		if d <= 0 {
+			return ""
+		}
+		return d.String()
+	}
+	secret := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		return redacted
+	}
+	size := func(payload string) string {
+		if payload == "" {
+			return ""
+		}
+		return strconv.Itoa(len(payload) / 1024)
+	}
+	values := func(m map[string]string) string {
+		if len(m) == 0 {
+			return ""
+		}
+		return fmt.Sprint(m)
+	}
+	field("borkValue", values(current.BorkValue), values(desired.BorkValue))
+	field("dataValue", values(current.DataValue), values(desired.DataValue))
+	field("region", current.Region, desired.Region)
+	field("tier", current.Tier, desired.Tier)
+	field("tags", values(current.Tags), values(desired.Tags))
+	field("driftInterval", duration(current.DriftInterval), duration(desired.DriftInterval))
+	field("teardownDelay", duration(current.TeardownDelay), duration(desired.TeardownDelay))
+	field("secretValue", secret(current.SecretValue), secret(desired.SecretValue))
+	field("payloadSizeKB", size(current.Payload), size(desired.Payload))
+	return changes
+}
+
+// payloadSize returns the size, in bytes, of the payload the supplied
+// parameters write to their record.
+func payloadSize(p v1alpha1.BorkResourceParameters) int {
+	return int(min(max(ptr.Deref(p.PayloadSizeKB, 0), 0), v1alpha1.MaxPayloadSizeKB)) * 1024
+}
+
+// payload returns the payload the supplied parameters write to their record.
+func payload(p v1alpha1.BorkResourceParameters) string {
+	return strings.Repeat("bork", payloadSize(p)/len("bork"))
+}
+
+// durationOf returns the supplied duration, or zero if it is nil.
+func durationOf(d *metav1.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.Duration
+}
+
+// lateInitialize fills any unset optional parameters with the values observed
+// in the backend. It returns the parameters it filled, keyed by their JSON
+// names, or nil if it didn't fill any.
+func lateInitialize(p *v1alpha1.BorkResourceParameters, o v1alpha1.BorkResourceObservation) map[string]any {
+	filled := map[string]any{}
+	if p.Region == nil && o.Region != "" {
+		p.Region = ptr.To(o.Region)
+		filled["region"] = o.Region
+	}
+	if p.Tier == nil && o.Tier != "" {
+		p.Tier = ptr.To(o.Tier)
+		filled["tier"] = o.Tier
+	}
+	tags := map[string]any{}
+	for k, v := range o.Tags {
+		if _, ok := p.Tags[k]; ok {
+			continue
+		}
+		if p.Tags == nil {
+			p.Tags = make(map[string]string, len(o.Tags))
+		}
+		p.Tags[k] = v
+		tags[k] = v
+	}
+	if len(tags) > 0 {
+		filled["tags"] = tags
+	}
+	if len(filled) == 0 {
+		return nil
+	}
+	return filled
+}
+
+// Fields of a bork record that a BorkResource's parameters can ignore. A
+// single tag is ignored as tags[<key>].
+const (
+	ignoreBorkValue     = "borkValue"
+	ignoreDataValue     = "dataValue"
+	ignoreRegion        = "region"
+	ignoreTier          = "tier"
+	ignoreTags          = "tags"
+	ignoreDriftInterval = "driftInterval"
+	ignoreTeardownDelay = "teardownDelay"
+)
+
+// ignores returns true if the supplied parameters ignore the supplied field.
+func ignores(p v1alpha1.BorkResourceParameters, field string) bool {
+	return slices.Contains(p.IgnoreFields, field)
+}
+
+// ignore returns the supplied parameters with each field they ignore set to
+// its observed value, so that ignored fields always match the observed record
+// and are never updated. The data value is ignored by desiredData, because
+// the desired data value is derived from the BorkValue.
+func ignore(p v1alpha1.BorkResourceParameters, o v1alpha1.BorkResourceObservation) v1alpha1.BorkResourceParameters {
+	for _, f := range p.IgnoreFields {
+		switch f {
+		case ignoreBorkValue:
+			p.BorkValue = o.BorkValue
+		case ignoreRegion:
+			p.Region = ptr.To(o.Region)
+		case ignoreTier:
+			p.Tier = ptr.To(o.Tier)
+		case ignoreTags:
+			p.Tags = o.Tags
+		case ignoreDriftInterval:
+			p.DriftInterval = o.DriftInterval
+		case ignoreTeardownDelay:
+			p.TeardownDelay = o.TeardownDelay
+		default:
+			k, ok := strings.CutPrefix(f, ignoreTags+"[")
+			if !ok {
+				continue
+			}
+			k = strings.TrimSuffix(k, "]")
+			if _, ok := p.Tags[k]; !ok {
+				continue
+			}
+			// Our parameters only require the tags they set, so an ignored
+			// tag is one they don't set. Updates keep its observed value.
+			p.Tags = maps.Clone(p.Tags)
+			delete(p.Tags, k)
+		}
+	}
+	return p
+}
+
+// rollback returns the supplied parameters with the borkValue applied at the
+// revision named by the supplied BorkResource's rollback annotation, if it has
+// one, so that its record is rolled back to that borkValue. It returns an
+// error if the revision isn't in the observed history.
+func rollback(cr *v1alpha1.BorkResource, p v1alpha1.BorkResourceParameters, o v1alpha1.BorkResourceObservation) (v1alpha1.BorkResourceParameters, error) {
+	v, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyRollbackToRevision]
+	if !ok {
+		return p, nil
+	}
+	rev, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return p, errors.Wrapf(err, errParseRollbackFmt, v1alpha1.AnnotationKeyRollbackToRevision, v)
+	}
+	for _, h := range o.History {
+		if h.Revision == rev {
+			p.BorkValue = h.BorkValue
+			return p, nil
+		}
+	}
+	return p, errors.Errorf(errRollbackRevisionFmt, rev)
+}
+
+// isRecordUpToDate returns true if the observed backend record matches the
+// supplied parameters, and has been borked. Unset optional parameters match
+// any observed value.
+func isRecordUpToDate(p v1alpha1.BorkResourceParameters, o v1alpha1.BorkResourceObservation) bool {
+	if !maps.Equal(p.BorkValue, o.BorkValue) || !isDataUpToDate(p, o.DataValue) {
+		return false
+	}
+	if p.Region != nil && *p.Region != o.Region {
+		return false
+	}
+	if p.Tier != nil && *p.Tier != o.Tier {
+		return false
+	}
+	if durationOf(p.DriftInterval) != durationOf(o.DriftInterval) {
+		return false
+	}
+	if durationOf(p.TeardownDelay) != durationOf(o.TeardownDelay) {
+		return false
+	}
+	if (p.SecretValue != nil) != o.HasSecretValue {
+		return false
+	}
+	if len(o.Payload) != payloadSize(p) {
+		return false
+	}
+	for k, v := range p.Tags {
+		if ov, ok := o.Tags[k]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns a human-readable diff of the record described by the supplied
+// parameters and the supplied backend record, or an empty string if the
+// record is up to date, just as the controller would when it observed it.
+func Diff(p v1alpha1.BorkResourceParameters, r backend.Record) string {
+	return diff(p, generateObservation(r))
+}
+
+// diff returns a human-readable diff of the record described by the supplied
+// parameters and the observed backend record, or an empty string if the
+// observed record is up to date. Parameters are compared as they are by
+// isRecordUpToDate.
+func diff(p v1alpha1.BorkResourceParameters, o v1alpha1.BorkResourceObservation) string {
+	desired := o
+	desired.BorkValue = p.BorkValue
+	desired.DataValue = desiredData(p, o.DataValue)
+	if p.Region != nil {
+		desired.Region = *p.Region
+	}
+	if p.Tier != nil {
+		desired.Tier = *p.Tier
+	}
+	desired.DriftInterval = p.DriftInterval
+	desired.TeardownDelay = p.TeardownDelay
+	desired.HasSecretValue = p.SecretValue != nil
+	if len(o.Payload) != payloadSize(p) {
+		desired.Payload = payload(p)
+	}
+	if len(p.Tags) > 0 {
+		desired.Tags = make(map[string]string, len(o.Tags)+len(p.Tags))
+		for k, v := range o.Tags {
+			desired.Tags[k] = v
+		}
+		for k, v := range p.Tags {
+			desired.Tags[k] = v
+		}
+	}
+	return cmp.Diff(desired, o, cmpopts.EquateEmpty())
+}
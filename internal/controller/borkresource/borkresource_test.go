@@ -0,0 +1,284 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	resourcefake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/middleware"
+)
+
+const benchNamespace = "default"
+
+// scales are the numbers of managed resources benchmarked.
+var scales = []int{1000, 10000}
+
+// fixture is a backend and the BorkResources that manage its records.
+type fixture struct {
+	store     *backend.Store
+	resources []*v1alpha1.BorkResource
+}
+
+// newFixture returns n BorkResources, each managing a record that the
+// backend already stores. Their status reflects the record's current
+// revision, such that each is up to date.
+func newFixture(b *testing.B, n int) fixture {
+	b.Helper()
+
+	s := backend.NewStore()
+	f := fixture{store: s, resources: make([]*v1alpha1.BorkResource, n)}
+	for i := range n {
+		p := v1alpha1.BorkResourceParameters{
+			BorkValue: map[string]string{"bork": fmt.Sprint(i)},
+			DataValue: map[string]string{"bork": fmt.Sprint(i)},
+			Region:    ptr.To(backend.DefaultRegion),
+			Tier:      ptr.To(backend.DefaultTier),
+			Tags:      map[string]string{"bork.crossplane.io/index": fmt.Sprint(i)},
+		}
+		r, err := s.Create(context.Background(), generateRecord(p))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		cr := &v1alpha1.BorkResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         benchNamespace,
+				Name:              fmt.Sprintf("bork-%d", i),
+				UID:               types.UID(fmt.Sprintf("uid-%d", i)),
+				Generation:        1,
+				CreationTimestamp: metav1.Now(),
+			},
+			Spec: v1alpha1.BorkResourceSpec{ForProvider: p},
+		}
+		cr.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"})
+		meta.SetExternalName(cr, r.Name)
+		lateInitialize(&cr.Spec.ForProvider, generateObservation(r))
+		cr.Status.AtProvider = generateObservation(r)
+		f.resources[i] = cr
+	}
+	return f
+}
+
+// connect returns an external client of the fixture's backend.
+func (f fixture) connect(b *testing.B, contentType string) *external {
+	b.Helper()
+	svc, err := f.store.Connect(contentType)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return &external{service: svc}
+}
+
+// kube returns a fake API server that stores the fixture's BorkResources,
+// and the default ClusterProviderConfig they use.
+func (f fixture) kube(b *testing.B, contentType string) client.Client {
+	b.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		b.Fatal(err)
+	}
+	objs := make([]client.Object, 0, len(f.resources)+1)
+	objs = append(objs, &apisv1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			Credentials:  apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+			ContentTypes: []apisv1alpha1.ContentType{apisv1alpha1.ContentType(contentType)},
+		},
+	})
+	for _, cr := range f.resources {
+		objs = append(objs, cr.DeepCopy())
+	}
+	return fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.BorkResource{}).
+		WithIndex(&v1alpha1.BorkResource{}, middleware.IndexExternalName, middleware.IndexExternalNames).
+		Build()
+}
+
+// BenchmarkObserve measures observing BorkResources that are up to date. When
+// the record is unchanged Observe only reads its revision. When it has
+// changed Observe reads the full record, then compares it to the spec.
+func BenchmarkObserve(b *testing.B) {
+	for _, n := range scales {
+		for _, ct := range []string{backend.ContentTypeJSON, backend.ContentTypeMessagePack} {
+			f := newFixture(b, n)
+			e := f.connect(b, ct)
+
+			b.Run(fmt.Sprintf("%d/%s/Unchanged", n, ct), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := range b.N {
+					cr := f.resources[i%n]
+					o, err := e.Observe(context.Background(), cr)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if !o.ResourceUpToDate {
+						b.Fatalf("%s is not up to date", cr.GetName())
+					}
+				}
+			})
+
+			b.Run(fmt.Sprintf("%d/%s/Changed", n, ct), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := range b.N {
+					cr := f.resources[i%n]
+					cr.Status.AtProvider.Revision = 0
+					o, err := e.Observe(context.Background(), cr)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if !o.ResourceUpToDate {
+						b.Fatalf("%s is not up to date", cr.GetName())
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkUpdate measures updating BorkResources whose record has drifted
+// from their spec.
+func BenchmarkUpdate(b *testing.B) {
+	for _, n := range scales {
+		for _, ct := range []string{backend.ContentTypeJSON, backend.ContentTypeMessagePack} {
+			f := newFixture(b, n)
+			e := f.connect(b, ct)
+
+			b.Run(fmt.Sprintf("%d/%s", n, ct), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := range b.N {
+					cr := f.resources[i%n]
+					cr.Spec.ForProvider.BorkValue = map[string]string{"bork": fmt.Sprint(i)}
+					cr.Spec.ForProvider.DataValue = cr.Spec.ForProvider.BorkValue
+					if _, err := e.Update(context.Background(), cr); err != nil {
+						b.Fatal(err)
+					}
+					if !maps.Equal(cr.Status.AtProvider.BorkValue, cr.Spec.ForProvider.BorkValue) {
+						b.Fatalf("%s was not updated", cr.GetName())
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkReconcile measures the throughput of the managed reconciler, using
+// the same connector middleware as the controller, against a fake API server.
+// Each reconcile reads the BorkResource and its provider config, observes the
+// record, and writes the BorkResource's status.
+func BenchmarkReconcile(b *testing.B) {
+	for _, n := range scales {
+		f := newFixture(b, n)
+		kube := f.kube(b, backend.ContentTypeMessagePack)
+		mgr := &resourcefake.Manager{Client: kube, Scheme: kube.Scheme()}
+
+		r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkResourceGroupVersionKind),
+			managed.WithExternalConnector(middleware.RecordMetrics(v1alpha1.BorkResourceKind, middleware.RejectConflicts(
+				middleware.NewPollBackoff(middleware.DefaultBackoffThreshold, middleware.DefaultMaxPollInterval).Connector(
+					middleware.SyncRequests(middleware.ObservedGeneration(middleware.ReportDrift(&connector{kube: kube, configMaps: kube, pool: clients.NewPool(backend.NewTenants(f.store), clients.DefaultPoolTTL), record: event.NewNopRecorder()}))),
+				),
+				kube,
+				func() resource.ManagedList { return &v1alpha1.BorkResourceList{} },
+			))),
+			managed.WithInitializers(),
+		)
+
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := range b.N {
+				cr := f.resources[i%n]
+				req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: cr.GetNamespace(), Name: cr.GetName()}}
+				if _, err := r.Reconcile(context.Background(), req); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "reconciles/s")
+		})
+	}
+}
+
+// TestRejectInvalidParameters checks that parameters the BorkResource CRD
+// should have rejected are never written to the backend.
+func TestRejectInvalidParameters(t *testing.T) {
+	invalid := v1alpha1.BorkResourceParameters{BorkValue: map[string]string{"bork bork": "2"}}
+
+	t.Run("Create", func(t *testing.T) {
+		store := backend.NewStore()
+		svc, err := store.Connect()
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := &external{service: svc, record: event.NewNopRecorder()}
+		cr := &v1alpha1.BorkResource{Spec: v1alpha1.BorkResourceSpec{ForProvider: invalid}}
+		if _, err := e.Create(context.Background(), cr); backend.Code(err) != backend.ErrorCodeBadRequest {
+			t.Errorf("Create: got error %v, want a bad request", err)
+		}
+		if names := store.Names(backend.KindRecord); len(names) > 0 {
+			t.Errorf("Create: got records %v, want none", names)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		store := backend.NewStore()
+		r, err := store.Create(context.Background(), backend.Record{BorkValue: map[string]string{"bork": "2"}, DataValue: map[string]string{"bork": "1"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		svc, err := store.Connect()
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := &external{service: svc, record: event.NewNopRecorder()}
+		cr := &v1alpha1.BorkResource{Spec: v1alpha1.BorkResourceSpec{ForProvider: invalid}}
+		meta.SetExternalName(cr, r.Name)
+		cr.Status.AtProvider = generateObservation(r)
+		if _, err := e.Update(context.Background(), cr); backend.Code(err) != backend.ErrorCodeBadRequest {
+			t.Errorf("Update: got error %v, want a bad request", err)
+		}
+		got, err := store.Get(context.Background(), r.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Generation != r.Generation {
+			t.Errorf("Update: got record generation %d, want %d", got.Generation, r.Generation)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+)
+
+// TypeCapabilityDenied BorkResources ask for more than their provider
+// config's capabilities allow, so their record is neither created nor
+// updated.
+const TypeCapabilityDenied xpv1.ConditionType = "CapabilityDenied"
+
+// Reasons a BorkResource's spec is or isn't within its provider config's
+// capabilities.
+const (
+	ReasonCapabilityDenied  xpv1.ConditionReason = "CapabilityDenied"
+	ReasonCapabilityAllowed xpv1.ConditionReason = "CapabilityAllowed"
+)
+
+// reasonCapabilityDenied is the reason of the event recorded when a
+// BorkResource is found to ask for more than its provider config allows.
+const reasonCapabilityDenied event.Reason = "CapabilityDenied"
+
+const (
+	errCapabilityDeniedFmt = "provider config capabilities don't allow %s"
+
+	msgTierFmt    = "spec.forProvider.tier %q; allowed tiers are %s"
+	msgRegionFmt  = "spec.forProvider.region %q; allowed regions are %s"
+	msgPayloadFmt = "spec.forProvider.payloadSizeKB %d; the largest allowed is %d"
+)
+
+// CapabilityDenied returns a condition that indicates the BorkResource asks
+// for more than its provider config's capabilities allow.
+func CapabilityDenied(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCapabilityDenied,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCapabilityDenied,
+		Message:            err.Error(),
+	}
+}
+
+// CapabilityAllowed returns a condition that indicates the BorkResource's
+// spec is within its provider config's capabilities.
+func CapabilityAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCapabilityDenied,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCapabilityAllowed,
+	}
+}
+
+// checkCapabilities returns an error that says what the supplied parameters
+// ask for that the supplied provider config capabilities don't allow. It
+// returns nil if they're within them, or there are none.
+func checkCapabilities(p v1alpha1.BorkResourceParameters, c *apisv1alpha1.Capabilities) error {
+	if c == nil {
+		return nil
+	}
+	var denied []string
+	if p.Tier != nil && len(c.AllowedTiers) > 0 && !slices.Contains(c.AllowedTiers, *p.Tier) {
+		denied = append(denied, fmt.Sprintf(msgTierFmt, *p.Tier, strings.Join(c.AllowedTiers, ", ")))
+	}
+	if p.Region != nil && len(c.AllowedRegions) > 0 && !slices.Contains(c.AllowedRegions, *p.Region) {
+		denied = append(denied, fmt.Sprintf(msgRegionFmt, *p.Region, strings.Join(c.AllowedRegions, ", ")))
+	}
+	if c.MaxPayloadSizeKB != nil && ptr.Deref(p.PayloadSizeKB, 0) > *c.MaxPayloadSizeKB {
+		denied = append(denied, fmt.Sprintf(msgPayloadFmt, *p.PayloadSizeKB, *c.MaxPayloadSizeKB))
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+	return errors.Errorf(errCapabilityDeniedFmt, strings.Join(denied, "; "))
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/middleware"
+)
+
+// TypeCorrupted BorkResources have a record that was found to be corrupted,
+// for example because it's missing fields or can't be decoded.
+const TypeCorrupted xpv1.ConditionType = "Corrupted"
+
+// Reasons a BorkResource's record is or is not corrupted.
+const (
+	ReasonRecordCorrupted xpv1.ConditionReason = "RecordCorrupted"
+	ReasonRecordRepaired  xpv1.ConditionReason = "RecordRepaired"
+	ReasonRecordIntact    xpv1.ConditionReason = "RecordIntact"
+)
+
+// reasonRepaired is the reason of the event recorded when a BorkResource's
+// corrupted record is repaired.
+const reasonRepaired event.Reason = "RecordRepaired"
+
+const errRepairRecord = "cannot repair corrupted bork record"
+
+const (
+	msgCorrupted = "bork record is corrupted: "
+	msgRepaired  = "bork record was corrupted, and has been repaired: "
+)
+
+// Corrupted returns a condition that indicates a BorkResource's record is
+// corrupted in the supplied way.
+func Corrupted(problem string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCorrupted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecordCorrupted,
+		Message:            msgCorrupted + problem,
+	}
+}
+
+// Repaired returns a condition that indicates a BorkResource's record was
+// corrupted in the supplied way, and has been repaired.
+func Repaired(problem string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCorrupted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecordRepaired,
+		Message:            msgRepaired + problem,
+	}
+}
+
+// Intact returns a condition that indicates a BorkResource's record, which
+// was corrupted, no longer is.
+func Intact() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCorrupted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecordIntact,
+	}
+}
+
+// corruption returns how the supplied record, which was got with the
+// supplied error, is corrupted, or an empty string if it isn't. Every record
+// the backend writes has an ARN, a region and a tier it offers, so a record
+// that doesn't was written by someone else.
+func corruption(r backend.Record, err error) string {
+	if backend.IsMalformed(err) {
+		return "it can't be decoded"
+	}
+	var problems []string
+	if r.ARN == "" {
+		problems = append(problems, "it has no ARN")
+	}
+	if r.Region == "" {
+		problems = append(problems, "it has no region")
+	}
+	switch r.Tier {
+	case "":
+		problems = append(problems, "it has no tier")
+	case backend.TierCorrupted:
+		problems = append(problems, "its tier is "+backend.TierCorrupted)
+	}
+	if garbage(r.BorkValue) {
+		problems = append(problems, "its borkValue holds garbage")
+	}
+	if garbage(r.DataValue) {
+		problems = append(problems, "its dataValue holds garbage")
+	}
+	return strings.Join(problems, ", ")
+}
+
+func garbage(v map[string]string) bool {
+	for _, s := range v {
+		if strings.HasPrefix(s, backend.TagValueCorrupted) {
+			return true
+		}
+	}
+	return false
+}
+
+// repairable returns true if the supplied BorkResource's corrupted record
+// may be repaired. Repairing a record updates it, so it's never repaired by a
+// dry run, or if the BorkResource's management policies don't allow updates.
+func repairable(cr *v1alpha1.BorkResource) bool {
+	return cr.Spec.ForProvider.AutoRepair && !middleware.DryRun(cr) && middleware.Allows(cr.GetManagementPolicies(), xpv1.ManagementActionUpdate)
+}
+
+// repair rewrites the supplied BorkResource's record, which is corrupted in
+// the supplied way, using the supplied desired parameters and its last
+// observation of the record, and returns the rewritten record. The record
+// keeps its name and UID, so anything that refers to it still does.
+func (c *external) repair(ctx context.Context, cr *v1alpha1.BorkResource, want v1alpha1.BorkResourceParameters, problem, secret string) (backend.Record, error) {
+	if err := validate(cr); err != nil {
+		return backend.Record{}, err
+	}
+	rec := updatedRecord(ignore(want, cr.Status.AtProvider), cr.Status.AtProvider)
+	rec.Name = meta.GetExternalName(cr)
+	rec.SecretValue = secret
+	r, err := c.service.Update(ctx, rec)
+	if err != nil {
+		return backend.Record{}, errors.Wrap(err, errRepairRecord)
+	}
+	cr.Status.SetConditions(Repaired(problem))
+	c.record.Event(cr, event.Normal(reasonRepaired, "Repaired corrupted bork record: "+problem))
+	return r, nil
+}
@@ -0,0 +1,946 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// errBoom is the error the fake backend fails operations with.
+var errBoom = backend.NewError(backend.ErrorCodeInternal, "boom")
+
+// Names and UIDs of the records the fake backend stores.
+const (
+	existingName = "bork-existing"
+	existingUID  = "uid-existing"
+	renamedName  = "bork-renamed"
+)
+
+// existing returns a record that has been borked with the supplied value.
+func existing(v string) backend.Record {
+	return backend.Record{Name: existingName, UID: existingUID, BorkValue: value(v), DataValue: value(v)}
+}
+
+// An externalFixture is an external client of a fake backend, and a fake API
+// server that stores the BorkResource the client manages.
+type externalFixture struct {
+	backend *borkfake.Client
+	kube    client.Client
+	e       *external
+}
+
+// newExternalFixture returns an external client of a fake backend storing the
+// supplied records, which every case starts from, and a fake API server
+// storing the supplied BorkResource and objects. The supplied setup function,
+// if any, prepares the backend further before its calls are recorded.
+func newExternalFixture(t *testing.T, cr *v1alpha1.BorkResource, records []backend.Record, setup func(*backend.Store) error, objs ...client.Object) externalFixture {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(append(objs, cr.DeepCopy())...).
+		WithStatusSubresource(&v1alpha1.BorkResource{}).
+		WithInterceptorFuncs(applyAsMerge).
+		Build()
+
+	f := borkfake.New()
+	for _, r := range records {
+		if _, err := f.Store.Create(context.Background(), r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if setup != nil {
+		if err := setup(f.Store); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return externalFixture{backend: f, kube: kube, e: &external{kube: kube, configMaps: kube, service: f.Client, record: event.NewNopRecorder()}}
+}
+
+// stored returns the named record as the fake backend stores it.
+func (f externalFixture) stored(t *testing.T, name string) (backend.Record, bool) {
+	t.Helper()
+	r, err := f.backend.Store.Get(context.Background(), name)
+	if backend.IsNotFound(err) {
+		return backend.Record{}, false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, true
+}
+
+// wrote returns true if the client called any of the supplied operations.
+func (f externalFixture) wrote(ops ...string) bool {
+	return slices.ContainsFunc(f.backend.Calls(), func(c string) bool {
+		return slices.Contains(ops, c)
+	})
+}
+
+// observedAs sets the supplied BorkResource's status to its observation of
+// the supplied record.
+func observedAs(cr *v1alpha1.BorkResource, r backend.Record) {
+	meta.SetExternalName(cr, r.Name)
+	cr.Status.AtProvider = generateObservation(r)
+}
+
+// deleted marks the supplied BorkResource as deleted.
+func deleted(cr *v1alpha1.BorkResource) {
+	cr.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+	cr.SetDeletionTimestamp(ptr.To(metav1.Now()))
+}
+
+// annotate sets an annotation of the supplied BorkResource.
+func annotate(cr *v1alpha1.BorkResource, k, v string) {
+	meta.AddAnnotations(cr, map[string]string{k: v})
+}
+
+// condition returns what's compared of a condition: its type, status and
+// reason.
+func condition(c xpv1.Condition) xpv1.Condition {
+	return xpv1.Condition{Type: c.Type, Status: c.Status, Reason: c.Reason}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o          managed.ExternalObservation
+		diff       bool
+		err        error
+		malformed  bool
+		conditions []xpv1.Condition
+		calls      []string
+	}
+
+	missingSecret := kerrors.NewNotFound(corev1.Resource("secrets"), "bork-secret")
+	missingConfigMap := kerrors.NewNotFound(corev1.Resource("configmaps"), "bork-values")
+
+	cases := map[string]struct {
+		reason       string
+		records      []backend.Record
+		setup        func(*backend.Store) error
+		objs         []client.Object
+		cr           func(cr *v1alpha1.BorkResource)
+		observed     bool
+		capabilities *apisv1alpha1.Capabilities
+		fail         map[string]error
+		want         want
+	}{
+		"NoExternalName": {
+			reason: "A BorkResource without an external name has never been created, so its record doesn't exist.",
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"DryRunNotCreated": {
+			reason: "A dry run whose record doesn't exist plans its creation, and reports that it exists so that it isn't created.",
+			cr:     func(cr *v1alpha1.BorkResource) { annotate(cr, v1alpha1.AnnotationKeyDryRun, "true") },
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{condition(xpv1.Unavailable())},
+			},
+		},
+		"CapabilityDenied": {
+			reason:       "A BorkResource that asks for more than its provider config allows isn't observed.",
+			cr:           func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.Tier = ptr.To("premium") },
+			capabilities: &apisv1alpha1.Capabilities{AllowedTiers: []string{"standard"}},
+			want: want{
+				err:        errors.Errorf(errCapabilityDeniedFmt, fmt.Sprintf(msgTierFmt, "premium", "standard")),
+				conditions: []xpv1.Condition{condition(CapabilityDenied(errBoom))},
+			},
+		},
+		"HeadFailed": {
+			reason:  "An error getting the record's revision is returned.",
+			records: []backend.Record{existing("2")},
+			cr:      func(cr *v1alpha1.BorkResource) { meta.SetExternalName(cr, existingName) },
+			fail:    map[string]error{"Head": errBoom},
+			want:    want{err: errors.Wrap(errBoom, errHeadRecord)},
+		},
+		"GetFailed": {
+			reason:  "An error getting a record whose revision changed is returned.",
+			records: []backend.Record{existing("2")},
+			cr:      func(cr *v1alpha1.BorkResource) { meta.SetExternalName(cr, existingName) },
+			fail:    map[string]error{"Get": errBoom},
+			want:    want{err: errors.Wrap(errBoom, errGetRecord)},
+		},
+		"NotFound": {
+			reason: "A record that doesn't exist, and wasn't renamed, must be created.",
+			cr:     func(cr *v1alpha1.BorkResource) { meta.SetExternalName(cr, existingName) },
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}, calls: []string{"Head"}},
+		},
+		"UpToDate": {
+			reason:  "A record that matches the spec and has been borked is up to date.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.DataValue = value("2")
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				conditions: []xpv1.Condition{condition(xpv1.Available())},
+				calls:      []string{"Head", "Get"},
+			},
+		},
+		"UnchangedRevisionNotRead": {
+			reason:   "A record whose revision is the one last observed isn't read again.",
+			records:  []backend.Record{existing("2")},
+			cr:       func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.DataValue = value("2") },
+			observed: true,
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				conditions: []xpv1.Condition{condition(xpv1.Available())},
+				calls:      []string{"Head"},
+			},
+		},
+		"NotBorked": {
+			reason:  "A record whose data value isn't its borkValue is out of date.",
+			records: []backend.Record{{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1")}},
+			cr:      func(cr *v1alpha1.BorkResource) { meta.SetExternalName(cr, existingName) },
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				diff:       true,
+				conditions: []xpv1.Condition{condition(xpv1.Available())},
+			},
+		},
+		"IgnoredFieldsDiffer": {
+			reason:  "A record whose ignored fields differ from the spec is up to date.",
+			records: []backend.Record{{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1"), Tier: "premium"}},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.Tier = ptr.To("standard")
+				cr.Spec.ForProvider.IgnoreFields = []string{ignoreDataValue, "tier"}
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				conditions: []xpv1.Condition{condition(xpv1.Available())},
+			},
+		},
+		"SecretValueMissing": {
+			reason:  "A BorkResource whose secret value can't be read isn't observed.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.SecretValue = &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "bork-secret"}, Key: "value"}
+			},
+			want: want{err: errors.Wrap(errors.Wrap(missingSecret, "cannot get credentials secret"), errGetSecretValue)},
+		},
+		"DeletedSecretValueMissing": {
+			reason:  "A BorkResource that was deleted is observed even if its secret value can't be read, so that its record can be deleted.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.SecretValue = &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "bork-secret"}, Key: "value"}
+				deleted(cr)
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				diff:       true,
+				conditions: []xpv1.Condition{condition(xpv1.Available())},
+			},
+		},
+		"ValueFromMissing": {
+			reason:  "A BorkResource whose valueFrom selects a ConfigMap that doesn't exist isn't observed.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.ValueFrom = map[string]v1alpha1.BorkValueSource{"bork": {ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: "bork-values", Key: "bork"}}}
+			},
+			want: want{err: errors.Wrapf(missingConfigMap, errGetValueFromFmt, "bork", kindConfigMap, "bork-values")},
+		},
+		"ValueFromUpToDate": {
+			reason:  "A record whose borkValue is the one valueFrom selects is up to date, whatever the spec's borkValue.",
+			records: []backend.Record{existing("3")},
+			objs:    []client.Object{&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork-values"}, Data: map[string]string{"bork": "3"}}},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.ValueFrom = map[string]v1alpha1.BorkValueSource{"bork": {ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: "bork-values", Key: "bork"}}}
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				conditions: []xpv1.Condition{condition(xpv1.Available())},
+			},
+		},
+		"AwaitingActivation": {
+			reason:  "A record that awaits activation doesn't exist as far as the managed reconciler is concerned.",
+			records: []backend.Record{{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("2"), RequiresActivation: true}},
+			cr:      func(cr *v1alpha1.BorkResource) { meta.SetExternalName(cr, existingName) },
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: false},
+				conditions: []xpv1.Condition{condition(xpv1.Unavailable())},
+			},
+		},
+		"Thawed": {
+			reason:  "A frozen record whose freeze window has passed is thawed.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				meta.SetExternalCreateSucceeded(cr, time.Now().Add(-2*time.Minute))
+				cr.Spec.ForProvider.DataValue = value("2")
+				cr.Spec.ForProvider.FreezeSeconds = ptr.To[int64](60)
+				cr.Status.SetConditions(Frozen(time.Minute))
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				conditions: []xpv1.Condition{condition(xpv1.Available()), condition(Thawed())},
+				calls:      []string{"Head", "Get"},
+			},
+		},
+		"Deleting": {
+			reason:  "A record that is being deleted exists, and has nothing to update.",
+			records: []backend.Record{{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("2"), TeardownDelay: time.Hour}},
+			setup:   func(s *backend.Store) error { return s.Delete(context.Background(), existingName) },
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				deleted(cr)
+			},
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{condition(xpv1.Deleting())},
+			},
+		},
+		"Replaced": {
+			reason:  "A record whose UID isn't the one last observed was replaced.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.DataValue = value("2")
+				cr.Status.AtProvider.ID = "uid-replaced"
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				conditions: []xpv1.Condition{condition(xpv1.Available()), condition(Replaced(existingName, "uid-replaced", existingUID))},
+			},
+		},
+		"Renamed": {
+			reason:  "A record that was renamed is adopted by its new name.",
+			records: []backend.Record{{Name: renamedName, UID: existingUID, BorkValue: value("2"), DataValue: value("2")}},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.DataValue = value("2")
+				cr.Status.AtProvider.ID = existingUID
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				conditions: []xpv1.Condition{condition(xpv1.Available()), condition(RenamedExternally(existingName, renamedName, v1alpha1.RenamePolicyAdopt))},
+				calls:      []string{"Head", "GetByUID", "Head", "Get"},
+			},
+		},
+		"NotFoundByName": {
+			reason:  "A record the backend doesn't find by name, but finds by UID with the same name, wasn't renamed.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Status.AtProvider.ID = existingUID
+			},
+			fail: map[string]error{"Head": backend.NewError(backend.ErrorCodeNotFound, "not found")},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}, calls: []string{"Head", "GetByUID"}},
+		},
+		"RenamedGetByUIDFailed": {
+			reason: "An error finding a renamed record is returned.",
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Status.AtProvider.ID = existingUID
+			},
+			fail: map[string]error{"GetByUID": errBoom},
+			want: want{err: errors.Wrap(errBoom, errGetRecordByUID)},
+		},
+		"Corrupted": {
+			reason:  "A corrupted record that can't be decoded isn't observed. The decoding error varies by codec.",
+			records: []backend.Record{existing("2")},
+			setup: func(s *backend.Store) error {
+				_, err := s.CorruptRecord(existingName, backend.CorruptionWrongTypes)
+				return err
+			},
+			cr: func(cr *v1alpha1.BorkResource) { meta.SetExternalName(cr, existingName) },
+			want: want{
+				malformed:  true,
+				conditions: []xpv1.Condition{condition(Corrupted("it can't be decoded"))},
+			},
+		},
+		"Repaired": {
+			reason:  "A corrupted record is repaired if the BorkResource asks for it.",
+			records: []backend.Record{existing("2")},
+			setup: func(s *backend.Store) error {
+				_, err := s.CorruptRecord(existingName, backend.CorruptionMissingFields)
+				return err
+			},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.DataValue = value("2")
+				cr.Spec.ForProvider.AutoRepair = true
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionSecretKeyID: []byte(existingUID)},
+				},
+				conditions: []xpv1.Condition{condition(xpv1.Available()), condition(Repaired(""))},
+				calls:      []string{"Head", "Get", "Update"},
+			},
+		},
+		"RollbackRevisionMissing": {
+			reason:  "Rolling back to a revision that isn't in the record's history is an error.",
+			records: []backend.Record{existing("2")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				annotate(cr, v1alpha1.AnnotationKeyRollbackToRevision, "99")
+			},
+			want: want{
+				err:        errors.Errorf(errRollbackRevisionFmt, 99),
+				conditions: []xpv1.Condition{condition(xpv1.Available())},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkResource(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+			if tc.cr != nil {
+				tc.cr(cr)
+			}
+			f := newExternalFixture(t, cr, tc.records, tc.setup, tc.objs...)
+			if tc.observed {
+				r, _ := f.stored(t, existingName)
+				observedAs(cr, r)
+			}
+			f.e.capabilities = tc.capabilities
+			for op, err := range tc.fail {
+				f.backend.Fail(op, err)
+			}
+
+			o, err := f.e.Observe(context.Background(), cr)
+			if tc.want.malformed {
+				if !backend.IsMalformed(err) {
+					t.Errorf("\n%s\nObserve(...): got error %v, want a malformed record", tc.reason, err)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if got := o.Diff != ""; got != tc.want.diff {
+				t.Errorf("\n%s\nObserve(...): got diff %q, want a diff: %t", tc.reason, o.Diff, tc.want.diff)
+			}
+			for _, want := range tc.want.conditions {
+				if diff := cmp.Diff(want, condition(cr.GetCondition(want.Type))); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+			if tc.want.calls != nil {
+				if diff := cmp.Diff(tc.want.calls, f.backend.Calls()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want calls, +got calls:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		err       error
+		borkValue map[string]string
+		secret    string
+		state     backend.RecordState
+		calls     []string
+	}
+
+	invalid := errors.Wrap(backend.NewError(backend.ErrorCodeBadRequest, field.Invalid(field.NewPath("spec", "forProvider", "borkValue"), "bork bork", "keys must be at most 63 letters, digits, '_', '.' or '-', and start and end with a letter or digit").Error()), errInvalidParams)
+
+	cases := map[string]struct {
+		reason  string
+		records []backend.Record
+		objs    []client.Object
+		cr      func(cr *v1alpha1.BorkResource)
+		fail    map[string]error
+		want    want
+	}{
+		"Created": {
+			reason: "The record is created per the spec, and named by the external name.",
+			want:   want{borkValue: value("2"), state: backend.RecordActive, calls: []string{"Create"}},
+		},
+		"CreateFailed": {
+			reason: "An error creating the record is returned.",
+			fail:   map[string]error{"Create": errBoom},
+			want:   want{err: errors.Wrap(errBoom, errCreateRecord)},
+		},
+		"InvalidParameters": {
+			reason: "Parameters the CRD should have rejected are never written to the backend.",
+			cr: func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.BorkValue = value("2")
+				cr.Spec.ForProvider.BorkValue["bork bork"] = "2"
+			},
+			want: want{err: invalid},
+		},
+		"SecretValue": {
+			reason: "The secret value is written with the record, and published as a connection detail.",
+			objs:   []client.Object{&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork-secret"}, Data: map[string][]byte{"value": []byte("hunter2")}}},
+			cr: func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.SecretValue = &xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "bork-secret"}, Key: "value"}
+			},
+			want: want{borkValue: value("2"), secret: "hunter2", state: backend.RecordActive},
+		},
+		"ValueFrom": {
+			reason: "The values valueFrom selects are merged into the borkValue the record is created with.",
+			objs:   []client.Object{&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork-values"}, Data: map[string]string{"bork": "3"}}},
+			cr: func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.ValueFrom = map[string]v1alpha1.BorkValueSource{"bork": {ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: "bork-values", Key: "bork"}}}
+			},
+			want: want{borkValue: value("3"), state: backend.RecordActive},
+		},
+		"RequiresActivation": {
+			reason: "A record that requires activation is created pending.",
+			cr:     func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.Activation = &v1alpha1.Activation{} },
+			want:   want{borkValue: value("2"), state: backend.RecordPending, calls: []string{"Create"}},
+		},
+		"Activated": {
+			reason:  "Creating a record that awaits activation activates it.",
+			records: []backend.Record{{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1"), RequiresActivation: true}},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.Activation = &v1alpha1.Activation{}
+				cr.Status.AtProvider.State = string(backend.RecordPending)
+			},
+			want: want{borkValue: value("2"), state: backend.RecordActivating, calls: []string{"Activate"}},
+		},
+		"ActivateFailed": {
+			reason:  "An error activating a record is returned.",
+			records: []backend.Record{{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1"), RequiresActivation: true}},
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.Activation = &v1alpha1.Activation{}
+			},
+			fail: map[string]error{"Activate": errBoom},
+			want: want{err: errors.Wrap(errBoom, errActivateRecord)},
+		},
+		"ActivatedRecordGone": {
+			reason: "A record that awaited activation but no longer exists is created again.",
+			cr: func(cr *v1alpha1.BorkResource) {
+				meta.SetExternalName(cr, existingName)
+				cr.Spec.ForProvider.Activation = &v1alpha1.Activation{}
+			},
+			want: want{borkValue: value("2"), state: backend.RecordPending, calls: []string{"Activate", "Create"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkResource(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+			if tc.cr != nil {
+				tc.cr(cr)
+			}
+			f := newExternalFixture(t, cr, tc.records, nil, tc.objs...)
+			for op, err := range tc.fail {
+				f.backend.Fail(op, err)
+			}
+
+			c, err := f.e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.calls != nil {
+				if diff := cmp.Diff(tc.want.calls, f.backend.Calls()); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want calls, +got calls:\n%s", tc.reason, diff)
+				}
+			}
+			if tc.want.err != nil {
+				if f.wrote("Create") && !errors.Is(err, errBoom) {
+					t.Errorf("\n%s\nCreate(...): created a record, want none", tc.reason)
+				}
+				return
+			}
+
+			r, ok := f.stored(t, meta.GetExternalName(cr))
+			if !ok {
+				t.Fatalf("\n%s\nCreate(...): record %q doesn't exist", tc.reason, meta.GetExternalName(cr))
+			}
+			if diff := cmp.Diff(tc.want.borkValue, r.BorkValue); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want borkValue, +got borkValue:\n%s", tc.reason, diff)
+			}
+			if r.SecretValue != tc.want.secret {
+				t.Errorf("\n%s\nCreate(...): got secret value %q, want %q", tc.reason, r.SecretValue, tc.want.secret)
+			}
+			if got := backend.RecordState(cr.Status.AtProvider.State); got != tc.want.state {
+				t.Errorf("\n%s\nCreate(...): got state %q, want %q", tc.reason, got, tc.want.state)
+			}
+			if tc.want.secret != "" && string(c.ConnectionDetails[ConnectionSecretKeySecretValue]) != tc.want.secret {
+				t.Errorf("\n%s\nCreate(...): got connection details %v, want secret value %q", tc.reason, c.ConnectionDetails, tc.want.secret)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err       error
+		dataValue map[string]string
+		patched   bool
+		planned   bool
+		frozen    bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		record backend.Record
+		setup  func(*backend.Store) error
+		cr     func(cr *v1alpha1.BorkResource)
+		fail   map[string]error
+		want   want
+	}{
+		"UpToDate": {
+			reason: "A record that is up to date isn't written.",
+			record: existing("2"),
+			want:   want{dataValue: value("2")},
+		},
+		"Borked": {
+			reason: "Updating a record borks it, replacing its data value with its borkValue.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: map[string]string{"bork": "1", "other": "1"}},
+			want:   want{dataValue: value("2"), patched: true},
+		},
+		"Merged": {
+			reason: "Updating a record with the Merge strategy merges its borkValue into its data value.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: map[string]string{"bork": "1", "other": "1"}},
+			cr:     func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.UpdateStrategy = v1alpha1.UpdateStrategyMerge },
+			want:   want{dataValue: map[string]string{"bork": "2", "other": "1"}, patched: true},
+		},
+		"IgnoredDataValue": {
+			reason: "An ignored data value is never written.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1")},
+			cr:     func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.IgnoreFields = []string{ignoreDataValue} },
+			want:   want{dataValue: value("1")},
+		},
+		"PatchFailed": {
+			reason: "An error updating the record is returned.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1")},
+			fail:   map[string]error{"Patch": errBoom},
+			want:   want{err: errors.Wrap(errBoom, errUpdateRecord), dataValue: value("1")},
+		},
+		"DryRun": {
+			reason: "A dry run plans its update rather than writing the record.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1")},
+			cr:     func(cr *v1alpha1.BorkResource) { annotate(cr, v1alpha1.AnnotationKeyDryRun, "true") },
+			want:   want{dataValue: value("1"), planned: true},
+		},
+		"Frozen": {
+			reason: "A record created within its freeze window isn't written until the window has passed.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.FreezeSeconds = ptr.To[int64](60)
+				meta.SetExternalCreateSucceeded(cr, time.Now())
+			},
+			want: want{dataValue: value("1"), frozen: true},
+		},
+		"FrozenWindowPassed": {
+			reason: "A record created before its freeze window is written.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1")},
+			cr: func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.FreezeSeconds = ptr.To[int64](60)
+				meta.SetExternalCreateSucceeded(cr, time.Now().Add(-2*time.Minute))
+			},
+			want: want{dataValue: value("2"), patched: true},
+		},
+		"AdoptedNotFrozen": {
+			reason: "A record the BorkResource adopted, rather than created, is never frozen.",
+			record: backend.Record{Name: existingName, UID: existingUID, BorkValue: value("2"), DataValue: value("1")},
+			cr:     func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.FreezeSeconds = ptr.To[int64](60) },
+			want:   want{dataValue: value("2"), patched: true},
+		},
+		"RolledBack": {
+			reason: "A BorkResource annotated with a revision of its record rolls the record back to that revision's borkValue.",
+			record: existing("1"),
+			setup: func(s *backend.Store) error {
+				_, err := s.Update(context.Background(), existing("2"))
+				return err
+			},
+			cr: func(cr *v1alpha1.BorkResource) {
+				annotate(cr, v1alpha1.AnnotationKeyRollbackToRevision, "1")
+			},
+			want: want{dataValue: value("1"), patched: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkResource(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+			if tc.cr != nil {
+				tc.cr(cr)
+			}
+			f := newExternalFixture(t, cr, []backend.Record{tc.record}, tc.setup)
+			r, _ := f.stored(t, existingName)
+			observedAs(cr, r)
+			for op, err := range tc.fail {
+				f.backend.Fail(op, err)
+			}
+
+			_, err := f.e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if got := f.wrote("Patch") && tc.want.err == nil; got != tc.want.patched {
+				t.Errorf("\n%s\nUpdate(...): got patched %t, want %t", tc.reason, got, tc.want.patched)
+			}
+			got, _ := f.stored(t, existingName)
+			if diff := cmp.Diff(tc.want.dataValue, got.DataValue); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want dataValue, +got dataValue:\n%s", tc.reason, diff)
+			}
+			if planned := len(cr.Status.AtProvider.PlannedChanges) > 0; planned != tc.want.planned {
+				t.Errorf("\n%s\nUpdate(...): got planned changes %v, want planned: %t", tc.reason, cr.Status.AtProvider.PlannedChanges, tc.want.planned)
+			}
+			if frozen := cr.Status.GetCondition(TypeFrozen).Status == corev1.ConditionTrue; frozen != tc.want.frozen {
+				t.Errorf("\n%s\nUpdate(...): got frozen %t, want %t", tc.reason, frozen, tc.want.frozen)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err     error
+		exists  bool
+		planned bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     func(cr *v1alpha1.BorkResource)
+		fail   map[string]error
+		want   want
+	}{
+		"Deleted": {
+			reason: "Deleting a BorkResource deletes its record.",
+		},
+		"DeleteFailed": {
+			reason: "An error deleting the record is returned.",
+			fail:   map[string]error{"Delete": errBoom},
+			want:   want{err: errors.Wrap(errBoom, errDeleteRecord), exists: true},
+		},
+		"DryRun": {
+			reason: "Deleting a dry run plans its deletion rather than deleting its record.",
+			cr:     func(cr *v1alpha1.BorkResource) { annotate(cr, v1alpha1.AnnotationKeyDryRun, "true") },
+			want:   want{exists: true, planned: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkResource(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+			meta.SetExternalName(cr, existingName)
+			deleted(cr)
+			if tc.cr != nil {
+				tc.cr(cr)
+			}
+			f := newExternalFixture(t, cr, []backend.Record{existing("2")}, nil)
+			for op, err := range tc.fail {
+				f.backend.Fail(op, err)
+			}
+
+			_, err := f.e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if _, exists := f.stored(t, existingName); exists != tc.want.exists {
+				t.Errorf("\n%s\nDelete(...): got record exists %t, want %t", tc.reason, exists, tc.want.exists)
+			}
+			if planned := len(cr.Status.AtProvider.PlannedChanges) > 0; planned != tc.want.planned {
+				t.Errorf("\n%s\nDelete(...): got planned changes %v, want planned: %t", tc.reason, cr.Status.AtProvider.PlannedChanges, tc.want.planned)
+			}
+		})
+	}
+}
+
+// TestValueFromUncachedConfigMap checks that a ConfigMap valueFrom selects is
+// read even if the cache is restricted to the namespaces of the ConfigMaps
+// the provider is configured by, as it is when --concurrency-configmap or
+// --features-configmap is set.
+func TestValueFromUncachedConfigMap(t *testing.T) {
+	cr := newBorkResource(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+	meta.SetExternalName(cr, existingName)
+	cr.Spec.ForProvider.ValueFrom = map[string]v1alpha1.BorkValueSource{"bork": {ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: "bork-values", Key: "bork"}}}
+	f := newExternalFixture(t, cr, []backend.Record{existing("3")}, nil,
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: cr.GetNamespace(), Name: "bork-values"}, Data: map[string]string{"bork": "3"}})
+
+	// The cache never reaches an API server: it refuses to get a ConfigMap
+	// outside crossplane-system before it would start an informer.
+	m := apimeta.NewDefaultRESTMapper(nil)
+	m.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), apimeta.RESTScopeNamespace)
+	cached, err := cache.New(&rest.Config{Host: "https://127.0.0.1:1"}, cache.Options{
+		Scheme:   f.kube.Scheme(),
+		Mapper:   m,
+		ByObject: map[client.Object]cache.ByObject{&corev1.ConfigMap{}: {Namespaces: map[string]cache.Config{"crossplane-system": {}}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.e.kube = interceptor.NewClient(f.kube.(client.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if _, ok := obj.(*corev1.ConfigMap); ok {
+				return cached.Get(ctx, key, obj, opts...)
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+	})
+
+	o, err := f.e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): got a record that isn't up to date, want the borkValue valueFrom selects from the uncached ConfigMap: %s", o.Diff)
+	}
+}
+
+// TestBackendErrors injects an error of every code into each operation of
+// the external client. The error must be returned classified as it was by
+// the backend, so that the middleware that handles each code can tell what
+// it is.
+func TestBackendErrors(t *testing.T) {
+	ops := map[string]struct {
+		op  string
+		run func(e *external, cr *v1alpha1.BorkResource) error
+
+		// handled are codes the operation handles rather than returns.
+		handled []backend.ErrorCode
+	}{
+		"Observe": {op: "Head", handled: []backend.ErrorCode{backend.ErrorCodeNotFound}, run: func(e *external, cr *v1alpha1.BorkResource) error {
+			_, err := e.Observe(context.Background(), cr)
+			return err
+		}},
+		"Create": {op: "Create", run: func(e *external, cr *v1alpha1.BorkResource) error {
+			meta.SetExternalName(cr, "")
+			_, err := e.Create(context.Background(), cr)
+			return err
+		}},
+		"Update": {op: "Patch", run: func(e *external, cr *v1alpha1.BorkResource) error {
+			cr.Spec.ForProvider.BorkValue = value("3")
+			_, err := e.Update(context.Background(), cr)
+			return err
+		}},
+		"Delete": {op: "Delete", run: func(e *external, cr *v1alpha1.BorkResource) error {
+			_, err := e.Delete(context.Background(), cr)
+			return err
+		}},
+	}
+
+	for name, o := range ops {
+		for _, code := range backend.ErrorCodes {
+			if slices.Contains(o.handled, code) {
+				continue
+			}
+			t.Run(fmt.Sprintf("%s/%s", name, code), func(t *testing.T) {
+				cr := newBorkResource(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+				f := newExternalFixture(t, cr, []backend.Record{existing("2")}, nil)
+				r, _ := f.stored(t, existingName)
+				observedAs(cr, r)
+				f.backend.FailWith(o.op, code)
+
+				err := o.run(f.e, cr)
+				if got := backend.Code(err); got != code {
+					t.Errorf("%s(...): got error %v classified as %q, want %q", name, err, got, code)
+				}
+			})
+		}
+	}
+}
+
+// TestNotBorkResource checks that the external client refuses to manage
+// anything but a BorkResource.
+func TestNotBorkResource(t *testing.T) {
+	e := &external{record: event.NewNopRecorder()}
+	mg := resource.Managed(&v1alpha1.BorkBucket{})
+	want := errors.New(errNotBorkResource)
+
+	if _, err := e.Observe(context.Background(), mg); cmp.Diff(want, err, test.EquateErrors()) != "" {
+		t.Errorf("Observe(...): got error %v, want %v", err, want)
+	}
+	if _, err := e.Create(context.Background(), mg); cmp.Diff(want, err, test.EquateErrors()) != "" {
+		t.Errorf("Create(...): got error %v, want %v", err, want)
+	}
+	if _, err := e.Update(context.Background(), mg); cmp.Diff(want, err, test.EquateErrors()) != "" {
+		t.Errorf("Update(...): got error %v, want %v", err, want)
+	}
+	if _, err := e.Delete(context.Background(), mg); cmp.Diff(want, err, test.EquateErrors()) != "" {
+		t.Errorf("Delete(...): got error %v, want %v", err, want)
+	}
+}
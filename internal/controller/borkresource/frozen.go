@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+// TypeFrozen BorkResources have a record that was created too recently to
+// be modified, per their freezeSeconds parameter.
+const TypeFrozen xpv1.ConditionType = "Frozen"
+
+// Reasons a BorkResource's record is or is not frozen.
+const (
+	ReasonUpdateDeferred xpv1.ConditionReason = "UpdateDeferred"
+	ReasonThawed         xpv1.ConditionReason = "Thawed"
+)
+
+const msgFrozenFmt = "bork record can't be modified for %s after it's created; its update is deferred until then"
+
+// Frozen returns a condition that indicates a BorkResource's record can't be
+// modified for the supplied duration, so its update was deferred.
+func Frozen(d time.Duration) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeFrozen,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpdateDeferred,
+		Message:            fmt.Sprintf(msgFrozenFmt, d.Round(time.Second)),
+	}
+}
+
+// Thawed returns a condition that indicates a BorkResource's record, which
+// was frozen, may now be modified.
+func Thawed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeFrozen,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonThawed,
+	}
+}
+
+// frozenFor returns how long after the supplied time the supplied
+// BorkResource's record may be modified, per its freezeSeconds parameter, or
+// zero if it may be modified now. The window is timed from when the provider
+// created the record. A record the BorkResource adopted is never frozen.
+func frozenFor(cr *v1alpha1.BorkResource, now time.Time) time.Duration {
+	s := ptr.Deref(cr.Spec.ForProvider.FreezeSeconds, 0)
+	created := meta.GetExternalCreateSucceeded(cr)
+	if s <= 0 || created.IsZero() {
+		return 0
+	}
+	return max(created.Add(time.Duration(s)*time.Second).Sub(now), 0)
+}
+
+// untilThawed wraps the supplied poll interval hook such that a BorkResource
+// whose update was deferred because its record is frozen is polled again as
+// soon as the record thaws, rather than at its next poll.
+func untilThawed(h managed.PollIntervalHook) managed.PollIntervalHook {
+	return func(mg resource.Managed, d time.Duration) time.Duration {
+		d = h(mg, d)
+		cr, ok := mg.(*v1alpha1.BorkResource)
+		if !ok || cr.Status.GetCondition(TypeFrozen).Status != corev1.ConditionTrue {
+			return d
+		}
+		if r := frozenFor(cr, time.Now()); r > 0 && r < d {
+			return r
+		}
+		return d
+	}
+}
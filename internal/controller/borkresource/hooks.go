@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+const (
+	errRunHookFmt         = "cannot run %s hook %q"
+	errHookFailedFmt      = "simulated failure of attempt %d"
+	errUpdateHookProgress = "cannot record progress of provisioning hooks"
+)
+
+// Event reasons recorded as a BorkResource's provisioning hooks run.
+const (
+	reasonHookSucceeded event.Reason = "HookSucceeded"
+	reasonHookFailed    event.Reason = "HookFailed"
+)
+
+// startHooks adds the progress of each of the supplied BorkResource's hooks
+// to its status, in the order they run, keeping the progress of hooks that
+// have already run. Only hooks that have progress run, so hooks added once
+// the BorkResource's record is created never do.
+func startHooks(cr *v1alpha1.BorkResource) {
+	if len(cr.Spec.ForProvider.Hooks) == 0 {
+		return
+	}
+	hooks := make([]v1alpha1.HookProgress, 0, len(cr.Spec.ForProvider.Hooks))
+	for _, phase := range []v1alpha1.HookPhase{v1alpha1.HookPhasePreCreate, v1alpha1.HookPhasePostCreate} {
+		for _, h := range cr.Spec.ForProvider.Hooks {
+			if phaseOf(h) != phase {
+				continue
+			}
+			p := v1alpha1.HookProgress{Name: h.Name, State: v1alpha1.HookPending}
+			if existing := progressOf(cr, h.Name); existing != nil {
+				p = *existing
+			}
+			p.Phase = phase
+			hooks = append(hooks, p)
+		}
+	}
+	cr.Status.Hooks = hooks
+}
+
+// hooksPending returns true if any of the supplied BorkResource's hooks of
+// the supplied phase have progress, but haven't succeeded.
+func hooksPending(cr *v1alpha1.BorkResource, phase v1alpha1.HookPhase) bool {
+	for _, h := range cr.Spec.ForProvider.Hooks {
+		if p := progressOf(cr, h.Name); p != nil && phaseOf(h) == phase && p.State != v1alpha1.HookSucceeded {
+			return true
+		}
+	}
+	return false
+}
+
+// runHooks runs each of the supplied BorkResource's hooks of the supplied
+// phase that hasn't succeeded, in order, recording its progress in the
+// BorkResource's status as it starts and finishes. It stops at the first hook
+// that fails, so that the next attempt resumes from it.
+func (c *external) runHooks(ctx context.Context, cr *v1alpha1.BorkResource, phase v1alpha1.HookPhase) error {
+	for _, h := range cr.Spec.ForProvider.Hooks {
+		p := progressOf(cr, h.Name)
+		if p == nil || phaseOf(h) != phase || p.State == v1alpha1.HookSucceeded {
+			continue
+		}
+		p.Phase = phase
+		p.State = v1alpha1.HookRunning
+		p.Attempts++
+		p.LastAttemptTime = &metav1.Time{Time: time.Now()}
+		p.Message = ""
+		attempts := p.Attempts
+		if err := c.recordHooks(ctx, cr); err != nil {
+			return err
+		}
+
+		err := attempt(ctx, h, attempts)
+		// Recording the hook's progress replaced the BorkResource's status.
+		p = progressOf(cr, h.Name)
+		if err != nil {
+			p.State = v1alpha1.HookFailed
+			p.Message = err.Error()
+			c.record.Event(cr, event.Warning(reasonHookFailed, errors.Wrapf(err, errRunHookFmt, phase, h.Name)))
+		} else {
+			p.State = v1alpha1.HookSucceeded
+			c.record.Event(cr, event.Normal(reasonHookSucceeded, "Ran "+string(phase)+" hook "+h.Name))
+		}
+		if rerr := c.recordHooks(ctx, cr); rerr != nil {
+			return rerr
+		}
+		if err != nil {
+			return errors.Wrapf(err, errRunHookFmt, phase, h.Name)
+		}
+	}
+	return nil
+}
+
+// recordHooks persists the progress of the supplied BorkResource's hooks.
+// Updating its status replaces the BorkResource with the API server's copy,
+// whose annotations may not yet include those set while it's being created,
+// such as its external name, so they're restored.
+func (c *external) recordHooks(ctx context.Context, cr *v1alpha1.BorkResource) error {
+	a := cr.GetAnnotations()
+	err := c.kube.Status().Update(ctx, cr)
+	meta.AddAnnotations(cr, a)
+	return errors.Wrap(err, errUpdateHookProgress)
+}
+
+// attempt runs the supplied hook once: it waits for the hook's duration,
+// then fails with the hook's failure probability. Its error counts the
+// supplied attempts.
+func attempt(ctx context.Context, h v1alpha1.ProvisioningHook, attempts int64) error {
+	t := time.NewTimer(durationOf(h.Duration))
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+	}
+	if h.FailurePercent > 0 && rand.Int64N(100) < h.FailurePercent {
+		return errors.Errorf(errHookFailedFmt, attempts)
+	}
+	return nil
+}
+
+// progressOf returns the progress of the supplied BorkResource's named hook,
+// or nil if it has none.
+func progressOf(cr *v1alpha1.BorkResource, name string) *v1alpha1.HookProgress {
+	for i := range cr.Status.Hooks {
+		if cr.Status.Hooks[i].Name == name {
+			return &cr.Status.Hooks[i]
+		}
+	}
+	return nil
+}
+
+// phaseOf returns the phase in which the supplied hook runs.
+func phaseOf(h v1alpha1.ProvisioningHook) v1alpha1.HookPhase {
+	if h.Phase == "" {
+		return v1alpha1.HookPhasePreCreate
+	}
+	return h.Phase
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	resourcefake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+// policies are the management policies the managed reconciler supports.
+var policies = []xpv1.ManagementPolicies{
+	{xpv1.ManagementActionAll},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate, xpv1.ManagementActionLateInitialize, xpv1.ManagementActionDelete},
+	{xpv1.ManagementActionObserve},
+	{},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate, xpv1.ManagementActionDelete},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate, xpv1.ManagementActionLateInitialize},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionDelete, xpv1.ManagementActionLateInitialize},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionLateInitialize},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionDelete},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionDelete},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionUpdate},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionLateInitialize},
+	{xpv1.ManagementActionObserve, xpv1.ManagementActionUpdate, xpv1.ManagementActionLateInitialize},
+}
+
+// has returns true if the supplied policy allows the supplied action.
+func has(p xpv1.ManagementPolicies, a xpv1.ManagementAction) bool {
+	return slices.Contains(p, xpv1.ManagementActionAll) || slices.Contains(p, a)
+}
+
+// A policyFixture is a backend and a fake API server, reconciled by a managed
+// reconciler that honours management policies.
+type policyFixture struct {
+	store *backend.Store
+	kube  client.Client
+	r     *managed.Reconciler
+}
+
+func newPolicyFixture(t *testing.T, cr *v1alpha1.BorkResource, store *backend.Store) policyFixture {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(cr, &apisv1alpha1.ClusterProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone}},
+		}).
+		WithStatusSubresource(&v1alpha1.BorkResource{}).
+		WithInterceptorFuncs(applyAsMerge).
+		Build()
+	mgr := clients.ApplyStatus(&resourcefake.Manager{Client: kube, Scheme: s})
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkResourceGroupVersionKind),
+		managed.WithExternalConnector(&connector{kube: kube, configMaps: kube, pool: clients.NewPool(backend.NewTenants(store), 0), record: event.NewNopRecorder()}),
+		managed.WithInitializers(),
+		managed.WithManagementPolicies(),
+	)
+	return policyFixture{store: store, kube: kube, r: r}
+}
+
+// applyAsMerge makes the fake API server, which doesn't support server-side
+// apply, merge the fields that are applied instead. Merging never removes
+// fields or conflicts, but is otherwise equivalent for a single field manager.
+var applyAsMerge = interceptor.Funcs{
+	Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+		if patch.Type() == types.ApplyPatchType {
+			return c.Patch(ctx, obj, client.Merge)
+		}
+		return c.Patch(ctx, obj, patch, opts...)
+	},
+	SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+		if patch.Type() == types.ApplyPatchType {
+			return c.SubResource(subResource).Patch(ctx, obj, client.Merge)
+		}
+		return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+	},
+}
+
+func (f policyFixture) reconcile(t *testing.T, cr *v1alpha1.BorkResource) {
+	t.Helper()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: cr.GetNamespace(), Name: cr.GetName()}}
+	if _, err := f.r.Reconcile(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// get returns the BorkResource as stored by the fake API server, or nil if it
+// doesn't exist.
+func (f policyFixture) get(t *testing.T, cr *v1alpha1.BorkResource) *v1alpha1.BorkResource {
+	t.Helper()
+	got := &v1alpha1.BorkResource{}
+	err := f.kube.Get(context.Background(), types.NamespacedName{Namespace: cr.GetNamespace(), Name: cr.GetName()}, got)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+// value returns a borkValue or dataValue with a single key.
+func value(v string) map[string]string {
+	return map[string]string{"bork": v}
+}
+
+func newBorkResource(p xpv1.ManagementPolicies) *v1alpha1.BorkResource {
+	cr := &v1alpha1.BorkResource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork", UID: "uid-bork", Generation: 1},
+		Spec:       v1alpha1.BorkResourceSpec{ForProvider: v1alpha1.BorkResourceParameters{BorkValue: value("2"), DataValue: value("1")}},
+	}
+	cr.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"})
+	cr.SetManagementPolicies(p)
+	return cr
+}
+
+// TestPolicyCreate reconciles a BorkResource whose record doesn't exist yet,
+// which must be created only if its management policies allow it.
+func TestPolicyCreate(t *testing.T) {
+	for _, p := range policies {
+		t.Run(fmt.Sprint(p), func(t *testing.T) {
+			cr := newBorkResource(p)
+			f := newPolicyFixture(t, cr, backend.NewStore())
+			f.reconcile(t, cr)
+
+			got := f.get(t, cr)
+			name := meta.GetExternalName(got)
+			if created := name != ""; created != has(p, xpv1.ManagementActionCreate) {
+				t.Fatalf("created: got %t, want %t", created, has(p, xpv1.ManagementActionCreate))
+			}
+			if name == "" {
+				return
+			}
+			r, err := f.store.Get(context.Background(), name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(r.BorkValue, value("2")) || !maps.Equal(r.DataValue, value("1")) {
+				t.Errorf("record: got borkValue %v and dataValue %v, want %v and %v", r.BorkValue, r.DataValue, value("2"), value("1"))
+			}
+			if !maps.Equal(got.Spec.ForProvider.DataValue, value("1")) {
+				t.Errorf("spec.forProvider.dataValue: got %v, want %v", got.Spec.ForProvider.DataValue, value("1"))
+			}
+		})
+	}
+}
+
+// TestPolicyUpdate reconciles a BorkResource whose record exists but hasn't
+// been borked. The record must be borked only if the BorkResource's
+// management policies allow it to be updated, and the BorkResource's spec
+// must only be late initialized if they allow that. The BorkResource's data
+// value is never changed.
+func TestPolicyUpdate(t *testing.T) {
+	for _, p := range policies {
+		t.Run(fmt.Sprint(p), func(t *testing.T) {
+			store := backend.NewStore()
+			existing, err := store.Create(context.Background(), backend.Record{BorkValue: value("2"), DataValue: value("1"), Region: "bork-west-2", Tags: map[string]string{"owner": "someone-else"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cr := newBorkResource(p)
+			meta.SetExternalName(cr, existing.Name)
+			f := newPolicyFixture(t, cr, store)
+			f.reconcile(t, cr)
+
+			r, err := store.Get(context.Background(), existing.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if borked := maps.Equal(r.DataValue, value("2")); borked != has(p, xpv1.ManagementActionUpdate) {
+				t.Errorf("record borked: got %t, want %t", borked, has(p, xpv1.ManagementActionUpdate))
+			}
+			if r.Region != "bork-west-2" || r.Tags["owner"] != "someone-else" {
+				t.Errorf("record: got region %q and owner tag %q, want fields the spec doesn't set to be unchanged", r.Region, r.Tags["owner"])
+			}
+
+			got := f.get(t, cr)
+			if !maps.Equal(got.Spec.ForProvider.DataValue, value("1")) {
+				t.Errorf("spec.forProvider.dataValue: got %v, want %v", got.Spec.ForProvider.DataValue, value("1"))
+			}
+			if li := got.Spec.ForProvider.Region != nil; li != has(p, xpv1.ManagementActionLateInitialize) {
+				t.Errorf("spec.forProvider.region late initialized: got %t, want %t", li, has(p, xpv1.ManagementActionLateInitialize))
+			}
+			if has(p, xpv1.ManagementActionLateInitialize) && ptr.Deref(got.Spec.ForProvider.Region, "") != "bork-west-2" {
+				t.Errorf("spec.forProvider.region: got %q, want %q", ptr.Deref(got.Spec.ForProvider.Region, ""), "bork-west-2")
+			}
+		})
+	}
+}
+
+// TestPolicyDelete reconciles a BorkResource that was deleted. Its record
+// must be deleted only if its management policies allow it.
+func TestPolicyDelete(t *testing.T) {
+	for _, p := range policies {
+		t.Run(fmt.Sprint(p), func(t *testing.T) {
+			store := backend.NewStore()
+			existing, err := store.Create(context.Background(), backend.Record{BorkValue: value("2"), DataValue: value("2")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cr := newBorkResource(p)
+			meta.SetExternalName(cr, existing.Name)
+			cr.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+			cr.SetDeletionTimestamp(ptr.To(metav1.Now()))
+			f := newPolicyFixture(t, cr, store)
+			f.reconcile(t, cr)
+
+			_, err = store.Get(context.Background(), existing.Name)
+			if deleted := backend.IsNotFound(err); deleted != has(p, xpv1.ManagementActionDelete) {
+				t.Errorf("record deleted: got %t, want %t", deleted, has(p, xpv1.ManagementActionDelete))
+			}
+		})
+	}
+}
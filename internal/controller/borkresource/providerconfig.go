@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+// providerConfigInfo returns a description of the provider config with the
+// supplied key and spec, which the supplied BorkResource's providerConfigRef
+// resolved to.
+func providerConfigInfo(mg resource.ModernManaged, key clients.ProviderConfigKey, pc *apisv1alpha1.ProviderConfigSpec) *v1alpha1.ProviderConfigInfo {
+	return &v1alpha1.ProviderConfigInfo{
+		Kind:              key.Kind,
+		Name:              key.Name,
+		Namespace:         key.Namespace,
+		CredentialsSource: pc.Credentials.Source,
+		Fallback:          key.Kind != mg.GetProviderConfigReference().Kind,
+	}
+}
+
+// recordProviderConfig records the provider config the BorkResource was
+// connected with in its observation. Observing, creating and updating its
+// record replace its observation, so each records it once it's done.
+func (c *external) recordProviderConfig(cr *v1alpha1.BorkResource) {
+	cr.Status.AtProvider.ProviderConfigInfo = c.providerConfig
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// TestProviderConfigInfo reconciles a BorkResource that references a
+// ProviderConfig its namespace doesn't have, which falls back to the
+// ClusterProviderConfig of that name, then reconciles it again once the
+// namespace has the ProviderConfig. Its status must report which provider
+// config it used, and that provider config's credentials source, each time.
+func TestProviderConfigInfo(t *testing.T) {
+	cr := newBorkResource(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+	cr.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ProviderConfigKind, Name: "default"})
+	f := newPolicyFixture(t, cr, backend.NewStore())
+
+	// The first reconcile creates the record, the second observes it.
+	f.reconcile(t, cr)
+	f.reconcile(t, cr)
+	want := v1alpha1.ProviderConfigInfo{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default", CredentialsSource: xpv1.CredentialsSourceNone, Fallback: true}
+	if got := f.get(t, cr).Status.AtProvider.ProviderConfigInfo; got == nil || *got != want {
+		t.Errorf("fallback: got providerConfigInfo %+v, want %+v", got, want)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: cr.GetNamespace(), Name: "default"},
+		Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret}},
+	}
+	if err := f.kube.Create(context.Background(), pc); err != nil {
+		t.Fatal(err)
+	}
+	f.reconcile(t, cr)
+	want = v1alpha1.ProviderConfigInfo{Kind: apisv1alpha1.ProviderConfigKind, Namespace: cr.GetNamespace(), Name: "default", CredentialsSource: xpv1.CredentialsSourceSecret}
+	if got := f.get(t, cr).Status.AtProvider.ProviderConfigInfo; got == nil || *got != want {
+		t.Errorf("override: got providerConfigInfo %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// TypeRenamedExternally BorkResources have a record that was renamed by
+// someone other than the provider, so that it no longer has their external
+// name.
+const TypeRenamedExternally xpv1.ConditionType = "RenamedExternally"
+
+// Reasons a BorkResource's record was found to have been renamed, per its
+// rename policy.
+const (
+	ReasonAdopted   xpv1.ConditionReason = "Adopted"
+	ReasonRecreated xpv1.ConditionReason = "Recreated"
+)
+
+// reasonRenamed is the reason of the event recorded when a BorkResource's
+// record is found to have been renamed.
+const reasonRenamed event.Reason = "RenamedExternally"
+
+const (
+	msgAdoptedFmt   = "bork record %q was renamed to %q by someone other than the provider; the renamed record was adopted"
+	msgRecreatedFmt = "bork record %q was renamed to %q by someone other than the provider; a new record will be created"
+)
+
+const (
+	errGetRecordByUID = "cannot get bork record by UID"
+	errAdoptRenamed   = "cannot adopt renamed bork record"
+)
+
+// RenamedExternally returns a condition that indicates the named record was
+// renamed to the supplied name, and what was done about it per the supplied
+// rename policy.
+func RenamedExternally(name, to string, p v1alpha1.RenamePolicy) xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               TypeRenamedExternally,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAdopted,
+		Message:            fmt.Sprintf(msgAdoptedFmt, name, to),
+	}
+	if p == v1alpha1.RenamePolicyRecreate {
+		c.Reason = ReasonRecreated
+		c.Message = fmt.Sprintf(msgRecreatedFmt, name, to)
+	}
+	return c
+}
+
+// renamed handles the supplied BorkResource's record having no record with
+// the supplied external name. If a record has the UID the BorkResource last
+// observed, the record was renamed. Per the BorkResource's rename policy it
+// either adopts the renamed record, changing its external name to the new
+// name, or forgets it, so that it creates a new record. It returns true if
+// it adopted a renamed record.
+func (c *external) renamed(ctx context.Context, cr *v1alpha1.BorkResource, name string) (bool, error) {
+	// BorkResources observed before records had UIDs have their record's
+	// name as their ID.
+	id := cr.Status.AtProvider.ID
+	if id == "" || id == name {
+		return false, nil
+	}
+	r, err := c.service.GetByUID(ctx, id)
+	if backend.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetRecordByUID)
+	}
+	if r.Name == name {
+		// The record wasn't renamed; the backend just didn't find it by
+		// name. Adopting it would observe it by the same name again.
+		return false, nil
+	}
+
+	policy := cr.Spec.ForProvider.RenamePolicy
+	cond := RenamedExternally(name, r.Name, policy)
+	cr.Status.SetConditions(cond)
+	c.record.Event(cr, event.Warning(reasonRenamed, errors.New(cond.Message)))
+
+	if policy == v1alpha1.RenamePolicyRecreate {
+		// The renamed record is no longer ours. Forgetting it means the
+		// new record isn't reported as a replacement of it.
+		cr.Status.AtProvider = v1alpha1.BorkResourceObservation{}
+		return false, nil
+	}
+
+	// The managed reconciler doesn't persist an external name that changes
+	// when it observes, so we patch it. Only the annotation is patched, so
+	// that our status isn't reset to the API server's.
+	before := cr.DeepCopy()
+	adopted := cr.DeepCopy()
+	meta.SetExternalName(adopted, r.Name)
+	if err := c.kube.Patch(ctx, adopted, client.MergeFrom(before)); err != nil {
+		return false, errors.Wrap(err, errAdoptRenamed)
+	}
+	meta.SetExternalName(cr, r.Name)
+	cr.SetResourceVersion(adopted.GetResourceVersion())
+	return true, nil
+}
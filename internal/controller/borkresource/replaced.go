@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+)
+
+// TypeResourceReplaced BorkResources have a record that was replaced out from
+// under them: deleted and recreated with the same name, by someone other than
+// the provider, so that it has a new UID.
+const TypeResourceReplaced xpv1.ConditionType = "ResourceReplaced"
+
+// ReasonUIDChanged is the reason a BorkResource's record was found to have
+// been replaced.
+const ReasonUIDChanged xpv1.ConditionReason = "UIDChanged"
+
+// reasonReplaced is the reason of the event recorded when a BorkResource's
+// record is found to have been replaced.
+const reasonReplaced event.Reason = "ResourceReplaced"
+
+const msgReplacedFmt = "bork record %q was replaced out from under us: its UID was %s, and is now %s"
+
+// Replaced returns a condition that indicates the named record was replaced,
+// changing its UID from was to is.
+func Replaced(name, was, is string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeResourceReplaced,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUIDChanged,
+		Message:            fmt.Sprintf(msgReplacedFmt, name, was, is),
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"time"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// ClockSkewTolerance is how far the backend's clock may differ from ours
+// before the timestamps of its records are considered implausible.
+const ClockSkewTolerance = time.Minute
+
+// normalize returns the supplied record, which was returned by the backend at
+// the supplied time, with implausible timestamps replaced by that time. A
+// record can't have been written after it was returned, so timestamps further
+// ahead of it than ClockSkewTolerance are implausible. A record that was
+// returned by a write was written when it was returned, so its lastModified
+// is implausible if it's further from that time in either direction. The
+// difference is the backend's clock skew, which is reported by the
+// bork_backend_clock_skew_seconds metric whenever it's measured.
+func normalize(r backend.Record, now time.Time, written bool) backend.Record {
+	skew := r.LastModified.Sub(now)
+	implausible := skew > ClockSkewTolerance || (written && skew < -ClockSkewTolerance)
+	if written || implausible {
+		metrics.BackendClockSkew.WithLabelValues(v1alpha1.BorkResourceKind).Set(skew.Seconds())
+	}
+	if implausible {
+		r.LastModified = now
+		metrics.NormalizedTimestamps.WithLabelValues(v1alpha1.BorkResourceKind).Inc()
+	}
+	for i := range r.History {
+		if r.History[i].AppliedAt.Sub(now) > ClockSkewTolerance || r.History[i].AppliedAt.After(r.LastModified) {
+			r.History[i].AppliedAt = r.LastModified
+			metrics.NormalizedTimestamps.WithLabelValues(v1alpha1.BorkResourceKind).Inc()
+		}
+	}
+	return r
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const (
+	errIndexValueFrom    = "cannot index BorkResources by the ConfigMaps and Secrets their valueFrom selects"
+	errGetValueFromFmt   = "cannot get borkValue %q from %s %q"
+	errValueFromKeyFmt   = "cannot get borkValue %q from %s %q: it has no key %q"
+	errValueFromValueFmt = "borkValue %q from %s %q must be at most %d characters"
+)
+
+// Kinds of the objects a BorkResource's valueFrom selects values from.
+const (
+	kindConfigMap = "ConfigMap"
+	kindSecret    = "Secret"
+)
+
+// indexValueFrom is the field index of BorkResources by the kind, namespace
+// and name of each ConfigMap and Secret their spec.forProvider.valueFrom
+// selects.
+const indexValueFrom = "spec.forProvider.valueFrom"
+
+// valueFromKey returns the key of the object of the supplied kind, namespace
+// and name in the valueFrom index.
+func valueFromKey(kind, namespace, name string) string {
+	return kind + "/" + types.NamespacedName{Namespace: namespace, Name: name}.String()
+}
+
+// indexValueFroms indexes BorkResources by the ConfigMaps and Secrets their
+// valueFrom selects.
+func indexValueFroms(o client.Object) []string {
+	cr, ok := o.(*v1alpha1.BorkResource)
+	if !ok {
+		return nil
+	}
+	var keys []string
+	for _, src := range cr.Spec.ForProvider.ValueFrom {
+		switch {
+		case src.ConfigMapKeyRef != nil:
+			keys = append(keys, valueFromKey(kindConfigMap, cr.GetNamespace(), src.ConfigMapKeyRef.Name))
+		case src.SecretKeyRef != nil:
+			keys = append(keys, valueFromKey(kindSecret, cr.GetNamespace(), src.SecretKeyRef.Name))
+		}
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// setupIndex adds the field index that enqueueValueFrom lists BorkResources
+// with.
+func setupIndex(ctx context.Context, fi client.FieldIndexer) error {
+	return errors.Wrap(fi.IndexField(ctx, &v1alpha1.BorkResource{}, indexValueFrom, indexValueFroms), errIndexValueFrom)
+}
+
+// enqueueValueFrom returns a function that maps a ConfigMap or Secret of the
+// supplied kind to the BorkResources whose valueFrom selects it, so that
+// they're reconciled as soon as it changes rather than when they're next
+// polled.
+func enqueueValueFrom(kube client.Reader, kind string) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		l := &v1alpha1.BorkResourceList{}
+		if err := kube.List(ctx, l, client.MatchingFields{indexValueFrom: valueFromKey(kind, o.GetNamespace(), o.GetName())}); err != nil {
+			return nil
+		}
+
+		reqs := make([]reconcile.Request, 0, len(l.Items))
+		for _, cr := range l.Items {
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: cr.GetNamespace(), Name: cr.GetName()}})
+		}
+		return reqs
+	}
+}
+
+// valueFrom returns the values the supplied BorkResource's valueFrom selects,
+// keyed by the borkValue key they set.
+func (c *external) valueFrom(ctx context.Context, cr *v1alpha1.BorkResource) (map[string]string, error) {
+	sources := cr.Spec.ForProvider.ValueFrom
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	values := make(map[string]string, len(sources))
+	for _, k := range slices.Sorted(maps.Keys(sources)) {
+		var kind, name, key, v string
+		var ok bool
+		switch src := sources[k]; {
+		case src.ConfigMapKeyRef != nil:
+			kind, name, key = kindConfigMap, src.ConfigMapKeyRef.Name, src.ConfigMapKeyRef.Key
+			cm := &corev1.ConfigMap{}
+			if err := c.configMaps.Get(ctx, types.NamespacedName{Namespace: cr.GetNamespace(), Name: name}, cm); err != nil {
+				return nil, errors.Wrapf(err, errGetValueFromFmt, k, kind, name)
+			}
+			v, ok = cm.Data[key]
+		case src.SecretKeyRef != nil:
+			kind, name, key = kindSecret, src.SecretKeyRef.Name, src.SecretKeyRef.Key
+			s := &corev1.Secret{}
+			if err := c.kube.Get(ctx, types.NamespacedName{Namespace: cr.GetNamespace(), Name: name}, s); err != nil {
+				return nil, errors.Wrapf(err, errGetValueFromFmt, k, kind, name)
+			}
+			var b []byte
+			b, ok = s.Data[key]
+			v = string(b)
+		default:
+			continue
+		}
+		if !ok {
+			return nil, errors.Errorf(errValueFromKeyFmt, k, kind, name, key)
+		}
+		if len(v) > v1alpha1.MaxValueLength {
+			return nil, backend.NewError(backend.ErrorCodeBadRequest, errors.Errorf(errValueFromValueFmt, k, kind, name, v1alpha1.MaxValueLength).Error())
+		}
+		values[k] = v
+	}
+	return values, nil
+}
+
+// desired returns the supplied parameters with the supplied values, selected
+// by their valueFrom, merged into their borkValue. The parameters themselves
+// are unchanged, so that the values are never written to the BorkResource's
+// spec.
+func desired(p v1alpha1.BorkResourceParameters, values map[string]string) v1alpha1.BorkResourceParameters {
+	if len(values) == 0 {
+		return p
+	}
+	bv := make(map[string]string, len(p.BorkValue)+len(values))
+	maps.Copy(bv, p.BorkValue)
+	maps.Copy(bv, values)
+	p.BorkValue = bv
+	return p
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkschedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkSchedule = "managed resource is not a BorkSchedule custom resource"
+
+	errGetSchedule    = "cannot get schedule"
+	errCreateSchedule = "cannot create schedule"
+	errUpdateSchedule = "cannot update schedule"
+	errDeleteSchedule = "cannot delete schedule"
+	errWindow         = "cannot determine whether the maintenance window is open"
+)
+
+// SetupGated adds a controller that reconciles BorkSchedule managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkSchedule controller"))
+		}
+	}, v1alpha1.BorkScheduleGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkScheduleGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkScheduleKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkScheduleList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+		)),
+		// The backend assigns each schedule's external name when it is created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkScheduleList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkScheduleList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkScheduleList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkScheduleList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindSchedule, func() resource.ManagedList { return &v1alpha1.BorkScheduleList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkScheduleList")
+		}
+	}
+
+	leak.Default.Register(backend.KindSchedule, func() resource.ManagedList { return &v1alpha1.BorkScheduleList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkScheduleGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkSchedule{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkScheduleKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkSchedule{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindSchedule, func() resource.ManagedList { return &v1alpha1.BorkScheduleList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkScheduleGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkScheduleGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkScheduleGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkScheduleKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles schedules in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkSchedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkSchedule)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	sc, err := c.service.GetSchedule(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSchedule)
+	}
+	cr.Status.AtProvider = generateObservation(sc)
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	d := cmp.Diff(cr.Spec.ForProvider.Settings, sc.Settings, cmpopts.EquateEmpty())
+	if d == "" {
+		if cr.GetCondition(TypePendingWindow).Status == corev1.ConditionTrue {
+			cr.SetConditions(NoPendingUpdate())
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	// The schedule is only updated while its maintenance window is open.
+	// Until then it's reported as up to date, so that the managed
+	// reconciler doesn't update it, and polled again when the window opens.
+	open, next, err := isOpen(cr.Spec.ForProvider.MaintenanceWindow, time.Now())
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errWindow)
+	}
+	if !open {
+		if !next.IsZero() {
+			cr.Status.AtProvider.NextWindowStart = &metav1.Time{Time: next}
+		}
+		cr.SetConditions(PendingWindow(next))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: false,
+		Diff:             d,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkSchedule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkSchedule)
+	}
+
+	sc, err := c.service.CreateSchedule(ctx, backend.Schedule{Settings: cr.Spec.ForProvider.Settings})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSchedule)
+	}
+	meta.SetExternalName(cr, sc.Name)
+	cr.Status.AtProvider = generateObservation(sc)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkSchedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkSchedule)
+	}
+
+	sc, err := c.service.UpdateSchedule(ctx, backend.Schedule{Name: meta.GetExternalName(cr), Settings: cr.Spec.ForProvider.Settings})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSchedule)
+	}
+	cr.Status.AtProvider = generateObservation(sc)
+	if cr.GetCondition(TypePendingWindow).Status == corev1.ConditionTrue {
+		cr.SetConditions(NoPendingUpdate())
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkSchedule)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkSchedule)
+	}
+
+	if err := c.service.DeleteSchedule(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteSchedule)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// pollInterval returns the supplied poll interval, shortened so that a
+// BorkSchedule whose update is deferred is polled as soon as its maintenance
+// window opens.
+func pollInterval(mg resource.Managed, d time.Duration) time.Duration {
+	cr, ok := mg.(*v1alpha1.BorkSchedule)
+	if !ok || cr.Status.AtProvider.NextWindowStart == nil {
+		return d
+	}
+	if until := time.Until(cr.Status.AtProvider.NextWindowStart.Time); until > 0 {
+		// Poll just after the window opens, so that it's certainly open.
+		return min(d, until+time.Second)
+	}
+	return d
+}
+
+func generateObservation(sc backend.Schedule) v1alpha1.BorkScheduleObservation {
+	return v1alpha1.BorkScheduleObservation{
+		Settings: sc.Settings,
+		Revision: sc.Revision,
+	}
+}
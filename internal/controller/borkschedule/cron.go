@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkschedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errCronFieldsFmt = "cron expression %q must have five fields"
+	errCronFieldFmt  = "invalid cron field %q"
+	errCronRangeFmt  = "cron field %q must be between %d and %d"
+)
+
+// How far ahead next looks for a time the schedule fires. Every valid
+// schedule fires at least once every four years, on February 29th.
+const cronHorizon = 5
+
+// A cronSchedule is a five field cron expression. Each field is a bitset of
+// the values it matches.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// Whether the day of month or day of week fields are *. If neither is,
+	// a day matches if either does.
+	domAny, dowAny bool
+}
+
+// The range of values of each field of a cron expression. Day of week 7 is
+// Sunday, like 0.
+var cronBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// parseCron parses a five field cron expression of minute, hour, day of
+// month, month and day of week. Each field is * or a list of values and
+// ranges, each with an optional step, e.g. 1-5, */15 or 0,30.
+func parseCron(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.Errorf(errCronFieldsFmt, expr)
+	}
+	var bits [5]uint64
+	for i, f := range fields {
+		b, err := parseCronField(f, cronBounds[i][0], cronBounds[i][1])
+		if err != nil {
+			return nil, err
+		}
+		bits[i] = b
+	}
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &cronSchedule{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		domAny: strings.HasPrefix(fields[2], "*"),
+		dowAny: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField parses a field whose values are between lo and hi.
+func parseCronField(f string, lo, hi int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(f, ",") {
+		rng, step, stepped := strings.Cut(part, "/")
+		first, last := lo, hi
+		if rng != "*" {
+			a, b, ranged := strings.Cut(rng, "-")
+			var err error
+			if first, err = strconv.Atoi(a); err != nil {
+				return 0, errors.Errorf(errCronFieldFmt, f)
+			}
+			switch {
+			case ranged:
+				if last, err = strconv.Atoi(b); err != nil {
+					return 0, errors.Errorf(errCronFieldFmt, f)
+				}
+			case !stepped:
+				last = first
+			}
+		}
+		every := 1
+		if stepped {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return 0, errors.Errorf(errCronFieldFmt, f)
+			}
+			every = n
+		}
+		if first < lo || last > hi || first > last {
+			return 0, errors.Errorf(errCronRangeFmt, f, lo, hi)
+		}
+		for v := first; v <= last; v += every {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// next returns the first minute after the supplied time at which the schedule
+// fires, in UTC, or the zero time if it never does.
+func (c *cronSchedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	horizon := t.AddDate(cronHorizon, 0, 0)
+	for t.Before(horizon) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *cronSchedule) matchesDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkschedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the schedule the fake backend stores.
+const existingName = "schedule-existing"
+
+// window returns a maintenance window that opens at the supplied offset from
+// now, and stays open for an hour.
+func window(offset time.Duration) v1alpha1.MaintenanceWindow {
+	start := time.Now().Add(offset)
+	return v1alpha1.MaintenanceWindow{Ranges: []v1alpha1.TimeRange{{
+		Start: metav1.Time{Time: start},
+		End:   metav1.Time{Time: start.Add(time.Hour)},
+	}}}
+}
+
+// newBorkSchedule returns a BorkSchedule whose maintenance window is open.
+func newBorkSchedule() *v1alpha1.BorkSchedule {
+	return &v1alpha1.BorkSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkScheduleSpec{ForProvider: v1alpha1.BorkScheduleParameters{
+			MaintenanceWindow: window(-30 * time.Minute),
+			Settings:          map[string]string{"size": "large"},
+		}},
+	}
+}
+
+// newExternal returns an external client of a fake backend storing a
+// schedule with the supplied settings.
+func newExternal(t *testing.T, settings map[string]string) (*borkfake.Client, *external) {
+	t.Helper()
+	f := borkfake.New()
+	if _, err := f.Store.CreateSchedule(context.Background(), backend.Schedule{Name: existingName, Settings: settings}); err != nil {
+		t.Fatal(err)
+	}
+	return f, &external{service: f.Client}
+}
+
+// condition returns what's compared of a condition: its type, status and
+// reason.
+func condition(c xpv1.Condition) xpv1.Condition {
+	return xpv1.Condition{Type: c.Type, Status: c.Status, Reason: c.Reason}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o         managed.ExternalObservation
+		diff      bool
+		err       error
+		condition xpv1.Condition
+		next      bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		window   *v1alpha1.MaintenanceWindow
+		pending  bool
+		settings map[string]string
+		want     want
+	}{
+		"UpToDateNoLongerPending": {
+			reason:   "A schedule that was pending its window, but now matches the spec, no longer has a pending update.",
+			pending:  true,
+			settings: map[string]string{"size": "large"},
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: condition(NoPendingUpdate()),
+			},
+		},
+		"WindowOpen": {
+			reason:   "A schedule that differs from the spec is out of date while its window is open.",
+			settings: map[string]string{"size": "small"},
+			want:     want{o: managed.ExternalObservation{ResourceExists: true}, diff: true},
+		},
+		"WindowClosed": {
+			reason:   "A schedule that differs from the spec is reported up to date, pending its window, while its window is closed.",
+			window:   func() *v1alpha1.MaintenanceWindow { w := window(time.Hour); return &w }(),
+			settings: map[string]string{"size": "small"},
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: condition(PendingWindow(time.Now())),
+				next:      true,
+			},
+		},
+		"WindowInvalid": {
+			reason:   "An error determining whether the window is open is returned.",
+			window:   &v1alpha1.MaintenanceWindow{Cron: "bork"},
+			settings: map[string]string{"size": "small"},
+			want:     want{err: errors.Wrap(errors.New(`cron expression "bork" must have five fields`), errWindow)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkSchedule()
+			meta.SetExternalName(cr, existingName)
+			if tc.window != nil {
+				cr.Spec.ForProvider.MaintenanceWindow = *tc.window
+			}
+			if tc.pending {
+				cr.SetConditions(PendingWindow(time.Now()))
+			}
+			_, e := newExternal(t, tc.settings)
+
+			o, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if got := o.Diff != ""; got != tc.want.diff {
+				t.Errorf("\n%s\nObserve(...): got diff %q, want a diff: %t", tc.reason, o.Diff, tc.want.diff)
+			}
+			if tc.want.condition.Type != "" {
+				if diff := cmp.Diff(tc.want.condition, condition(cr.GetCondition(TypePendingWindow))); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+			if got := cr.Status.AtProvider.NextWindowStart != nil; got != tc.want.next {
+				t.Errorf("\n%s\nObserve(...): got next window start %v, want one: %t", tc.reason, cr.Status.AtProvider.NextWindowStart, tc.want.next)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := newBorkSchedule()
+	meta.SetExternalName(cr, existingName)
+	cr.SetConditions(PendingWindow(time.Now()))
+	f, e := newExternal(t, map[string]string{"size": "small"})
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	sc, err := f.Store.GetSchedule(context.Background(), existingName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(cr.Spec.ForProvider.Settings, sc.Settings); diff != "" {
+		t.Errorf("Update(...): -want settings, +got settings:\n%s", diff)
+	}
+	if c := cr.GetCondition(TypePendingWindow); c.Status != corev1.ConditionFalse {
+		t.Errorf("Update(...): got condition %v, want no pending update once the schedule is updated", c)
+	}
+}
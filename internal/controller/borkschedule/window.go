@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkschedule
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+// TypePendingWindow resources have changes that won't be applied until their
+// maintenance window opens.
+const TypePendingWindow xpv1.ConditionType = "PendingWindow"
+
+// Reasons a resource does or does not have changes pending its maintenance
+// window.
+const (
+	ReasonOutsideWindow   xpv1.ConditionReason = "OutsideWindow"
+	ReasonNoPendingUpdate xpv1.ConditionReason = "NoPendingUpdate"
+)
+
+// PendingWindow returns a condition that indicates the resource's changes
+// won't be applied until its maintenance window next opens, at the supplied
+// time. A zero time means the window never opens again.
+func PendingWindow(next time.Time) xpv1.Condition {
+	msg := "Update deferred; the maintenance window never opens again"
+	if !next.IsZero() {
+		msg = fmt.Sprintf("Update deferred until the maintenance window opens at %s", next.UTC().Format(time.RFC3339))
+	}
+	return xpv1.Condition{
+		Type:               TypePendingWindow,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOutsideWindow,
+		Message:            msg,
+	}
+}
+
+// NoPendingUpdate returns a condition that indicates the resource has no
+// changes pending its maintenance window.
+func NoPendingUpdate() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePendingWindow,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoPendingUpdate,
+	}
+}
+
+// isOpen returns true if the supplied maintenance window is open at the
+// supplied time. If it isn't, it also returns when the window next opens, or
+// the zero time if it never does.
+func isOpen(w v1alpha1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	if w.Cron != "" {
+		c, err := parseCron(w.Cron)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		var d time.Duration
+		if w.Duration != nil {
+			d = w.Duration.Duration
+		}
+		// The window is open if it last opened less than its duration ago.
+		if t := c.next(now.Add(-d)); !t.IsZero() && !t.After(now) {
+			return true, time.Time{}, nil
+		}
+		return false, c.next(now), nil
+	}
+
+	var next time.Time
+	for _, r := range w.Ranges {
+		if !now.Before(r.Start.Time) && now.Before(r.End.Time) {
+			return true, time.Time{}, nil
+		}
+		if r.Start.After(now) && (next.IsZero() || r.Start.Time.Before(next)) {
+			next = r.Start.Time
+		}
+	}
+	return false, next, nil
+}
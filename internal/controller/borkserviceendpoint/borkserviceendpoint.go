@@ -0,0 +1,333 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkserviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkServiceEndpoint = "managed resource is not a BorkServiceEndpoint custom resource"
+
+	errGetEndpoint    = "cannot get service endpoint"
+	errCreateEndpoint = "cannot create service endpoint"
+	errUpdateEndpoint = "cannot update service endpoint"
+	errDeleteEndpoint = "cannot delete service endpoint"
+
+	msgEndpointStateFmt = "endpoint is %s"
+)
+
+// pendingPollInterval is how often endpoints that are not yet available are
+// polled.
+const pendingPollInterval = 10 * time.Second
+
+// SetupGated adds a controller that reconciles BorkServiceEndpoint managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkServiceEndpoint controller"))
+		}
+	}, v1alpha1.BorkServiceEndpointGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkServiceEndpointGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkServiceEndpointKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkServiceEndpointList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithConnectionDetailTemplates(),
+			middleware.WithTagPropagation(),
+		)),
+		// The backend assigns each endpoint's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithPollIntervalHook(pollInterval),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkServiceEndpointList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkServiceEndpointList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkServiceEndpointList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkServiceEndpointList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindServiceEndpoint, func() resource.ManagedList { return &v1alpha1.BorkServiceEndpointList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkServiceEndpointList")
+		}
+	}
+
+	leak.Default.Register(backend.KindServiceEndpoint, func() resource.ManagedList { return &v1alpha1.BorkServiceEndpointList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkServiceEndpointGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkServiceEndpoint{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkServiceEndpointKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkServiceEndpoint{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindServiceEndpoint, func() resource.ManagedList { return &v1alpha1.BorkServiceEndpointList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkServiceEndpointGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkServiceEndpointGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkServiceEndpointGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkServiceEndpointKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles service endpoints in the
+// simulated backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkServiceEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkServiceEndpoint)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	e, err := c.service.GetServiceEndpoint(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEndpoint)
+	}
+	cr.Status.AtProvider = generateObservation(e)
+
+	// Endpoints take several reconciles to become available. They're
+	// considered creating until then.
+	if e.State == backend.EndpointAvailable {
+		cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+	} else {
+		cr.Status.SetConditions(xpv1.Creating().WithMessage(fmt.Sprintf(msgEndpointStateFmt, e.State)).WithObservedGeneration(cr.GetGeneration()))
+	}
+
+	lateInitialized := false
+	if cr.Spec.ForProvider.Region == nil {
+		cr.Spec.ForProvider.Region = ptr.To(e.Region)
+		lateInitialized = true
+	}
+
+	d := diff(generateEndpoint(cr.Spec.ForProvider), e)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        d == "",
+		Diff:                    d,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       connectionDetails(e),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkServiceEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkServiceEndpoint)
+	}
+
+	e, err := c.service.CreateServiceEndpoint(ctx, generateEndpoint(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEndpoint)
+	}
+	meta.SetExternalName(cr, e.Name)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: connectionDetails(e),
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkServiceEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkServiceEndpoint)
+	}
+
+	e := generateEndpoint(cr.Spec.ForProvider)
+	e.Name = meta.GetExternalName(cr)
+	e, err := c.service.UpdateServiceEndpoint(ctx, e)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEndpoint)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: connectionDetails(e),
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkServiceEndpoint)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkServiceEndpoint)
+	}
+
+	if err := c.service.DeleteServiceEndpoint(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteEndpoint)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// generateEndpoint returns the backend service endpoint described by the
+// supplied parameters.
+func generateEndpoint(p v1alpha1.BorkServiceEndpointParameters) backend.ServiceEndpoint {
+	return backend.ServiceEndpoint{
+		Service:           p.Service,
+		Region:            ptr.Deref(p.Region, ""),
+		PrivateDNSEnabled: ptr.Deref(p.PrivateDNSEnabled, true),
+		Tags:              p.Tags,
+	}
+}
+
+func generateObservation(e backend.ServiceEndpoint) v1alpha1.BorkServiceEndpointObservation {
+	return v1alpha1.BorkServiceEndpointObservation{
+		State:          string(e.State),
+		Region:         e.Region,
+		PrivateDNSName: e.PrivateDNSName,
+		Tags:           e.Tags,
+		Revision:       e.Revision,
+	}
+}
+
+// endpointCompareOptions compare the fields of an endpoint that are under our
+// control. The backend defaults an unset region, and an empty map of tags is
+// equivalent to an omitted one.
+var endpointCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.ServiceEndpoint{}, "Name", "State", "PrivateDNSName", "CreatedAt", "Revision"),
+	cmpopts.EquateEmpty(),
+}
+
+// diff returns a human-readable diff of the desired and observed endpoints,
+// or an empty string if the observed endpoint is up to date.
+func diff(desired, observed backend.ServiceEndpoint) string {
+	if desired.Region == "" {
+		desired.Region = backend.DefaultRegion
+	}
+	return cmp.Diff(desired, observed, endpointCompareOptions...)
+}
+
+// connectionDetails returns the details a consumer needs to connect to the
+// supplied endpoint. Endpoints have no private DNS name until they're
+// available.
+func connectionDetails(e backend.ServiceEndpoint) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{
+		"endpointId": []byte(e.Name),
+		"service":    []byte(e.Service),
+	}
+	if e.PrivateDNSName != "" {
+		cd["privateDnsName"] = []byte(e.PrivateDNSName)
+	}
+	return cd
+}
+
+// pollInterval polls endpoints that are not yet available more frequently,
+// so that they are marked available soon after they're provisioned.
+func pollInterval(mg resource.Managed, d time.Duration) time.Duration {
+	cr, ok := mg.(*v1alpha1.BorkServiceEndpoint)
+	if !ok || cr.Status.AtProvider.State == string(backend.EndpointAvailable) || d < pendingPollInterval {
+		return d
+	}
+	return pendingPollInterval
+}
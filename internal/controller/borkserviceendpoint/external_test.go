@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkserviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+func TestObserve(t *testing.T) {
+	cases := map[string]struct {
+		reason          string
+		region          *string
+		lateInitialized bool
+	}{
+		"RegionSet": {
+			reason: "An endpoint whose spec sets its region isn't late initialized.",
+			region: ptr.To(backend.DefaultRegion),
+		},
+		"RegionDefaulted": {
+			reason:          "The region the backend defaulted is late initialized.",
+			lateInitialized: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := borkfake.New()
+			ep, err := f.Store.CreateServiceEndpoint(context.Background(), backend.ServiceEndpoint{Service: "bork-api", PrivateDNSEnabled: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cr := &v1alpha1.BorkServiceEndpoint{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+				Spec:       v1alpha1.BorkServiceEndpointSpec{ForProvider: v1alpha1.BorkServiceEndpointParameters{Service: "bork-api", Region: tc.region}},
+			}
+			meta.SetExternalName(cr, ep.Name)
+
+			o, err := (&external{service: f.Client}).Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+
+			// The endpoint was only just created, so it's still pending
+			// acceptance and has no private DNS name to publish.
+			want := managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        true,
+				ResourceLateInitialized: tc.lateInitialized,
+				ConnectionDetails:       managed.ConnectionDetails{"endpointId": []byte(ep.Name), "service": []byte("bork-api")},
+			}
+			if diff := cmp.Diff(want, o); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(ptr.To(backend.DefaultRegion), cr.Spec.ForProvider.Region); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want region, +got region:\n%s", tc.reason, diff)
+			}
+			creating := xpv1.Creating().WithMessage(fmt.Sprintf(msgEndpointStateFmt, backend.EndpointPendingAcceptance))
+			if diff := cmp.Diff(creating, cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkthrottleplan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// errBoom is the error the fake backend fails operations with.
+var errBoom = backend.NewError(backend.ErrorCodeInternal, "boom")
+
+// existingName is the name of the plan the fake backend stores.
+const existingName = "plan-existing"
+
+func TestObserveUsage(t *testing.T) {
+	type want struct {
+		err error
+		obs v1alpha1.BorkThrottlePlanObservation
+	}
+
+	cases := map[string]struct {
+		reason string
+		keys   []string
+		fail   map[string]error
+		want   want
+	}{
+		"NoKeys": {
+			reason: "A plan no key is attached to allocates no requests.",
+		},
+		"KeysAttached": {
+			reason: "The keys attached to a plan, and the requests they're allocated, are observed.",
+			keys:   []string{"key-a", "key-b"},
+			want:   want{obs: v1alpha1.BorkThrottlePlanObservation{AttachedKeys: 2, Keys: []string{"key-a", "key-b"}, AllocatedRequestsPerSecond: 20}},
+		},
+		"GetUsageFailed": {
+			reason: "An error getting the plan's usage is returned.",
+			keys:   []string{"key-a"},
+			fail:   map[string]error{"GetPlanUsage": errBoom},
+			want:   want{err: errors.Wrap(errBoom, errGetPlanUsage)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := borkfake.New()
+			if _, err := f.Store.CreatePlan(context.Background(), backend.Plan{Name: existingName, Tier: "gold", RequestsPerSecond: 10}); err != nil {
+				t.Fatal(err)
+			}
+			for _, k := range tc.keys {
+				if _, err := f.Store.CreateKey(context.Background(), backend.Key{Name: k, Plan: existingName}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for op, err := range tc.fail {
+				f.Fail(op, err)
+			}
+			cr := &v1alpha1.BorkThrottlePlan{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+				Spec:       v1alpha1.BorkThrottlePlanSpec{ForProvider: v1alpha1.BorkThrottlePlanParameters{Tier: "gold", RequestsPerSecond: 10}},
+			}
+			meta.SetExternalName(cr, existingName)
+
+			_, err := (&external{service: f.Client}).Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
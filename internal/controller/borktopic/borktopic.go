@@ -0,0 +1,320 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borktopic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkTopic = "managed resource is not a BorkTopic custom resource"
+
+	errGetTopic    = "cannot get topic"
+	errCreateTopic = "cannot create topic"
+	errUpdateTopic = "cannot update topic"
+	errDeleteTopic = "cannot delete topic"
+)
+
+// reasonCredentialsRotated is the reason of the event recorded when a
+// BorkTopic observes that its topic's password was rotated.
+const reasonCredentialsRotated event.Reason = "CredentialsRotated"
+
+// The connection details a BorkTopic publishes. The version is the topic's
+// credentials version, which consumers can compare to tell whether the
+// password they hold is current.
+const (
+	ConnectionDetailEndpoint = "endpoint"
+	ConnectionDetailUsername = "username"
+	ConnectionDetailPassword = "password"
+	ConnectionDetailVersion  = "version"
+)
+
+// SetupGated adds a controller that reconciles BorkTopic managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkTopic controller"))
+		}
+	}, v1alpha1.BorkTopicGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkTopicGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkTopicKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkTopicList{} },
+			&connector{
+				kube:   mgr.GetClient(),
+				pool:   clients.DefaultPool,
+				record: recorder,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithConnectionDetailTemplates(),
+			middleware.WithTagPropagation(),
+		)),
+		// The backend assigns each topic's external name when it is created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkTopicList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkTopicList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkTopicList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkTopicList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindTopic, func() resource.ManagedList { return &v1alpha1.BorkTopicList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkTopicList")
+		}
+	}
+
+	leak.Default.Register(backend.KindTopic, func() resource.ManagedList { return &v1alpha1.BorkTopicList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkTopicGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkTopic{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkTopicKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkTopic{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindTopic, func() resource.ManagedList { return &v1alpha1.BorkTopicList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkTopicGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkTopicGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkTopicGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkTopicKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	pool   *clients.Pool
+	record event.Recorder
+}
+
+// Connect produces an ExternalClient that reconciles topics in the simulated
+// backend.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc, record: c.record}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+	record  event.Recorder
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkTopic)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkTopic)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Every observation counts towards the rotation of the topic's password,
+	// so the topic may return a password it never returned before.
+	t, err := c.service.GetTopic(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTopic)
+	}
+	if v := cr.Status.AtProvider.CredentialsVersion; t.CredentialsVersion > v {
+		c.record.Event(cr, event.Normal(reasonCredentialsRotated, "Topic credentials were rotated; publishing version "+strconv.FormatInt(t.CredentialsVersion, 10)))
+	}
+	cr.Status.AtProvider = generateObservation(t)
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	// The managed reconciler publishes the connection details every time the
+	// topic is observed, so a rotated password reaches the connection secret
+	// without the topic being updated.
+	d := diff(generateTopic(cr.Spec.ForProvider), t)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: connectionDetails(t),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkTopic)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkTopic)
+	}
+
+	t, err := c.service.CreateTopic(ctx, generateTopic(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTopic)
+	}
+	meta.SetExternalName(cr, t.Name)
+	cr.Status.AtProvider = generateObservation(t)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: connectionDetails(t),
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkTopic)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkTopic)
+	}
+
+	t := generateTopic(cr.Spec.ForProvider)
+	t.Name = meta.GetExternalName(cr)
+	t, err := c.service.UpdateTopic(ctx, t)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: connectionDetails(t),
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkTopic)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkTopic)
+	}
+
+	if err := c.service.DeleteTopic(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteTopic)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+func generateTopic(p v1alpha1.BorkTopicParameters) backend.Topic {
+	return backend.Topic{
+		Partitions:     p.Partitions,
+		RetentionHours: p.RetentionHours,
+		Tags:           p.Tags,
+		RotateEvery:    ptr.Deref(p.RotateCredentialsEvery, 0),
+	}
+}
+
+func generateObservation(t backend.Topic) v1alpha1.BorkTopicObservation {
+	return v1alpha1.BorkTopicObservation{
+		Endpoint:             t.Endpoint,
+		Username:             t.Username,
+		Partitions:           t.Partitions,
+		RetentionHours:       t.RetentionHours,
+		Tags:                 t.Tags,
+		CredentialsVersion:   t.CredentialsVersion,
+		CredentialsRotatedAt: ptr.To(metav1.NewTime(t.RotatedAt)),
+		Revision:             t.Revision,
+	}
+}
+
+func connectionDetails(t backend.Topic) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ConnectionDetailEndpoint: []byte(t.Endpoint),
+		ConnectionDetailUsername: []byte(t.Username),
+		ConnectionDetailPassword: []byte(t.Password),
+		ConnectionDetailVersion:  []byte(strconv.FormatInt(t.CredentialsVersion, 10)),
+	}
+}
+
+// topicCompareOptions compare the fields of a topic that are under our
+// control. An empty map is equivalent to an omitted one.
+var topicCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.Topic{}, "Name", "Endpoint", "Username", "Password", "CredentialsVersion", "RotatedAt", "Revision"),
+	cmpopts.EquateEmpty(),
+}
+
+// diff returns a human-readable diff of the desired and observed topics, or
+// an empty string if the observed topic is up to date.
+func diff(desired, observed backend.Topic) string {
+	return cmp.Diff(desired, observed, topicCompareOptions...)
+}
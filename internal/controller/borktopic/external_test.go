@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borktopic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the topic the fake backend stores.
+const existingName = "topic-existing"
+
+// An eventRecorder records the reasons of the events it's asked to record.
+type eventRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+// newBorkTopic returns a BorkTopic with three partitions.
+func newBorkTopic() *v1alpha1.BorkTopic {
+	return &v1alpha1.BorkTopic{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec: v1alpha1.BorkTopicSpec{ForProvider: v1alpha1.BorkTopicParameters{
+			Partitions:     3,
+			RetentionHours: 24,
+		}},
+	}
+}
+
+// newExternal returns an external client of a fake backend storing the
+// supplied topic, and the topic as it was created.
+func newExternal(t *testing.T, topic backend.Topic) (*borkfake.Client, *external, *eventRecorder, backend.Topic) {
+	t.Helper()
+	f := borkfake.New()
+	topic.Name = existingName
+	created, err := f.Store.CreateTopic(context.Background(), topic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &eventRecorder{}
+	return f, &external{service: f.Client, record: r}, r, created
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o       managed.ExternalObservation
+		version string
+		reasons []event.Reason
+	}
+
+	cases := map[string]struct {
+		reason string
+		topic  backend.Topic
+		want   want
+	}{
+		"UpToDate": {
+			reason: "A topic that matches the spec is up to date, and its credentials are published.",
+			topic:  backend.Topic{Partitions: 3, RetentionHours: 24},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, version: "0"},
+		},
+		"Rotated": {
+			reason: "A topic whose password was rotated publishes its new credentials, and records an event.",
+			topic:  backend.Topic{Partitions: 3, RetentionHours: 24, RotateEvery: 1},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				version: "1",
+				reasons: []event.Reason{reasonCredentialsRotated},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkTopic()
+			meta.SetExternalName(cr, existingName)
+			if tc.topic.RotateEvery != 0 {
+				cr.Spec.ForProvider.RotateCredentialsEvery = ptr.To(tc.topic.RotateEvery)
+			}
+			_, e, r, created := newExternal(t, tc.topic)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff", "ConnectionDetails")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reasons, r.reasons); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want event reasons, +got event reasons:\n%s", tc.reason, diff)
+			}
+			if got := string(o.ConnectionDetails[ConnectionDetailVersion]); got != tc.want.version {
+				t.Errorf("\n%s\nObserve(...): got credentials version %q, want %q", tc.reason, got, tc.want.version)
+			}
+			if got := string(o.ConnectionDetails[ConnectionDetailEndpoint]); got != created.Endpoint {
+				t.Errorf("\n%s\nObserve(...): got endpoint %q, want %q", tc.reason, got, created.Endpoint)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cr := newBorkTopic()
+	f, e, _, _ := newExternal(t, backend.Topic{Partitions: 1})
+
+	c, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	topic, err := f.Store.GetTopic(context.Background(), meta.GetExternalName(cr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(connectionDetails(topic), c.ConnectionDetails); diff != "" {
+		t.Errorf("Create(...): a created topic's credentials are published: -want connection details, +got connection details:\n%s", diff)
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkuser
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/concurrency"
+	"github.com/crossplane/provider-bork/internal/drain"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/leak"
+	"github.com/crossplane/provider-bork/internal/metrics"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/quarantine"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/shard"
+	"github.com/crossplane/provider-bork/internal/subscription"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errNotBorkUser = "managed resource is not a BorkUser custom resource"
+
+	errResolvePC  = "cannot resolve provider config"
+	errGetUser    = "cannot get user"
+	errCreateUser = "cannot create user"
+	errUpdateUser = "cannot update user"
+	errDeleteUser = "cannot delete user"
+	errGenerate   = "cannot generate password"
+)
+
+// SetupGated adds a controller that reconciles BorkUser managed resources with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup BorkUser controller"))
+		}
+	}, v1alpha1.BorkUserGroupVersionKind)
+	return nil
+}
+
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BorkUserGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	retry := middleware.NewRetryAfter()
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(middleware.Chain(v1alpha1.BorkUserKind, o.Logger.WithValues("controller", name), recorder, mgr.GetClient(),
+			func() resource.ManagedList { return &v1alpha1.BorkUserList{} },
+			&connector{
+				kube: mgr.GetClient(),
+				pool: clients.DefaultPool,
+			},
+			middleware.WithRetryAfter(retry),
+			middleware.WithConnectionDetailTemplates(),
+		)),
+		// The backend assigns each user's external name when it is
+		// created.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(middleware.ReconcileTimeout),
+		managed.WithRecorder(recorder),
+	}
+
+	if o.MetricOptions != nil {
+		opts = append(opts, managed.WithMetricRecorder(o.MetricOptions.MRMetrics))
+	}
+
+	if o.MetricOptions != nil && o.MetricOptions.MRStateMetrics != nil {
+		stateMetricsRecorder := statemetrics.NewMRStateRecorder(
+			mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BorkUserList{}, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(stateMetricsRecorder); err != nil {
+			return errors.Wrap(err, "cannot register MR state metrics recorder for kind v1alpha1.BorkUserList")
+		}
+	}
+
+	if o.MetricOptions != nil {
+		pausedRecorder := metrics.NewPausedRecorder(
+			mgr.GetClient(), o.Logger, metrics.PausedResources, func() resource.ManagedList { return &v1alpha1.BorkUserList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(pausedRecorder); err != nil {
+			return errors.Wrap(err, "cannot register paused resource metrics recorder for kind v1alpha1.BorkUserList")
+		}
+
+		orphanRecorder := metrics.NewOrphanRecorder(
+			mgr.GetClient(), o.Logger, metrics.OrphanedResources, backend.DefaultTenants, backend.KindUser, func() resource.ManagedList { return &v1alpha1.BorkUserList{} }, o.MetricOptions.PollStateMetricInterval,
+		)
+		if err := mgr.Add(orphanRecorder); err != nil {
+			return errors.Wrap(err, "cannot register orphaned resource metrics recorder for kind v1alpha1.BorkUserList")
+		}
+	}
+
+	leak.Default.Register(backend.KindUser, func() resource.ManagedList { return &v1alpha1.BorkUserList{} })
+
+	r := features.Default.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BorkUserGroupVersionKind), o, opts...)
+
+	if err := middleware.SetupConflictIndex(context.Background(), mgr.GetFieldIndexer(), &v1alpha1.BorkUser{}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(v1alpha1.BorkUserKind, o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(shard.Default.Predicate()).
+		For(&v1alpha1.BorkUser{}).
+		WatchesRawSource(subscription.Default.Source(backend.KindUser, func() resource.ManagedList { return &v1alpha1.BorkUserList{} })).
+		Complete(shard.Default.Reconciler(drain.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkUserGroupVersionKind), quarantine.Default.Reconciler(mgr, resource.ManagedKind(v1alpha1.BorkUserGroupVersionKind), o.PollInterval, concurrency.Default.ProviderConfigReconciler(mgr, resource.ManagedKind(v1alpha1.BorkUserGroupVersionKind), concurrency.Default.Reconciler(v1alpha1.BorkUserKind, ratelimiter.NewReconciler(name, retry.Reconciler(tracing.NewReconciler(name, r)), o.GlobalRateLimiter)))))))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	pool *clients.Pool
+}
+
+// Connect produces an ExternalClient that reconciles users in the simulated
+// backend, generating their passwords per their provider config's password
+// policy.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, pc, err := clients.ResolveProviderConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolvePC)
+	}
+	svc, err := c.pool.Connect(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{service: svc, policy: pc.PasswordPolicy}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	service *backend.Client
+	policy  *apisv1alpha1.PasswordPolicy
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BorkUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBorkUser)
+	}
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	u, err := c.service.GetUser(ctx, name)
+	if backend.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetUser)
+	}
+	cr.Status.AtProvider.PasswordVersion = u.PasswordVersion
+
+	cr.Status.SetConditions(xpv1.Available().WithObservedGeneration(cr.GetGeneration()))
+
+	d := diff(generateUser(cr.Spec.ForProvider), u)
+	if rotationRequested(cr) {
+		d += fmt.Sprintf("password rotation requested for %q\n", cr.GetAnnotations()[v1alpha1.AnnotationKeyRotatePassword])
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  d == "",
+		Diff:              d,
+		ConnectionDetails: connectionDetails(u),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BorkUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBorkUser)
+	}
+
+	pw, err := generatePassword(c.policy)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerate)
+	}
+	u := generateUser(cr.Spec.ForProvider)
+	u.Password = pw
+	u, err = c.service.CreateUser(ctx, u)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUser)
+	}
+	meta.SetExternalName(cr, u.Name)
+
+	// A new user's password satisfies any rotation that was requested
+	// before it was created.
+	cr.Status.AtProvider.PasswordRotatedFor = cr.GetAnnotations()[v1alpha1.AnnotationKeyRotatePassword]
+
+	return managed.ExternalCreation{
+		ConnectionDetails: connectionDetails(u),
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BorkUser)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBorkUser)
+	}
+
+	u := generateUser(cr.Spec.ForProvider)
+	u.Name = meta.GetExternalName(cr)
+	rotate := rotationRequested(cr)
+	if rotate {
+		pw, err := generatePassword(c.policy)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenerate)
+		}
+		u.Password = pw
+	}
+	u, err := c.service.UpdateUser(ctx, u)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUser)
+	}
+	if rotate {
+		cr.Status.AtProvider.PasswordRotatedFor = cr.GetAnnotations()[v1alpha1.AnnotationKeyRotatePassword]
+	}
+	cr.Status.AtProvider.PasswordVersion = u.PasswordVersion
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: connectionDetails(u),
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BorkUser)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBorkUser)
+	}
+
+	if err := c.service.DeleteUser(ctx, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeleteUser)
+	}
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
+	return c.service.Close()
+}
+
+// userCompareOptions compare the fields of a user that are under our control.
+// The password is generated, and is only changed when it's rotated.
+var userCompareOptions = []cmp.Option{
+	cmpopts.IgnoreFields(backend.User{}, "Name", "Password", "PasswordVersion", "Revision"),
+}
+
+// diff returns a human-readable diff of the desired and observed users, or an
+// empty string if the observed user is up to date.
+func diff(desired, observed backend.User) string {
+	return cmp.Diff(desired, observed, userCompareOptions...)
+}
+
+// generateUser returns the backend user described by the supplied parameters.
+func generateUser(p v1alpha1.BorkUserParameters) backend.User {
+	return backend.User{
+		DisplayName: p.DisplayName,
+	}
+}
+
+// rotationRequested returns true if the supplied BorkUser's rotate-password
+// annotation is set to a value its password wasn't last rotated for.
+func rotationRequested(cr *v1alpha1.BorkUser) bool {
+	v := cr.GetAnnotations()[v1alpha1.AnnotationKeyRotatePassword]
+	return v != "" && v != cr.Status.AtProvider.PasswordRotatedFor
+}
+
+// connectionDetails returns the details a consumer needs to authenticate as
+// the supplied user.
+func connectionDetails(u backend.User) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		"username": []byte(u.Name),
+		"password": []byte(u.Password),
+		"version":  []byte(strconv.FormatInt(u.PasswordVersion, 10)),
+	}
+}
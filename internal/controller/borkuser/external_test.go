@@ -0,0 +1,224 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkuser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	borkfake "github.com/crossplane/provider-bork/pkg/borkclient/fake"
+)
+
+// existingName is the name of the user the fake backend stores.
+const existingName = "user-existing"
+
+// existingPassword is the password of the user the fake backend stores.
+const existingPassword = "bork-bork-bork"
+
+// newBorkUser returns a BorkUser named Bork.
+func newBorkUser() *v1alpha1.BorkUser {
+	return &v1alpha1.BorkUser{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bork"},
+		Spec:       v1alpha1.BorkUserSpec{ForProvider: v1alpha1.BorkUserParameters{DisplayName: "Bork"}},
+	}
+}
+
+// newExternal returns an external client of a fake backend storing a user
+// with the supplied display name, and generating passwords per the supplied
+// policy.
+func newExternal(t *testing.T, displayName string, policy *apisv1alpha1.PasswordPolicy) (*borkfake.Client, *external) {
+	t.Helper()
+	f := borkfake.New()
+	if _, err := f.Store.CreateUser(context.Background(), backend.User{Name: existingName, DisplayName: displayName, Password: existingPassword}); err != nil {
+		t.Fatal(err)
+	}
+	return f, &external{service: f.Client, policy: policy}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o    managed.ExternalObservation
+		diff bool
+	}
+
+	details := managed.ConnectionDetails{
+		"username": []byte(existingName),
+		"password": []byte(existingPassword),
+		"version":  []byte("1"),
+	}
+
+	cases := map[string]struct {
+		reason      string
+		displayName string
+		rotate      string
+		want        want
+	}{
+		"UpToDate": {
+			reason:      "A user that matches the spec is up to date, and its credentials are published.",
+			displayName: "Bork",
+			want:        want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details}},
+		},
+		"RotationRequested": {
+			reason:      "A user whose password rotation is requested is out of date.",
+			displayName: "Bork",
+			rotate:      "2025-06-01",
+			want:        want{o: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: details}, diff: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkUser()
+			meta.SetExternalName(cr, existingName)
+			if tc.rotate != "" {
+				meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyRotatePassword: tc.rotate})
+			}
+			_, e := newExternal(t, tc.displayName, nil)
+
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if got := o.Diff != ""; got != tc.want.diff {
+				t.Errorf("\n%s\nObserve(...): got diff %q, want a diff: %t", tc.reason, o.Diff, tc.want.diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		err            error
+		passwordLength int
+	}
+
+	cases := map[string]struct {
+		reason string
+		policy *apisv1alpha1.PasswordPolicy
+		want   want
+	}{
+		"Created": {
+			reason: "The user is created per the spec with a generated password, and its name set as the external name.",
+			want:   want{passwordLength: defaultLength},
+		},
+		"PolicyLength": {
+			reason: "The user's password is as long as the password policy requires.",
+			policy: &apisv1alpha1.PasswordPolicy{Length: 8},
+			want:   want{passwordLength: 8},
+		},
+		"PolicyTooShort": {
+			reason: "An error generating a password for the password policy is returned.",
+			policy: &apisv1alpha1.PasswordPolicy{Length: 2},
+			want:   want{err: errors.Wrap(errors.Errorf(errTooShortFmt, 2, len(defaultCharsets)), errGenerate)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkUser()
+			f, e := newExternal(t, "", tc.policy)
+
+			c, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			u, err := f.Store.GetUser(context.Background(), meta.GetExternalName(cr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := diff(generateUser(cr.Spec.ForProvider), u); d != "" {
+				t.Errorf("\n%s\nCreate(...): -want user, +got user:\n%s", tc.reason, d)
+			}
+			if len(u.Password) != tc.want.passwordLength {
+				t.Errorf("\n%s\nCreate(...): got password of %d characters, want %d", tc.reason, len(u.Password), tc.want.passwordLength)
+			}
+			if diff := cmp.Diff(connectionDetails(u), c.ConnectionDetails); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want connection details, +got connection details:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		displayName string
+		version     int64
+		rotatedFor  string
+	}
+
+	cases := map[string]struct {
+		reason string
+		rotate string
+		want   want
+	}{
+		"Updated": {
+			reason: "The user is updated per the spec, without changing its password.",
+			want:   want{displayName: "Bork", version: 1},
+		},
+		"Rotated": {
+			reason: "The user's password is rotated when rotation is requested.",
+			rotate: "2025-06-01",
+			want:   want{displayName: "Bork", version: 2, rotatedFor: "2025-06-01"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newBorkUser()
+			meta.SetExternalName(cr, existingName)
+			if tc.rotate != "" {
+				meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyRotatePassword: tc.rotate})
+			}
+			f, e := newExternal(t, "Woof", nil)
+
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\nUpdate(...): %v", tc.reason, err)
+			}
+			u, err := f.Store.GetUser(context.Background(), existingName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.DisplayName != tc.want.displayName {
+				t.Errorf("\n%s\nUpdate(...): got display name %q, want %q", tc.reason, u.DisplayName, tc.want.displayName)
+			}
+			if u.PasswordVersion != tc.want.version {
+				t.Errorf("\n%s\nUpdate(...): got password version %d, want %d", tc.reason, u.PasswordVersion, tc.want.version)
+			}
+			if got := cr.Status.AtProvider.PasswordRotatedFor; got != tc.want.rotatedFor {
+				t.Errorf("\n%s\nUpdate(...): got password rotated for %q, want %q", tc.reason, got, tc.want.rotatedFor)
+			}
+		})
+	}
+}
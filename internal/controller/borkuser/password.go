@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkuser
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+)
+
+const (
+	errUnknownCharsetFmt = "unknown password charset %q"
+	errTooShortFmt       = "passwords of %d characters can't contain a character of each of %d charsets"
+)
+
+// defaultLength is the length of passwords generated for provider configs
+// without a password policy, or whose policy doesn't set one.
+const defaultLength = 24
+
+// defaultCharsets are the charsets of passwords generated for provider
+// configs without a password policy, or whose policy doesn't set any.
+var defaultCharsets = []apisv1alpha1.PasswordCharset{
+	apisv1alpha1.PasswordCharsetLowercase,
+	apisv1alpha1.PasswordCharsetUppercase,
+	apisv1alpha1.PasswordCharsetDigits,
+}
+
+// charsets are the characters of each password charset. Symbols omits quotes,
+// backslashes and spaces, which consumers often fail to escape.
+var charsets = map[apisv1alpha1.PasswordCharset]string{
+	apisv1alpha1.PasswordCharsetLowercase: "abcdefghijklmnopqrstuvwxyz",
+	apisv1alpha1.PasswordCharsetUppercase: "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	apisv1alpha1.PasswordCharsetDigits:    "0123456789",
+	apisv1alpha1.PasswordCharsetSymbols:   "!#$%&*+-.:=?@^_~",
+}
+
+// generatePassword returns a random password that satisfies the supplied
+// policy, which may be nil. The password contains at least one character of
+// each of the policy's charsets, and its other characters are drawn from all
+// of them.
+func generatePassword(p *apisv1alpha1.PasswordPolicy) (string, error) {
+	length, sets := defaultLength, defaultCharsets
+	if p != nil && p.Length > 0 {
+		length = int(p.Length)
+	}
+	if p != nil && len(p.Charsets) > 0 {
+		sets = p.Charsets
+	}
+	if length < len(sets) {
+		return "", errors.Errorf(errTooShortFmt, length, len(sets))
+	}
+
+	all := &strings.Builder{}
+	pw := make([]byte, 0, length)
+	for _, cs := range sets {
+		chars, ok := charsets[cs]
+		if !ok {
+			return "", errors.Errorf(errUnknownCharsetFmt, cs)
+		}
+		all.WriteString(chars)
+		c, err := pick(chars)
+		if err != nil {
+			return "", err
+		}
+		pw = append(pw, c)
+	}
+	for len(pw) < length {
+		c, err := pick(all.String())
+		if err != nil {
+			return "", err
+		}
+		pw = append(pw, c)
+	}
+
+	// Shuffle the password, so that the characters picked from each charset
+	// aren't always at its start.
+	for i := len(pw) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		pw[i], pw[j.Int64()] = pw[j.Int64()], pw[i]
+	}
+	return string(pw), nil
+}
+
+// pick returns a random character of the supplied characters.
+func pick(chars string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+	return chars[i.Int64()], nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config contains the controllers that account for the managed
+// resources using each ProviderConfig and ClusterProviderConfig, block the
+// deletion of provider configs that are in use, and check that the backend
+// accepts their credentials.
+package config
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+// SetupGated adds the controllers that reconcile ProviderConfigs and
+// ClusterProviderConfigs with safe-start support.
+func SetupGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o); err != nil {
+			panic(errors.Wrap(err, "cannot setup ProviderConfig controllers"))
+		}
+	}, apisv1alpha1.ProviderConfigGroupVersionKind, apisv1alpha1.ClusterProviderConfigGroupVersionKind, apisv1alpha1.ProviderConfigUsageGroupVersionKind)
+	return nil
+}
+
+// Setup adds the controllers that reconcile ProviderConfigs and
+// ClusterProviderConfigs and check their health, and the sweeper that deletes
+// stale usages of them.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, apisv1alpha1.ProviderConfigGroupKind, &apisv1alpha1.ProviderConfig{}, apisv1alpha1.ProviderConfigKind); err != nil {
+		return err
+	}
+	if err := setup(mgr, o, apisv1alpha1.ClusterProviderConfigGroupKind, &apisv1alpha1.ClusterProviderConfig{}, apisv1alpha1.ClusterProviderConfigKind); err != nil {
+		return err
+	}
+	if err := setupHealth(mgr, o, apisv1alpha1.ProviderConfigGroupKind, func() client.Object { return &apisv1alpha1.ProviderConfig{} }); err != nil {
+		return err
+	}
+	if err := setupHealth(mgr, o, apisv1alpha1.ClusterProviderConfigGroupKind, func() client.Object { return &apisv1alpha1.ClusterProviderConfig{} }); err != nil {
+		return err
+	}
+	return errors.Wrap(mgr.Add(NewUsageSweeper(mgr.GetAPIReader(), mgr.GetClient(), o.Logger, DefaultUsageSweepInterval)), "cannot register ProviderConfigUsage sweeper")
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gk string, of client.Object, kind string) error {
+	name := providerconfig.ControllerName(gk)
+
+	r := providerconfig.NewReconciler(mgr, resource.ProviderConfigKinds{
+		Config:    apisv1alpha1.SchemeGroupVersion.WithKind(kind),
+		Usage:     apisv1alpha1.ProviderConfigUsageGroupVersionKind,
+		UsageList: apisv1alpha1.ProviderConfigUsageListGroupVersionKind,
+	},
+		providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
+		providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(kind, o)).
+		For(of).
+		Watches(&apisv1alpha1.ProviderConfigUsage{}, handler.EnqueueRequestsFromMapFunc(enqueueProviderConfig(kind))).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+// enqueueProviderConfig returns a function that maps a ProviderConfigUsage to
+// a request to reconcile the provider config of the supplied kind it uses, if
+// any. A ProviderConfig is in the namespace of the managed resource, and so of
+// its usage. A ClusterProviderConfig has no namespace.
+func enqueueProviderConfig(kind string) handler.MapFunc {
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		pcu, ok := o.(*apisv1alpha1.ProviderConfigUsage)
+		if !ok || pcu.ProviderConfigReference.Kind != kind {
+			return nil
+		}
+		nn := types.NamespacedName{Name: pcu.ProviderConfigReference.Name}
+		if kind == apisv1alpha1.ProviderConfigKind {
+			nn.Namespace = pcu.GetNamespace()
+		}
+		return []reconcile.Request{{NamespacedName: nn}}
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+const (
+	errGetConfig          = "cannot get provider config"
+	errUpdateConfigStatus = "cannot update provider config status"
+)
+
+// TypeHealthy provider configs have credentials that the backend accepts.
+const TypeHealthy xpv1.ConditionType = "Healthy"
+
+// Reasons a provider config is or is not healthy.
+const (
+	ReasonHealthy   xpv1.ConditionReason = "Healthy"
+	ReasonUnhealthy xpv1.ConditionReason = "Unhealthy"
+)
+
+// Defaults for checking the health of provider configs.
+const (
+	DefaultHealthCheckInterval = 5 * time.Minute
+	DefaultHealthCheckTimeout  = clients.DefaultProbeTimeout
+)
+
+// Healthy returns a condition that indicates the backend accepted the
+// provider config's credentials.
+func Healthy() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHealthy,
+	}
+}
+
+// Unhealthy returns a condition that indicates the backend couldn't be reached
+// or rejected the provider config's credentials.
+func Unhealthy(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnhealthy,
+		Message:            err.Error(),
+	}
+}
+
+// A HealthChecker validates the credentials of a kind of provider config. It
+// connects to the backend each provider config configures when the provider
+// config is created or its spec changes, then every interval, and reports
+// whether it's healthy and who the backend authenticated the provider as in
+// its status. A provider config whose credentials are bad is thus visible
+// before any managed resource using it fails.
+type HealthChecker struct {
+	client    client.Client
+	tenants   *backend.Tenants
+	newConfig func() client.Object
+	log       logging.Logger
+	record    event.Recorder
+	interval  time.Duration
+	timeout   time.Duration
+}
+
+// NewHealthChecker returns a checker of the kind of provider config returned
+// by the supplied function. Provider configs that don't specify an endpoint
+// use the store of the supplied tenants that their namespace belongs to.
+func NewHealthChecker(c client.Client, tenants *backend.Tenants, newConfig func() client.Object, log logging.Logger, rec event.Recorder, interval, timeout time.Duration) *HealthChecker {
+	return &HealthChecker{
+		client:    c,
+		tenants:   tenants,
+		newConfig: newConfig,
+		log:       log,
+		record:    rec,
+		interval:  interval,
+		timeout:   timeout,
+	}
+}
+
+// Reconcile checks the health of the requested provider config.
+func (h *HealthChecker) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	pc := h.newConfig()
+	if err := h.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetConfig)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+	spec, status := specAndStatus(pc)
+	wasHealthy := status.GetCondition(TypeHealthy).Status == corev1.ConditionTrue
+
+	cctx, cancel := context.WithTimeout(ctx, h.timeout)
+	id, err := clients.Identify(cctx, h.client, h.tenants.For(pc.GetNamespace()), spec)
+	cancel()
+
+	now := metav1.Now()
+	status.LastHealthCheckTime = &now
+	status.Identity = id
+	if err != nil {
+		status.SetConditions(Unhealthy(err))
+		h.record.Event(pc, event.Warning(event.Reason(ReasonUnhealthy), err))
+		h.log.Debug("Provider config is unhealthy", "namespace", pc.GetNamespace(), "name", pc.GetName(), "error", err)
+	} else {
+		status.SetConditions(Healthy())
+		if !wasHealthy {
+			h.record.Event(pc, event.Normal(event.Reason(ReasonHealthy), "Backend accepted credentials", "account-id", id.AccountID))
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: h.interval}, errors.Wrap(h.client.Status().Update(ctx, pc), errUpdateConfigStatus)
+}
+
+// specAndStatus returns the spec and status of the supplied ProviderConfig or
+// ClusterProviderConfig.
+func specAndStatus(pc client.Object) (*apisv1alpha1.ProviderConfigSpec, *apisv1alpha1.ProviderConfigStatus) {
+	switch pc := pc.(type) {
+	case *apisv1alpha1.ProviderConfig:
+		return &pc.Spec, &pc.Status
+	case *apisv1alpha1.ClusterProviderConfig:
+		return &pc.Spec, &pc.Status
+	}
+	return &apisv1alpha1.ProviderConfigSpec{}, &apisv1alpha1.ProviderConfigStatus{}
+}
+
+func setupHealth(mgr ctrl.Manager, o controller.Options, gk string, newConfig func() client.Object) error {
+	name := "providerconfighealth/" + gk
+
+	h := NewHealthChecker(mgr.GetClient(), backend.DefaultTenants, newConfig, o.Logger.WithValues("controller", name),
+		event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), DefaultHealthCheckInterval, DefaultHealthCheckTimeout)
+
+	// Only changes to the spec are checked immediately. The status is
+	// written by this controller and the one that accounts for usages.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.Default.ForControllerRuntime(schema.ParseGroupKind(gk).Kind, o)).
+		For(newConfig(), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, h), o.GlobalRateLimiter))
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+)
+
+const (
+	errListPCUs  = "cannot list ProviderConfigUsages"
+	errGetMR     = "cannot get managed resource"
+	errDeletePCU = "cannot delete ProviderConfigUsage"
+)
+
+// DefaultUsageSweepInterval is how often stale ProviderConfigUsages are
+// swept.
+const DefaultUsageSweepInterval = 10 * time.Minute
+
+// A UsageSweeper periodically deletes ProviderConfigUsages whose managed
+// resource no longer exists. Usages are usually garbage collected with their
+// managed resource, which controls them, but a usage can outlive its managed
+// resource if it was restored from a backup, or if the managed resource was
+// deleted and recreated with the same name. A stale usage blocks the
+// deletion of its provider config forever.
+type UsageSweeper struct {
+	reader   client.Reader
+	client   client.Client
+	log      logging.Logger
+	interval time.Duration
+}
+
+// NewUsageSweeper returns a sweeper that deletes stale ProviderConfigUsages
+// every interval. Managed resources are read using the supplied reader,
+// which should read from the API server rather than a cache, so that a
+// managed resource that was just created isn't mistaken for one that doesn't
+// exist.
+func NewUsageSweeper(r client.Reader, c client.Client, log logging.Logger, interval time.Duration) *UsageSweeper {
+	return &UsageSweeper{
+		reader:   r,
+		client:   c,
+		log:      log,
+		interval: interval,
+	}
+}
+
+// Sweep deletes every stale ProviderConfigUsage.
+func (s *UsageSweeper) Sweep(ctx context.Context) error {
+	l := &apisv1alpha1.ProviderConfigUsageList{}
+	if err := s.client.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListPCUs)
+	}
+
+	for i := range l.Items {
+		pcu := &l.Items[i]
+		stale, err := s.stale(ctx, pcu)
+		if err != nil {
+			return err
+		}
+		if !stale {
+			continue
+		}
+		if err := s.client.Delete(ctx, pcu); resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errDeletePCU)
+		}
+		s.log.Debug("Deleted stale ProviderConfigUsage", "namespace", pcu.GetNamespace(), "name", pcu.GetName())
+	}
+	return nil
+}
+
+// stale returns true if the managed resource that the supplied usage
+// references doesn't exist. Usages are named for the UID of their managed
+// resource, so a managed resource with the same name but a different UID is
+// a different resource.
+func (s *UsageSweeper) stale(ctx context.Context, pcu *apisv1alpha1.ProviderConfigUsage) (bool, error) {
+	ref := pcu.ResourceReference
+	mg := &metav1.PartialObjectMetadata{}
+	mg.SetGroupVersionKind(ref.GroupVersionKind())
+	err := s.reader.Get(ctx, types.NamespacedName{Namespace: pcu.GetNamespace(), Name: ref.Name}, mg)
+	if kerrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetMR)
+	}
+	return string(mg.GetUID()) != pcu.GetName(), nil
+}
+
+// Start sweeps stale usages every interval, until the supplied context is
+// done. Failures to sweep are logged, and retried at the next interval.
+func (s *UsageSweeper) Start(ctx context.Context) error {
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := s.Sweep(ctx); err != nil {
+				s.log.Debug("Cannot sweep stale ProviderConfigUsages", "error", err)
+			}
+		}
+	}
+}
@@ -20,16 +20,93 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/activation"
+	"github.com/crossplane/provider-bork/internal/controller/borkaccesspolicy"
+	"github.com/crossplane/provider-bork/internal/controller/borkbucket"
+	"github.com/crossplane/provider-bork/internal/controller/borkcertificate"
+	"github.com/crossplane/provider-bork/internal/controller/borkcostexport"
+	"github.com/crossplane/provider-bork/internal/controller/borkdatabase"
+	"github.com/crossplane/provider-bork/internal/controller/borkfleet"
+	"github.com/crossplane/provider-bork/internal/controller/borkkey"
+	"github.com/crossplane/provider-bork/internal/controller/borklink"
+	"github.com/crossplane/provider-bork/internal/controller/borkobject"
+	"github.com/crossplane/provider-bork/internal/controller/borkobjecttemplate"
+	"github.com/crossplane/provider-bork/internal/controller/borkplacementpolicy"
+	"github.com/crossplane/provider-bork/internal/controller/borkqueue"
+	"github.com/crossplane/provider-bork/internal/controller/borkregion"
 	"github.com/crossplane/provider-bork/internal/controller/borkresource"
+	"github.com/crossplane/provider-bork/internal/controller/borkschedule"
+	"github.com/crossplane/provider-bork/internal/controller/borkserviceendpoint"
+	"github.com/crossplane/provider-bork/internal/controller/borkthrottleplan"
+	"github.com/crossplane/provider-bork/internal/controller/borktopic"
+	"github.com/crossplane/provider-bork/internal/controller/borkuser"
+	"github.com/crossplane/provider-bork/internal/controller/config"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/quarantine"
 )
 
+// controllers of each kind that can be disabled, in the order they're set up.
+// The controllers of experimental kinds are only set up if
+// features.EnableAlphaExperimentalKinds is enabled.
+var controllers = []struct {
+	kind         string
+	setup        func(ctrl.Manager, controller.Options) error
+	experimental bool
+}{
+	{kind: v1alpha1.BorkResourceKind, setup: borkresource.SetupGated},
+	{kind: v1alpha1.BorkPlacementPolicyKind, setup: borkplacementpolicy.SetupGated},
+	{kind: v1alpha1.BorkBucketKind, setup: borkbucket.SetupGated},
+	{kind: v1alpha1.BorkThrottlePlanKind, setup: borkthrottleplan.SetupGated},
+	{kind: v1alpha1.BorkKeyKind, setup: borkkey.SetupGated},
+	{kind: v1alpha1.BorkObjectKind, setup: borkobject.SetupGated},
+	{kind: v1alpha1.BorkRegionKind, setup: borkregion.SetupGated},
+	{kind: v1alpha1.BorkCostExportKind, setup: borkcostexport.SetupGated},
+	{kind: v1alpha1.BorkServiceEndpointKind, setup: borkserviceendpoint.SetupGated},
+	{kind: v1alpha1.BorkQueueKind, setup: borkqueue.SetupGated, experimental: true},
+	{kind: v1alpha1.BorkDatabaseKind, setup: borkdatabase.SetupGated},
+	{kind: v1alpha1.BorkCertificateKind, setup: borkcertificate.SetupGated},
+	{kind: v1alpha1.BorkTopicKind, setup: borktopic.SetupGated, experimental: true},
+	{kind: v1alpha1.BorkAccessPolicyKind, setup: borkaccesspolicy.SetupGated},
+	{kind: v1alpha1.BorkObjectTemplateKind, setup: borkobjecttemplate.SetupGated},
+	{kind: v1alpha1.BorkScheduleKind, setup: borkschedule.SetupGated},
+	{kind: v1alpha1.BorkFleetKind, setup: borkfleet.SetupGated},
+	{kind: v1alpha1.BorkUserKind, setup: borkuser.SetupGated},
+	{kind: v1alpha1.BorkLinkKind, setup: borklink.SetupGated},
+}
+
+// Kinds returns the kinds whose controllers can be disabled.
+func Kinds() []string {
+	kinds := make([]string, len(controllers))
+	for i, c := range controllers {
+		kinds[i] = c.kind
+	}
+	return kinds
+}
+
 // SetupGated creates all Bork controllers with safe-start support and adds them to
-// the supplied manager.
+// the supplied manager. The controllers of kinds the default quarantine
+// disables, and of experimental kinds unless they're enabled, aren't created.
+// The state of each controller is tracked by the default activation
+// controllers.
 func SetupGated(mgr ctrl.Manager, o controller.Options) error {
-	for _, setup := range []func(ctrl.Manager, controller.Options) error{
-		borkresource.SetupGated,
-	} {
-		if err := setup(mgr, o); err != nil {
+	if err := config.SetupGated(mgr, o); err != nil {
+		return err
+	}
+	for _, c := range controllers {
+		if quarantine.Default.Disabled(c.kind) {
+			o.Logger.Info("Controller disabled", "kind", c.kind)
+			activation.Default.Set(c.kind, activation.StateDisabled)
+			continue
+		}
+		if c.experimental && !o.Features.Enabled(features.EnableAlphaExperimentalKinds) {
+			o.Logger.Info("Experimental controller not enabled", "kind", c.kind, "flag", features.EnableAlphaExperimentalKinds)
+			activation.Default.Set(c.kind, activation.StateExperimental)
+			continue
+		}
+		co := o
+		co.Gate = activation.Default.Gate(c.kind, o.Gate)
+		if err := c.setup(mgr, co); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,231 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decisions records the decisions the provider makes about managed
+// resources that ask to be traced: what each reconcile observed, whether it
+// found the external resource up to date, and what it did about it, so that
+// why a resource was or wasn't updated can be answered without reading logs.
+package decisions
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// DefaultCycles is how many reconciles a resource traces unless it says
+// otherwise.
+const DefaultCycles = 5
+
+// MaxCycles is the most reconciles a resource may trace at once.
+const MaxCycles = 100
+
+// An Action is what a reconcile did to an external resource once it had
+// observed it.
+type Action string
+
+// Actions.
+const (
+	ActionNone   Action = "None"
+	ActionCreate Action = "Create"
+	ActionUpdate Action = "Update"
+	ActionDelete Action = "Delete"
+)
+
+// A Resource is a traced managed resource.
+type Resource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+// An Observation is what a reconcile observed of an external resource.
+type Observation struct {
+	// Exists is true if the external resource exists.
+	Exists bool `json:"exists"`
+
+	// UpToDate is true if the external resource is up to date with the
+	// managed resource's desired state.
+	UpToDate bool `json:"upToDate"`
+
+	// Diff between the managed resource's desired state and the external
+	// resource's observed state, if it isn't up to date.
+	Diff string `json:"diff,omitempty"`
+}
+
+// An Error is an error returned by an operation on an external resource.
+type Error struct {
+	// Operation that returned the error, e.g. Observe.
+	Operation string `json:"operation"`
+
+	// Code classifies the error, e.g. Throttled.
+	Code string `json:"code"`
+
+	// Message of the error.
+	Message string `json:"message"`
+}
+
+// A Cycle is one traced reconcile of a managed resource.
+type Cycle struct {
+	// Time at which the reconcile connected to the external resource's API.
+	Time time.Time `json:"time"`
+
+	// Latency of the reconcile, from connecting to disconnecting.
+	Latency time.Duration `json:"latency"`
+
+	// Generation and ResourceVersion of the managed resource the reconcile
+	// started from.
+	Generation      int64  `json:"generation"`
+	ResourceVersion string `json:"resourceVersion"`
+
+	// ManagementPolicies of the managed resource, which determine which
+	// actions the reconcile may take.
+	ManagementPolicies []string `json:"managementPolicies,omitempty"`
+
+	// Deleting is true if the managed resource was being deleted.
+	Deleting bool `json:"deleting,omitempty"`
+
+	// DryRun is true if the managed resource is a dry run, whose actions
+	// are only planned.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Observation the reconcile made, unless observing failed.
+	Observation *Observation `json:"observation,omitempty"`
+
+	// Action the reconcile took after observing.
+	Action Action `json:"action"`
+
+	// Error returned by the operation that failed, if any.
+	Error *Error `json:"error,omitempty"`
+
+	// Conditions of the managed resource once the reconcile finished.
+	Conditions []xpv1.Condition `json:"conditions,omitempty"`
+}
+
+// A Trace is the traced reconciles of a managed resource.
+type Trace struct {
+	Resource Resource `json:"resource"`
+
+	// Started is when the resource asked to be traced.
+	Started time.Time `json:"started"`
+
+	// Remaining is how many more reconciles will be traced.
+	Remaining int `json:"remaining"`
+
+	// Cycles that were traced, oldest first.
+	Cycles []Cycle `json:"cycles"`
+}
+
+// A trace is being recorded while the resource asks to be traced. One that
+// is no longer asked for keeps its cycles until it's asked for again.
+type trace struct {
+	Trace
+	active bool
+}
+
+// A Recorder records the traces of managed resources.
+type Recorder struct {
+	mu     sync.Mutex
+	traces map[string]*trace
+}
+
+// Default records the traces of every controller's managed resources.
+var Default = NewRecorder()
+
+// NewRecorder returns a recorder that has recorded no traces.
+func NewRecorder() *Recorder {
+	return &Recorder{traces: make(map[string]*trace)}
+}
+
+func key(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// Tracing returns true if the supplied resource, which asks to be traced for
+// the supplied number of reconciles, should trace the reconcile that's
+// starting. A resource that wasn't already asking to be traced starts a new
+// trace, replacing any it traced before.
+func (r *Recorder) Tracing(res Resource, cycles int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := key(res.Kind, res.Namespace, res.Name)
+	t, ok := r.traces[k]
+	if !ok || !t.active {
+		t = &trace{Trace: Trace{Resource: res, Started: time.Now().UTC(), Remaining: min(max(cycles, 1), MaxCycles)}, active: true}
+		r.traces[k] = t
+	}
+	return t.Remaining > 0
+}
+
+// Stop stops tracing the resource of the supplied kind, namespace and name,
+// which no longer asks to be traced. Its trace is kept until it asks again.
+func (r *Recorder) Stop(kind, namespace, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.traces[key(kind, namespace, name)]; ok {
+		t.active = false
+		t.Remaining = 0
+	}
+}
+
+// Record records a traced reconcile of the supplied resource.
+func (r *Recorder) Record(res Resource, c Cycle) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.traces[key(res.Kind, res.Namespace, res.Name)]
+	if !ok || t.Remaining <= 0 {
+		return
+	}
+	t.Resource = res
+	t.Cycles = append(t.Cycles, c)
+	t.Remaining--
+}
+
+// Get returns the trace of the resource of the supplied kind, namespace and
+// name, if it has one.
+func (r *Recorder) Get(kind, namespace, name string) (Trace, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.traces[key(kind, namespace, name)]
+	if !ok {
+		return Trace{}, false
+	}
+	return t.copy(), true
+}
+
+// List returns every trace, sorted by kind, namespace and name.
+func (r *Recorder) List() []Trace {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Trace, 0, len(r.traces))
+	for _, t := range r.traces {
+		out = append(out, t.copy())
+	}
+	slices.SortFunc(out, func(a, b Trace) int {
+		return strings.Compare(key(a.Resource.Kind, a.Resource.Namespace, a.Resource.Name), key(b.Resource.Kind, b.Resource.Namespace, b.Resource.Name))
+	})
+	return out
+}
+
+func (t *trace) copy() Trace {
+	cp := t.Trace
+	cp.Cycles = slices.Clone(t.Cycles)
+	return cp
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// A step is a call a controller makes to a recorder.
+type step struct {
+	// Op is Tracing, Record or Stop.
+	Op     string
+	Res    Resource
+	Cycles int
+	Action Action
+}
+
+func TestRecorder(t *testing.T) {
+	doh := Resource{Kind: "BorkResource", Namespace: "default", Name: "doh", UID: "1"}
+	bork := Resource{Kind: "BorkResource", Namespace: "default", Name: "bork", UID: "2"}
+
+	// reconcile is a traced reconcile of the supplied resource, which asks
+	// to be traced for the supplied number of reconciles.
+	reconcile := func(res Resource, cycles int, a Action) []step {
+		return []step{{Op: "Tracing", Res: res, Cycles: cycles}, {Op: "Record", Res: res, Action: a}}
+	}
+	steps := func(s ...[]step) []step {
+		var out []step
+		for _, ss := range s {
+			out = append(out, ss...)
+		}
+		return out
+	}
+
+	type want struct {
+		tracing []bool
+		traces  []Trace
+	}
+
+	cases := map[string]struct {
+		reason string
+		steps  []step
+		want   want
+	}{
+		"NotTraced": {
+			reason: "Reconciles of a resource that never asked to be traced shouldn't be recorded.",
+			steps:  []step{{Op: "Record", Res: doh, Action: ActionCreate}},
+			want:   want{traces: []Trace{}},
+		},
+		"Cycles": {
+			reason: "A resource should trace as many reconciles as it asks to, then stop.",
+			steps: steps(
+				reconcile(doh, 2, ActionCreate),
+				reconcile(doh, 2, ActionNone),
+				reconcile(doh, 2, ActionUpdate),
+			),
+			want: want{
+				tracing: []bool{true, true, false},
+				traces: []Trace{{
+					Resource: doh,
+					Cycles:   []Cycle{{Action: ActionCreate}, {Action: ActionNone}},
+				}},
+			},
+		},
+		"TooFewCycles": {
+			reason: "A resource should trace at least one reconcile.",
+			steps:  []step{{Op: "Tracing", Res: doh, Cycles: 0}},
+			want: want{
+				tracing: []bool{true},
+				traces:  []Trace{{Resource: doh, Remaining: 1}},
+			},
+		},
+		"TooManyCycles": {
+			reason: "A resource should trace at most MaxCycles reconciles.",
+			steps:  []step{{Op: "Tracing", Res: doh, Cycles: MaxCycles + 1}},
+			want: want{
+				tracing: []bool{true},
+				traces:  []Trace{{Resource: doh, Remaining: MaxCycles}},
+			},
+		},
+		"Continued": {
+			reason: "A resource that keeps asking to be traced should continue its trace, not start a new one.",
+			steps: steps(
+				reconcile(doh, 3, ActionCreate),
+				reconcile(doh, 10, ActionNone),
+			),
+			want: want{
+				tracing: []bool{true, true},
+				traces: []Trace{{
+					Resource:  doh,
+					Remaining: 1,
+					Cycles:    []Cycle{{Action: ActionCreate}, {Action: ActionNone}},
+				}},
+			},
+		},
+		"Stopped": {
+			reason: "A resource that stops asking to be traced should keep its trace, but not record more reconciles.",
+			steps: steps(
+				reconcile(doh, 3, ActionCreate),
+				[]step{{Op: "Stop", Res: doh}, {Op: "Record", Res: doh, Action: ActionUpdate}},
+			),
+			want: want{
+				tracing: []bool{true},
+				traces: []Trace{{
+					Resource: doh,
+					Cycles:   []Cycle{{Action: ActionCreate}},
+				}},
+			},
+		},
+		"Restarted": {
+			reason: "A resource that asks to be traced again should start a new trace, replacing its old one.",
+			steps: steps(
+				reconcile(doh, 3, ActionCreate),
+				[]step{{Op: "Stop", Res: doh}},
+				reconcile(doh, 2, ActionUpdate),
+			),
+			want: want{
+				tracing: []bool{true, true},
+				traces: []Trace{{
+					Resource:  doh,
+					Remaining: 1,
+					Cycles:    []Cycle{{Action: ActionUpdate}},
+				}},
+			},
+		},
+		"Sorted": {
+			reason: "Traces should be listed by kind, namespace and name.",
+			steps: steps(
+				reconcile(doh, 1, ActionCreate),
+				reconcile(bork, 1, ActionDelete),
+			),
+			want: want{
+				tracing: []bool{true, true},
+				traces: []Trace{
+					{Resource: bork, Cycles: []Cycle{{Action: ActionDelete}}},
+					{Resource: doh, Cycles: []Cycle{{Action: ActionCreate}}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewRecorder()
+			var tracing []bool
+			for _, s := range tc.steps {
+				switch s.Op {
+				case "Tracing":
+					tracing = append(tracing, r.Tracing(s.Res, s.Cycles))
+				case "Record":
+					r.Record(s.Res, Cycle{Action: s.Action})
+				case "Stop":
+					r.Stop(s.Res.Kind, s.Res.Namespace, s.Res.Name)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.tracing, tracing); diff != "" {
+				t.Errorf("\n%s\nTracing(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.traces, r.List(), cmpopts.IgnoreFields(Trace{}, "Started")); diff != "" {
+				t.Errorf("\n%s\nList(): -want, +got:\n%s", tc.reason, diff)
+			}
+			for _, want := range tc.want.traces {
+				got, ok := r.Get(want.Resource.Kind, want.Resource.Namespace, want.Resource.Name)
+				if !ok {
+					t.Errorf("\n%s\nGet(...): got no trace of %s, want one", tc.reason, want.Resource.Name)
+				}
+				if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(Trace{}, "Started")); diff != "" {
+					t.Errorf("\n%s\nGet(...): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drain drains the provider when it's asked to shut down, so that an
+// upgrade can be tested without leaving deletes half done. A draining
+// provider stops starting new work, finishes its in-flight reconciles and,
+// optionally, flushes pending deletes to the backend before it exits.
+package drain
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/shard"
+)
+
+const (
+	errNewManagedFmt = "cannot create managed resource of kind %s"
+	errNewListFmt    = "cannot create managed resource list of kind %s"
+	errGetManaged    = "cannot get managed resource"
+	errListManaged   = "cannot list managed resources"
+	errWaitDelete    = "cannot wait to flush pending delete"
+)
+
+// Defaults for draining the provider.
+const (
+	DefaultTimeout    = 30 * time.Second
+	DefaultDeleteRate = 10
+)
+
+// pollInterval is how often a draining provider checks whether its pending
+// deletes have been flushed.
+const pollInterval = time.Second
+
+// Default drains the provider. It doesn't flush pending deletes until it's
+// set up.
+var Default = &Drainer{log: logging.NewNopLogger()}
+
+// A Drainer drains the provider's controllers when it's asked to shut down.
+type Drainer struct {
+	draining atomic.Bool
+
+	mu      sync.RWMutex
+	kube    client.Client
+	log     logging.Logger
+	flush   bool
+	timeout time.Duration
+	limiter *rate.Limiter
+	kinds   []resource.ManagedKind
+}
+
+// Setup configures the drainer. A drainer that flushes pending deletes keeps
+// reconciling managed resources that are being deleted, at most deleteRate
+// per second, until none remain or the timeout passes.
+func (d *Drainer) Setup(mgr ctrl.Manager, log logging.Logger, flush bool, deleteRate float64, timeout time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.kube = mgr.GetClient()
+	d.log = log.WithValues("controller", "drain")
+	d.flush = flush
+	d.timeout = timeout
+	d.limiter = rate.NewLimiter(rate.Limit(deleteRate), 1)
+}
+
+// Drain returns a context that's done once the provider has drained after
+// the supplied context is done, for example because the provider received
+// SIGTERM. The provider starts no new reconciles once the supplied context
+// is done. If the drainer flushes pending deletes the returned context is
+// done once no managed resource this replica reconciles is being deleted, or
+// once the drainer's timeout has passed. Otherwise it's done immediately, and
+// the controller manager finishes the reconciles in flight as it stops.
+func (d *Drainer) Drain(ctx context.Context) context.Context {
+	drained, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	go func() {
+		defer cancel()
+		<-ctx.Done()
+		d.draining.Store(true)
+
+		d.mu.RLock()
+		flush, timeout, log := d.flush, d.timeout, d.log
+		d.mu.RUnlock()
+		log.Info("Draining", "flush-deletes", flush, "timeout", timeout)
+		if !flush {
+			return
+		}
+
+		deadline := time.NewTimer(timeout)
+		defer deadline.Stop()
+		t := time.NewTicker(pollInterval)
+		defer t.Stop()
+		for {
+			n, err := d.pending(drained)
+			switch {
+			case err != nil:
+				log.Info("Cannot count pending deletes", "error", err)
+			case n == 0:
+				log.Info("Flushed pending deletes")
+				return
+			}
+			select {
+			case <-deadline.C:
+				log.Info("Timed out flushing pending deletes", "pending", n)
+				return
+			case <-t.C:
+			}
+		}
+	}()
+	return drained
+}
+
+// Reconciler wraps the supplied managed reconciler such that it starts no
+// new reconciles once the drainer is draining, except of managed resources
+// that are being deleted if the drainer flushes pending deletes. Those are
+// rate limited, so that flushing doesn't overwhelm the backend.
+func (d *Drainer) Reconciler(mgr ctrl.Manager, of resource.ManagedKind, r reconcile.Reconciler) reconcile.Reconciler {
+	d.mu.Lock()
+	d.kinds = append(d.kinds, of)
+	d.mu.Unlock()
+
+	kube := mgr.GetClient()
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if !d.draining.Load() {
+			return r.Reconcile(ctx, req)
+		}
+		d.mu.RLock()
+		flush, limiter := d.flush, d.limiter
+		d.mu.RUnlock()
+		if !flush {
+			return reconcile.Result{}, nil
+		}
+
+		obj, err := mgr.GetScheme().New(schema.GroupVersionKind(of))
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, errNewManagedFmt, of)
+		}
+		mg, ok := obj.(resource.Managed)
+		if !ok {
+			return reconcile.Result{}, errors.Errorf(errNewManagedFmt, of)
+		}
+		if err := kube.Get(ctx, req.NamespacedName, mg); err != nil {
+			if kerrors.IsNotFound(err) {
+				return reconcile.Result{}, nil
+			}
+			return reconcile.Result{}, errors.Wrap(err, errGetManaged)
+		}
+		if !meta.WasDeleted(mg) {
+			return reconcile.Result{}, nil
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errWaitDelete)
+		}
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// pending returns how many managed resources that this replica reconciles
+// are being deleted. Paused managed resources aren't counted, because they
+// won't be reconciled however long the drainer waits.
+func (d *Drainer) pending(ctx context.Context) (int, error) {
+	d.mu.RLock()
+	kube, kinds := d.kube, d.kinds
+	d.mu.RUnlock()
+
+	n := 0
+	for _, of := range kinds {
+		gvk := schema.GroupVersionKind(of)
+		gvk.Kind += "List"
+		obj, err := kube.Scheme().New(gvk)
+		if err != nil {
+			return 0, errors.Wrapf(err, errNewListFmt, of)
+		}
+		l, ok := obj.(resource.ManagedList)
+		if !ok {
+			return 0, errors.Errorf(errNewListFmt, of)
+		}
+		if err := kube.List(ctx, l); err != nil {
+			return 0, errors.Wrap(err, errListManaged)
+		}
+		for _, mg := range l.GetItems() {
+			if meta.WasDeleted(mg) && !meta.IsPaused(mg) && shard.Default.Owns(mg.GetNamespace(), mg.GetName()) {
+				n++
+			}
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,22 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package e2e tests the golden path of the provider end to end: managed
+// resources are reconciled by its controllers in a real API server, started
+// by envtest, against the in-memory backend. The tests are skipped unless
+// KUBEBUILDER_ASSETS names a directory containing the etcd and kube-apiserver
+// binaries, e.g. as set up by setup-envtest.
+package e2e
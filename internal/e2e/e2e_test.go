@@ -0,0 +1,232 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/controller/borkresource"
+	"github.com/crossplane/provider-bork/internal/features"
+	"github.com/crossplane/provider-bork/internal/middleware"
+	"github.com/crossplane/provider-bork/internal/ratelimit"
+	"github.com/crossplane/provider-bork/internal/subscription"
+)
+
+// How long to wait for, and how often to check, the state the tests expect.
+const (
+	timeout  = 30 * time.Second
+	interval = 250 * time.Millisecond
+)
+
+// start starts an API server with the provider's CRDs, and the provider's
+// BorkResource controller and backend subscriptions. It returns a client of
+// the API server. Everything is stopped when the test ends.
+func start(t *testing.T) client.Client {
+	t.Helper()
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is unset; set it to a directory containing etcd and kube-apiserver to run end to end tests")
+	}
+
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "package", "crds")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("cannot start envtest: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Errorf("cannot stop envtest: %v", err)
+		}
+	})
+
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:                 s,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	if err != nil {
+		t.Fatalf("cannot create manager: %v", err)
+	}
+
+	log := logging.NewNopLogger()
+	rl, err := ratelimit.NewGlobal(100, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := controller.Options{
+		Logger:                  log,
+		MaxConcurrentReconciles: 1,
+		PollInterval:            time.Second,
+		GlobalRateLimiter:       rl,
+		Features:                &feature.Flags{},
+	}
+	o.Features.Enable(feature.EnableBetaManagementPolicies)
+	features.Default.SetFlags(o.Features)
+	if err := borkresource.Setup(mgr, o); err != nil {
+		t.Fatalf("cannot set up BorkResource controller: %v", err)
+	}
+	if err := subscription.Default.Setup(mgr, log); err != nil {
+		t.Fatalf("cannot set up backend subscriptions: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := mgr.Start(ctx); err != nil {
+			t.Errorf("cannot start manager: %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		t.Fatalf("cannot create client: %v", err)
+	}
+	return kube
+}
+
+// eventually fails the test unless the supplied condition is met before the
+// timeout.
+func eventually(t *testing.T, what string, condition func() (bool, error)) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	var err error
+	for time.Now().Before(deadline) {
+		var ok bool
+		if ok, err = condition(); ok {
+			return
+		}
+		time.Sleep(interval)
+	}
+	t.Fatalf("timed out waiting for %s (last error: %v)", what, err)
+}
+
+// TestBorkResourceLifecycle creates a BorkResource and waits for it to become
+// ready, drifts its record and waits for the drift to be corrected, then
+// deletes it and waits for its record to be deleted.
+func TestBorkResourceLifecycle(t *testing.T) {
+	kube := start(t)
+	ctx := context.Background()
+
+	pc := &apisv1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+			Watch:       &apisv1alpha1.WatchConfig{Enabled: true},
+		},
+	}
+	if err := kube.Create(ctx, pc); err != nil {
+		t.Fatal(err)
+	}
+
+	cr := &v1alpha1.BorkResource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "golden-path"},
+		Spec:       v1alpha1.BorkResourceSpec{ForProvider: v1alpha1.BorkResourceParameters{BorkValue: map[string]string{"bork": "2"}, DataValue: map[string]string{"bork": "1"}}},
+	}
+	if err := kube.Create(ctx, cr); err != nil {
+		t.Fatal(err)
+	}
+	key := types.NamespacedName{Namespace: cr.GetNamespace(), Name: cr.GetName()}
+
+	// Create, then become ready once the record is borked.
+	var name string
+	eventually(t, "the BorkResource to become ready", func() (bool, error) {
+		got := &v1alpha1.BorkResource{}
+		if err := kube.Get(ctx, key, got); err != nil {
+			return false, err
+		}
+		name = meta.GetExternalName(got)
+		return name != "" && got.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue, nil
+	})
+	eventually(t, "the record to be borked", func() (bool, error) {
+		r, err := backend.Default.Get(ctx, name)
+		return err == nil && r.DataValue["bork"] == "2", err
+	})
+
+	// Drift, then have the drift corrected.
+	r, err := backend.Default.Get(ctx, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.DataValue = map[string]string{"bork": "7"}
+	if _, err := backend.Default.Update(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the drifted record to be borked again", func() (bool, error) {
+		r, err := backend.Default.Get(ctx, name)
+		return err == nil && r.DataValue["bork"] == "2", err
+	})
+	eventually(t, "the BorkResource to report that its drift was corrected", func() (bool, error) {
+		got := &v1alpha1.BorkResource{}
+		if err := kube.Get(ctx, key, got); err != nil {
+			return false, err
+		}
+		c := got.GetCondition(middleware.TypeDrifted)
+		return c.Status == corev1.ConditionFalse && c.Reason == middleware.ReasonNoDrift, nil
+	})
+
+	// Delete, then have the record deleted too.
+	if err := kube.Delete(ctx, cr); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the BorkResource to be deleted", func() (bool, error) {
+		err := kube.Get(ctx, key, &v1alpha1.BorkResource{})
+		return kerrors.IsNotFound(err), client.IgnoreNotFound(err)
+	})
+	if _, err := backend.Default.Get(ctx, name); !backend.IsNotFound(err) {
+		t.Errorf("backend.Get(%q): want a not found error once the BorkResource is deleted, got %v", name, err)
+	}
+}
@@ -0,0 +1,474 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package examples generates an example manifest of every kind of the
+// provider's API from its CustomResourceDefinitions, so that the examples
+// can't drift from the API as it grows.
+package examples
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/util/json"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/yaml"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+const (
+	errReadCRDsFmt     = "cannot read CustomResourceDefinitions from %s"
+	errDecodeCRDFmt    = "cannot decode CustomResourceDefinition %s"
+	errNoStorageFmt    = "CustomResourceDefinition %s has no storage version"
+	errDecodeDefault   = "cannot decode default value"
+	errSchemaFmt       = "cannot build the validators of %s"
+	errInvalidFmt      = "generated example %s is invalid: %s"
+	errMarshalFieldFmt = "cannot marshal field %s"
+)
+
+// A suffix of the messages of validation rules that require a parameter only
+// when the resource is managed, i.e. when its management policies create or
+// update it.
+const requiredParameterSuffix = " is a required parameter"
+
+// Namespace of the generated examples of namespaced kinds.
+const Namespace = "default"
+
+// An Example is a generated example manifest.
+type Example struct {
+	// Name of the file the example is written to, e.g. borkresource.yaml.
+	Name string
+
+	// Manifest of the example, as YAML.
+	Manifest []byte
+}
+
+// credentialSelectors are the fields of a provider config's credentials that
+// select the credentials of each credentials source. Sources that select no
+// credentials, like None and InjectedIdentity, aren't listed.
+var credentialSelectors = map[string]string{
+	"Secret":      "secretRef",
+	"Environment": "env",
+	"Filesystem":  "fs",
+}
+
+// overrides are the values of fields of each kind whose valid values the
+// schema can't express, keyed by kind and the field's path. Examples that
+// reference others reference their generated examples.
+var overrides = map[string]map[string]any{
+	v1alpha1.BorkAccessPolicyKind: {
+		"spec.forProvider.policy": `{"Statement": [{"Action": ["bork:Get"], "Resource": ["bork:record/*"]}]}`,
+	},
+	v1alpha1.BorkCertificateKind: {
+		"spec.forProvider.commonName": "example.bork.local",
+		"spec.forProvider.ttl":        "1h",
+	},
+	v1alpha1.BorkCostExportKind: {
+		"spec.forProvider.bucketRef": map[string]any{"name": name(v1alpha1.BorkBucketKind, "")},
+	},
+	v1alpha1.BorkFleetKind: {
+		"spec.replicas": int64(1),
+		// The fleet's BorkResources require a borkValue.
+		"spec.template.forProvider.borkValue": map[string]any{"bork": "2"},
+	},
+	v1alpha1.BorkLinkKind: {
+		// A BorkFleet's BorkResources are named for it and their index.
+		"spec.forProvider.sourceRef": map[string]any{"name": name(v1alpha1.BorkResourceKind, "")},
+		"spec.forProvider.targetRef": map[string]any{"name": name(v1alpha1.BorkFleetKind, "") + "-0"},
+	},
+	v1alpha1.BorkObjectKind: {
+		"spec.forProvider.bucketRef": map[string]any{"name": name(v1alpha1.BorkBucketKind, "")},
+	},
+	v1alpha1.BorkObjectTemplateKind: {
+		"spec.forProvider.manifest": map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": name(v1alpha1.BorkObjectTemplateKind, "")},
+			"data":       map[string]any{"bork": "doh"},
+		},
+	},
+	v1alpha1.BorkPlacementPolicyKind: {
+		"spec.forProvider.zone": "bork-zone-1",
+	},
+	v1alpha1.BorkScheduleKind: {
+		"spec.forProvider.maintenanceWindow": map[string]any{"cron": "0 2 * * 6", "duration": "4h"},
+	},
+	v1alpha1.BorkThrottlePlanKind: {
+		"spec.forProvider.tier": "gold",
+	},
+}
+
+// LoadCRDs returns the CustomResourceDefinitions in the supplied directory.
+func LoadCRDs(dir string) ([]*extv1.CustomResourceDefinition, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil || len(files) == 0 {
+		return nil, errors.Errorf(errReadCRDsFmt, dir)
+	}
+	crds := make([]*extv1.CustomResourceDefinition, 0, len(files))
+	for _, f := range files {
+		b, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return nil, errors.Wrapf(err, errReadCRDsFmt, dir)
+		}
+		crd := &extv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(b, crd); err != nil {
+			return nil, errors.Wrapf(err, errDecodeCRDFmt, f)
+		}
+		crds = append(crds, crd)
+	}
+	return crds, nil
+}
+
+// Generate returns an example of every kind defined by the supplied
+// CustomResourceDefinitions, sorted by name. Each example's fields are those
+// the schema requires or defaults, and the fields it omits are listed as
+// comments, so that uncommenting them is a quick start. A kind whose
+// credentials have a source, like ProviderConfig, has an example of each
+// source. Kinds that have no spec, like ProviderConfigUsage, are created by
+// the provider and have no example. Generate returns an error if any example
+// would be rejected by the API server.
+func Generate(crds []*extv1.CustomResourceDefinition) ([]Example, error) {
+	var examples []Example
+	for _, crd := range crds {
+		e, err := generate(crd)
+		if err != nil {
+			return nil, err
+		}
+		examples = append(examples, e...)
+	}
+	slices.SortFunc(examples, func(a, b Example) int { return strings.Compare(a.Name, b.Name) })
+	return examples, nil
+}
+
+// A variant of an example, which sets the supplied fields by path.
+type variant struct {
+	suffix string
+	fields map[string]any
+}
+
+func generate(crd *extv1.CustomResourceDefinition) ([]Example, error) {
+	var version *extv1.CustomResourceDefinitionVersion
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Storage {
+			version = &crd.Spec.Versions[i]
+		}
+	}
+	if version == nil || version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return nil, errors.Errorf(errNoStorageFmt, crd.GetName())
+	}
+	root := version.Schema.OpenAPIV3Schema
+	spec, ok := root.Properties["spec"]
+	if !ok {
+		return nil, nil
+	}
+	v, err := newValidator(root)
+	if err != nil {
+		return nil, errors.Wrapf(err, errSchemaFmt, crd.GetName())
+	}
+
+	kind := crd.Spec.Names.Kind
+	var examples []Example
+	for _, vr := range variants(spec) {
+		s, err := required(spec)
+		if err != nil {
+			return nil, err
+		}
+		sm, _ := s.(map[string]any)
+		for _, r := range root.XValidations {
+			path, ok := strings.CutSuffix(r.Message, requiredParameterSuffix)
+			if !ok {
+				continue
+			}
+			val, err := sample(field(spec, strings.TrimPrefix(path, "spec.")))
+			if err != nil {
+				return nil, err
+			}
+			set(sm, strings.TrimPrefix(path, "spec."), val)
+		}
+		for path, val := range overrides[kind] {
+			set(sm, strings.TrimPrefix(path, "spec."), val)
+		}
+		for path, val := range vr.fields {
+			set(sm, strings.TrimPrefix(path, "spec."), val)
+		}
+
+		meta := map[string]any{"name": name(kind, vr.suffix)}
+		if crd.Spec.Scope == extv1.NamespaceScoped {
+			meta["namespace"] = Namespace
+		}
+		obj := map[string]any{
+			"apiVersion": crd.Spec.Group + "/" + version.Name,
+			"kind":       kind,
+			"metadata":   meta,
+			"spec":       sm,
+		}
+		file := strings.TrimPrefix(name(kind, vr.suffix), "example-") + ".yaml"
+		if msg := v.validate(obj); msg != "" {
+			return nil, errors.Errorf(errInvalidFmt, file, msg)
+		}
+
+		b := &bytes.Buffer{}
+		header, err := yaml.Marshal(map[string]any{"apiVersion": obj["apiVersion"], "kind": kind, "metadata": meta})
+		if err != nil {
+			return nil, errors.Wrapf(err, errMarshalFieldFmt, "metadata")
+		}
+		b.Write(header)
+		b.WriteString("spec:\n")
+		if err := render(b, &spec, sm, 1); err != nil {
+			return nil, err
+		}
+		examples = append(examples, Example{Name: file, Manifest: b.Bytes()})
+	}
+	return examples, nil
+}
+
+// variants returns the variants of the example of a kind with the supplied
+// spec: one per credentials source if it has credentials, or one otherwise.
+func variants(spec extv1.JSONSchemaProps) []variant {
+	creds, ok := spec.Properties["credentials"]
+	if !ok {
+		return []variant{{}}
+	}
+	src, ok := creds.Properties["source"]
+	if !ok || len(src.Enum) == 0 {
+		return []variant{{}}
+	}
+	vs := make([]variant, 0, len(src.Enum))
+	for _, e := range src.Enum {
+		var source string
+		if err := json.Unmarshal(e.Raw, &source); err != nil {
+			continue
+		}
+		vr := variant{suffix: strings.ToLower(source), fields: map[string]any{"spec.credentials.source": source}}
+		if sel, ok := credentialSelectors[source]; ok {
+			if s, err := sample(creds.Properties[sel]); err == nil {
+				vr.fields["spec.credentials."+sel] = s
+			}
+		}
+		vs = append(vs, vr)
+	}
+	return vs
+}
+
+// name returns the name of the example of the supplied kind and variant.
+func name(kind, suffix string) string {
+	n := "example-" + strings.ToLower(kind)
+	if suffix != "" {
+		n += "-" + suffix
+	}
+	return n
+}
+
+// set sets the field at the supplied dotted path of the supplied object,
+// creating the objects that lead to it.
+func set(obj map[string]any, path string, val any) {
+	parts := strings.Split(path, ".")
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := obj[p].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			obj[p] = next
+		}
+		obj = next
+	}
+	obj[parts[len(parts)-1]] = val
+}
+
+// field returns the schema of the field at the supplied dotted path of an
+// object with the supplied schema.
+func field(s extv1.JSONSchemaProps, path string) extv1.JSONSchemaProps {
+	for _, p := range strings.Split(path, ".") {
+		s = s.Properties[p]
+	}
+	return s
+}
+
+// required returns the value of a field with the supplied schema that has
+// only the fields the schema requires or defaults.
+func required(s extv1.JSONSchemaProps) (any, error) {
+	if s.Default != nil {
+		var v any
+		return v, errors.Wrap(json.Unmarshal(s.Default.Raw, &v), errDecodeDefault)
+	}
+	switch s.Type {
+	case "object":
+		m := map[string]any{}
+		for k, p := range s.Properties {
+			if !slices.Contains(s.Required, k) && p.Default == nil {
+				continue
+			}
+			v, err := required(p)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	case "array":
+		if s.MinItems == nil || *s.MinItems == 0 || s.Items == nil || s.Items.Schema == nil {
+			return []any{}, nil
+		}
+		v, err := sample(*s.Items.Schema)
+		return []any{v}, err
+	}
+	return sample(s)
+}
+
+// sample returns an example value of a field with the supplied schema. Its
+// objects have the fields the schema requires or defaults, and a sample entry
+// if they're maps. Its arrays have a sample item.
+func sample(s extv1.JSONSchemaProps) (any, error) {
+	switch {
+	case s.Default != nil:
+		return required(s)
+	case len(s.Enum) > 0:
+		var v any
+		return v, errors.Wrap(json.Unmarshal(s.Enum[0].Raw, &v), errDecodeDefault)
+	case s.XIntOrString:
+		return int64(1), nil
+	}
+	switch s.Type {
+	case "object":
+		if len(s.Properties) == 0 && s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+			v, err := sample(*s.AdditionalProperties.Schema)
+			return map[string]any{"example": v}, err
+		}
+		return required(s)
+	case "array":
+		if s.Items == nil || s.Items.Schema == nil {
+			return []any{}, nil
+		}
+		v, err := sample(*s.Items.Schema)
+		return []any{v}, err
+	case "integer":
+		if s.Minimum != nil {
+			return int64(*s.Minimum), nil
+		}
+		return int64(1), nil
+	case "number":
+		if s.Minimum != nil {
+			return *s.Minimum, nil
+		}
+		return float64(1), nil
+	case "boolean":
+		return true, nil
+	case "string":
+		if s.Format == "date-time" {
+			return "2025-01-01T00:00:00Z", nil
+		}
+		// Durations are strings that validation rules parse.
+		for _, r := range s.XValidations {
+			if strings.Contains(r.Rule, "duration(self)") {
+				return "1h", nil
+			}
+		}
+		return "example", nil
+	}
+	return map[string]any{}, nil
+}
+
+// render writes the supplied object, whose schema is supplied, as YAML
+// indented by the supplied depth. Fields of the schema the object omits are
+// written as comments.
+func render(b *bytes.Buffer, s *extv1.JSONSchemaProps, obj map[string]any, depth int) error {
+	indent := strings.Repeat("  ", depth)
+	keys := make([]string, 0, len(s.Properties))
+	for k := range s.Properties {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		p := s.Properties[k]
+		v, ok := obj[k]
+		if m, isObject := v.(map[string]any); ok && isObject && len(p.Properties) > 0 {
+			if len(m) == 0 {
+				b.WriteString(indent + k + ": {}\n")
+			} else {
+				b.WriteString(indent + k + ":\n")
+			}
+			if err := render(b, &p, m, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+		prefix := indent
+		if !ok {
+			prefix += "# "
+			sv, err := sample(p)
+			if err != nil {
+				return err
+			}
+			v = sv
+		}
+		out, err := yaml.Marshal(map[string]any{k: v})
+		if err != nil {
+			return errors.Wrapf(err, errMarshalFieldFmt, k)
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(string(out), "\n"), "\n") {
+			b.WriteString(prefix + line + "\n")
+		}
+	}
+	return nil
+}
+
+// A validator validates objects as the API server would, using a
+// CustomResourceDefinition's schema and validation rules.
+type validator struct {
+	schema     validation.SchemaValidator
+	structural *structuralschema.Structural
+	rules      *cel.Validator
+}
+
+func newValidator(root *extv1.JSONSchemaProps) (*validator, error) {
+	props := &apiextensions.JSONSchemaProps{}
+	if err := extv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(root, props, nil); err != nil {
+		return nil, err
+	}
+	sv, _, err := validation.NewSchemaValidator(props)
+	if err != nil {
+		return nil, err
+	}
+	s, err := structuralschema.NewStructural(props)
+	if err != nil {
+		return nil, err
+	}
+	return &validator{schema: sv, structural: s, rules: cel.NewValidator(s, true, celconfig.PerCallLimit)}, nil
+}
+
+// validate returns why the API server would reject the supplied object, or
+// an empty string if it would accept it.
+func (v *validator) validate(obj map[string]any) string {
+	errs := validation.ValidateCustomResource(nil, obj, v.schema)
+	if v.rules != nil {
+		ruleErrs, _ := v.rules.Validate(context.Background(), nil, v.structural, obj, nil, celconfig.RuntimeCELCostBudget)
+		errs = append(errs, ruleErrs...)
+	}
+	if len(errs) == 0 {
+		return ""
+	}
+	return errs.ToAggregate().Error()
+}
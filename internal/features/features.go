@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package features allows the provider's optional features to be toggled
+// while it runs, by editing a ConfigMap.
+//
+// A controller can't be removed from a running manager, so rather than set
+// controllers up again when a feature is toggled each controller's
+// reconciler consults the features every reconcile.
+package features
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/apis/changelogs/proto/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	errGetFeatures   = "cannot get features ConfigMap"
+	errSetupFeatures = "cannot setup features controller"
+)
+
+// EnableAlphaRealtimeCompositions enables alpha support for realtime
+// compositions. Managed resources that reference another are reconciled as
+// soon as it changes, rather than when they're next polled.
+const EnableAlphaRealtimeCompositions feature.Flag = "EnableAlphaRealtimeCompositions"
+
+// EnableAlphaExperimentalKinds enables alpha support for experimental kinds,
+// whose controllers aren't started unless it's enabled. It's read when the
+// provider starts, so it can't be toggled.
+const EnableAlphaExperimentalKinds feature.Flag = "EnableAlphaExperimentalKinds"
+
+// Toggleable features, which may be set by a features ConfigMap.
+var Toggleable = []feature.Flag{
+	feature.EnableBetaManagementPolicies,
+	feature.EnableAlphaChangeLogs,
+	EnableAlphaRealtimeCompositions,
+}
+
+// Default features of the provider.
+var Default = &Features{}
+
+// Features are the provider's optional features. A feature is enabled if its
+// flag is enabled, unless it's overridden.
+type Features struct {
+	mu        sync.RWMutex
+	flags     *feature.Flags
+	overrides map[feature.Flag]bool
+}
+
+// SetFlags sets the flags of features that aren't overridden.
+func (f *Features) SetFlags(fs *feature.Flags) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.flags = fs
+}
+
+// Override the supplied features. Features that aren't supplied revert to
+// their flags.
+func (f *Features) Override(overrides map[feature.Flag]bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.overrides = overrides
+}
+
+// Enabled returns true if the supplied feature is enabled.
+func (f *Features) Enabled(flag feature.Flag) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if on, ok := f.overrides[flag]; ok {
+		return on
+	}
+	return f.flags.Enabled(flag)
+}
+
+// NewReconciler returns a managed reconciler that honours management
+// policies only while they're enabled, and logs changes only while change
+// logs are enabled. Changes are never logged if the supplied options have no
+// change logger.
+func (f *Features) NewReconciler(mgr ctrl.Manager, of resource.ManagedKind, o controller.Options, opts ...managed.ReconcilerOption) reconcile.Reconciler {
+	if o.ChangeLogOptions != nil {
+		opts = append(opts, managed.WithChangeLogger(&changeLogger{features: f, wrapped: o.ChangeLogOptions.ChangeLogger}))
+	}
+
+	withPolicies := managed.NewReconciler(mgr, of, append(opts, managed.WithManagementPolicies())...)
+	withoutPolicies := managed.NewReconciler(mgr, of, opts...)
+
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if f.Enabled(feature.EnableBetaManagementPolicies) {
+			return withPolicies.Reconcile(ctx, req)
+		}
+		return withoutPolicies.Reconcile(ctx, req)
+	})
+}
+
+// Setup watches the ConfigMap with the supplied namespace and name,
+// overriding the toggleable features its data names, e.g.
+// EnableAlphaChangeLogs: "true", whenever it changes. Invalid values and
+// features that can't be toggled are logged and ignored. Features revert to
+// their flags if the ConfigMap is deleted.
+func (f *Features) Setup(mgr ctrl.Manager, log logging.Logger, namespace, name string) error {
+	log = log.WithValues("controller", "features", "configmap", namespace+"/"+name)
+	kube := mgr.GetClient()
+
+	r := reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		cm := &corev1.ConfigMap{}
+		err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm)
+		if kerrors.IsNotFound(err) {
+			f.Override(nil)
+			log.Info("Reverted features to their flags")
+			return reconcile.Result{}, nil
+		}
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errGetFeatures)
+		}
+
+		overrides := make(map[feature.Flag]bool, len(cm.Data))
+		for k, v := range cm.Data {
+			flag := feature.Flag(k)
+			on, err := strconv.ParseBool(v)
+			if err != nil || !slices.Contains(Toggleable, flag) {
+				log.Info("Ignoring invalid feature", "feature", k, "value", v, "toggleable", Toggleable)
+				continue
+			}
+			overrides[flag] = on
+		}
+		f.Override(overrides)
+		for _, flag := range Toggleable {
+			log.Info("Set feature", "feature", flag, "enabled", f.Enabled(flag))
+		}
+		return reconcile.Result{}, nil
+	})
+
+	return errors.Wrap(ctrl.NewControllerManagedBy(mgr).
+		Named("features").
+		For(&corev1.ConfigMap{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(o client.Object) bool {
+			return o.GetNamespace() == namespace && o.GetName() == name
+		}))).
+		Complete(r), errSetupFeatures)
+}
+
+// A changeLogger logs changes only while change logs are enabled.
+type changeLogger struct {
+	features *Features
+	wrapped  managed.ChangeLogger
+}
+
+func (c *changeLogger) Log(ctx context.Context, mg resource.Managed, op v1alpha1.OperationType, changeErr error, ad managed.AdditionalDetails) error {
+	if !c.features.Enabled(feature.EnableAlphaChangeLogs) {
+		return nil
+	}
+	return c.wrapped.Log(ctx, mg, op, changeErr, ad)
+}
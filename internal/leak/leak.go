@@ -0,0 +1,350 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package leak sweeps the backend for leaked external resources: those that
+// no managed resource refers to by external name. Depending on its policy the
+// sweeper reports or deletes them, like the orphan cleanup tooling of real
+// providers.
+package leak
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+const (
+	errListMRs    = "cannot list managed resources"
+	errGetGVK     = "cannot determine managed resource kind"
+	errMapKind    = "cannot map managed resource kind to its resource"
+	errGetCRD     = "cannot get managed resource CustomResourceDefinition"
+	errAddSweeper = "cannot add leak sweeper to controller manager"
+)
+
+// A Policy determines what the sweeper does with leaked external resources.
+type Policy string
+
+// Leak policies.
+const (
+	// PolicyIgnore disables the sweeper.
+	PolicyIgnore Policy = "Ignore"
+
+	// PolicyReport records an event and increments a metric the first time
+	// each leaked external resource is found.
+	PolicyReport Policy = "Report"
+
+	// PolicyDelete deletes leaked external resources, recording an event
+	// and incrementing a metric for each.
+	PolicyDelete Policy = "Delete"
+)
+
+// Policies are the supported leak policies.
+var Policies = []Policy{PolicyIgnore, PolicyReport, PolicyDelete}
+
+// Defaults for sweeping leaked external resources.
+const (
+	DefaultInterval    = time.Minute
+	DefaultGracePeriod = 5 * time.Minute
+)
+
+// Reasons for the events the sweeper records.
+const (
+	ReasonLeaked       event.Reason = "LeakedExternalResource"
+	ReasonDeleted      event.Reason = "DeletedLeakedExternalResource"
+	ReasonCannotDelete event.Reason = "CannotDeleteLeakedExternalResource"
+)
+
+// A Backend stores external resources, which may be partitioned between
+// tenants.
+type Backend interface {
+	metrics.Inventory
+
+	// Remove deletes the named stored resource of the supplied backend
+	// kind that belongs to the supplied tenant.
+	Remove(ctx context.Context, tenant, kind, name string) error
+}
+
+// Default sweeps the simulated backend shared by all of the provider's
+// controllers.
+var Default = NewSweeper(backend.DefaultTenants)
+
+// A Sweeper periodically finds the external resources of each registered kind
+// that no managed resource refers to by external name. An external resource
+// is leaked once it has been orphaned for longer than the grace period, which
+// gives a managed resource that just created its external resource time to
+// record its external name. Leaks are recorded as events of the
+// CustomResourceDefinition of the managed resource kind that leaked them,
+// because they have no managed resource.
+//
+// Deleting leaks also deletes the external resources of managed resources
+// that were deliberately orphaned, e.g. by management policies that don't
+// allow Delete.
+type Sweeper struct {
+	backend Backend
+
+	mu    sync.Mutex
+	kinds map[string]func() resource.ManagedList
+
+	kube     client.Client
+	mapper   kmeta.RESTMapper
+	record   event.Recorder
+	log      logging.Logger
+	policy   Policy
+	interval time.Duration
+	grace    time.Duration
+
+	// orphaned records when each orphaned external resource was first
+	// found, and reported whether it has been reported as leaked.
+	orphaned map[leak]*orphan
+}
+
+type leak struct {
+	tenant string
+	kind   string
+	name   string
+}
+
+type orphan struct {
+	since    time.Time
+	reported bool
+}
+
+// NewSweeper returns a sweeper of the supplied backend that sweeps no kinds.
+func NewSweeper(b Backend) *Sweeper {
+	return &Sweeper{
+		backend:  b,
+		kinds:    make(map[string]func() resource.ManagedList),
+		record:   event.NewNopRecorder(),
+		log:      logging.NewNopLogger(),
+		policy:   PolicyIgnore,
+		interval: DefaultInterval,
+		grace:    DefaultGracePeriod,
+		orphaned: make(map[leak]*orphan),
+	}
+}
+
+// Register sweeps the external resources of the supplied backend kind, which
+// are managed by the managed resource kind listed by newList. newList must
+// return an empty list of the managed resource kind. Kinds may be registered
+// after the sweeper starts.
+func (s *Sweeper) Register(kind string, newList func() resource.ManagedList) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.kinds[kind] = newList
+}
+
+// Setup configures the sweeper's policy, interval and grace period, and adds
+// it to the supplied controller manager unless its policy is PolicyIgnore.
+func (s *Sweeper) Setup(mgr ctrl.Manager, log logging.Logger, p Policy, interval, grace time.Duration) error {
+	s.mu.Lock()
+	s.kube = mgr.GetClient()
+	s.mapper = mgr.GetRESTMapper()
+	s.record = event.NewAPIRecorder(mgr.GetEventRecorderFor("leak-sweeper"))
+	s.log = log.WithValues("controller", "leak-sweeper")
+	s.policy = p
+	s.interval = interval
+	s.grace = grace
+	s.mu.Unlock()
+
+	if p == PolicyIgnore {
+		return nil
+	}
+	return errors.Wrap(mgr.Add(s), errAddSweeper)
+}
+
+// Sweep reports or deletes the leaked external resources of every registered
+// kind, per the sweeper's policy.
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := make(map[leak]bool)
+	for kind, newList := range s.kinds {
+		if err := s.sweep(ctx, kind, newList, found); err != nil {
+			return err
+		}
+	}
+
+	// Forget external resources that are no longer orphaned, because a
+	// managed resource now refers to them or because they were deleted.
+	for l := range s.orphaned {
+		if !found[l] {
+			delete(s.orphaned, l)
+		}
+	}
+	return nil
+}
+
+// sweep reports or deletes the leaked external resources of the supplied
+// backend kind, adding every orphaned external resource to found. The caller
+// must hold the sweeper's lock.
+func (s *Sweeper) sweep(ctx context.Context, kind string, newList func() resource.ManagedList, found map[leak]bool) error {
+	l := newList()
+	if err := s.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListMRs)
+	}
+	gvk, err := apiutil.GVKForObject(l, s.kube.Scheme())
+	if err != nil {
+		return errors.Wrap(err, errGetGVK)
+	}
+	// Remove "List" to get the managed resource kind.
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+
+	managed := make(map[string]map[string]bool)
+	for _, mg := range l.GetItems() {
+		name := meta.GetExternalName(mg)
+		if name == "" {
+			continue
+		}
+		tenant := s.backend.Tenant(mg.GetNamespace())
+		if managed[tenant] == nil {
+			managed[tenant] = make(map[string]bool)
+		}
+		managed[tenant][name] = true
+	}
+
+	now := time.Now()
+	for _, tenant := range s.backend.Tenants() {
+		names, err := metrics.ListNames(ctx, s.backend, tenant, kind)
+		if err != nil {
+			return err
+		}
+		// Every page is listed before any of the tenant's leaks are
+		// handled, so that leaks are never handled given a partial list.
+		for _, name := range slices.Sorted(maps.Keys(names)) {
+			if managed[tenant][name] {
+				continue
+			}
+			lk := leak{tenant: tenant, kind: kind, name: name}
+			found[lk] = true
+			o, ok := s.orphaned[lk]
+			if !ok {
+				o = &orphan{since: now}
+				s.orphaned[lk] = o
+			}
+			if now.Sub(o.since) < s.grace {
+				continue
+			}
+			s.handle(ctx, gvk, lk, o)
+		}
+	}
+	return nil
+}
+
+// handle reports or deletes the supplied leaked external resource, which is
+// of the supplied managed resource kind. The caller must hold the sweeper's
+// lock.
+func (s *Sweeper) handle(ctx context.Context, gvk schema.GroupVersionKind, l leak, o *orphan) {
+	log := s.log.WithValues("kind", gvk.Kind, "tenant", l.tenant, "external-name", l.name)
+	desc := describe(gvk, l)
+
+	switch s.policy {
+	case PolicyReport:
+		if o.reported {
+			return
+		}
+		o.reported = true
+		metrics.LeakedResources.With(prometheus.Labels{"gvk": gvk.String(), metrics.LabelTenant: l.tenant, "action": metrics.LeakActionReported}).Inc()
+		log.Info("Found leaked external resource")
+		s.event(ctx, gvk, event.Warning(ReasonLeaked, errors.New(desc+" has no managed resource")))
+	case PolicyDelete:
+		if err := s.backend.Remove(ctx, l.tenant, l.kind, l.name); err != nil {
+			log.Info("Cannot delete leaked external resource", "error", err)
+			s.event(ctx, gvk, event.Warning(ReasonCannotDelete, errors.Wrapf(err, "cannot delete %s", desc)))
+			return
+		}
+		delete(s.orphaned, l)
+		metrics.LeakedResources.With(prometheus.Labels{"gvk": gvk.String(), metrics.LabelTenant: l.tenant, "action": metrics.LeakActionDeleted}).Inc()
+		log.Info("Deleted leaked external resource")
+		s.event(ctx, gvk, event.Normal(ReasonDeleted, "Deleted "+desc+", which had no managed resource"))
+	}
+}
+
+// describe returns a description of the supplied leaked external resource,
+// e.g. external BorkResource "doh" in tenant "default".
+func describe(gvk schema.GroupVersionKind, l leak) string {
+	if l.tenant == "" {
+		return fmt.Sprintf("external %s %q", gvk.Kind, l.name)
+	}
+	return fmt.Sprintf("external %s %q in tenant %q", gvk.Kind, l.name, l.tenant)
+}
+
+// event records the supplied event on the CustomResourceDefinition of the
+// supplied managed resource kind. Events are best effort; the event isn't
+// recorded if the CustomResourceDefinition can't be found.
+func (s *Sweeper) event(ctx context.Context, gvk schema.GroupVersionKind, e event.Event) {
+	crd, err := s.crd(ctx, gvk)
+	if err != nil {
+		s.log.Debug("Cannot record leaked external resource event", "error", err)
+		return
+	}
+	s.record.Event(crd, e)
+}
+
+func (s *Sweeper) crd(ctx context.Context, gvk schema.GroupVersionKind) (*metav1.PartialObjectMetadata, error) {
+	m, err := s.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, errors.Wrap(err, errMapKind)
+	}
+	crd := &metav1.PartialObjectMetadata{}
+	crd.SetGroupVersionKind(extv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"))
+	if err := s.kube.Get(ctx, types.NamespacedName{Name: m.Resource.Resource + "." + gvk.Group}, crd); err != nil {
+		return nil, errors.Wrap(err, errGetCRD)
+	}
+	return crd, nil
+}
+
+// Start sweeps leaked external resources every interval, until the supplied
+// context is done. Failures to sweep are logged, and retried at the next
+// interval.
+func (s *Sweeper) Start(ctx context.Context) error {
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := s.Sweep(ctx); err != nil {
+				s.log.Debug("Cannot sweep leaked external resources", "error", err)
+			}
+		}
+	}
+}
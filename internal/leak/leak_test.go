@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leak
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// An eventRecorder records the reasons of the events it's asked to record.
+type eventRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+// borkResource returns a BorkResource in the supplied namespace that refers to
+// the supplied external name.
+func borkResource(namespace, externalName string) client.Object {
+	cr := &v1alpha1.BorkResource{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: externalName}}
+	meta.SetExternalName(cr, externalName)
+	return cr
+}
+
+func TestSweep(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+	if err := extv1.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+	crd := &extv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "borkresources." + v1alpha1.Group}}
+	mapper := kmeta.NewDefaultRESTMapper(nil)
+	mapper.Add(v1alpha1.BorkResourceGroupVersionKind, kmeta.RESTScopeNamespace)
+
+	type want struct {
+		events  []event.Reason
+		records map[string][]string
+	}
+
+	cases := map[string]struct {
+		reason  string
+		tenancy backend.TenancyMode
+		policy  Policy
+		grace   time.Duration
+		// records are the names of the records created in the store of
+		// each namespace.
+		records map[string][]string
+		mrs     []client.Object
+		sweeps  int
+		want    want
+	}{
+		"Managed": {
+			reason:  "Records that a managed resource refers to by external name aren't leaked.",
+			policy:  PolicyDelete,
+			records: map[string][]string{"default": {"bork"}},
+			mrs:     []client.Object{borkResource("default", "bork")},
+			sweeps:  1,
+			want:    want{records: map[string][]string{"": {"bork"}}},
+		},
+		"Ignore": {
+			reason:  "Leaks are neither reported nor deleted when the sweeper's policy is Ignore.",
+			policy:  PolicyIgnore,
+			records: map[string][]string{"default": {"bork"}},
+			sweeps:  1,
+			want:    want{records: map[string][]string{"": {"bork"}}},
+		},
+		"Report": {
+			reason:  "A leak should be reported once, however many times it's found, and not deleted.",
+			policy:  PolicyReport,
+			records: map[string][]string{"default": {"bork", "doh"}},
+			mrs:     []client.Object{borkResource("default", "bork")},
+			sweeps:  2,
+			want: want{
+				events:  []event.Reason{ReasonLeaked},
+				records: map[string][]string{"": {"bork", "doh"}},
+			},
+		},
+		"Delete": {
+			reason:  "A leak should be deleted, and the deletion recorded.",
+			policy:  PolicyDelete,
+			records: map[string][]string{"default": {"bork", "doh"}},
+			mrs:     []client.Object{borkResource("default", "bork")},
+			sweeps:  2,
+			want: want{
+				events:  []event.Reason{ReasonDeleted},
+				records: map[string][]string{"": {"bork"}},
+			},
+		},
+		"GracePeriod": {
+			reason:  "Records orphaned for less than the grace period aren't leaked yet.",
+			policy:  PolicyDelete,
+			grace:   time.Hour,
+			records: map[string][]string{"default": {"doh"}},
+			sweeps:  2,
+			want:    want{records: map[string][]string{"": {"doh"}}},
+		},
+		"OtherTenant": {
+			reason:  "A managed resource only protects the record of that name in its own tenant's store.",
+			tenancy: backend.TenancyNamespace,
+			policy:  PolicyDelete,
+			records: map[string][]string{"a": {"bork"}, "b": {"bork"}},
+			mrs:     []client.Object{borkResource("a", "bork")},
+			sweeps:  1,
+			want: want{
+				events:  []event.Reason{ReasonDeleted},
+				records: map[string][]string{"": nil, "a": {"bork"}, "b": nil},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			tn := backend.NewTenants(backend.NewStore())
+			if tc.tenancy != "" {
+				tn.SetMode(tc.tenancy)
+			}
+			for ns, names := range tc.records {
+				for _, n := range names {
+					if _, err := tn.For(ns).Create(ctx, backend.Record{Name: n, BorkValue: map[string]string{"bork": "1"}}); err != nil {
+						t.Fatalf("Create(...): %v", err)
+					}
+				}
+			}
+
+			r := &eventRecorder{}
+			sw := NewSweeper(tn)
+			sw.kube = fake.NewClientBuilder().WithScheme(s).WithObjects(append([]client.Object{crd}, tc.mrs...)...).Build()
+			sw.mapper = mapper
+			sw.record = r
+			sw.policy = tc.policy
+			sw.grace = tc.grace
+			sw.Register(backend.KindRecord, func() resource.ManagedList { return &v1alpha1.BorkResourceList{} })
+
+			for range tc.sweeps {
+				if err := sw.Sweep(ctx); err != nil {
+					t.Fatalf("Sweep(...): %v", err)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.events, r.reasons); diff != "" {
+				t.Errorf("\n%s\nSweep(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+			got := map[string][]string{}
+			for _, tenant := range tn.Tenants() {
+				names, _, err := tn.List(ctx, tenant, backend.KindRecord, "")
+				if err != nil {
+					t.Fatalf("List(...): %v", err)
+				}
+				got[tenant] = names
+			}
+			if diff := cmp.Diff(tc.want.records, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nSweep(...): -want records, +got records:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
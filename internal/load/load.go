@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package load generates load against the provider by creating, updating and
+// deleting BorkResources at configurable rates, so that its performance can be
+// tracked across releases.
+package load
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+const (
+	errNoCount = "count must be at least one"
+	errRateFmt = "%s rate must be positive"
+	errWaitFmt = "cannot %s BorkResources"
+
+	errCreateFmt = "cannot create BorkResource %s"
+	errUpdateFmt = "cannot update BorkResource %s"
+	errDeleteFmt = "cannot delete BorkResource %s"
+)
+
+// LabelRun is the label of a BorkResource that names the load run that created
+// it. Resources left behind by an interrupted run can be deleted by label.
+const LabelRun = "bork.crossplane.io/load"
+
+// Options configure a load run.
+type Options struct {
+	// Run names the load run. Resources are named for the run and their
+	// index, and labelled with the run.
+	Run string
+
+	// Namespace to create resources in.
+	Namespace string
+
+	// ProviderConfig the resources reference.
+	ProviderConfig *xpv1.ProviderConfigReference
+
+	// Count of resources to create.
+	Count int
+
+	// Rates at which resources are created, updated and deleted, per second.
+	CreateRate float64
+	UpdateRate float64
+	DeleteRate float64
+
+	// Soak is how long resources are updated for once they're all created.
+	Soak time.Duration
+}
+
+// Validate returns an error if the options can't be run.
+func (o Options) Validate() error {
+	if o.Count < 1 {
+		return errors.New(errNoCount)
+	}
+	for op, r := range map[string]float64{"create": o.CreateRate, "update": o.UpdateRate, "delete": o.DeleteRate} {
+		if r <= 0 {
+			return errors.Errorf(errRateFmt, op)
+		}
+	}
+	return nil
+}
+
+// A Report of a load run.
+type Report struct {
+	Created, Updated, Deleted int64
+
+	// Errors is how many operations failed.
+	Errors int64
+
+	// LastError is the last error an operation returned, if any.
+	LastError error
+}
+
+// A Generator generates load against the provider.
+type Generator struct {
+	kube client.Client
+	opts Options
+
+	mu     sync.Mutex
+	report Report
+}
+
+// NewGenerator returns a generator that generates load using the supplied
+// client.
+func NewGenerator(kube client.Client, o Options) *Generator {
+	return &Generator{kube: kube, opts: o}
+}
+
+// Run creates the configured count of resources, updates them until the soak
+// ends, then deletes them. It returns early if the supplied context is done,
+// leaving any resources it created.
+func (g *Generator) Run(ctx context.Context) (Report, error) {
+	if err := g.each(ctx, "create", g.opts.CreateRate, g.opts.Count, g.create); err != nil {
+		return g.Report(), err
+	}
+
+	soak, cancel := context.WithTimeout(ctx, g.opts.Soak)
+	defer cancel()
+	// Updates in flight when the soak ends aren't cancelled.
+	_ = g.each(soak, "update", g.opts.UpdateRate, -1, func(_ context.Context, _ int) error {
+		return g.update(ctx, rand.IntN(g.opts.Count))
+	})
+	if err := ctx.Err(); err != nil {
+		return g.Report(), errors.Wrapf(err, errWaitFmt, "update")
+	}
+
+	err := g.each(ctx, "delete", g.opts.DeleteRate, g.opts.Count, g.delete)
+	return g.Report(), err
+}
+
+// Report of the load generated so far.
+func (g *Generator) Report() Report {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.report
+}
+
+// each calls the supplied operation the supplied number of times, or until
+// the supplied context is done if n is negative, at the supplied rate. Calls
+// run concurrently so that slow calls don't hold back the rate.
+func (g *Generator) each(ctx context.Context, op string, r float64, n int, fn func(ctx context.Context, i int) error) error {
+	l := rate.NewLimiter(rate.Limit(r), 1)
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+	for i := 0; n < 0 || i < n; i++ {
+		if err := l.Wait(ctx); err != nil {
+			if n < 0 {
+				return nil
+			}
+			return errors.Wrapf(err, errWaitFmt, op)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g.record(fn(ctx, i))
+		}()
+	}
+	return nil
+}
+
+func (g *Generator) record(err error) {
+	if err == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.report.Errors++
+	g.report.LastError = err
+}
+
+func (g *Generator) name(i int) string {
+	return fmt.Sprintf("%s-%05d", g.opts.Run, i)
+}
+
+func (g *Generator) create(ctx context.Context, i int) error {
+	cr := &v1alpha1.BorkResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: g.opts.Namespace,
+			Name:      g.name(i),
+			Labels:    map[string]string{LabelRun: g.opts.Run},
+		},
+		Spec: v1alpha1.BorkResourceSpec{
+			ForProvider: v1alpha1.BorkResourceParameters{
+				BorkValue: map[string]string{"load": "1"},
+				DataValue: map[string]string{"load": "1"},
+				Tags:      map[string]string{"load": g.opts.Run},
+			},
+		},
+	}
+	cr.SetProviderConfigReference(g.opts.ProviderConfig)
+	if err := g.kube.Create(ctx, cr); err != nil {
+		return errors.Wrapf(err, errCreateFmt, cr.GetName())
+	}
+	g.count(&g.report.Created)
+	return nil
+}
+
+// update patches a resource's bork value and a tag, so that both the record
+// and its tags are updated in the backend.
+func (g *Generator) update(ctx context.Context, i int) error {
+	v := rand.IntN(100) + 1
+	patch := fmt.Sprintf(`{"spec":{"forProvider":{"borkValue":{"load":%[1]q},"tags":{"load-value":%[1]q}}}}`, strconv.Itoa(v))
+	cr := &v1alpha1.BorkResource{ObjectMeta: metav1.ObjectMeta{Namespace: g.opts.Namespace, Name: g.name(i)}}
+	if err := g.kube.Patch(ctx, cr, client.RawPatch(types.MergePatchType, []byte(patch))); err != nil {
+		return errors.Wrapf(err, errUpdateFmt, cr.GetName())
+	}
+	g.count(&g.report.Updated)
+	return nil
+}
+
+func (g *Generator) delete(ctx context.Context, i int) error {
+	cr := &v1alpha1.BorkResource{ObjectMeta: metav1.ObjectMeta{Namespace: g.opts.Namespace, Name: g.name(i)}}
+	if err := client.IgnoreNotFound(g.kube.Delete(ctx, cr)); err != nil {
+		return errors.Wrapf(err, errDeleteFmt, cr.GetName())
+	}
+	g.count(&g.report.Deleted)
+	return nil
+}
+
+func (g *Generator) count(n *int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	*n++
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package load
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	errScrapeFmt      = "cannot scrape metrics from %s"
+	errScrapeCodeFmt  = "cannot scrape metrics from %s: %s"
+	errParseMetrics   = "cannot parse metrics"
+	errNoHistogramFmt = "no %s histogram for controller %q"
+)
+
+// MetricReconcileTime is the controller-runtime histogram of how long each
+// reconcile took, labelled by controller.
+const MetricReconcileTime = "controller_runtime_reconcile_time_seconds"
+
+const labelController = "controller"
+
+// A Histogram is a cumulative histogram of observations.
+type Histogram struct {
+	// Buckets counts the observations no greater than each upper bound,
+	// sorted by upper bound. The last bucket's upper bound is +Inf.
+	Buckets []Bucket
+
+	// Count of observations.
+	Count uint64
+
+	// Sum of observations.
+	Sum float64
+}
+
+// A Bucket of a Histogram.
+type Bucket struct {
+	UpperBound float64
+	Count      uint64
+}
+
+// Scrape returns the reconcile time histogram of the supplied controller
+// from the Prometheus metrics served at the supplied URL.
+func Scrape(ctx context.Context, hc *http.Client, url, controller string) (Histogram, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Histogram{}, errors.Wrapf(err, errScrapeFmt, url)
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return Histogram{}, errors.Wrapf(err, errScrapeFmt, url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Histogram{}, errors.Errorf(errScrapeCodeFmt, url, resp.Status)
+	}
+	return parse(resp.Body, controller)
+}
+
+// parse returns the reconcile time histogram of the supplied controller from
+// the supplied metrics, in the Prometheus text format.
+func parse(r io.Reader, controller string) (Histogram, error) {
+	p := expfmt.TextParser{}
+	mfs, err := p.TextToMetricFamilies(r)
+	if err != nil {
+		return Histogram{}, errors.Wrap(err, errParseMetrics)
+	}
+	for _, m := range mfs[MetricReconcileTime].GetMetric() {
+		if !hasLabel(m, labelController, controller) || m.GetHistogram() == nil {
+			continue
+		}
+		dh := m.GetHistogram()
+		h := Histogram{Count: dh.GetSampleCount(), Sum: dh.GetSampleSum()}
+		for _, b := range dh.GetBucket() {
+			h.Buckets = append(h.Buckets, Bucket{UpperBound: b.GetUpperBound(), Count: b.GetCumulativeCount()})
+		}
+		sort.Slice(h.Buckets, func(i, j int) bool { return h.Buckets[i].UpperBound < h.Buckets[j].UpperBound })
+		if len(h.Buckets) == 0 || !math.IsInf(h.Buckets[len(h.Buckets)-1].UpperBound, 1) {
+			h.Buckets = append(h.Buckets, Bucket{UpperBound: math.Inf(1), Count: h.Count})
+		}
+		return h, nil
+	}
+	return Histogram{}, errors.Errorf(errNoHistogramFmt, MetricReconcileTime, controller)
+}
+
+func hasLabel(m *dto.Metric, name, value string) bool {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue() == value
+		}
+	}
+	return false
+}
+
+// Sub returns the observations made since the supplied earlier histogram of
+// the same metric. Buckets must match.
+func (h Histogram) Sub(earlier Histogram) Histogram {
+	d := Histogram{Count: h.Count - earlier.Count, Sum: h.Sum - earlier.Sum, Buckets: make([]Bucket, len(h.Buckets))}
+	for i, b := range h.Buckets {
+		d.Buckets[i] = b
+		if i < len(earlier.Buckets) && earlier.Buckets[i].UpperBound == b.UpperBound {
+			d.Buckets[i].Count -= earlier.Buckets[i].Count
+		}
+	}
+	return d
+}
+
+// Quantile estimates the supplied quantile, from 0 to 1, of the histogram's
+// observations, interpolating linearly within buckets like Prometheus's
+// histogram_quantile. It returns NaN if there are no observations, and the
+// upper bound of the last finite bucket if the quantile is in the +Inf
+// bucket.
+func (h Histogram) Quantile(q float64) float64 {
+	if h.Count == 0 || len(h.Buckets) == 0 {
+		return math.NaN()
+	}
+	rank := q * float64(h.Count)
+	lower, below := 0.0, uint64(0)
+	for _, b := range h.Buckets {
+		if float64(b.Count) >= rank {
+			if math.IsInf(b.UpperBound, 1) {
+				return lower
+			}
+			in := b.Count - below
+			if in == 0 {
+				return b.UpperBound
+			}
+			return lower + (b.UpperBound-lower)*(rank-float64(below))/float64(in)
+		}
+		lower, below = b.UpperBound, b.Count
+	}
+	return lower
+}
+
+// Mean returns the mean of the histogram's observations, or NaN if there are
+// none.
+func (h Histogram) Mean() float64 {
+	if h.Count == 0 {
+		return math.NaN()
+	}
+	return h.Sum / float64(h.Count)
+}
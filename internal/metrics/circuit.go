@@ -0,0 +1,37 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// CircuitOpen is whether the circuit of each kind of managed resource that
+// uses each provider config in each tenant is open: 1 if it is, and 0 if it's closed.
+var CircuitOpen = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "circuit_open",
+	Help:      "Whether calls to the backend on behalf of a kind of managed resource that uses a provider config are short-circuited.",
+}, []string{LabelKind, LabelProviderConfig, LabelTenant})
+
+// CircuitTrips is the number of times each circuit opened, including when a
+// probe of an open circuit failed.
+var CircuitTrips = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "circuit_trips_total",
+	Help:      "The number of times calls to the backend on behalf of a kind of managed resource that uses a provider config started being short-circuited.",
+}, []string{LabelKind, LabelProviderConfig, LabelTenant})
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BackendClockSkew is the most recently measured difference between the
+// backend's clock and the provider's, by the backend timestamps of the kind
+// that measured it.
+var BackendClockSkew = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "backend_clock_skew_seconds",
+	Help:      "The most recently measured difference between the backend's clock and the provider's, in seconds. Positive when the backend's clock is ahead.",
+}, []string{LabelKind})
+
+// NormalizedTimestamps is the number of implausible backend timestamps that
+// were normalized to the provider's clock.
+var NormalizedTimestamps = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "normalized_timestamps_total",
+	Help:      "The number of implausible backend timestamps that were normalized to the provider's clock.",
+}, []string{LabelKind})
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Labels that identify a managed resource.
+const (
+	LabelNamespace = "namespace"
+	LabelName      = "name"
+)
+
+// ConditionFlaps is the number of times each managed resource's Ready
+// condition transitioned between True and False.
+var ConditionFlaps = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "condition_flaps_total",
+	Help:      "The number of times a managed resource's Ready condition transitioned between True and False.",
+}, []string{LabelKind, LabelNamespace, LabelName})
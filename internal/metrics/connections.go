@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BackendConnectionsOpen is the number of connections to the backend that are
+// open: dialed, and not yet closed.
+var BackendConnectionsOpen = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "backend_connections_open",
+	Help:      "The number of connections to the backend that are open.",
+})
+
+// BackendConnectionsLeased is the number of leases of pooled connections to
+// the backend that haven't been released.
+var BackendConnectionsLeased = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "backend_connections_leased",
+	Help:      "The number of leases of pooled connections to the backend that haven't been released.",
+})
+
+// BackendConnectionsDialed is the number of connections to the backend that
+// were dialed.
+var BackendConnectionsDialed = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "backend_connections_dialed_total",
+	Help:      "The number of connections to the backend that were dialed.",
+})
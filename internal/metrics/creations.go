@@ -0,0 +1,37 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ThrottledCreates is the number of creates of external resources that the
+// provider's creation rate limiter queued, rather than allowed immediately.
+var ThrottledCreates = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "throttled_creates_total",
+	Help:      "The number of creates of external resources queued by the provider's creation rate limiter.",
+}, []string{LabelKind})
+
+// QueuedCreates is how many managed resources are waiting for their turn to
+// create their external resource.
+var QueuedCreates = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "queued_creates",
+	Help:      "How many managed resources are waiting for the provider's creation rate limiter to allow them to create their external resource.",
+})
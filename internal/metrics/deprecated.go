@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// LabelAPIVersion is the version of the bork API served by a backend.
+const LabelAPIVersion = "api_version"
+
+// DeprecatedAPIObservations is the number of observations of external
+// resources that were served by a backend whose API version differs from the
+// version the provider expects.
+var DeprecatedAPIObservations = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "deprecated_api_observations_total",
+	Help:      "The number of observations of external resources served by a deprecated bork API version.",
+}, []string{LabelKind, LabelProviderConfig, LabelAPIVersion})
+
+// DeduplicatedCreates is the number of creates of external resources that the
+// backend deduplicated, because an earlier create with the same idempotency
+// key had already created the external resource.
+var DeduplicatedCreates = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "deduplicated_creates_total",
+	Help:      "The number of creates of external resources deduplicated by the backend's idempotency keys.",
+}, []string{LabelKind, LabelProviderConfig})
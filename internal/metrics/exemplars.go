@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// LabelTraceID is the label of the exemplars that link an observation to the
+// trace it was made in.
+const LabelTraceID = "trace_id"
+
+// metricsPath is the path the metrics server serves metrics at.
+const metricsPath = "/metrics"
+
+// ObserveWithTrace observes the supplied value. If the supplied context's span
+// is sampled its trace ID is attached to the observation as an exemplar, so
+// that a dashboard can jump from a slow bucket to a trace that landed in it.
+func ObserveWithTrace(ctx context.Context, o prometheus.Observer, v float64) {
+	sc := trace.SpanContextFromContext(ctx)
+	eo, ok := o.(prometheus.ExemplarObserver)
+	if !ok || !sc.IsSampled() {
+		o.Observe(v)
+		return
+	}
+	eo.ObserveWithExemplar(v, prometheus.Labels{LabelTraceID: sc.TraceID().String()})
+}
+
+// OpenMetrics is a metrics server filter provider that serves metrics in the
+// OpenMetrics format to scrapers that accept it. Exemplars are only exposed
+// in the OpenMetrics format, which the metrics server doesn't otherwise
+// negotiate.
+func OpenMetrics(_ *rest.Config, _ *http.Client) (metricsserver.Filter, error) {
+	om := promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{
+		ErrorHandling:     promhttp.HTTPErrorOnError,
+		EnableOpenMetrics: true,
+	})
+	return func(_ logr.Logger, h http.Handler) (http.Handler, error) {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The filter wraps the server's extra handlers too, like
+			// the pprof handlers, which it passes through.
+			if r.URL.Path != metricsPath {
+				h.ServeHTTP(w, r)
+				return
+			}
+			om.ServeHTTP(w, r)
+		}), nil
+	}, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// External operations recorded by the external operation metrics, which are
+// the values of their verb label.
+const (
+	OperationObserve = "observe"
+	OperationCreate  = "create"
+	OperationUpdate  = "update"
+	OperationDelete  = "delete"
+)
+
+// Results of external operations.
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+// Labels of the external operation metrics. The provider config label is the
+// kind and name of the provider config a managed resource references, e.g.
+// ClusterProviderConfig/default. The tenant label is the backend tenant a
+// managed resource belongs to; it's empty unless the backend is partitioned
+// by namespace.
+const (
+	LabelKind           = "kind"
+	LabelProviderConfig = "provider_config"
+	LabelVerb           = "verb"
+	LabelResult         = "result"
+	LabelTenant         = "tenant"
+)
+
+var externalLabels = []string{LabelKind, LabelProviderConfig, LabelVerb, LabelTenant}
+
+// ExternalOperationDuration is how long external operations take, including
+// those that fail. Every one of its series has a bucket per boundary, so its
+// labels are bounded by the provider's kinds rather than by its provider
+// configs or tenants, which large tests create thousands of. Observations
+// made while tracing have the trace ID as an exemplar.
+var ExternalOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "bork",
+	Name:      "external_operation_duration_seconds",
+	Help:      "How long operations on external resources take.",
+	Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+}, []string{LabelKind, LabelVerb, LabelResult})
+
+// ExternalOperationErrors is the number of external operations that failed.
+var ExternalOperationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "external_operation_errors_total",
+	Help:      "The number of operations on external resources that failed.",
+}, externalLabels)
+
+// ExternalOperationPanics is the number of external operations that panicked.
+// A panicked operation is also counted as one that failed.
+var ExternalOperationPanics = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "external_operation_panics_total",
+	Help:      "The number of operations on external resources that panicked.",
+}, externalLabels)
+
+// Collectors returns every metric exposed by this package, for registration.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		PausedResources, OrphanedResources, LeakedResources, ExternalOperationDuration, ExternalOperationErrors, ExternalOperationPanics,
+		ReconcileWorkersActive, ReconcileWorkersLimit, ReconcileWorkerSaturation,
+		ProviderConfigReconcilesActive, ProviderConfigReconcilesLimit, ProviderConfigReconcilesDeferred,
+		QuotaRemaining, DeprecatedAPIObservations, ConditionFlaps,
+		QueueAdds, QueueRequeues, Queues,
+		CircuitOpen, CircuitTrips,
+		BackendConnectionsOpen, BackendConnectionsLeased, BackendConnectionsDialed,
+		DeduplicatedCreates, ThrottledCreates, QueuedCreates, RetryBudgetsExhausted,
+		BackendClockSkew, NormalizedTimestamps,
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const errListExternalFmt = "cannot list external resources of kind %q"
+
+// OrphanedResources is the number of external resources of each kind that
+// remain in the backend but that no managed resource refers to by external
+// name. Deleting a managed resource whose management policies don't allow
+// Delete orphans its external resource, which is then counted here. Each
+// backend tenant's external resources are counted separately.
+var OrphanedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "orphaned_external_resources",
+	Help:      "The number of external resources in the backend that no managed resource refers to.",
+}, []string{"gvk", LabelTenant})
+
+// Actions taken on leaked external resources.
+const (
+	LeakActionReported = "reported"
+	LeakActionDeleted  = "deleted"
+)
+
+// LeakedResources is the number of leaked external resources, those that were
+// orphaned for longer than the leak sweeper's grace period, that the sweeper
+// reported or deleted.
+var LeakedResources = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "leaked_external_resources_total",
+	Help:      "The number of leaked external resources the leak sweeper reported or deleted.",
+}, []string{"gvk", LabelTenant, "action"})
+
+// An Inventory lists the external resources stored by a backend, which may be
+// partitioned between tenants.
+type Inventory interface {
+	// Tenants returns the backend's tenants. A backend that isn't
+	// partitioned has one tenant, "".
+	Tenants() []string
+
+	// Tenant returns the tenant that managed resources in the supplied
+	// namespace belong to.
+	Tenant(namespace string) string
+
+	// List returns the page of the names of the stored resources of the
+	// supplied backend kind that belong to the supplied tenant that starts
+	// at the supplied page token, and the token of the next page. The first
+	// page's token is empty, as is the last page's next token. A name may
+	// appear on more than one page.
+	List(ctx context.Context, tenant, kind, pageToken string) (names []string, next string, err error)
+}
+
+// ListNames returns the names of the stored resources of the supplied backend
+// kind that belong to the supplied tenant, listing every page of them and
+// dropping the names that appear on more than one page.
+func ListNames(ctx context.Context, inv Inventory, tenant, kind string) (map[string]bool, error) {
+	names := make(map[string]bool)
+	token := ""
+	for {
+		page, next, err := inv.List(ctx, tenant, kind, token)
+		if err != nil {
+			return nil, errors.Wrapf(err, errListExternalFmt, kind)
+		}
+		for _, name := range page {
+			names[name] = true
+		}
+		if next == "" {
+			return names, nil
+		}
+		token = next
+	}
+}
+
+// An OrphanRecorder periodically records how many external resources of one
+// kind remain in the backend without a managed resource.
+type OrphanRecorder struct {
+	client    client.Client
+	log       logging.Logger
+	gauge     *prometheus.GaugeVec
+	inventory Inventory
+	kind      string
+	newList   func() resource.ManagedList
+	interval  time.Duration
+}
+
+// NewOrphanRecorder returns a recorder that counts the external resources of
+// the supplied backend kind that none of the managed resources listed by the
+// supplied function refer to, every interval, recording the count using the
+// supplied gauge. newList must return an empty list of the managed resource
+// kind that manages the backend kind.
+func NewOrphanRecorder(c client.Client, log logging.Logger, gauge *prometheus.GaugeVec, inv Inventory, kind string, newList func() resource.ManagedList, interval time.Duration) *OrphanRecorder {
+	return &OrphanRecorder{
+		client:    c,
+		log:       log,
+		gauge:     gauge,
+		inventory: inv,
+		kind:      kind,
+		newList:   newList,
+		interval:  interval,
+	}
+}
+
+// Record records the number of orphaned external resources.
+func (r *OrphanRecorder) Record(ctx context.Context) error {
+	l := r.newList()
+	if err := r.client.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListMRs)
+	}
+	gvk, err := apiutil.GVKForObject(l, r.client.Scheme())
+	if err != nil {
+		return errors.Wrap(err, errGetGVK)
+	}
+
+	managed := make(map[string]map[string]bool)
+	for _, mg := range l.GetItems() {
+		name := meta.GetExternalName(mg)
+		if name == "" {
+			continue
+		}
+		tenant := r.inventory.Tenant(mg.GetNamespace())
+		if managed[tenant] == nil {
+			managed[tenant] = make(map[string]bool)
+		}
+		managed[tenant][name] = true
+	}
+
+	// Remove "List" to get the managed resource kind.
+	kind := strings.TrimSuffix(gvk.String(), "List")
+	for _, tenant := range r.inventory.Tenants() {
+		names, err := ListNames(ctx, r.inventory, tenant, r.kind)
+		if err != nil {
+			return err
+		}
+		var orphaned float64
+		for name := range names {
+			if !managed[tenant][name] {
+				orphaned++
+			}
+		}
+		r.gauge.With(prometheus.Labels{"gvk": kind, LabelTenant: tenant}).Set(orphaned)
+	}
+	return nil
+}
+
+// Start records the number of orphaned external resources every interval,
+// until the supplied context is done. Failures to record are logged, and
+// retried at the next interval.
+func (r *OrphanRecorder) Start(ctx context.Context) error {
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := r.Record(ctx); err != nil {
+				r.log.Debug("Cannot record orphaned external resources", "error", err)
+			}
+		}
+	}
+}
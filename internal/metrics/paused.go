@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exposes Prometheus metrics specific to the bork provider.
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	errListMRs = "cannot list managed resources"
+	errGetGVK  = "cannot determine managed resource kind"
+)
+
+// PausedResources is the number of managed resources of each kind that have
+// the crossplane.io/paused annotation, and are therefore not reconciled.
+var PausedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "paused_resources",
+	Help:      "The number of managed resources whose reconciliation is paused.",
+}, []string{"gvk"})
+
+// A PausedRecorder periodically records how many managed resources of one
+// kind are paused.
+type PausedRecorder struct {
+	client   client.Client
+	log      logging.Logger
+	gauge    *prometheus.GaugeVec
+	newList  func() resource.ManagedList
+	interval time.Duration
+}
+
+// NewPausedRecorder returns a recorder that counts the paused managed
+// resources listed by the supplied function every interval, recording the
+// count using the supplied gauge. newList must return an empty list of the
+// managed resource kind.
+func NewPausedRecorder(c client.Client, log logging.Logger, gauge *prometheus.GaugeVec, newList func() resource.ManagedList, interval time.Duration) *PausedRecorder {
+	return &PausedRecorder{
+		client:   c,
+		log:      log,
+		gauge:    gauge,
+		newList:  newList,
+		interval: interval,
+	}
+}
+
+// Record records the number of paused managed resources.
+func (r *PausedRecorder) Record(ctx context.Context) error {
+	l := r.newList()
+	if err := r.client.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListMRs)
+	}
+	gvk, err := apiutil.GVKForObject(l, r.client.Scheme())
+	if err != nil {
+		return errors.Wrap(err, errGetGVK)
+	}
+
+	var paused float64
+	for _, mg := range l.GetItems() {
+		if meta.IsPaused(mg) {
+			paused++
+		}
+	}
+
+	// Remove "List" to get the managed resource kind.
+	r.gauge.With(prometheus.Labels{"gvk": strings.TrimSuffix(gvk.String(), "List")}).Set(paused)
+	return nil
+}
+
+// Start records the number of paused managed resources every interval, until
+// the supplied context is done. Failures to record are logged, and retried at
+// the next interval.
+func (r *PausedRecorder) Start(ctx context.Context) error {
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := r.Record(ctx); err != nil {
+				r.log.Debug("Cannot record paused managed resources", "error", err)
+			}
+		}
+	}
+}
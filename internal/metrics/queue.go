@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// LabelController is the name of the controller a workqueue belongs to. A
+// kind may have more than one controller, e.g. the provider config kinds.
+const LabelController = "controller"
+
+var queueLabels = []string{LabelKind, LabelController}
+
+// QueueAdds is the number of requests added to each controller's workqueue,
+// whether or not they were already queued.
+var QueueAdds = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "workqueue_adds_total",
+	Help:      "The number of requests added to a controller's workqueue.",
+}, queueLabels)
+
+// QueueRequeues is the number of requests each controller's workqueue
+// requeued after a backoff, because their reconcile failed or asked to be
+// requeued.
+var QueueRequeues = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "workqueue_requeues_total",
+	Help:      "The number of requests a controller's workqueue requeued after a backoff.",
+}, queueLabels)
+
+// Queues reports the depth of each controller's workqueue, and the age
+// of the request that has waited longest in it, as of when they're scraped.
+var Queues = &queues{
+	depth:       prometheus.NewDesc("bork_workqueue_depth", "The number of requests waiting for a worker in a controller's workqueue.", queueLabels, nil),
+	age:         prometheus.NewDesc("bork_workqueue_oldest_item_age_seconds", "How long the request that has waited longest for a worker in a controller's workqueue has waited.", queueLabels, nil),
+	controllers: make(map[string]*queueOf),
+}
+
+// A queueOf is the workqueue of a controller of a kind.
+type queueOf struct {
+	kind  string
+	queue interface {
+		Len() int
+		oldest(now time.Time) time.Duration
+	}
+}
+
+type queues struct {
+	depth *prometheus.Desc
+	age   *prometheus.Desc
+
+	mu          sync.RWMutex
+	controllers map[string]*queueOf
+}
+
+// Describe the metrics of every workqueue.
+func (q *queues) Describe(ch chan<- *prometheus.Desc) {
+	ch <- q.depth
+	ch <- q.age
+}
+
+// Collect the metrics of every workqueue.
+func (q *queues) Collect(ch chan<- prometheus.Metric) {
+	now := time.Now()
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	for name, wq := range q.controllers {
+		ch <- prometheus.MustNewConstMetric(q.depth, prometheus.GaugeValue, float64(wq.queue.Len()), wq.kind, name)
+		ch <- prometheus.MustNewConstMetric(q.age, prometheus.GaugeValue, wq.queue.oldest(now).Seconds(), wq.kind, name)
+	}
+}
+
+// InstrumentQueue returns the supplied workqueue of the named controller of
+// the supplied kind, instrumented so that its depth, oldest item age, adds and
+// requeues are reported. A request that's requeued after a backoff isn't
+// waiting for a worker until its backoff passes, so it isn't aged until it's
+// added again.
+func InstrumentQueue[T comparable](kind, controller string, wq workqueue.TypedRateLimitingInterface[T]) workqueue.TypedRateLimitingInterface[T] {
+	iq := &instrumentedQueue[T]{
+		TypedRateLimitingInterface: wq,
+		adds:                       QueueAdds.WithLabelValues(kind, controller),
+		requeues:                   QueueRequeues.WithLabelValues(kind, controller),
+		controller:                 controller,
+		added:                      make(map[T]time.Time),
+	}
+	Queues.mu.Lock()
+	Queues.controllers[controller] = &queueOf{kind: kind, queue: iq}
+	Queues.mu.Unlock()
+	return iq
+}
+
+type instrumentedQueue[T comparable] struct {
+	workqueue.TypedRateLimitingInterface[T]
+	adds       prometheus.Counter
+	requeues   prometheus.Counter
+	controller string
+
+	// added is when each item that's waiting for a worker was first added.
+	mu    sync.Mutex
+	added map[T]time.Time
+}
+
+func (q *instrumentedQueue[T]) Add(item T) {
+	q.adds.Inc()
+	q.mu.Lock()
+	if _, ok := q.added[item]; !ok {
+		q.added[item] = time.Now()
+	}
+	q.mu.Unlock()
+	q.TypedRateLimitingInterface.Add(item)
+}
+
+func (q *instrumentedQueue[T]) AddRateLimited(item T) {
+	q.requeues.Inc()
+	q.TypedRateLimitingInterface.AddRateLimited(item)
+}
+
+func (q *instrumentedQueue[T]) Get() (T, bool) {
+	item, shutdown := q.TypedRateLimitingInterface.Get()
+	q.mu.Lock()
+	delete(q.added, item)
+	q.mu.Unlock()
+	return item, shutdown
+}
+
+func (q *instrumentedQueue[T]) ShutDown() {
+	q.forget()
+	q.TypedRateLimitingInterface.ShutDown()
+}
+
+func (q *instrumentedQueue[T]) ShutDownWithDrain() {
+	q.forget()
+	q.TypedRateLimitingInterface.ShutDownWithDrain()
+}
+
+// forget stops reporting the depth and oldest item age of the queue.
+func (q *instrumentedQueue[T]) forget() {
+	Queues.mu.Lock()
+	defer Queues.mu.Unlock()
+	if wq, ok := Queues.controllers[q.controller]; ok && wq.queue == q {
+		delete(Queues.controllers, q.controller)
+	}
+}
+
+func (q *instrumentedQueue[T]) oldest(now time.Time) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var age time.Duration
+	for _, t := range q.added {
+		age = max(age, now.Sub(t))
+	}
+	return age
+}
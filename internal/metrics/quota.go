@@ -0,0 +1,32 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// QuotaRemaining is how many more managed resources may use each provider
+// config that has a quota. It's zero once a provider config's quota is
+// exhausted, even if more managed resources than it allows use it. When the
+// backend is partitioned by namespace each tenant has its own quota of each
+// ClusterProviderConfig.
+var QuotaRemaining = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "provider_config_quota_remaining",
+	Help:      "How many more managed resources may use a provider config before its quota is exceeded.",
+}, []string{LabelProviderConfig, LabelTenant})
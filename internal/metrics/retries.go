@@ -0,0 +1,30 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RetryBudgetsExhausted is the number of times a managed resource's retry
+// budget was exhausted, so that it stopped attempting to create or update its
+// external resource.
+var RetryBudgetsExhausted = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "retry_budgets_exhausted_total",
+	Help:      "The number of times a managed resource exhausted its budget of failed attempts to create or update its external resource.",
+}, []string{LabelKind})
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ReconcileWorkersActive is the number of workers of each kind's controller
+// that are reconciling.
+var ReconcileWorkersActive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "reconcile_workers_active",
+	Help:      "The number of workers that are reconciling managed resources.",
+}, []string{LabelKind})
+
+// ReconcileWorkersLimit is how many workers of each kind's controller may
+// reconcile at once.
+var ReconcileWorkersLimit = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "reconcile_workers_limit",
+	Help:      "The number of workers that may reconcile managed resources at once.",
+}, []string{LabelKind})
+
+// ReconcileWorkerSaturation is the fraction of its limit of workers each
+// kind's controller is using. A controller that is saturated, at 1, has
+// requests waiting for a worker whenever its queue isn't empty.
+var ReconcileWorkerSaturation = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "reconcile_worker_saturation",
+	Help:      "The fraction of the workers that may reconcile managed resources at once that are reconciling.",
+}, []string{LabelKind})
+
+// ProviderConfigReconcilesActive is the number of managed resources that use
+// each provider config that are being reconciled, across every kind.
+var ProviderConfigReconcilesActive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "provider_config_reconciles_active",
+	Help:      "The number of managed resources that use a provider config that are being reconciled.",
+}, []string{LabelProviderConfig})
+
+// ProviderConfigReconcilesLimit is how many managed resources that use each
+// provider config with a concurrency limit may be reconciled at once.
+var ProviderConfigReconcilesLimit = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bork",
+	Name:      "provider_config_reconciles_limit",
+	Help:      "The number of managed resources that use a provider config that may be reconciled at once.",
+}, []string{LabelProviderConfig})
+
+// ProviderConfigReconcilesDeferred is the number of reconciles that were
+// deferred because their provider config was at its concurrency limit.
+var ProviderConfigReconcilesDeferred = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bork",
+	Name:      "provider_config_reconciles_deferred_total",
+	Help:      "The number of reconciles deferred because their provider config was at its concurrency limit.",
+}, []string{LabelProviderConfig})
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/audit"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// Audit wraps the supplied connector such that every create, update and
+// delete its clients perform on an external resource is recorded by the
+// default audit log, whether it succeeds or fails. The supplied kind is the
+// kind of managed resource the connector's clients operate on. Updates are
+// recorded with the diff their client's observation found.
+func Audit(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &auditConnector{ExternalConnector: c, kind: kind}
+}
+
+type auditConnector struct {
+	managed.ExternalConnector
+	kind string
+}
+
+func (c *auditConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil || !audit.Default.Enabled() {
+		return ec, err
+	}
+	return &auditClient{ExternalClient: ec, kind: c.kind}, nil
+}
+
+// An auditClient is connected for a single reconcile, in which it observes
+// an external resource before it updates it.
+type auditClient struct {
+	managed.ExternalClient
+	kind string
+	diff string
+}
+
+func (c *auditClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	c.diff = o.Diff
+	return o, err
+}
+
+func (c *auditClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	start := time.Now()
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.record(mg, audit.OperationCreate, "", start, err)
+	return cr, err
+}
+
+func (c *auditClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	start := time.Now()
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.record(mg, audit.OperationUpdate, c.diff, start, err)
+	return u, err
+}
+
+func (c *auditClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	start := time.Now()
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.record(mg, audit.OperationDelete, "", start, err)
+	return d, err
+}
+
+// record an operation on the supplied resource that started at the supplied
+// time. The external name is recorded after the operation, so that a create
+// records the name the backend assigned.
+func (c *auditClient) record(mg resource.Managed, op audit.Operation, diff string, start time.Time, err error) {
+	e := audit.Entry{
+		Time:      start.UTC(),
+		Operation: op,
+		Resource: audit.Resource{
+			Kind:         c.kind,
+			Namespace:    mg.GetNamespace(),
+			Name:         mg.GetName(),
+			UID:          string(mg.GetUID()),
+			ExternalName: meta.GetExternalName(mg),
+		},
+		Diff:    diff,
+		DryRun:  DryRun(mg),
+		Result:  audit.ResultSucceeded,
+		Latency: time.Since(start),
+	}
+	if m, ok := mg.(resource.ModernManaged); ok && m.GetProviderConfigReference() != nil {
+		ref := m.GetProviderConfigReference()
+		e.Actor.ProviderConfig = ref.Kind + "/" + ref.Name
+	}
+	if err != nil {
+		e.Result = audit.ResultFailed
+		e.Error = &audit.Error{Code: string(backend.Code(err)), Message: err.Error()}
+	}
+	audit.Default.Record(e)
+}
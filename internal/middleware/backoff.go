@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Defaults with which polling backs off.
+const (
+	// DefaultBackoffThreshold is how many consecutive observations must find
+	// a resource up to date before its polling backs off.
+	DefaultBackoffThreshold = 5
+
+	// DefaultMaxPollInterval is the longest a resource's polling backs off
+	// to.
+	DefaultMaxPollInterval = 30 * time.Minute
+)
+
+// PollJitter is the fraction by which a backed off poll interval is randomly
+// lengthened or shortened, so that resources that were created together
+// don't keep being polled together.
+const PollJitter = 0.1
+
+// A PollBackoff polls resources that are consistently up to date less often.
+// Once a resource has been observed to be up to date a threshold number of
+// times in a row its poll interval doubles with every further up to date
+// observation, to a maximum. Observing that the resource doesn't exist or is
+// not up to date resets its poll interval.
+//
+// Observations are counted in memory, so every resource's poll interval is
+// reset when the provider restarts.
+type PollBackoff struct {
+	threshold int
+	max       time.Duration
+
+	mu      sync.Mutex
+	streaks map[types.UID]int
+}
+
+// NewPollBackoff returns a PollBackoff that backs off polling resources that
+// have been observed to be up to date threshold times in a row, to at most
+// the supplied interval.
+func NewPollBackoff(threshold int, maxInterval time.Duration) *PollBackoff {
+	return &PollBackoff{
+		threshold: threshold,
+		max:       maxInterval,
+		streaks:   make(map[types.UID]int),
+	}
+}
+
+// Connector wraps the supplied connector such that the observations its
+// clients make are counted by the PollBackoff.
+func (b *PollBackoff) Connector(c managed.ExternalConnector) managed.ExternalConnector {
+	return &backoffConnector{ExternalConnector: c, backoff: b}
+}
+
+// Hook wraps the supplied poll interval hook such that the intervals it
+// returns are backed off for resources that are consistently up to date.
+func (b *PollBackoff) Hook(h managed.PollIntervalHook) managed.PollIntervalHook {
+	return func(mg resource.Managed, d time.Duration) time.Duration {
+		b.mu.Lock()
+		n := b.streaks[mg.GetUID()]
+		b.mu.Unlock()
+		return b.backoff(h(mg, d), n)
+	}
+}
+
+// backoff returns the supplied poll interval backed off for a resource that
+// has been observed to be up to date n times in a row.
+func (b *PollBackoff) backoff(d time.Duration, n int) time.Duration {
+	if n < b.threshold || d >= b.max {
+		return d
+	}
+	for range n - b.threshold + 1 {
+		d *= 2
+		if d >= b.max {
+			d = b.max
+			break
+		}
+	}
+	jitter := time.Duration((2*rand.Float64() - 1) * PollJitter * float64(d))
+	return min(d+jitter, b.max)
+}
+
+// observed counts the supplied observation of the supplied resource.
+func (b *PollBackoff) observed(mg resource.Managed, o managed.ExternalObservation) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if o.ResourceExists && o.ResourceUpToDate {
+		b.streaks[mg.GetUID()]++
+		return
+	}
+	delete(b.streaks, mg.GetUID())
+}
+
+type backoffConnector struct {
+	managed.ExternalConnector
+	backoff *PollBackoff
+}
+
+func (c *backoffConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &backoffClient{ExternalClient: ec, backoff: c.backoff}, nil
+}
+
+type backoffClient struct {
+	managed.ExternalClient
+	backoff *PollBackoff
+}
+
+func (c *backoffClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+	c.backoff.observed(mg, o)
+	return o, nil
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// TypeRetryBudgetExhausted resources have stopped attempting to create or
+// update their external resource, because too many attempts failed.
+const TypeRetryBudgetExhausted xpv1.ConditionType = "RetryBudgetExhausted"
+
+// Reasons a resource's retry budget is or isn't exhausted.
+const (
+	ReasonRetryBudgetExhausted xpv1.ConditionReason = "RetryBudgetExhausted"
+	ReasonRetryBudgetAvailable xpv1.ConditionReason = "RetryBudgetAvailable"
+)
+
+// RetryBudgetWindow is the window within which a resource's failed attempts
+// to create or update its external resource count against its retry budget.
+const RetryBudgetWindow = time.Hour
+
+const (
+	errRetryBudgetExhaustedFmt = "%d attempts to create or update the external resource failed since %s; no more are made until %s, or the spec changes"
+
+	msgRetryBudgetExhaustedFmt = "stopped attempting to %s the external resource: %d of the %d failed attempts allowed per hour have been made; attempts resume at %s, or when the spec changes"
+)
+
+// RetryBudget is how many attempts to create or update the external resource
+// of each managed resource may fail per RetryBudgetWindow. Attempts aren't
+// budgeted if it's zero. It must be set before controllers are set up.
+var RetryBudget = 0
+
+// A RetryBudgetRecorder records its retry budget.
+type RetryBudgetRecorder interface {
+	GetRetryBudget() *v1alpha1.RetryBudget
+	SetRetryBudget(b *v1alpha1.RetryBudget)
+}
+
+// RetryBudgetExhausted returns a condition that indicates the resource has
+// stopped attempting to create or update its external resource.
+func RetryBudgetExhausted(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRetryBudgetExhausted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRetryBudgetExhausted,
+		Message:            msg,
+	}
+}
+
+// RetryBudgetAvailable returns a condition that indicates the resource may
+// attempt to create or update its external resource again.
+func RetryBudgetAvailable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRetryBudgetExhausted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRetryBudgetAvailable,
+	}
+}
+
+// BudgetRetries wraps the supplied connector such that its clients stop
+// attempting to create or update an external resource once RetryBudget
+// attempts have failed within RetryBudgetWindow. The supplied kind is the kind
+// of managed resource the connector's clients operate on. The budget is
+// tracked in the status of each managed resource, and is reset when its
+// window ends, when its spec changes, and when it's observed to be up to date.
+// An attempt that isn't made because the budget is exhausted fails without
+// calling the backend, and sets a RetryBudgetExhausted condition. Exhausting a
+// budget records a RetryBudgetExhausted event. Wrap a connector that records
+// events, so that attempts that were never made aren't recorded as ones that
+// failed.
+func BudgetRetries(kind string, r event.Recorder, c managed.ExternalConnector) managed.ExternalConnector {
+	return &budgetConnector{ExternalConnector: c, kind: kind, record: r, limit: RetryBudget}
+}
+
+type budgetConnector struct {
+	managed.ExternalConnector
+	kind   string
+	record event.Recorder
+	limit  int
+}
+
+func (c *budgetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	if c.limit <= 0 {
+		return ec, nil
+	}
+	return &budgetClient{ExternalClient: ec, kind: c.kind, record: c.record, limit: c.limit}, nil
+}
+
+type budgetClient struct {
+	managed.ExternalClient
+	kind   string
+	record event.Recorder
+	limit  int
+}
+
+func (c *budgetClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err == nil && o.ResourceExists && o.ResourceUpToDate {
+		c.reset(mg)
+	}
+	return o, err
+}
+
+func (c *budgetClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := c.spend(mg, "create"); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.spent(mg, "create", err)
+	return cr, err
+}
+
+func (c *budgetClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if err := c.spend(mg, "update"); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.spent(mg, "update", err)
+	return u, err
+}
+
+// budget returns the supplied resource's retry budget, starting a new window
+// if its window has ended or its spec has changed since it was started.
+func (c *budgetClient) budget(mg resource.Managed, br RetryBudgetRecorder) *v1alpha1.RetryBudget {
+	b := br.GetRetryBudget()
+	now := time.Now()
+	if b == nil || b.ObservedGeneration != mg.GetGeneration() || now.Sub(b.WindowStart.Time) >= RetryBudgetWindow {
+		b = &v1alpha1.RetryBudget{WindowStart: metav1.NewTime(now), ObservedGeneration: mg.GetGeneration()}
+	}
+	b.Limit = c.limit
+	return b
+}
+
+// spend returns an error if the supplied resource may not make the named
+// attempt, because its retry budget is exhausted.
+func (c *budgetClient) spend(mg resource.Managed, op string) error {
+	br, ok := mg.(RetryBudgetRecorder)
+	if !ok {
+		return nil
+	}
+	b := c.budget(mg, br)
+	br.SetRetryBudget(b)
+	if b.FailedAttempts < b.Limit {
+		if mg.GetCondition(TypeRetryBudgetExhausted).Status == corev1.ConditionTrue {
+			mg.SetConditions(RetryBudgetAvailable())
+		}
+		return nil
+	}
+	mg.SetConditions(RetryBudgetExhausted(exhausted(op, b)))
+	return errors.Errorf(errRetryBudgetExhaustedFmt, b.FailedAttempts, b.WindowStart.Format(time.RFC3339), b.WindowStart.Add(RetryBudgetWindow).Format(time.RFC3339))
+}
+
+// spent records that the supplied resource made the named attempt, which
+// returned the supplied error. A successful attempt resets the budget.
+func (c *budgetClient) spent(mg resource.Managed, op string, err error) {
+	br, ok := mg.(RetryBudgetRecorder)
+	if !ok {
+		return
+	}
+	if err == nil {
+		c.reset(mg)
+		return
+	}
+	b := c.budget(mg, br)
+	b.FailedAttempts++
+	br.SetRetryBudget(b)
+	if b.FailedAttempts == b.Limit {
+		msg := exhausted(op, b)
+		mg.SetConditions(RetryBudgetExhausted(msg))
+		c.record.Event(mg, event.Warning(event.Reason(ReasonRetryBudgetExhausted), errors.New(msg)))
+		metrics.RetryBudgetsExhausted.WithLabelValues(c.kind).Inc()
+	}
+}
+
+// reset the supplied resource's retry budget.
+func (c *budgetClient) reset(mg resource.Managed) {
+	br, ok := mg.(RetryBudgetRecorder)
+	if !ok || br.GetRetryBudget() == nil {
+		return
+	}
+	br.SetRetryBudget(nil)
+	if mg.GetCondition(TypeRetryBudgetExhausted).Status == corev1.ConditionTrue {
+		mg.SetConditions(RetryBudgetAvailable())
+	}
+}
+
+func exhausted(op string, b *v1alpha1.RetryBudget) string {
+	return fmt.Sprintf(msgRetryBudgetExhaustedFmt, op, b.FailedAttempts, b.Limit, b.WindowStart.Add(RetryBudgetWindow).Format(time.RFC3339))
+}
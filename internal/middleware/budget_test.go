@@ -0,0 +1,285 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// errBoom is the error the fake external client fails operations with.
+var errBoom = backend.NewError(backend.ErrorCodeInternal, "boom")
+
+// An eventRecorder records the reasons of the events it's asked to record.
+type eventRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+// A fakeClient is an external client whose operations return the supplied
+// observation and error, and which records the operations it performs.
+type fakeClient struct {
+	observation managed.ExternalObservation
+	err         error
+	calls       []string
+}
+
+func (c *fakeClient) connector() managed.ExternalConnector {
+	return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				c.calls = append(c.calls, "Observe")
+				return c.observation, c.err
+			},
+			CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+				c.calls = append(c.calls, "Create")
+				return managed.ExternalCreation{}, c.err
+			},
+			UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+				c.calls = append(c.calls, "Update")
+				return managed.ExternalUpdate{}, c.err
+			},
+			DeleteFn: func(_ context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+				c.calls = append(c.calls, "Delete")
+				return managed.ExternalDelete{}, c.err
+			},
+			DisconnectFn: func(_ context.Context) error { return nil },
+		}, nil
+	})
+}
+
+// call performs the named operation using the supplied client.
+func call(ctx context.Context, ec managed.ExternalClient, op string, mg resource.Managed) error {
+	var err error
+	switch op {
+	case "Observe":
+		_, err = ec.Observe(ctx, mg)
+	case "Create":
+		_, err = ec.Create(ctx, mg)
+	case "Update":
+		_, err = ec.Update(ctx, mg)
+	case "Delete":
+		_, err = ec.Delete(ctx, mg)
+	}
+	return err
+}
+
+func TestBudgetRetries(t *testing.T) {
+	const limit = 3
+
+	// A budget is compared by its failed attempts, and whether its window
+	// is the one the case started with.
+	type budget struct {
+		FailedAttempts int
+		SameWindow     bool
+	}
+	type want struct {
+		err       bool
+		calls     []string
+		budget    *budget
+		condition xpv1.ConditionReason
+		events    []event.Reason
+	}
+
+	start := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	spent := func(n int, generation int64) *v1alpha1.RetryBudget {
+		return &v1alpha1.RetryBudget{Limit: limit, FailedAttempts: n, WindowStart: start, ObservedGeneration: generation}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		limit       int
+		op          string
+		budget      *v1alpha1.RetryBudget
+		generation  int64
+		exhausted   bool
+		observation managed.ExternalObservation
+		err         error
+		want        want
+	}{
+		"NotBudgeted": {
+			reason: "Attempts aren't budgeted when the budget is zero.",
+			op:     "Create",
+			err:    errBoom,
+			want:   want{err: true, calls: []string{"Create"}},
+		},
+		"FirstFailure": {
+			reason: "A failed attempt starts a window, and counts against the budget.",
+			limit:  limit,
+			op:     "Create",
+			err:    errBoom,
+			want:   want{err: true, calls: []string{"Create"}, budget: &budget{FailedAttempts: 1}},
+		},
+		"FailureCounted": {
+			reason: "A failed attempt within the window counts against the budget.",
+			limit:  limit,
+			op:     "Update",
+			budget: spent(1, 0),
+			err:    errBoom,
+			want:   want{err: true, calls: []string{"Update"}, budget: &budget{FailedAttempts: 2, SameWindow: true}},
+		},
+		"Exhausts": {
+			reason: "The attempt that uses the last of the budget exhausts it, and records an event.",
+			limit:  limit,
+			op:     "Update",
+			budget: spent(limit-1, 0),
+			err:    errBoom,
+			want: want{
+				err:       true,
+				calls:     []string{"Update"},
+				budget:    &budget{FailedAttempts: limit, SameWindow: true},
+				condition: ReasonRetryBudgetExhausted,
+				events:    []event.Reason{event.Reason(ReasonRetryBudgetExhausted)},
+			},
+		},
+		"Exhausted": {
+			reason: "An attempt isn't made once the budget is exhausted.",
+			limit:  limit,
+			op:     "Create",
+			budget: spent(limit, 0),
+			want: want{
+				err:       true,
+				budget:    &budget{FailedAttempts: limit, SameWindow: true},
+				condition: ReasonRetryBudgetExhausted,
+			},
+		},
+		"WindowEnded": {
+			reason:    "A new window starts once the window ends, so an exhausted budget may be spent again.",
+			limit:     limit,
+			op:        "Update",
+			budget:    &v1alpha1.RetryBudget{Limit: limit, FailedAttempts: limit, WindowStart: metav1.NewTime(start.Add(-RetryBudgetWindow))},
+			exhausted: true,
+			err:       errBoom,
+			want: want{
+				err:       true,
+				calls:     []string{"Update"},
+				budget:    &budget{FailedAttempts: 1},
+				condition: ReasonRetryBudgetAvailable,
+			},
+		},
+		"GenerationChanged": {
+			reason:     "A new window starts when the spec changes, so an exhausted budget may be spent again.",
+			limit:      limit,
+			op:         "Update",
+			budget:     spent(limit, 1),
+			generation: 2,
+			exhausted:  true,
+			want: want{
+				calls:     []string{"Update"},
+				condition: ReasonRetryBudgetAvailable,
+			},
+		},
+		"SuccessResets": {
+			reason: "A successful attempt resets the budget.",
+			limit:  limit,
+			op:     "Create",
+			budget: spent(limit-1, 0),
+			want:   want{calls: []string{"Create"}},
+		},
+		"UpToDateResets": {
+			reason:      "An exhausted budget is reset when the external resource is observed to be up to date.",
+			limit:       limit,
+			op:          "Observe",
+			budget:      spent(limit, 0),
+			exhausted:   true,
+			observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{
+				calls:     []string{"Observe"},
+				condition: ReasonRetryBudgetAvailable,
+			},
+		},
+		"NotUpToDateKept": {
+			reason:      "A budget isn't reset when the external resource is observed to need an update.",
+			limit:       limit,
+			op:          "Observe",
+			budget:      spent(limit, 0),
+			exhausted:   true,
+			observation: managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				calls:     []string{"Observe"},
+				budget:    &budget{FailedAttempts: limit, SameWindow: true},
+				condition: ReasonRetryBudgetExhausted,
+			},
+		},
+		"ObserveFailedKept": {
+			reason:      "A budget isn't reset when observing fails.",
+			limit:       limit,
+			op:          "Observe",
+			budget:      spent(1, 0),
+			observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			err:         errBoom,
+			want: want{
+				err:    true,
+				calls:  []string{"Observe"},
+				budget: &budget{FailedAttempts: 1, SameWindow: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.BorkResource{}
+			cr.SetGeneration(tc.generation)
+			cr.Status.RetryBudget = tc.budget
+			if tc.exhausted {
+				cr.SetConditions(RetryBudgetExhausted("exhausted"))
+			}
+			fc := &fakeClient{observation: tc.observation, err: tc.err}
+			r := &eventRecorder{}
+			c := &budgetConnector{ExternalConnector: fc.connector(), kind: v1alpha1.BorkResourceKind, record: r, limit: tc.limit}
+
+			ec, err := c.Connect(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = call(context.Background(), ec, tc.op, cr)
+
+			var gotBudget *budget
+			if b := cr.Status.RetryBudget; b != nil {
+				gotBudget = &budget{FailedAttempts: b.FailedAttempts, SameWindow: b.WindowStart.Equal(&start)}
+			}
+			got := want{
+				err:       err != nil,
+				calls:     fc.calls,
+				budget:    gotBudget,
+				condition: cr.GetCondition(TypeRetryBudgetExhausted).Reason,
+				events:    r.reasons,
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\n%s(...): -want, +got:\n%s", tc.reason, tc.op, diff)
+			}
+		})
+	}
+}
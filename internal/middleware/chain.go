@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// A chain configures the middleware Chain wraps a connector with.
+type chain struct {
+	retry     *RetryAfter
+	backoff   *PollBackoff
+	dedupe    bool
+	templates bool
+	tags      bool
+
+	// backend is false for kinds whose external resources aren't backend
+	// resources, and external for kinds that have no external resources.
+	backend  bool
+	external bool
+}
+
+// A ChainOption configures the middleware Chain wraps a connector with.
+type ChainOption func(c *chain)
+
+// WithRetryAfter records what the operations of the chain's clients found
+// with the supplied RetryAfter, whose reconciler then requeues accordingly.
+func WithRetryAfter(r *RetryAfter) ChainOption {
+	return func(c *chain) { c.retry = r }
+}
+
+// WithPollBackoff counts the observations of the chain's clients with the
+// supplied PollBackoff, whose poll interval hook then backs off accordingly.
+func WithPollBackoff(b *PollBackoff) ChainOption {
+	return func(c *chain) { c.backoff = b }
+}
+
+// WithDeduplicatedCreates deduplicates the creates of the chain's clients.
+// See DeduplicateCreates.
+func WithDeduplicatedCreates() ChainOption {
+	return func(c *chain) { c.dedupe = true }
+}
+
+// WithConnectionDetailTemplates renders the connection details templates of
+// managed resources. See TemplateConnectionDetails.
+func WithConnectionDetailTemplates() ChainOption {
+	return func(c *chain) { c.templates = true }
+}
+
+// WithTagPropagation propagates the tags of provider configs to managed
+// resources. See PropagateTags.
+func WithTagPropagation() ChainOption {
+	return func(c *chain) { c.tags = true }
+}
+
+// ForKubernetesObjects omits the middleware that deals with the backend's
+// credentials, quotas, errors and API versions, for kinds whose external
+// resources are Kubernetes objects rather than backend resources.
+func ForKubernetesObjects() ChainOption {
+	return func(c *chain) { c.backend = false }
+}
+
+// ObserveOnly omits the middleware that deals with external names, drift,
+// orphaning and quotas, for kinds that only observe the backend and never
+// create, update or delete an external resource.
+func ObserveOnly() ChainOption {
+	return func(c *chain) { c.external = false }
+}
+
+// Chain wraps the supplied connector with the middleware shared by every
+// kind of managed resource, configured by the supplied options. The supplied
+// kind is the kind of managed resource the connector's clients operate on,
+// and newList must return an empty list of that kind. From outermost to
+// innermost the middleware:
+//
+//   - records decisions, condition history and retry hints;
+//   - recreates, traces, meters and logs operations;
+//   - enforces quotas, throttles creates and budgets retries;
+//   - audits operations and records their events;
+//   - rejects external name conflicts and counts observations for backoff;
+//   - handles sync requests, observed generations, drift, orphaning and
+//     errors;
+//   - recovers panics, breaks circuits and simulates errors;
+//   - deduplicates creates, reports deprecated API versions, templates
+//     connection details, propagates tags and renews credentials.
+//
+// Middleware that records an operation wraps middleware that may refuse to
+// perform it, so that operations that were never attempted aren't recorded as
+// ones that failed.
+func Chain(kind string, log logging.Logger, r event.Recorder, kube client.Reader, newList func() resource.ManagedList, c managed.ExternalConnector, o ...ChainOption) managed.ExternalConnector {
+	ch := &chain{backend: true, external: true}
+	for _, fn := range o {
+		fn(ch)
+	}
+
+	// The chain is built from the innermost middleware out.
+	if ch.backend {
+		c = RenewCredentials(c)
+	}
+	if ch.tags {
+		c = PropagateTags(kube, c)
+	}
+	if ch.templates {
+		c = TemplateConnectionDetails(c)
+	}
+	if ch.backend {
+		c = ReportDeprecatedAPI(kind, c)
+	}
+	if ch.dedupe {
+		c = DeduplicateCreates(kind, c)
+	}
+	c = SimulateErrors(c)
+	c = BreakCircuits(kind, r, kube, c)
+	c = RecoverPanics(kind, log, c)
+	if ch.backend {
+		c = ReportErrors(c)
+	}
+	if ch.external {
+		c = ReportDrift(ReportOrphaning(c))
+	}
+	c = SyncRequests(ObservedGeneration(c))
+	if ch.backoff != nil {
+		c = ch.backoff.Connector(c)
+	}
+	if ch.external {
+		c = RejectConflicts(c, kube, newList)
+	}
+	c = Audit(kind, RecordEvents(r, c))
+	c = ThrottleCreates(kind, r, BudgetRetries(kind, r, c))
+	if ch.backend && ch.external {
+		c = EnforceQuota(r, kube, c)
+	}
+	c = Trace(kind, RecordMetrics(kind, Log(kind, log, c)))
+	c = Recreate(c)
+	if ch.retry != nil {
+		c = ch.retry.Connector(c)
+	}
+	return RecordDecisions(kind, RecordConditionHistory(kind, c))
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// TypeCircuitOpen resources can't call the backend, because too many calls
+// made on behalf of resources of their kind that use their provider config
+// failed in a row.
+const TypeCircuitOpen xpv1.ConditionType = "CircuitOpen"
+
+// Reasons a resource's circuit is or isn't open.
+const (
+	ReasonCircuitOpen   xpv1.ConditionReason = "CircuitOpen"
+	ReasonCircuitClosed xpv1.ConditionReason = "CircuitClosed"
+)
+
+// Defaults of a circuit breaker that doesn't specify them.
+const (
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+)
+
+const (
+	msgCircuitOpenFmt     = "calls to the backend are short-circuited after %d failed in a row; the backend will be probed again at %s"
+	msgCircuitOpenedFmt   = "%d calls to the backend failed in a row; calls are short-circuited until %s"
+	msgCircuitReopenedFmt = "probe of the backend failed; calls are short-circuited until %s"
+	msgCircuitClosed      = "probe of the backend succeeded; calls are no longer short-circuited"
+)
+
+// CircuitOpen returns a condition that indicates the resource's calls to the
+// backend are short-circuited.
+func CircuitOpen(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCircuitOpen,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCircuitOpen,
+		Message:            msg,
+	}
+}
+
+// CircuitClosed returns a condition that indicates the resource's calls to
+// the backend are no longer short-circuited.
+func CircuitClosed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCircuitOpen,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCircuitClosed,
+	}
+}
+
+// BreakCircuits wraps the supplied connector such that its clients stop
+// calling the backend once too many calls in a row have failed, per their
+// provider config's circuit breaker. The supplied kind is the kind of managed
+// resource the connector's clients operate on; each kind that uses a provider
+// config has a circuit of its own, as does each tenant. A call that's
+// short-circuited fails as if the backend were unavailable, and sets a
+// CircuitOpen condition. Opening and closing a circuit records an event.
+//
+// Only failures that suggest the backend is unhealthy count towards opening
+// a circuit: those where it was unavailable, timed out or failed internally.
+// Any other response, like NotFound, shows it's healthy enough to answer.
+// Wrap a connector that simulates errors, so that simulated failures open
+// circuits too.
+func BreakCircuits(kind string, r event.Recorder, kube client.Reader, c managed.ExternalConnector) managed.ExternalConnector {
+	return &circuitConnector{ExternalConnector: c, kind: kind, record: r, kube: kube}
+}
+
+// A circuitKey identifies a circuit.
+type circuitKey struct {
+	kind   string
+	pc     clients.ProviderConfigKey
+	tenant string
+}
+
+// circuits are every circuit, shared by every controller.
+var circuits = struct {
+	mu sync.Mutex
+	m  map[circuitKey]*circuit
+}{m: make(map[circuitKey]*circuit)}
+
+// circuitFor returns the circuit with the supplied key, creating it if
+// needed.
+func circuitFor(k circuitKey) *circuit {
+	circuits.mu.Lock()
+	defer circuits.mu.Unlock()
+	c, ok := circuits.m[k]
+	if !ok {
+		c = &circuit{}
+		circuits.m[k] = c
+	}
+	return c
+}
+
+// A circuit counts calls that failed in a row, and whether it's open.
+type circuit struct {
+	mu       sync.Mutex
+	failures int64
+
+	// openUntil is when an open circuit may next be probed. It's zero while
+	// the circuit is closed.
+	openUntil time.Time
+
+	// probing is whether a probe of an open circuit is in flight.
+	probing bool
+}
+
+// allow returns true if a call may be made at the supplied time. If the
+// circuit is open, and is due to be probed, the call is the probe; if it
+// isn't, allow returns when it will be. A probe that hasn't reported its
+// result within the supplied open duration is presumed lost.
+func (c *circuit) allow(now time.Time, open time.Duration) (bool, time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case c.openUntil.IsZero():
+		return true, time.Time{}
+	case now.Before(c.openUntil):
+		return false, c.openUntil
+	}
+	c.probing = true
+	c.openUntil = now.Add(open)
+	return true, time.Time{}
+}
+
+// succeeded records that a call succeeded, closing the circuit. It returns
+// true if the circuit was open.
+func (c *circuit) succeeded() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	wasOpen := !c.openUntil.IsZero()
+	c.failures, c.openUntil, c.probing = 0, time.Time{}, false
+	return wasOpen
+}
+
+// failed records that a call failed at the supplied time. It opens the
+// circuit if it's a probe that failed, or if the supplied threshold of calls
+// have now failed in a row. It returns when a circuit it opened may next be
+// probed, which is zero if it opened none, and whether the call was a probe.
+func (c *circuit) failed(now time.Time, threshold int64, open time.Duration) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures++
+	probe := c.probing
+	if probe || (c.openUntil.IsZero() && c.failures >= threshold) {
+		c.probing = false
+		c.openUntil = now.Add(open)
+		return c.openUntil, probe
+	}
+	return time.Time{}, false
+}
+
+// unhealthy returns true if the supplied error suggests the backend is
+// unhealthy.
+func unhealthy(err error) bool {
+	return backend.IsUnavailable(err) || backend.IsTimeout(err) || backend.IsInternal(err)
+}
+
+type circuitConnector struct {
+	managed.ExternalConnector
+	kind   string
+	record event.Recorder
+	kube   client.Reader
+}
+
+func (c *circuitConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	// The wrapped connector already resolved the provider config, so it
+	// reports any error resolving it.
+	key, pc, rerr := clients.ResolveProviderConfig(ctx, c.kube, mg)
+	if rerr != nil || pc.CircuitBreaker == nil {
+		// A resource whose provider config no longer breaks circuits
+		// isn't short-circuited.
+		if mg.GetCondition(TypeCircuitOpen).Status == corev1.ConditionTrue {
+			mg.SetConditions(CircuitClosed())
+		}
+		return ec, nil
+	}
+	return &circuitClient{
+		ExternalClient: ec,
+		kind:           c.kind,
+		record:         c.record,
+		config:         pc.CircuitBreaker,
+		circuit:        circuitFor(circuitKey{kind: c.kind, pc: key, tenant: backend.DefaultTenants.Tenant(mg.GetNamespace())}),
+	}, nil
+}
+
+type circuitClient struct {
+	managed.ExternalClient
+	kind    string
+	record  event.Recorder
+	config  *apisv1alpha1.CircuitBreakerConfig
+	circuit *circuit
+}
+
+func (c *circuitClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if err := c.allow(mg); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	c.result(mg, err)
+	return o, err
+}
+
+func (c *circuitClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := c.allow(mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.result(mg, err)
+	return cr, err
+}
+
+func (c *circuitClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if err := c.allow(mg); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.result(mg, err)
+	return u, err
+}
+
+func (c *circuitClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if err := c.allow(mg); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.result(mg, err)
+	return d, err
+}
+
+func (c *circuitClient) threshold() int64 {
+	if c.config.FailureThreshold > 0 {
+		return c.config.FailureThreshold
+	}
+	return defaultFailureThreshold
+}
+
+func (c *circuitClient) open() time.Duration {
+	if c.config.OpenDuration != nil && c.config.OpenDuration.Duration > 0 {
+		return c.config.OpenDuration.Duration
+	}
+	return defaultOpenDuration
+}
+
+// allow returns an error that satisfies backend.IsUnavailable if the supplied
+// resource's call should be short-circuited.
+func (c *circuitClient) allow(mg resource.Managed) error {
+	ok, probeAt := c.circuit.allow(time.Now(), c.open())
+	if ok {
+		return nil
+	}
+	msg := fmt.Sprintf(msgCircuitOpenFmt, c.threshold(), probeAt.UTC().Format(time.RFC3339))
+	mg.SetConditions(CircuitOpen(msg))
+	return backend.NewError(backend.ErrorCodeUnavailable, msg)
+}
+
+// result records the result of the supplied resource's call.
+func (c *circuitClient) result(mg resource.Managed, err error) {
+	l := prometheus.Labels{metrics.LabelKind: c.kind, metrics.LabelProviderConfig: providerConfig(mg), metrics.LabelTenant: backend.DefaultTenants.Tenant(mg.GetNamespace())}
+
+	if err == nil || !unhealthy(err) {
+		if c.circuit.succeeded() {
+			metrics.CircuitOpen.With(l).Set(0)
+			c.record.Event(mg, event.Normal(event.Reason(ReasonCircuitClosed), msgCircuitClosed))
+		}
+		if mg.GetCondition(TypeCircuitOpen).Status == corev1.ConditionTrue {
+			mg.SetConditions(CircuitClosed())
+		}
+		return
+	}
+
+	until, probe := c.circuit.failed(time.Now(), c.threshold(), c.open())
+	if until.IsZero() {
+		return
+	}
+	msg := fmt.Sprintf(msgCircuitOpenedFmt, c.threshold(), until.UTC().Format(time.RFC3339))
+	if probe {
+		msg = fmt.Sprintf(msgCircuitReopenedFmt, until.UTC().Format(time.RFC3339))
+	}
+	metrics.CircuitOpen.With(l).Set(1)
+	metrics.CircuitTrips.With(l).Inc()
+	mg.SetConditions(CircuitOpen(msg))
+	c.record.Event(mg, event.Warning(event.Reason(ReasonCircuitOpen), errors.New(msg)))
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// A circuitStep is a call of a circuit's state machine at an offset from the
+// start of a case, and what it should return.
+type circuitStep struct {
+	call string
+	at   time.Duration
+	want circuitResult
+}
+
+// A circuitResult is what a call of a circuit returns. At is an offset from
+// the start of a case; zero means no time was returned.
+type circuitResult struct {
+	Allowed bool
+	At      time.Duration
+	Probe   bool
+	WasOpen bool
+}
+
+func TestCircuit(t *testing.T) {
+	const (
+		threshold = 3
+		open      = 30 * time.Second
+	)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// offset returns the supplied time as an offset from the start of a
+	// case, or zero if it's zero.
+	offset := func(t time.Time) time.Duration {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Sub(start)
+	}
+
+	// tripped are the steps that open a circuit at 2s, until 32s.
+	tripped := []circuitStep{
+		{call: "failed", at: 0},
+		{call: "failed", at: time.Second},
+		{call: "failed", at: 2 * time.Second, want: circuitResult{At: 32 * time.Second}},
+	}
+
+	cases := map[string]struct {
+		reason string
+		steps  []circuitStep
+	}{
+		"Closed": {
+			reason: "A circuit that hasn't failed allows every call.",
+			steps: []circuitStep{
+				{call: "allow", at: 0, want: circuitResult{Allowed: true}},
+				{call: "succeeded", at: time.Second},
+				{call: "allow", at: 2 * time.Second, want: circuitResult{Allowed: true}},
+			},
+		},
+		"ThresholdOpens": {
+			reason: "A circuit opens once the threshold of calls have failed in a row, and then short-circuits calls until it may be probed.",
+			steps: append(tripped,
+				circuitStep{call: "allow", at: 3 * time.Second, want: circuitResult{At: 32 * time.Second}},
+				circuitStep{call: "allow", at: 31 * time.Second, want: circuitResult{At: 32 * time.Second}},
+			),
+		},
+		"SuccessResetsFailures": {
+			reason: "Failures only open a circuit if they're in a row; a success starts the count again.",
+			steps: []circuitStep{
+				{call: "failed", at: 0},
+				{call: "failed", at: time.Second},
+				{call: "succeeded", at: 2 * time.Second},
+				{call: "failed", at: 3 * time.Second},
+				{call: "failed", at: 4 * time.Second},
+				{call: "allow", at: 5 * time.Second, want: circuitResult{Allowed: true}},
+			},
+		},
+		"OneProbe": {
+			reason: "An open circuit that's due to be probed allows exactly one call, the probe, and short-circuits the rest until the probe reports.",
+			steps: append(tripped,
+				circuitStep{call: "allow", at: 32 * time.Second, want: circuitResult{Allowed: true}},
+				circuitStep{call: "allow", at: 33 * time.Second, want: circuitResult{At: 62 * time.Second}},
+				circuitStep{call: "allow", at: 34 * time.Second, want: circuitResult{At: 62 * time.Second}},
+			),
+		},
+		"FailedProbeReopens": {
+			reason: "A probe that fails opens the circuit again, however many calls have failed.",
+			steps: append(tripped,
+				circuitStep{call: "allow", at: 32 * time.Second, want: circuitResult{Allowed: true}},
+				circuitStep{call: "failed", at: 33 * time.Second, want: circuitResult{At: 63 * time.Second, Probe: true}},
+				circuitStep{call: "allow", at: 40 * time.Second, want: circuitResult{At: 63 * time.Second}},
+			),
+		},
+		"SucceededProbeCloses": {
+			reason: "A probe that succeeds closes the circuit, which then allows every call.",
+			steps: append(tripped,
+				circuitStep{call: "allow", at: 32 * time.Second, want: circuitResult{Allowed: true}},
+				circuitStep{call: "succeeded", at: 33 * time.Second, want: circuitResult{WasOpen: true}},
+				circuitStep{call: "allow", at: 34 * time.Second, want: circuitResult{Allowed: true}},
+				circuitStep{call: "failed", at: 35 * time.Second},
+			),
+		},
+		"LostProbeExpires": {
+			reason: "A probe that doesn't report within the open duration is presumed lost, so another probe is allowed.",
+			steps: append(tripped,
+				circuitStep{call: "allow", at: 32 * time.Second, want: circuitResult{Allowed: true}},
+				circuitStep{call: "allow", at: 61 * time.Second, want: circuitResult{At: 62 * time.Second}},
+				circuitStep{call: "allow", at: 62 * time.Second, want: circuitResult{Allowed: true}},
+				circuitStep{call: "failed", at: 63 * time.Second, want: circuitResult{At: 93 * time.Second, Probe: true}},
+			),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &circuit{}
+			for i, s := range tc.steps {
+				now := start.Add(s.at)
+				got := circuitResult{}
+				switch s.call {
+				case "allow":
+					ok, at := c.allow(now, open)
+					got = circuitResult{Allowed: ok, At: offset(at)}
+				case "failed":
+					until, probe := c.failed(now, threshold, open)
+					got = circuitResult{At: offset(until), Probe: probe}
+				case "succeeded":
+					got = circuitResult{WasOpen: c.succeeded()}
+				}
+				if diff := cmp.Diff(s.want, got); diff != "" {
+					t.Errorf("\n%s\nstep %d, %s at %s: -want, +got:\n%s", tc.reason, i, s.call, s.at, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUnhealthy(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   bool
+	}{
+		"Unavailable": {
+			reason: "A backend that's unavailable is unhealthy.",
+			err:    backend.NewError(backend.ErrorCodeUnavailable, "boom"),
+			want:   true,
+		},
+		"Timeout": {
+			reason: "A backend that timed out is unhealthy.",
+			err:    backend.NewError(backend.ErrorCodeTimeout, "boom"),
+			want:   true,
+		},
+		"DeadlineExceeded": {
+			reason: "A call whose deadline was exceeded timed out.",
+			err:    errors.Wrap(context.DeadlineExceeded, "boom"),
+			want:   true,
+		},
+		"Internal": {
+			reason: "A backend that failed internally is unhealthy.",
+			err:    errors.Wrap(backend.NewError(backend.ErrorCodeInternal, "boom"), "wrapped"),
+			want:   true,
+		},
+		"NotFound": {
+			reason: "A backend that answers that a resource doesn't exist is healthy enough to answer.",
+			err:    backend.NewError(backend.ErrorCodeNotFound, "boom"),
+			want:   false,
+		},
+		"Throttled": {
+			reason: "A backend that throttles calls is healthy enough to answer.",
+			err:    backend.NewError(backend.ErrorCodeThrottled, "boom"),
+			want:   false,
+		},
+		"BadRequest": {
+			reason: "A request the backend rejects says nothing of its health.",
+			err:    backend.NewError(backend.ErrorCodeBadRequest, "boom"),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := unhealthy(tc.err); got != tc.want {
+				t.Errorf("\n%s\nunhealthy(%v): want %t, got %t", tc.reason, tc.err, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// A ConditionHistoryRecorder records transitions of its conditions.
+type ConditionHistoryRecorder interface {
+	GetConditionHistory() []v1alpha1.ConditionTransition
+	SetConditionHistory(h []v1alpha1.ConditionTransition)
+}
+
+// RecordConditionHistory wraps the supplied connector such that the most
+// recent transitions of the conditions of each managed resource it observes
+// are recorded in its status. Transitions of the Ready condition between True
+// and False are counted as flaps. A resource's flaps are forgotten once its
+// external resource is deleted, so that the flap metric only has a series per
+// resource that exists. External clients replace the observation in the
+// status when they observe, create or update an external resource, so the
+// history is restored after each operation.
+func RecordConditionHistory(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &historyConnector{ExternalConnector: c, kind: kind}
+}
+
+type historyConnector struct {
+	managed.ExternalConnector
+	kind string
+}
+
+func (c *historyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &historyClient{ExternalClient: ec, kind: c.kind}, nil
+}
+
+type historyClient struct {
+	managed.ExternalClient
+	kind string
+
+	// history is set by Observe to the history it recorded.
+	history []v1alpha1.ConditionTransition
+}
+
+func (c *historyClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	hr, ok := mg.(ConditionHistoryRecorder)
+	if !ok {
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+	h := hr.GetConditionHistory()
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	c.history = c.transition(mg, h)
+	hr.SetConditionHistory(c.history)
+	return o, err
+}
+
+func (c *historyClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.restore(mg)
+	return cr, err
+}
+
+func (c *historyClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.restore(mg)
+	return u, err
+}
+
+func (c *historyClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.restore(mg)
+	if err == nil {
+		metrics.ConditionFlaps.DeleteLabelValues(c.kind, mg.GetNamespace(), mg.GetName())
+	}
+	return d, err
+}
+
+// restore the history recorded by Observe.
+func (c *historyClient) restore(mg resource.Managed) {
+	if hr, ok := mg.(ConditionHistoryRecorder); ok && c.history != nil {
+		hr.SetConditionHistory(c.history)
+	}
+}
+
+// transition returns the supplied history with a transition appended for each
+// of the supplied resource's conditions whose status or reason differs from
+// its most recent transition, keeping the most recent MaxConditionHistory.
+func (c *historyClient) transition(mg resource.Managed, h []v1alpha1.ConditionTransition) []v1alpha1.ConditionTransition {
+	h = slices.Clone(h)
+	var added []v1alpha1.ConditionTransition
+	for _, cond := range conditions(mg) {
+		i := latest(h, cond.Type)
+		if i >= 0 && h[i].Status == cond.Status && h[i].Reason == cond.Reason {
+			continue
+		}
+		if i >= 0 && cond.Type == xpv1.TypeReady && flapped(h[i].Status, cond.Status) {
+			metrics.ConditionFlaps.WithLabelValues(c.kind, mg.GetNamespace(), mg.GetName()).Inc()
+		}
+		added = append(added, v1alpha1.ConditionTransition{
+			Type:               cond.Type,
+			Status:             cond.Status,
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+			LastTransitionTime: cond.LastTransitionTime,
+		})
+	}
+	slices.SortStableFunc(added, func(a, b v1alpha1.ConditionTransition) int {
+		return a.LastTransitionTime.Compare(b.LastTransitionTime.Time)
+	})
+	h = append(h, added...)
+	if len(h) > v1alpha1.MaxConditionHistory {
+		h = h[len(h)-v1alpha1.MaxConditionHistory:]
+	}
+	return h
+}
+
+// latest returns the index of the most recent transition of the supplied type
+// of condition, or -1 if there is none.
+func latest(h []v1alpha1.ConditionTransition, ct xpv1.ConditionType) int {
+	for i := len(h) - 1; i >= 0; i-- {
+		if h[i].Type == ct {
+			return i
+		}
+	}
+	return -1
+}
+
+// flapped returns true if a condition transitioned between True and False.
+func flapped(from, to corev1.ConditionStatus) bool {
+	return from != to && from != corev1.ConditionUnknown && to != corev1.ConditionUnknown
+}
+
+// conditions returns the supplied resource's conditions. Managed resources
+// only get conditions by type, so they're read from its status.
+func conditions(mg resource.Managed) []xpv1.Condition {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return nil
+	}
+	conds := []xpv1.Condition{}
+	if err := fieldpath.Pave(u).GetValueInto("status.conditions", &conds); err != nil {
+		return nil
+	}
+	return conds
+}
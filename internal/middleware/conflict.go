@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+const (
+	errIndexExternalName = "cannot index managed resources by external name"
+	errListConflicts     = "cannot list managed resources to detect external name conflicts"
+	errResolveConflicts  = "cannot resolve provider config to detect external name conflicts"
+)
+
+// TypeConflicting resources share their external name with another managed
+// resource of the same kind, tenant and provider config.
+const TypeConflicting xpv1.ConditionType = "Conflicting"
+
+// Reasons a resource is or is not conflicting.
+const (
+	ReasonExternalNameConflict xpv1.ConditionReason = "ExternalNameConflict"
+	ReasonNoConflict           xpv1.ConditionReason = "NoConflict"
+)
+
+// Conflicting returns a condition that indicates the resource shares its
+// external name with the supplied, older, managed resource.
+func Conflicting(other resource.Managed) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConflicting,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExternalNameConflict,
+		Message: fmt.Sprintf("external name %q is already used by %s/%s; refusing to modify the external resource until the conflict is resolved",
+			meta.GetExternalName(other), other.GetNamespace(), other.GetName()),
+	}
+}
+
+// NotConflicting returns a condition that indicates the resource no longer
+// shares its external name with another managed resource.
+func NotConflicting() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConflicting,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoConflict,
+	}
+}
+
+// RejectConflicts wraps the supplied connector such that its clients refuse to
+// modify an external resource whose external name is shared by an older
+// managed resource of the same kind. Resources only share an external
+// resource if they're also of the same backend tenant and resolve the same
+// provider config, so resources of different tenants or provider configs
+// never conflict. Such a resource is marked Conflicting,
+// naming the older resource, and is reported to be up to date so that it is
+// never updated. Deleting it leaves the external resource in place for the
+// older resource to manage. newList must return an empty list of the kind
+// being reconciled, which must be indexed per SetupConflictIndex.
+//
+// The oldest resource is determined by creation timestamp, then by namespace
+// and name, so exactly one of a set of conflicting resources is allowed to
+// manage the external resource.
+func RejectConflicts(c managed.ExternalConnector, kube client.Reader, newList func() resource.ManagedList) managed.ExternalConnector {
+	return &conflictConnector{ExternalConnector: c, kube: kube, newList: newList}
+}
+
+type conflictConnector struct {
+	managed.ExternalConnector
+	kube    client.Reader
+	newList func() resource.ManagedList
+}
+
+func (c *conflictConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &conflictClient{ExternalClient: ec, kube: c.kube, newList: c.newList}, nil
+}
+
+type conflictClient struct {
+	managed.ExternalClient
+	kube    client.Reader
+	newList func() resource.ManagedList
+
+	// conflicting is set by Observe if the resource conflicts with an older
+	// resource.
+	conflicting bool
+}
+
+func (c *conflictClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	other, err := c.olderConflict(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if other != nil {
+		c.conflicting = true
+		mg.SetConditions(Conflicting(other))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	// Only record that a conflict was resolved; resources that never
+	// conflicted don't need the extra condition.
+	if mg.GetCondition(TypeConflicting).Status == corev1.ConditionTrue {
+		mg.SetConditions(NotConflicting())
+	}
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *conflictClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if c.conflicting {
+		return managed.ExternalDelete{}, nil
+	}
+	return c.ExternalClient.Delete(ctx, mg)
+}
+
+// IndexExternalName is the field index of managed resources by their
+// external name, which RejectConflicts lists managed resources with.
+const IndexExternalName = "metadata.annotations[" + meta.AnnotationKeyExternalName + "]"
+
+// IndexExternalNames indexes managed resources by their external name.
+// Resources without one aren't indexed.
+func IndexExternalNames(o client.Object) []string {
+	if name := meta.GetExternalName(o); name != "" {
+		return []string{name}
+	}
+	return nil
+}
+
+// SetupConflictIndex adds the field index that RejectConflicts lists managed
+// resources of the supplied kind with.
+func SetupConflictIndex(ctx context.Context, fi client.FieldIndexer, obj client.Object) error {
+	return errors.Wrap(fi.IndexField(ctx, obj, IndexExternalName, IndexExternalNames), errIndexExternalName)
+}
+
+// olderConflict returns the oldest managed resource that shares the supplied
+// resource's external name, tenant and provider config and is older than it,
+// if any. Resources whose provider config doesn't resolve can't manage an
+// external resource, so they never conflict.
+func (c *conflictClient) olderConflict(ctx context.Context, mg resource.Managed) (resource.Managed, error) {
+	name := meta.GetExternalName(mg)
+	if name == "" {
+		return nil, nil
+	}
+
+	l := c.newList()
+	if err := c.kube.List(ctx, l, client.MatchingFields{IndexExternalName: name}); err != nil {
+		return nil, errors.Wrap(err, errListConflicts)
+	}
+
+	var (
+		oldest   resource.Managed
+		pc       clients.ProviderConfigKey
+		resolved bool
+	)
+	for _, o := range l.GetItems() {
+		if o.GetUID() == mg.GetUID() || meta.GetExternalName(o) != name {
+			continue
+		}
+		if !olderThan(o, mg) || backend.DefaultTenants.Tenant(o.GetNamespace()) != backend.DefaultTenants.Tenant(mg.GetNamespace()) {
+			continue
+		}
+		if !resolved {
+			key, _, err := clients.ResolveProviderConfig(ctx, c.kube, mg)
+			if err != nil {
+				return nil, errors.Wrap(err, errResolveConflicts)
+			}
+			pc, resolved = key, true
+		}
+		if key, _, err := clients.ResolveProviderConfig(ctx, c.kube, o); err != nil || key != pc {
+			continue
+		}
+		if oldest == nil || olderThan(o, oldest) {
+			oldest = o
+		}
+	}
+	return oldest, nil
+}
+
+// olderThan returns true if a was created before b. Resources created in the
+// same second are ordered by namespace and name.
+func olderThan(a, b resource.Managed) bool {
+	at, bt := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !at.Equal(&bt) {
+		return at.Before(&bt)
+	}
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
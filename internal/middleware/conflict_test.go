@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// created is when the oldest BorkResource of a conflict test was created.
+var created = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type conflictModifier func(cr *v1alpha1.BorkResource)
+
+func withExternalName(name string) conflictModifier {
+	return func(cr *v1alpha1.BorkResource) { meta.SetExternalName(cr, name) }
+}
+
+func withCreated(after time.Duration) conflictModifier {
+	return func(cr *v1alpha1.BorkResource) { cr.SetCreationTimestamp(metav1.NewTime(created.Add(after))) }
+}
+
+func withPC(name string) conflictModifier {
+	return func(cr *v1alpha1.BorkResource) {
+		cr.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: name})
+	}
+}
+
+func withConflicting(c xpv1.Condition) conflictModifier {
+	return func(cr *v1alpha1.BorkResource) { cr.SetConditions(c) }
+}
+
+// conflictResource returns a BorkResource whose external name is bork, created
+// an hour after the oldest, that uses the default provider config.
+func conflictResource(namespace, name string, m ...conflictModifier) *v1alpha1.BorkResource {
+	cr := &v1alpha1.BorkResource{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID(namespace + "/" + name)}}
+	meta.SetExternalName(cr, "bork")
+	cr.SetCreationTimestamp(metav1.NewTime(created.Add(time.Hour)))
+	cr.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"})
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestRejectConflicts(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+	pcs := []client.Object{
+		&apisv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&apisv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+	}
+
+	// A conflicted is what's compared of a resource that's observed, then
+	// deleted.
+	type conflicted struct {
+		Observation managed.ExternalObservation
+		Calls       []string
+		Conflicting corev1.ConditionStatus
+		Reason      xpv1.ConditionReason
+	}
+
+	type want struct {
+		got conflicted
+		err error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		tenancy  backend.TenancyMode
+		existing []client.Object
+		mg       *v1alpha1.BorkResource
+		want     want
+	}{
+		"NoExternalName": {
+			reason: "A resource without an external name can't conflict.",
+			mg:     conflictResource("default", "new", withExternalName("")),
+			want:   want{got: conflicted{Calls: []string{"Observe", "Delete"}}},
+		},
+		"NoConflict": {
+			reason:   "A resource whose external name no other resource uses isn't conflicting.",
+			existing: []client.Object{conflictResource("default", "old", withCreated(0), withExternalName("other"))},
+			mg:       conflictResource("default", "new"),
+			want:     want{got: conflicted{Calls: []string{"Observe", "Delete"}}},
+		},
+		"OlderConflict": {
+			reason:   "A resource that shares its external name with an older resource is conflicting, up to date, and leaves the external resource in place when it's deleted.",
+			existing: []client.Object{conflictResource("default", "old", withCreated(0))},
+			mg:       conflictResource("default", "new"),
+			want: want{got: conflicted{
+				Observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Conflicting: corev1.ConditionTrue,
+				Reason:      ReasonExternalNameConflict,
+			}},
+		},
+		"NewerConflict": {
+			reason:   "The oldest of the resources that share an external name manages the external resource.",
+			existing: []client.Object{conflictResource("default", "newer", withCreated(2*time.Hour))},
+			mg:       conflictResource("default", "new"),
+			want:     want{got: conflicted{Calls: []string{"Observe", "Delete"}}},
+		},
+		"CreatedTogether": {
+			reason:   "Resources created at the same time are ordered by namespace and name.",
+			existing: []client.Object{conflictResource("default", "a")},
+			mg:       conflictResource("default", "b"),
+			want: want{got: conflicted{
+				Observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Conflicting: corev1.ConditionTrue,
+				Reason:      ReasonExternalNameConflict,
+			}},
+		},
+		"DifferentProviderConfig": {
+			reason:   "Resources that use different provider configs don't share an external resource.",
+			existing: []client.Object{conflictResource("default", "old", withCreated(0), withPC("other"))},
+			mg:       conflictResource("default", "new"),
+			want:     want{got: conflicted{Calls: []string{"Observe", "Delete"}}},
+		},
+		"OtherProviderConfigMissing": {
+			reason:   "A resource whose provider config doesn't resolve can't manage an external resource, so it doesn't conflict.",
+			existing: []client.Object{conflictResource("default", "old", withCreated(0), withPC("missing"))},
+			mg:       conflictResource("default", "new"),
+			want:     want{got: conflicted{Calls: []string{"Observe", "Delete"}}},
+		},
+		"SharedTenant": {
+			reason:   "Resources of different namespaces share an external resource when the backend isn't partitioned.",
+			tenancy:  backend.TenancyShared,
+			existing: []client.Object{conflictResource("elsewhere", "old", withCreated(0))},
+			mg:       conflictResource("default", "new"),
+			want: want{got: conflicted{
+				Observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				Conflicting: corev1.ConditionTrue,
+				Reason:      ReasonExternalNameConflict,
+			}},
+		},
+		"DifferentTenant": {
+			reason:   "Resources of namespaces with stores of their own don't share an external resource.",
+			tenancy:  backend.TenancyNamespace,
+			existing: []client.Object{conflictResource("elsewhere", "old", withCreated(0))},
+			mg:       conflictResource("default", "new"),
+			want:     want{got: conflicted{Calls: []string{"Observe", "Delete"}}},
+		},
+		"ConflictResolved": {
+			reason: "A resource that was conflicting is marked as no longer conflicting once the older resource is gone.",
+			mg:     conflictResource("default", "new", withConflicting(Conflicting(conflictResource("default", "old")))),
+			want: want{got: conflicted{
+				Calls:       []string{"Observe", "Delete"},
+				Conflicting: corev1.ConditionFalse,
+				Reason:      ReasonNoConflict,
+			}},
+		},
+		"ProviderConfigMissing": {
+			reason:   "We should return an error if the resource's own provider config doesn't resolve.",
+			existing: []client.Object{conflictResource("default", "old", withCreated(0))},
+			mg:       conflictResource("default", "new", withPC("missing")),
+			want: want{
+				got: conflicted{Calls: []string{"Delete"}},
+				err: errors.Wrap(errors.Wrap(errors.New(`clusterproviderconfigs.bork.crossplane.io "missing" not found`), "cannot get ClusterProviderConfig"), errResolveConflicts),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.tenancy != "" {
+				backend.DefaultTenants.SetMode(tc.tenancy)
+				defer backend.DefaultTenants.SetMode(backend.TenancyShared)
+			}
+			kube := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(append(append([]client.Object{}, pcs...), append(tc.existing, tc.mg)...)...).
+				WithIndex(&v1alpha1.BorkResource{}, IndexExternalName, IndexExternalNames).
+				Build()
+
+			fc := &fakeClient{}
+			c := RejectConflicts(fc.connector(), kube, func() resource.ManagedList { return &v1alpha1.BorkResourceList{} })
+			ec, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			o, err := ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if _, err := ec.Delete(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Delete(...): %v", err)
+			}
+
+			// A resource without the condition has an Unknown one.
+			cond := tc.mg.GetCondition(TypeConflicting)
+			got := conflicted{Observation: o, Calls: fc.calls, Reason: cond.Reason}
+			if cond.Status != corev1.ConditionUnknown {
+				got.Conflicting = cond.Status
+			}
+			if diff := cmp.Diff(tc.want.got, got); diff != "" {
+				t.Errorf("\n%s\nRejectConflicts(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"maps"
+	"slices"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const (
+	errConvertManaged              = "cannot convert managed resource to unstructured"
+	errParseConnectionTemplateFmt  = "cannot parse connection template of key %q"
+	errRenderConnectionTemplateFmt = "cannot render connection template of key %q"
+)
+
+// A ConnectionTemplater has a template that shapes the connection details it
+// publishes.
+type ConnectionTemplater interface {
+	GetConnectionTemplate() *v1alpha1.ConnectionTemplate
+}
+
+// connectionTemplateFuncs are the functions a connection template may call,
+// beyond those text/template provides.
+var connectionTemplateFuncs = template.FuncMap{
+	"b64enc": func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+	"b64dec": func(s string) (string, error) {
+		b, err := base64.StdEncoding.DecodeString(s)
+		return string(b), err
+	},
+}
+
+// connectionTemplateData is what a connection template is rendered with.
+type connectionTemplateData struct {
+	Name         string
+	Namespace    string
+	ExternalName string
+	Details      map[string]string
+	AtProvider   map[string]any
+}
+
+// TemplateConnectionDetails wraps the supplied connector such that the
+// connection details its clients publish are shaped by their managed
+// resource's connection template, if it has one. Templates are rendered
+// whenever an operation publishes connection details, so a client that
+// publishes none when it observes a resource that hasn't changed doesn't
+// render them either. A template that can't be parsed or rendered fails the
+// operation as a bad request.
+func TemplateConnectionDetails(c managed.ExternalConnector) managed.ExternalConnector {
+	return &templateConnector{ExternalConnector: c}
+}
+
+type templateConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *templateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &templateClient{ExternalClient: ec}, nil
+}
+
+type templateClient struct {
+	managed.ExternalClient
+}
+
+func (c *templateClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil || !o.ResourceExists {
+		return o, err
+	}
+	o.ConnectionDetails, err = templated(mg, o.ConnectionDetails)
+	return o, err
+}
+
+func (c *templateClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	if err != nil {
+		return cr, err
+	}
+	cr.ConnectionDetails, err = templated(mg, cr.ConnectionDetails)
+	return cr, err
+}
+
+func (c *templateClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := c.ExternalClient.Update(ctx, mg)
+	if err != nil {
+		return u, err
+	}
+	u.ConnectionDetails, err = templated(mg, u.ConnectionDetails)
+	return u, err
+}
+
+// templated returns the supplied connection details shaped by the supplied
+// managed resource's connection template. Details are returned unchanged if
+// the resource has no template, or if there are none to shape.
+func templated(mg resource.Managed, cd managed.ConnectionDetails) (managed.ConnectionDetails, error) {
+	ct, ok := mg.(ConnectionTemplater)
+	if !ok || ct.GetConnectionTemplate() == nil || len(cd) == 0 {
+		return cd, nil
+	}
+	t := ct.GetConnectionTemplate()
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvertManaged)
+	}
+	atProvider, _, _ := unstructured.NestedMap(u, "status", "atProvider")
+	data := connectionTemplateData{
+		Name:         mg.GetName(),
+		Namespace:    mg.GetNamespace(),
+		ExternalName: meta.GetExternalName(mg),
+		Details:      make(map[string]string, len(cd)),
+		AtProvider:   atProvider,
+	}
+	for k, v := range cd {
+		data.Details[k] = string(v)
+	}
+
+	out := managed.ConnectionDetails{}
+	if !t.OmitDefaultKeys {
+		maps.Copy(out, cd)
+	}
+	for _, k := range slices.Sorted(maps.Keys(t.Keys)) {
+		tmpl, err := template.New(k).Funcs(connectionTemplateFuncs).Option("missingkey=error").Parse(t.Keys[k])
+		if err != nil {
+			return nil, backend.NewError(backend.ErrorCodeBadRequest, errors.Wrapf(err, errParseConnectionTemplateFmt, k).Error())
+		}
+		b := &bytes.Buffer{}
+		if err := tmpl.Execute(b, data); err != nil {
+			return nil, backend.NewError(backend.ErrorCodeBadRequest, errors.Wrapf(err, errRenderConnectionTemplateFmt, k).Error())
+		}
+		out[k] = b.Bytes()
+	}
+	return out, nil
+}
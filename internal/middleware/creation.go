@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// TypeCreationThrottled resources are waiting for their turn to create their
+// external resource, because the provider limits how many external resources
+// it creates per second.
+const TypeCreationThrottled xpv1.ConditionType = "CreationThrottled"
+
+// Reasons a resource's creation is or isn't throttled.
+const (
+	ReasonCreationThrottled xpv1.ConditionReason = "CreationThrottled"
+	ReasonCreationAdmitted  xpv1.ConditionReason = "CreationAdmitted"
+)
+
+const (
+	errCreationBurst        = "creation burst must be at least 1"
+	errCreationThrottledFmt = "creation is throttled: retry after %s"
+
+	msgCreationThrottledFmt = "the provider creates at most %g external resources per second; this resource is number %d in the queue, and will be created in %s"
+)
+
+// creationTurnExpiry is how long after its turn came a queued resource keeps
+// its turn. A resource that doesn't return to create its external resource by
+// then, e.g. because it was deleted, is forgotten.
+const creationTurnExpiry = 5 * time.Minute
+
+// CreationThrottled returns a condition that indicates the resource is
+// waiting for its turn to create its external resource.
+func CreationThrottled(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCreationThrottled,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCreationThrottled,
+		Message:            msg,
+	}
+}
+
+// CreationAdmitted returns a condition that indicates the resource's turn to
+// create its external resource came.
+func CreationAdmitted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCreationThrottled,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCreationAdmitted,
+	}
+}
+
+// Creations limits how many external resources every controller creates per
+// second. It doesn't limit them until its rate is set.
+var Creations = &CreationLimiter{}
+
+// A CreationLimiter limits how many external resources are created per
+// second, across every kind. A resource that would exceed the rate is given a
+// turn and queued, rather than rejected, so that a burst of creates is
+// smoothed to the rate in the order the resources first tried to create.
+type CreationLimiter struct {
+	mu      sync.Mutex
+	limiter *rate.Limiter
+	turns   map[types.UID]time.Time
+}
+
+// Set the limiter to allow at most perSecond creates per second on average,
+// in bursts of at most burst creates. A rate of zero or less stops limiting
+// creates. Resources that are queued lose their turn.
+func (l *CreationLimiter) Set(perSecond float64, burst int) error {
+	if perSecond > 0 && burst < 1 {
+		return errors.New(errCreationBurst)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limiter = nil
+	if perSecond > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(perSecond), burst)
+	}
+	l.turns = make(map[types.UID]time.Time)
+	metrics.QueuedCreates.Set(0)
+	return nil
+}
+
+// admit returns how long the resource with the supplied UID must wait before
+// it may create its external resource, and its number in the queue. It
+// returns zero if the resource may create it now. The first time a resource
+// must wait it's given a turn, and true is returned.
+func (l *CreationLimiter) admit(uid types.UID) (wait time.Duration, number int, queued bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limiter == nil {
+		return 0, 0, false
+	}
+
+	now := time.Now()
+	defer func() { metrics.QueuedCreates.Set(float64(len(l.turns))) }()
+	for u, t := range l.turns {
+		if now.Sub(t) > creationTurnExpiry {
+			delete(l.turns, u)
+		}
+	}
+
+	turn, ok := l.turns[uid]
+	if !ok {
+		turn = now.Add(l.limiter.ReserveN(now, 1).DelayFrom(now))
+		if !turn.After(now) {
+			return 0, 0, false
+		}
+		l.turns[uid] = turn
+	}
+	if !turn.After(now) {
+		delete(l.turns, uid)
+		return 0, 0, false
+	}
+	for _, t := range l.turns {
+		if !t.After(turn) {
+			number++
+		}
+	}
+	return turn.Sub(now), number, !ok
+}
+
+// rate returns the limiter's rate.
+func (l *CreationLimiter) rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limiter == nil {
+		return 0
+	}
+	return float64(l.limiter.Limit())
+}
+
+type creationThrottled struct {
+	error
+	retryAfter time.Duration
+}
+
+func (t creationThrottled) RetryAfter() time.Duration { return t.retryAfter }
+
+// ThrottleCreates wraps the supplied connector such that its clients create
+// external resources no faster than Creations allows. The supplied kind is the
+// kind of managed resource the connector's clients operate on. A create that
+// must wait its turn fails as if the backend throttled it, hinting how long
+// until its turn, and sets a CreationThrottled condition. The first time a
+// resource is queued records a CreationThrottled event. The condition becomes
+// false once the resource's turn comes. Wrap a connector that records events,
+// so that a create that was never attempted isn't recorded as one that
+// failed.
+func ThrottleCreates(kind string, r event.Recorder, c managed.ExternalConnector) managed.ExternalConnector {
+	return &creationConnector{ExternalConnector: c, kind: kind, record: r, limiter: Creations}
+}
+
+type creationConnector struct {
+	managed.ExternalConnector
+	kind    string
+	record  event.Recorder
+	limiter *CreationLimiter
+}
+
+func (c *creationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &creationClient{ExternalClient: ec, kind: c.kind, record: c.record, limiter: c.limiter}, nil
+}
+
+type creationClient struct {
+	managed.ExternalClient
+	kind    string
+	record  event.Recorder
+	limiter *CreationLimiter
+}
+
+func (c *creationClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	wait, number, queued := c.limiter.admit(mg.GetUID())
+	if wait > 0 {
+		msg := fmt.Sprintf(msgCreationThrottledFmt, c.limiter.rate(), number, wait.Round(time.Millisecond))
+		mg.SetConditions(CreationThrottled(msg))
+		if queued {
+			metrics.ThrottledCreates.WithLabelValues(c.kind).Inc()
+			c.record.Event(mg, event.Normal(event.Reason(ReasonCreationThrottled), msg))
+		}
+		return managed.ExternalCreation{}, creationThrottled{error: errors.Errorf(errCreationThrottledFmt, wait.Round(time.Millisecond)), retryAfter: wait}
+	}
+	if mg.GetCondition(TypeCreationThrottled).Status == corev1.ConditionTrue {
+		mg.SetConditions(CreationAdmitted())
+	}
+	return c.ExternalClient.Create(ctx, mg)
+}
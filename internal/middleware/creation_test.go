@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+// creationRate is slow enough that no queued resource's turn comes during a
+// test, unless the test makes it due.
+const creationRate = 0.001
+
+// An admission is a resource asking to create its external resource. A due
+// resource's turn is made to have come before it asks.
+type admission struct {
+	uid types.UID
+	due bool
+}
+
+// due makes the turn of the resource with the supplied UID come.
+func (l *CreationLimiter) due(uid types.UID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.turns[uid]; ok {
+		l.turns[uid] = time.Now().Add(-time.Millisecond)
+	}
+}
+
+func TestCreationLimiterAdmit(t *testing.T) {
+	// An admitted is what admit returned, except the exact wait.
+	type admitted struct {
+		Wait   bool
+		Number int
+		Queued bool
+	}
+
+	cases := map[string]struct {
+		reason     string
+		rate       float64
+		burst      int
+		admissions []admission
+		want       []admitted
+	}{
+		"NotLimited": {
+			reason:     "Creates aren't limited until a rate is set.",
+			admissions: []admission{{uid: "a"}, {uid: "b"}, {uid: "c"}},
+			want:       []admitted{{}, {}, {}},
+		},
+		"Burst": {
+			reason:     "A burst of creates is admitted immediately.",
+			rate:       creationRate,
+			burst:      2,
+			admissions: []admission{{uid: "a"}, {uid: "b"}, {uid: "c"}},
+			want:       []admitted{{}, {}, {Wait: true, Number: 1, Queued: true}},
+		},
+		"QueueOrder": {
+			reason:     "Resources that exceed the rate are queued in the order they first asked, and keep their place when they ask again.",
+			rate:       creationRate,
+			burst:      1,
+			admissions: []admission{{uid: "a"}, {uid: "b"}, {uid: "c"}, {uid: "d"}, {uid: "c"}, {uid: "b"}},
+			want: []admitted{
+				{},
+				{Wait: true, Number: 1, Queued: true},
+				{Wait: true, Number: 2, Queued: true},
+				{Wait: true, Number: 3, Queued: true},
+				{Wait: true, Number: 2},
+				{Wait: true, Number: 1},
+			},
+		},
+		"TurnComes": {
+			reason:     "A resource whose turn has come is admitted and leaves the queue, moving those behind it up.",
+			rate:       creationRate,
+			burst:      1,
+			admissions: []admission{{uid: "a"}, {uid: "b"}, {uid: "c"}, {uid: "b", due: true}, {uid: "c"}},
+			want: []admitted{
+				{},
+				{Wait: true, Number: 1, Queued: true},
+				{Wait: true, Number: 2, Queued: true},
+				{},
+				{Wait: true, Number: 1},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &CreationLimiter{}
+			if err := l.Set(tc.rate, tc.burst); err != nil {
+				t.Fatal(err)
+			}
+			got := make([]admitted, 0, len(tc.admissions))
+			for _, a := range tc.admissions {
+				if a.due {
+					l.due(a.uid)
+				}
+				wait, number, queued := l.admit(a.uid)
+				got = append(got, admitted{Wait: wait > 0, Number: number, Queued: queued})
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nadmit(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestThrottleCreates(t *testing.T) {
+	// A created is what's compared of a create.
+	type created struct {
+		Created    bool
+		RetryAfter bool
+		Condition  xpv1.ConditionReason
+		Events     int
+	}
+
+	cases := map[string]struct {
+		reason     string
+		admissions []admission
+		want       []created
+	}{
+		"Admitted": {
+			reason:     "A resource that's admitted creates its external resource, and isn't marked as throttled.",
+			admissions: []admission{{uid: "a"}},
+			want:       []created{{Created: true}},
+		},
+		"Throttled": {
+			reason:     "A resource that must wait its turn isn't created. It fails with a hint of when to retry, is marked as throttled, and records an event the first time it's queued.",
+			admissions: []admission{{uid: "a"}, {uid: "b"}, {uid: "b"}},
+			want: []created{
+				{Created: true},
+				{RetryAfter: true, Condition: ReasonCreationThrottled, Events: 1},
+				{RetryAfter: true, Condition: ReasonCreationThrottled, Events: 1},
+			},
+		},
+		"TurnComes": {
+			reason:     "A throttled resource whose turn comes is created, and is marked as admitted.",
+			admissions: []admission{{uid: "a"}, {uid: "b"}, {uid: "b", due: true}},
+			want: []created{
+				{Created: true},
+				{RetryAfter: true, Condition: ReasonCreationThrottled, Events: 1},
+				{Created: true, Condition: ReasonCreationAdmitted, Events: 1},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &CreationLimiter{}
+			if err := l.Set(creationRate, 1); err != nil {
+				t.Fatal(err)
+			}
+			r := &eventRecorder{}
+			resources := map[types.UID]*v1alpha1.BorkResource{}
+			got := make([]created, 0, len(tc.admissions))
+			for _, a := range tc.admissions {
+				cr, ok := resources[a.uid]
+				if !ok {
+					cr = &v1alpha1.BorkResource{}
+					cr.SetUID(a.uid)
+					resources[a.uid] = cr
+				}
+				if a.due {
+					l.due(a.uid)
+				}
+
+				fc := &fakeClient{}
+				c := &creationConnector{ExternalConnector: fc.connector(), kind: v1alpha1.BorkResourceKind, record: r, limiter: l}
+				ec, err := c.Connect(context.Background(), cr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, err = ec.Create(context.Background(), cr)
+				var ra interface{ RetryAfter() time.Duration }
+				got = append(got, created{
+					Created:    len(fc.calls) > 0,
+					RetryAfter: errors.As(err, &ra) && ra.RetryAfter() > 0,
+					Condition:  cr.GetCondition(TypeCreationThrottled).Reason,
+					Events:     len(r.reasons),
+				})
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			for _, reason := range r.reasons {
+				if reason != event.Reason(ReasonCreationThrottled) {
+					t.Errorf("\n%s\nCreate(...): recorded a %s event, want %s", tc.reason, reason, ReasonCreationThrottled)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// TypeCredentialsExpired resources were last reconciled using credentials
+// that the backend rejected because they expired.
+const TypeCredentialsExpired xpv1.ConditionType = "CredentialsExpired"
+
+// Reasons a resource's credentials have or have not expired.
+const (
+	ReasonTokenExpired       xpv1.ConditionReason = "TokenExpired"
+	ReasonCredentialsRenewed xpv1.ConditionReason = "CredentialsRenewed"
+)
+
+const errRenewCredentials = "cannot renew expired credentials"
+
+// CredentialsExpired returns a condition that indicates the backend rejected
+// the resource's credentials because they expired.
+func CredentialsExpired(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsExpired,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTokenExpired,
+		Message:            err.Error(),
+	}
+}
+
+// CredentialsRenewed returns a condition that indicates the resource's
+// expired credentials were renewed.
+func CredentialsRenewed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsExpired,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsRenewed,
+	}
+}
+
+// RenewCredentials wraps the supplied connector such that when the backend
+// rejects a connection or operation because its credentials expired, the
+// connector connects again, getting new credentials, and the connection or
+// operation is retried once. Resources whose credentials expired have a
+// CredentialsExpired condition, which is true until their credentials are
+// renewed.
+func RenewCredentials(c managed.ExternalConnector) managed.ExternalConnector {
+	return &renewConnector{ExternalConnector: c}
+}
+
+type renewConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *renewConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if backend.IsCredentialsExpired(err) {
+		mg.SetConditions(CredentialsExpired(err))
+		if ec, err = c.ExternalConnector.Connect(ctx, mg); err != nil {
+			return nil, errors.Wrap(err, errRenewCredentials)
+		}
+		mg.SetConditions(CredentialsRenewed())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &renewClient{ExternalClient: ec, connector: c.ExternalConnector}, nil
+}
+
+type renewClient struct {
+	managed.ExternalClient
+	connector managed.ExternalConnector
+}
+
+// renew calls the supplied function with the client's external client. If
+// the call fails because the client's credentials expired it connects again
+// and retries the call with the new external client.
+func renew[T any](ctx context.Context, c *renewClient, mg resource.Managed, fn func(managed.ExternalClient) (T, error)) (T, error) {
+	out, err := fn(c.ExternalClient)
+	if !backend.IsCredentialsExpired(err) {
+		return out, err
+	}
+	mg.SetConditions(CredentialsExpired(err))
+
+	ec, cerr := c.connector.Connect(ctx, mg)
+	if cerr != nil {
+		return out, errors.Wrap(cerr, errRenewCredentials)
+	}
+	_ = c.ExternalClient.Disconnect(ctx)
+	c.ExternalClient = ec
+
+	out, err = fn(ec)
+	if err != nil {
+		return out, err
+	}
+	mg.SetConditions(CredentialsRenewed())
+	return out, nil
+}
+
+func (c *renewClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return renew(ctx, c, mg, func(ec managed.ExternalClient) (managed.ExternalObservation, error) { return ec.Observe(ctx, mg) })
+}
+
+func (c *renewClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return renew(ctx, c, mg, func(ec managed.ExternalClient) (managed.ExternalCreation, error) { return ec.Create(ctx, mg) })
+}
+
+func (c *renewClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return renew(ctx, c, mg, func(ec managed.ExternalClient) (managed.ExternalUpdate, error) { return ec.Update(ctx, mg) })
+}
+
+func (c *renewClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return renew(ctx, c, mg, func(ec managed.ExternalClient) (managed.ExternalDelete, error) { return ec.Delete(ctx, mg) })
+}
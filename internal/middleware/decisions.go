@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/decisions"
+)
+
+// RecordDecisions wraps the supplied connector such that the reconciles of
+// managed resources annotated to be traced are recorded by the default
+// decisions recorder: what each observed, whether it found the external
+// resource up to date, what it did about it and the conditions it left the
+// resource with. The supplied kind is the kind of managed resource the
+// connector's clients operate on. A reconcile is recorded when it
+// disconnects, which is after its conditions are set.
+func RecordDecisions(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &decisionsConnector{ExternalConnector: c, kind: kind}
+}
+
+type decisionsConnector struct {
+	managed.ExternalConnector
+	kind string
+}
+
+func (c *decisionsConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if mg.GetAnnotations()[v1alpha1.AnnotationKeyTrace] != "true" {
+		decisions.Default.Stop(c.kind, mg.GetNamespace(), mg.GetName())
+		return c.ExternalConnector.Connect(ctx, mg)
+	}
+	res := decisions.Resource{Kind: c.kind, Namespace: mg.GetNamespace(), Name: mg.GetName(), UID: string(mg.GetUID())}
+	if !decisions.Default.Tracing(res, traceCycles(mg)) {
+		return c.ExternalConnector.Connect(ctx, mg)
+	}
+
+	start := time.Now()
+	cycle := decisions.Cycle{
+		Time:            start.UTC(),
+		Generation:      mg.GetGeneration(),
+		ResourceVersion: mg.GetResourceVersion(),
+		Deleting:        meta.WasDeleted(mg),
+		DryRun:          DryRun(mg),
+		Action:          decisions.ActionNone,
+	}
+	for _, p := range mg.GetManagementPolicies() {
+		cycle.ManagementPolicies = append(cycle.ManagementPolicies, string(p))
+	}
+
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		// A reconcile that can't connect doesn't disconnect.
+		cycle.Error = decisionError("Connect", err)
+		cycle.Latency = time.Since(start)
+		decisions.Default.Record(res, cycle)
+		return nil, err
+	}
+	return &decisionsClient{ExternalClient: ec, mg: mg, res: res, start: start, cycle: cycle}, nil
+}
+
+// traceCycles returns how many reconciles the supplied resource asks to
+// trace.
+func traceCycles(mg resource.Managed) int {
+	n, err := strconv.Atoi(mg.GetAnnotations()[v1alpha1.AnnotationKeyTraceCycles])
+	if err != nil || n < 1 {
+		return decisions.DefaultCycles
+	}
+	return n
+}
+
+// A decisionsClient is connected for a single reconcile, whose decisions it
+// accumulates until it disconnects.
+type decisionsClient struct {
+	managed.ExternalClient
+	mg    resource.Managed
+	res   decisions.Resource
+	start time.Time
+	cycle decisions.Cycle
+}
+
+func (c *decisionsClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		c.cycle.Error = decisionError("Observe", err)
+		return o, err
+	}
+	c.cycle.Observation = &decisions.Observation{Exists: o.ResourceExists, UpToDate: o.ResourceUpToDate, Diff: o.Diff}
+	return o, nil
+}
+
+func (c *decisionsClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.decide(decisions.ActionCreate, err)
+	return cr, err
+}
+
+func (c *decisionsClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.decide(decisions.ActionUpdate, err)
+	return u, err
+}
+
+func (c *decisionsClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.decide(decisions.ActionDelete, err)
+	return d, err
+}
+
+func (c *decisionsClient) Disconnect(ctx context.Context) error {
+	c.cycle.Latency = time.Since(c.start)
+	c.cycle.Conditions = conditions(c.mg)
+	decisions.Default.Record(c.res, c.cycle)
+	return c.ExternalClient.Disconnect(ctx)
+}
+
+// decide records the action the reconcile took, and the error it returned.
+func (c *decisionsClient) decide(a decisions.Action, err error) {
+	c.cycle.Action = a
+	if err != nil {
+		c.cycle.Error = decisionError(string(a), err)
+	}
+}
+
+func decisionError(op string, err error) *decisions.Error {
+	return &decisions.Error{Operation: op, Code: string(backend.Code(err)), Message: err.Error()}
+}
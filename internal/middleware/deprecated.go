@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// TypeDeprecatedAPI resources were last observed using a bork API version
+// that's deprecated, because the backend serves a different version than the
+// provider expects.
+const TypeDeprecatedAPI xpv1.ConditionType = "DeprecatedAPI"
+
+// Reasons a resource's API version is or is not deprecated.
+const (
+	ReasonAPIVersionSkew    xpv1.ConditionReason = "APIVersionSkew"
+	ReasonAPIVersionCurrent xpv1.ConditionReason = "APIVersionCurrent"
+)
+
+// DeprecatedAPI returns a condition that indicates the resource was observed
+// using a deprecated bork API version.
+func DeprecatedAPI(message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeprecatedAPI,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAPIVersionSkew,
+		Message:            message,
+	}
+}
+
+// CurrentAPI returns a condition that indicates the resource was observed
+// using the bork API version the provider expects.
+func CurrentAPI() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeprecatedAPI,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAPIVersionCurrent,
+	}
+}
+
+// ReportDeprecatedAPI wraps the supplied connector such that a resource that
+// is observed using a backend serving a different bork API version than
+// backend.ClientAPIVersion has a DeprecatedAPI condition, and is counted by
+// the deprecated API metric. The observation succeeds as usual. The condition
+// becomes false once the resource is observed using the expected version.
+// The supplied kind is the kind of managed resource the connector's clients
+// operate on.
+func ReportDeprecatedAPI(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &deprecatedConnector{ExternalConnector: c, kind: kind}
+}
+
+type deprecatedConnector struct {
+	managed.ExternalConnector
+	kind string
+}
+
+func (c *deprecatedConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &deprecatedClient{ExternalClient: ec, kind: c.kind}, nil
+}
+
+type deprecatedClient struct {
+	managed.ExternalClient
+	kind string
+}
+
+func (c *deprecatedClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, check := backend.WithAPIVersionCheck(ctx)
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+
+	served, msg, deprecated := check.Deprecated()
+	switch {
+	case deprecated:
+		mg.SetConditions(DeprecatedAPI(msg))
+		metrics.DeprecatedAPIObservations.With(prometheus.Labels{
+			metrics.LabelKind:           c.kind,
+			metrics.LabelProviderConfig: providerConfig(mg),
+			metrics.LabelAPIVersion:     served,
+		}).Inc()
+	case check.Checked() && mg.GetCondition(TypeDeprecatedAPI).Status == corev1.ConditionTrue:
+		// Observations that didn't call the backend, for example because
+		// they were served from a cache, don't tell us anything.
+		mg.SetConditions(CurrentAPI())
+	}
+	return o, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// TypeDrifted resources have an external resource that differs from their
+// desired state.
+const TypeDrifted xpv1.ConditionType = "Drifted"
+
+// Reasons a resource has or has not drifted.
+const (
+	ReasonDriftDetected xpv1.ConditionReason = "DriftDetected"
+	ReasonNoDrift       xpv1.ConditionReason = "NoDrift"
+)
+
+// MaxDriftMessageLength is the longest drift diff recorded in a Drifted
+// condition's message. Longer diffs are truncated.
+const MaxDriftMessageLength = 4096
+
+const (
+	msgDriftedPrefix = "external resource differs from the desired state (-desired +observed):\n"
+	msgDriftTrunc    = "\n... (truncated)"
+)
+
+// Drifted returns a condition that indicates the resource's external resource
+// differs from its desired state, as described by the supplied diff.
+func Drifted(diff string) xpv1.Condition {
+	if len(diff) > MaxDriftMessageLength {
+		diff = diff[:MaxDriftMessageLength] + msgDriftTrunc
+	}
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDriftDetected,
+		Message:            msgDriftedPrefix + diff,
+	}
+}
+
+// NotDrifted returns a condition that indicates the resource's external
+// resource no longer differs from its desired state.
+func NotDrifted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoDrift,
+	}
+}
+
+// ReportDrift wraps the supplied connector such that its clients surface the
+// diff of an external resource that is not up to date as a Drifted
+// condition. External clients describe drift by setting the Diff of their
+// observation.
+func ReportDrift(c managed.ExternalConnector) managed.ExternalConnector {
+	return &driftConnector{ExternalConnector: c}
+}
+
+type driftConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *driftConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &driftClient{ExternalClient: ec}, nil
+}
+
+type driftClient struct {
+	managed.ExternalClient
+}
+
+func (c *driftClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil || !o.ResourceExists {
+		return o, err
+	}
+	if !o.ResourceUpToDate && o.Diff != "" {
+		mg.SetConditions(Drifted(o.Diff))
+		return o, nil
+	}
+
+	// Only record that drift was corrected; resources that never drifted
+	// don't need the extra condition.
+	if o.ResourceUpToDate && mg.GetCondition(TypeDrifted).Status == corev1.ConditionTrue {
+		mg.SetConditions(NotDrifted())
+	}
+	return o, nil
+}
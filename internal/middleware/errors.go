@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// TypeBackendError resources failed their last operation on their external
+// resource.
+const TypeBackendError xpv1.ConditionType = "BackendError"
+
+// Reasons a resource's last operation on its external resource did or did
+// not fail. A failed operation's reason classifies its error.
+const (
+	ReasonBackendNotFound            xpv1.ConditionReason = "NotFound"
+	ReasonBackendAlreadyExists       xpv1.ConditionReason = "AlreadyExists"
+	ReasonBackendConflict            xpv1.ConditionReason = "Conflict"
+	ReasonBackendDependencyViolation xpv1.ConditionReason = "DependencyViolation"
+	ReasonBackendBadRequest          xpv1.ConditionReason = "BadRequest"
+	ReasonBackendAuthDenied          xpv1.ConditionReason = "AuthDenied"
+	ReasonBackendCredentialsExpired  xpv1.ConditionReason = "CredentialsExpired"
+	ReasonBackendThrottled           xpv1.ConditionReason = "Throttled"
+	ReasonBackendInternal            xpv1.ConditionReason = "Internal"
+	ReasonBackendTimeout             xpv1.ConditionReason = "Timeout"
+	ReasonBackendUnavailable         xpv1.ConditionReason = "Unavailable"
+	ReasonBackendUnknown             xpv1.ConditionReason = "UnknownError"
+	ReasonNoBackendError             xpv1.ConditionReason = "NoBackendError"
+)
+
+// errorReasons maps each backend error code to the reason of the
+// BackendError condition it sets, and of the event it records.
+var errorReasons = map[backend.ErrorCode]xpv1.ConditionReason{
+	backend.ErrorCodeNotFound:            ReasonBackendNotFound,
+	backend.ErrorCodeAlreadyExists:       ReasonBackendAlreadyExists,
+	backend.ErrorCodeConflict:            ReasonBackendConflict,
+	backend.ErrorCodeDependencyViolation: ReasonBackendDependencyViolation,
+	backend.ErrorCodeBadRequest:          ReasonBackendBadRequest,
+	backend.ErrorCodeUnauthorized:        ReasonBackendAuthDenied,
+	backend.ErrorCodeCredentialsExpired:  ReasonBackendCredentialsExpired,
+	backend.ErrorCodeThrottled:           ReasonBackendThrottled,
+	backend.ErrorCodeInternal:            ReasonBackendInternal,
+	backend.ErrorCodeTimeout:             ReasonBackendTimeout,
+	backend.ErrorCodeUnavailable:         ReasonBackendUnavailable,
+	backend.ErrorCodeUnknown:             ReasonBackendUnknown,
+}
+
+// ErrorReason returns the reason that classifies the supplied error, which
+// must not be nil. A recovered panic isn't a backend error.
+func ErrorReason(err error) xpv1.ConditionReason {
+	if IsPanic(err) {
+		return ReasonPanic
+	}
+	return errorReasons[backend.Code(err)]
+}
+
+// BackendError returns a condition that indicates the resource's last
+// operation on its external resource failed with the supplied error.
+func BackendError(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBackendError,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ErrorReason(err),
+		Message:            err.Error(),
+	}
+}
+
+// NoBackendError returns a condition that indicates the resource's last
+// operation on its external resource succeeded.
+func NoBackendError() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBackendError,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoBackendError,
+	}
+}
+
+// ReportErrors wraps the supplied connector such that its clients surface
+// the class of error an operation on an external resource failed with as a
+// BackendError condition, so that throttling can be told apart from denied
+// credentials or a backend fault without reading the error. The condition
+// becomes false once an operation succeeds and the external resource is up
+// to date. Like the QuotaExceeded condition, the condition set by a failed
+// create isn't persisted, because the managed reconciler discards status
+// changes made by Create; its event records the same reason.
+func ReportErrors(c managed.ExternalConnector) managed.ExternalConnector {
+	return &errorConnector{ExternalConnector: c}
+}
+
+type errorConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *errorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &errorClient{ExternalClient: ec}, nil
+}
+
+type errorClient struct {
+	managed.ExternalClient
+}
+
+func (c *errorClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	// An external resource that needs creating or updating hasn't recovered
+	// from the error until the create or update succeeds.
+	report(mg, err, o.ResourceExists && o.ResourceUpToDate)
+	return o, err
+}
+
+func (c *errorClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	report(mg, err, true)
+	return cr, err
+}
+
+func (c *errorClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := c.ExternalClient.Update(ctx, mg)
+	report(mg, err, true)
+	return u, err
+}
+
+func (c *errorClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	report(mg, err, true)
+	return d, err
+}
+
+// report sets the BackendError condition of the supplied resource if the
+// supplied error isn't nil, and otherwise clears it if the resource has
+// recovered. Resources that never failed don't need the extra condition.
+func report(mg resource.Managed, err error, recovered bool) {
+	switch {
+	case err != nil:
+		mg.SetConditions(BackendError(err))
+	case recovered && mg.GetCondition(TypeBackendError).Status == corev1.ConditionTrue:
+		mg.SetConditions(NoBackendError())
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// Reasons of the events recorded for operations on external resources.
+const (
+	ReasonCreated event.Reason = "CreatedBackendResource"
+	ReasonUpdated event.Reason = "UpdatedBackendResource"
+	ReasonDeleted event.Reason = "DeletedBackendResource"
+	ReasonAdopted event.Reason = "Adopted"
+	ReasonPlanned event.Reason = "PlannedBackendResourceChanges"
+)
+
+// AnnotationKeyErrorCode annotates the event of a failed operation with the
+// backend's error code.
+const AnnotationKeyErrorCode = "bork.crossplane.io/error-code"
+
+// RecordEvents wraps the supplied connector such that an event is recorded
+// every time one of its clients creates, updates, or deletes an external
+// resource, or fails to. Events of failures have the reason that classifies
+// their error, as does the BackendError condition, and include the backend's
+// error code. Observations aren't recorded, because they happen every poll, except
+// the first observation of a managed resource that adopts an existing
+// external resource. Operations of a dry run record that changes were only
+// planned.
+func RecordEvents(r event.Recorder, c managed.ExternalConnector) managed.ExternalConnector {
+	return &eventConnector{ExternalConnector: c, record: r}
+}
+
+type eventConnector struct {
+	managed.ExternalConnector
+	record event.Recorder
+}
+
+func (c *eventConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &eventClient{ExternalClient: ec, record: c.record}, nil
+}
+
+type eventClient struct {
+	managed.ExternalClient
+	record event.Recorder
+}
+
+func (c *eventClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err == nil && o.ResourceExists && adopting(mg) {
+		c.record.Event(mg, event.Normal(ReasonAdopted, fmt.Sprintf("Adopted existing backend resource %q", meta.GetExternalName(mg))))
+	}
+	return o, err
+}
+
+// adopting returns true if the supplied managed resource is being reconciled
+// for the first time, and names an external resource it didn't create. Its
+// external resource exists if it can be observed, so the managed reconciler
+// adopts it rather than creating one. A dry run never adopts, because it
+// may plan to create an external resource it can't observe.
+func adopting(mg resource.Managed) bool {
+	if meta.GetExternalName(mg) == "" || DryRun(mg) {
+		return false
+	}
+	if !meta.GetExternalCreatePending(mg).IsZero() || !meta.GetExternalCreateSucceeded(mg).IsZero() {
+		return false
+	}
+	return mg.GetCondition(xpv1.TypeSynced).Status == corev1.ConditionUnknown
+}
+
+func (c *eventClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	start := time.Now()
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.event(mg, "create", "Created", ReasonCreated, start, err)
+	return cr, err
+}
+
+func (c *eventClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	start := time.Now()
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.event(mg, "update", "Updated", ReasonUpdated, start, err)
+	return u, err
+}
+
+func (c *eventClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	start := time.Now()
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.event(mg, "delete", "Deleted", ReasonDeleted, start, err)
+	return d, err
+}
+
+// event records the outcome of an operation on the supplied resource that
+// started at the supplied time.
+func (c *eventClient) event(mg resource.Managed, verb, past string, succeeded event.Reason, start time.Time, err error) {
+	if err != nil {
+		code := backend.Code(err)
+		c.record.Event(mg, event.Warning(event.Reason(ErrorReason(err)), errors.Wrapf(err, "cannot %s backend resource (%s)", verb, code), AnnotationKeyErrorCode, string(code)))
+		return
+	}
+	if DryRun(mg) {
+		c.record.Event(mg, event.Normal(ReasonPlanned, fmt.Sprintf("Planned to %s backend resource; made no changes because this is a dry run", verb)))
+		return
+	}
+	c.record.Event(mg, event.Normal(succeeded, fmt.Sprintf("%s backend resource %q in %s", past, meta.GetExternalName(mg), time.Since(start).Round(time.Millisecond))))
+}
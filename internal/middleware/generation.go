@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// ObservedGeneration wraps the supplied connector such that its clients set a
+// managed resource's status.observedGeneration to its metadata.generation
+// once an observation shows that generation of the spec has been fully
+// reconciled - i.e. the external resource exists, is up to date, and nothing
+// was late-initialized. A resource whose observed generation lags its
+// generation has spec changes that have not yet been reconciled.
+//
+// A resource whose management policies only allow it to be observed is never
+// updated, so it is considered reconciled as soon as it is observed to exist.
+func ObservedGeneration(c managed.ExternalConnector) managed.ExternalConnector {
+	return &observedGenerationConnector{ExternalConnector: c}
+}
+
+type observedGenerationConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *observedGenerationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &observedGenerationClient{ExternalClient: ec}, nil
+}
+
+type observedGenerationClient struct {
+	managed.ExternalClient
+}
+
+func (c *observedGenerationClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+	ro, ok := mg.(resource.ReconciliationObserver)
+	if ok && o.ResourceExists && (observeOnly(mg) || o.ResourceUpToDate && !o.ResourceLateInitialized) {
+		ro.SetObservedGeneration(mg.GetGeneration())
+	}
+	return o, nil
+}
+
+// observeOnly returns true if the supplied resource's management policies only
+// allow it to be observed.
+func observeOnly(mg resource.Managed) bool {
+	return sets.New(mg.GetManagementPolicies()...).Equal(sets.New(xpv1.ManagementActionObserve))
+}
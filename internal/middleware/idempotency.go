@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// DeduplicateCreates wraps the supplied connector such that the creates its
+// clients make present an idempotency key, so that the backend deduplicates
+// a create that's retried because its client saw an error even though it
+// succeeded, rather than create a duplicate external resource. A create that
+// the backend deduplicates returns the external resource the earlier create
+// created, and is counted by the deduplicated creates metric. The supplied
+// kind is the kind of managed resource the connector's clients operate on.
+func DeduplicateCreates(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &idempotentConnector{ExternalConnector: c, kind: kind}
+}
+
+type idempotentConnector struct {
+	managed.ExternalConnector
+	kind string
+}
+
+func (c *idempotentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &idempotentClient{ExternalClient: ec, kind: c.kind}, nil
+}
+
+type idempotentClient struct {
+	managed.ExternalClient
+	kind string
+}
+
+func (c *idempotentClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, replay := backend.WithIdempotencyKey(ctx, idempotencyKey(mg))
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	if replay.Replayed() {
+		metrics.DeduplicatedCreates.With(prometheus.Labels{
+			metrics.LabelKind:           c.kind,
+			metrics.LabelProviderConfig: providerConfig(mg),
+		}).Inc()
+	}
+	return cr, err
+}
+
+// idempotencyKey returns the idempotency key of creates of the supplied
+// managed resource's external resource. Every attempt to create it for the
+// same generation of its spec presents the same key, so a create that's
+// retried after it succeeded is deduplicated, but one made after the spec
+// changes isn't.
+func idempotencyKey(mg resource.Managed) string {
+	return fmt.Sprintf("%s/%d", mg.GetUID(), mg.GetGeneration())
+}
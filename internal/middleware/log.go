@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// VerboseExternalLogging logs every operation performed on an external
+// resource at info level, including connecting and observing. Otherwise only
+// operations that write to an external resource, or fail, are logged, at
+// debug level. It must be set before controllers are set up.
+var VerboseExternalLogging = false
+
+// Log wraps the supplied connector such that the operations its clients
+// perform on external resources are logged to the supplied logger, with
+// their duration. The supplied kind is the kind of managed resource the
+// connector's clients operate on. Managed resources are never logged in
+// full, so that none of their sensitive fields are.
+func Log(kind string, log logging.Logger, c managed.ExternalConnector) managed.ExternalConnector {
+	return &logConnector{ExternalConnector: c, log: &opLogger{log: log, kind: kind, verbose: VerboseExternalLogging}}
+}
+
+type logConnector struct {
+	managed.ExternalConnector
+	log *opLogger
+}
+
+func (c *logConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	start := time.Now()
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	c.log.read(mg, "Connect", start, err)
+	if err != nil {
+		return nil, err
+	}
+	return &logClient{ExternalClient: ec, log: c.log}, nil
+}
+
+type logClient struct {
+	managed.ExternalClient
+	log *opLogger
+}
+
+func (c *logClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	start := time.Now()
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	c.log.read(mg, "Observe", start, err, "exists", o.ResourceExists, "up-to-date", o.ResourceUpToDate)
+	return o, err
+}
+
+func (c *logClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	start := time.Now()
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.log.write(mg, "Create", start, err)
+	return cr, err
+}
+
+func (c *logClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	start := time.Now()
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.log.write(mg, "Update", start, err)
+	return u, err
+}
+
+func (c *logClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	start := time.Now()
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.log.write(mg, "Delete", start, err)
+	return d, err
+}
+
+// An opLogger logs operations on external resources.
+type opLogger struct {
+	log     logging.Logger
+	kind    string
+	verbose bool
+}
+
+// read logs an operation that doesn't write to the external resource. It is
+// only logged if it failed, or logging is verbose.
+func (l *opLogger) read(mg resource.Managed, op string, start time.Time, err error, kv ...any) {
+	if err == nil && !l.verbose {
+		return
+	}
+	l.logOp(mg, op, start, err, kv...)
+}
+
+// write logs an operation that writes to the external resource.
+func (l *opLogger) write(mg resource.Managed, op string, start time.Time, err error) {
+	l.logOp(mg, op, start, err)
+}
+
+func (l *opLogger) logOp(mg resource.Managed, op string, start time.Time, err error, kv ...any) {
+	kv = append([]any{
+		"kind", l.kind,
+		"namespace", mg.GetNamespace(),
+		"name", mg.GetName(),
+		"external-name", meta.GetExternalName(mg),
+		"operation", op,
+		"duration", time.Since(start),
+	}, kv...)
+	if err != nil {
+		kv = append(kv, "error", err)
+	}
+	msg := "External operation succeeded"
+	if err != nil {
+		msg = "External operation failed"
+	}
+	if l.verbose {
+		l.log.Info(msg, kv...)
+		return
+	}
+	l.log.Debug(msg, kv...)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// RecordMetrics wraps the supplied connector such that the duration and
+// outcome of every operation its clients perform on an external resource is
+// recorded by the external operation metrics. The supplied kind is the kind
+// of managed resource the connector's clients operate on. Operations that are
+// traced are recorded with their trace ID as an exemplar, so the connector
+// must be wrapped by Trace.
+func RecordMetrics(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &metricsConnector{ExternalConnector: c, kind: kind}
+}
+
+type metricsConnector struct {
+	managed.ExternalConnector
+	kind string
+}
+
+func (c *metricsConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsClient{ExternalClient: ec, kind: c.kind}, nil
+}
+
+type metricsClient struct {
+	managed.ExternalClient
+	kind string
+}
+
+func (c *metricsClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	start := time.Now()
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	c.record(ctx, mg, metrics.OperationObserve, start, err)
+	return o, err
+}
+
+func (c *metricsClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	start := time.Now()
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.record(ctx, mg, metrics.OperationCreate, start, err)
+	return cr, err
+}
+
+func (c *metricsClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	start := time.Now()
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.record(ctx, mg, metrics.OperationUpdate, start, err)
+	return u, err
+}
+
+func (c *metricsClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	start := time.Now()
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.record(ctx, mg, metrics.OperationDelete, start, err)
+	return d, err
+}
+
+// record records an operation on the supplied resource that started at the
+// supplied time, and returned the supplied error.
+func (c *metricsClient) record(ctx context.Context, mg resource.Managed, op string, start time.Time, err error) {
+	result := metrics.ResultSuccess
+	if err != nil {
+		result = metrics.ResultError
+	}
+	d := metrics.ExternalOperationDuration.With(prometheus.Labels{
+		metrics.LabelKind:   c.kind,
+		metrics.LabelVerb:   op,
+		metrics.LabelResult: result,
+	})
+	metrics.ObserveWithTrace(ctx, d, time.Since(start).Seconds())
+	if err == nil {
+		return
+	}
+	metrics.ExternalOperationErrors.With(prometheus.Labels{
+		metrics.LabelKind:           c.kind,
+		metrics.LabelProviderConfig: providerConfig(mg),
+		metrics.LabelVerb:           op,
+		metrics.LabelTenant:         backend.DefaultTenants.Tenant(mg.GetNamespace()),
+	}).Inc()
+}
+
+// providerConfig returns the kind and name of the provider config referenced
+// by the supplied resource, e.g. ClusterProviderConfig/default.
+func providerConfig(mg resource.Managed) string {
+	m, ok := mg.(resource.ModernManaged)
+	if !ok || m.GetProviderConfigReference() == nil {
+		return ""
+	}
+	ref := m.GetProviderConfigReference()
+	return ref.Kind + "/" + ref.Name
+}
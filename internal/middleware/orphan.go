@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// TypeOrphanOnDelete resources leave their external resource in the backend
+// when they're deleted.
+const TypeOrphanOnDelete xpv1.ConditionType = "OrphanOnDelete"
+
+// Reasons a resource will or will not orphan its external resource.
+const (
+	ReasonDeleteNotAllowed xpv1.ConditionReason = "DeleteNotAllowed"
+	ReasonDeleteAllowed    xpv1.ConditionReason = "DeleteAllowed"
+)
+
+const (
+	msgOrphanOnDeleteFmt = "management policies don't allow Delete; deleting this resource will leave %q in the backend"
+	msgDeleteOnDeleteFmt = "management policies allow Delete; deleting this resource will delete %q from the backend"
+)
+
+// OrphanOnDelete returns a condition that indicates deleting the resource
+// will orphan the named external resource.
+func OrphanOnDelete(name string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeOrphanOnDelete,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeleteNotAllowed,
+		Message:            fmt.Sprintf(msgOrphanOnDeleteFmt, name),
+	}
+}
+
+// DeleteOnDelete returns a condition that indicates deleting the resource
+// will delete the named external resource.
+func DeleteOnDelete(name string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeOrphanOnDelete,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeleteAllowed,
+		Message:            fmt.Sprintf(msgDeleteOnDeleteFmt, name),
+	}
+}
+
+// ReportOrphaning wraps the supplied connector such that its clients surface
+// whether deleting a resource will orphan its external resource as an
+// OrphanOnDelete condition. A resource orphans its external resource when
+// its management policies don't allow Delete. The condition is only set
+// while the external resource exists; the managed reconciler removes an
+// orphaning resource's finalizer without observing it, so its final state
+// is never recorded. Use the bork_orphaned_external_resources metric to
+// verify what was orphaned.
+func ReportOrphaning(c managed.ExternalConnector) managed.ExternalConnector {
+	return &orphanConnector{ExternalConnector: c}
+}
+
+type orphanConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *orphanConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &orphanClient{ExternalClient: ec}, nil
+}
+
+type orphanClient struct {
+	managed.ExternalClient
+}
+
+func (c *orphanClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil || !o.ResourceExists || meta.WasDeleted(mg) {
+		return o, err
+	}
+	if Allows(mg.GetManagementPolicies(), xpv1.ManagementActionDelete) {
+		mg.SetConditions(DeleteOnDelete(meta.GetExternalName(mg)))
+		return o, nil
+	}
+	mg.SetConditions(OrphanOnDelete(meta.GetExternalName(mg)))
+	return o, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+// ReasonPanic is the reason of the BackendError condition set, and the event
+// recorded, when an operation on an external resource panics.
+const ReasonPanic xpv1.ConditionReason = "Panic"
+
+const errPanicFmt = "panic during %s: %v"
+
+// A panicError is a panic recovered from an operation on an external
+// resource.
+type panicError struct {
+	error
+}
+
+// IsPanic returns true if the supplied error is a panic recovered from an
+// operation on an external resource.
+func IsPanic(err error) bool {
+	return errors.As(err, &panicError{})
+}
+
+// RecoverPanics wraps the supplied connector such that a panic in any
+// operation its clients perform on an external resource is recovered, and
+// returned as an error. The supplied kind is the kind of managed resource
+// the connector's clients operate on. Each panic is logged with its stack to
+// the supplied logger, and counted by the external operation panics metric.
+// The managed reconciler reports the error in the resource's Synced
+// condition, so wrap a connector with RecoverPanics inside ReportErrors,
+// which reports it in the BackendError condition with the Panic reason.
+// Panics elsewhere in the reconcile are recovered by controller-runtime,
+// which doesn't update the resource's status.
+func RecoverPanics(kind string, log logging.Logger, c managed.ExternalConnector) managed.ExternalConnector {
+	return &panicConnector{ExternalConnector: c, kind: kind, log: log}
+}
+
+type panicConnector struct {
+	managed.ExternalConnector
+	kind string
+	log  logging.Logger
+}
+
+func (c *panicConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &panicClient{ExternalClient: ec, kind: c.kind, log: c.log}, nil
+}
+
+type panicClient struct {
+	managed.ExternalClient
+	kind string
+	log  logging.Logger
+}
+
+func (c *panicClient) Observe(ctx context.Context, mg resource.Managed) (o managed.ExternalObservation, err error) {
+	defer c.recoverPanic(mg, metrics.OperationObserve, &err)
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *panicClient) Create(ctx context.Context, mg resource.Managed) (cr managed.ExternalCreation, err error) {
+	defer c.recoverPanic(mg, metrics.OperationCreate, &err)
+	return c.ExternalClient.Create(ctx, mg)
+}
+
+func (c *panicClient) Update(ctx context.Context, mg resource.Managed) (u managed.ExternalUpdate, err error) {
+	defer c.recoverPanic(mg, metrics.OperationUpdate, &err)
+	return c.ExternalClient.Update(ctx, mg)
+}
+
+func (c *panicClient) Delete(ctx context.Context, mg resource.Managed) (d managed.ExternalDelete, err error) {
+	defer c.recoverPanic(mg, metrics.OperationDelete, &err)
+	return c.ExternalClient.Delete(ctx, mg)
+}
+
+// recoverPanic recovers a panic in the supplied operation on the supplied
+// resource's external resource, if any, and sets the supplied error to it.
+// It must be deferred.
+func (c *panicClient) recoverPanic(mg resource.Managed, op string, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	*err = panicError{errors.Errorf(errPanicFmt, op, r)}
+	c.log.Info("Recovered panic in external operation",
+		"kind", c.kind,
+		"namespace", mg.GetNamespace(),
+		"name", mg.GetName(),
+		"operation", op,
+		"panic", fmt.Sprint(r),
+		"stack", string(debug.Stack()),
+	)
+	metrics.ExternalOperationPanics.With(prometheus.Labels{
+		metrics.LabelKind:           c.kind,
+		metrics.LabelProviderConfig: providerConfig(mg),
+		metrics.LabelVerb:           op,
+		metrics.LabelTenant:         backend.DefaultTenants.Tenant(mg.GetNamespace()),
+	}).Inc()
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+// TypeQuotaExceeded resources can't create their external resource, because
+// their provider config's quota doesn't allow it.
+const TypeQuotaExceeded xpv1.ConditionType = "QuotaExceeded"
+
+// Reasons a resource has or has not exceeded its provider config's quota.
+const (
+	ReasonQuotaExceeded xpv1.ConditionReason = "QuotaExceeded"
+	ReasonWithinQuota   xpv1.ConditionReason = "WithinQuota"
+)
+
+const errCheckQuota = "cannot check provider config quota"
+
+// QuotaExceeded returns a condition that indicates the resource's provider
+// config's quota doesn't allow it to create its external resource.
+func QuotaExceeded(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQuotaExceeded,
+		Message:            err.Error(),
+	}
+}
+
+// WithinQuota returns a condition that indicates the resource's provider
+// config's quota allows it to create its external resource.
+func WithinQuota() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWithinQuota,
+	}
+}
+
+// EnforceQuota wraps the supplied connector such that its clients only create
+// external resources that their provider config's quota allows. Creating one
+// it doesn't allow fails, sets a QuotaExceeded condition and records a
+// QuotaExceeded event. The condition becomes false once the quota allows the
+// resource to be created. Wrap a connector that records events, so that a
+// create that was never attempted isn't recorded as one that failed.
+func EnforceQuota(r event.Recorder, kube client.Reader, c managed.ExternalConnector) managed.ExternalConnector {
+	return &quotaConnector{ExternalConnector: c, record: r, kube: kube}
+}
+
+type quotaConnector struct {
+	managed.ExternalConnector
+	record event.Recorder
+	kube   client.Reader
+}
+
+func (c *quotaConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &quotaClient{ExternalClient: ec, record: c.record, kube: c.kube}, nil
+}
+
+type quotaClient struct {
+	managed.ExternalClient
+	record event.Recorder
+	kube   client.Reader
+}
+
+func (c *quotaClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	err := clients.CheckQuota(ctx, c.kube, mg)
+	if clients.IsQuotaExceeded(err) {
+		mg.SetConditions(QuotaExceeded(err))
+		c.record.Event(mg, event.Warning(event.Reason(ReasonQuotaExceeded), err))
+		return managed.ExternalCreation{}, err
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCheckQuota)
+	}
+	if mg.GetCondition(TypeQuotaExceeded).Status == corev1.ConditionTrue {
+		mg.SetConditions(WithinQuota())
+	}
+	return c.ExternalClient.Create(ctx, mg)
+}
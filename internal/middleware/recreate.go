@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+// TypeRecreating resources are deleting and creating their external resource
+// again, as requested by their recreate annotation.
+const TypeRecreating xpv1.ConditionType = "Recreating"
+
+// Reasons a resource is or is not recreating its external resource.
+const (
+	ReasonRecreateDeleting   xpv1.ConditionReason = "DeletingExternalResource"
+	ReasonRecreateCreating   xpv1.ConditionReason = "CreatingExternalResource"
+	ReasonRecreateNotAllowed xpv1.ConditionReason = "RecreateNotAllowed"
+	ReasonRecreated          xpv1.ConditionReason = "Recreated"
+)
+
+const (
+	msgRecreateDeletingFmt = "deleting external resource %q to recreate it as requested by %q"
+	msgRecreateCreatingFmt = "creating external resource %q again as requested by %q"
+	msgRecreateOrphanFmt   = "cannot recreate external resource %q as requested by %q: the deletion policy orphans it"
+	msgRecreatePolicyFmt   = "cannot recreate external resource %q as requested by %q: management policies don't allow both Delete and Create"
+	msgRecreatedFmt        = "recreated external resource %q as requested by %q"
+)
+
+// A Recreatable resource records the recreate request for which its external
+// resource was last recreated.
+type Recreatable interface {
+	GetRecreatedFor() string
+	SetRecreatedFor(v string)
+}
+
+// Recreating returns a condition that indicates the resource is recreating
+// its external resource, and is now in the supplied phase.
+func Recreating(r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRecreating,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
+
+// NotRecreating returns a condition that indicates the resource isn't
+// recreating its external resource, for the supplied reason.
+func NotRecreating(r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRecreating,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
+
+// Recreate wraps the supplied connector such that its clients honour recreate
+// requests. When a managed resource's recreate annotation changes its
+// external resource is deleted, then created again, as if the managed
+// resource had been deleted and created. Progress is reported as a
+// Recreating condition, and the request is recorded in the managed
+// resource's status once the external resource has been created again.
+// Recreation respects the managed resource's deletion policy: an external
+// resource that would be orphaned, or whose management policies don't allow
+// it to be both deleted and created, isn't recreated. Dry runs don't recreate
+// their external resource.
+func Recreate(c managed.ExternalConnector) managed.ExternalConnector {
+	return &recreateConnector{ExternalConnector: c}
+}
+
+type recreateConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *recreateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &recreateClient{ExternalClient: ec}, nil
+}
+
+type recreateClient struct {
+	managed.ExternalClient
+
+	// pending is set by Observe to the recreate request the next Create
+	// satisfies.
+	pending string
+}
+
+func (c *recreateClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	rc, ok := mg.(Recreatable)
+	req := mg.GetAnnotations()[v1alpha1.AnnotationKeyRecreate]
+	if !ok || req == "" || req == rc.GetRecreatedFor() || meta.WasDeleted(mg) || DryRun(mg) {
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+
+	name := meta.GetExternalName(mg)
+	if o, ok := mg.(resource.Orphanable); ok && o.GetDeletionPolicy() == xpv1.DeletionOrphan {
+		mg.SetConditions(NotRecreating(ReasonRecreateNotAllowed, fmt.Sprintf(msgRecreateOrphanFmt, name, req)))
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+	mp := mg.GetManagementPolicies()
+	if !Allows(mp, xpv1.ManagementActionDelete) || !Allows(mp, xpv1.ManagementActionCreate) {
+		mg.SetConditions(NotRecreating(ReasonRecreateNotAllowed, fmt.Sprintf(msgRecreatePolicyFmt, name, req)))
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+	if o.ResourceExists {
+		mg.SetConditions(Recreating(ReasonRecreateDeleting, fmt.Sprintf(msgRecreateDeletingFmt, name, req)))
+		if _, err := c.ExternalClient.Delete(ctx, mg); err != nil {
+			return o, err
+		}
+		// Some external resources take a while to be deleted, in which
+		// case they're observed again on the next poll.
+		if o, err = c.ExternalClient.Observe(ctx, mg); err != nil || o.ResourceExists {
+			return o, err
+		}
+	}
+
+	mg.SetConditions(Recreating(ReasonRecreateCreating, fmt.Sprintf(msgRecreateCreatingFmt, name, req)))
+	c.pending = req
+	return o, nil
+}
+
+func (c *recreateClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	rc, ok := mg.(Recreatable)
+	if err != nil || !ok || c.pending == "" {
+		return cr, err
+	}
+	rc.SetRecreatedFor(c.pending)
+	mg.SetConditions(NotRecreating(ReasonRecreated, fmt.Sprintf(msgRecreatedFmt, meta.GetExternalName(mg), c.pending)))
+	return cr, nil
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// Operations on external resources that can fail with a simulated error.
+const (
+	opObserve = "observe"
+	opCreate  = "create"
+	opUpdate  = "update"
+	opDelete  = "delete"
+)
+
+const (
+	errSimulateErrorFmt = "invalid %s annotation %q: %s"
+
+	msgUnknownOperationFmt  = "unknown operation %q"
+	msgUnknownCodeFmt       = "unknown error code %q"
+	msgInvalidInvocationFmt = "invalid invocation %q; must be a positive integer"
+	msgSimulatedFmt         = "simulated %s error from %s"
+	msgSimulatedPanicFmt    = "simulated panic on invocation %d of %s"
+)
+
+// SimulateErrors wraps the supplied connector such that its clients' operations
+// fail with the class of backend error the resource's simulate-error
+// annotation names, without calling the backend, or panic on the invocation
+// its simulate-panic annotation names. Wrap a connector with SimulateErrors
+// inside any middleware that handles errors, so that simulated errors surface
+// just as errors returned by the backend would, and inside RecoverPanics so
+// that simulated panics surface as real ones would.
+func SimulateErrors(c managed.ExternalConnector) managed.ExternalConnector {
+	return &simulateConnector{ExternalConnector: c, invocations: &invocations{counts: make(map[invocation]int)}}
+}
+
+type simulateConnector struct {
+	managed.ExternalConnector
+	invocations *invocations
+}
+
+func (c *simulateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &simulateClient{ExternalClient: ec, invocations: c.invocations}, nil
+}
+
+type simulateClient struct {
+	managed.ExternalClient
+	invocations *invocations
+}
+
+func (c *simulateClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if err := simulated(mg, opObserve); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if err := c.invocations.maybePanic(mg, opObserve); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *simulateClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := simulated(mg, opCreate); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := c.invocations.maybePanic(mg, opCreate); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return c.ExternalClient.Create(ctx, mg)
+}
+
+func (c *simulateClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if err := simulated(mg, opUpdate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := c.invocations.maybePanic(mg, opUpdate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return c.ExternalClient.Update(ctx, mg)
+}
+
+func (c *simulateClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if err := simulated(mg, opDelete); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	return c.ExternalClient.Delete(ctx, mg)
+}
+
+// simulated returns the error the supplied operation on the supplied
+// resource's external resource should fail with, if any.
+func simulated(mg resource.Managed, op string) error {
+	v := strings.TrimSpace(mg.GetAnnotations()[v1alpha1.AnnotationKeySimulateError])
+	if v == "" {
+		return nil
+	}
+	invalid := func(reason string) error {
+		return errors.Errorf(errSimulateErrorFmt, v1alpha1.AnnotationKeySimulateError, v, reason)
+	}
+
+	if !strings.Contains(v, "=") {
+		code := backend.ErrorCode(v)
+		if !slices.Contains(backend.ErrorCodes, code) {
+			return invalid(fmt.Sprintf(msgUnknownCodeFmt, v))
+		}
+		return backend.NewError(code, fmt.Sprintf(msgSimulatedFmt, code, op))
+	}
+
+	var err error
+	for _, pair := range strings.Split(v, ",") {
+		o, c, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		code := backend.ErrorCode(strings.TrimSpace(c))
+		o = strings.TrimSpace(o)
+		switch {
+		case !ok || !slices.Contains([]string{opObserve, opCreate, opUpdate, opDelete}, o):
+			return invalid(fmt.Sprintf(msgUnknownOperationFmt, o))
+		case !slices.Contains(backend.ErrorCodes, code):
+			return invalid(fmt.Sprintf(msgUnknownCodeFmt, code))
+		case o == op:
+			err = backend.NewError(code, fmt.Sprintf(msgSimulatedFmt, code, op))
+		}
+	}
+	return err
+}
+
+// An invocation of an operation on a resource's external resource.
+type invocation struct {
+	uid types.UID
+	op  string
+}
+
+// invocations counts the invocations of each operation on the external
+// resources of resources with a simulate-panic annotation.
+type invocations struct {
+	mu     sync.Mutex
+	counts map[invocation]int
+}
+
+// maybePanic counts an invocation of the supplied operation on the supplied
+// resource's external resource, and panics if it's the invocation the
+// resource's simulate-panic annotation names. It returns an error if the
+// annotation is invalid. Resources that are being deleted never panic, and
+// are no longer counted, so that they can be deleted.
+func (i *invocations) maybePanic(mg resource.Managed, op string) error {
+	v := strings.TrimSpace(mg.GetAnnotations()[v1alpha1.AnnotationKeySimulatePanic])
+	if v == "" {
+		return nil
+	}
+	if meta.WasDeleted(mg) {
+		i.forget(mg.GetUID())
+		return nil
+	}
+	n, err := panicInvocation(v, op)
+	if err != nil || n == 0 {
+		return err
+	}
+
+	i.mu.Lock()
+	k := invocation{uid: mg.GetUID(), op: op}
+	i.counts[k]++
+	count := i.counts[k]
+	i.mu.Unlock()
+
+	if count == n {
+		panic(fmt.Sprintf(msgSimulatedPanicFmt, count, op))
+	}
+	return nil
+}
+
+// forget stops counting the invocations of operations on the supplied
+// resource's external resource.
+func (i *invocations) forget(uid types.UID) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for k := range i.counts {
+		if k.uid == uid {
+			delete(i.counts, k)
+		}
+	}
+}
+
+// panicInvocation returns the invocation of the supplied operation that the
+// supplied simulate-panic annotation value names, or zero if it names none.
+func panicInvocation(v, op string) (int, error) {
+	invalid := func(reason string) error {
+		return errors.Errorf(errSimulateErrorFmt, v1alpha1.AnnotationKeySimulatePanic, v, reason)
+	}
+	parse := func(s string) (int, error) {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || n < 1 {
+			return 0, invalid(fmt.Sprintf(msgInvalidInvocationFmt, s))
+		}
+		return n, nil
+	}
+
+	if !strings.Contains(v, "=") {
+		return parse(v)
+	}
+
+	n := 0
+	for _, pair := range strings.Split(v, ",") {
+		o, c, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		o = strings.TrimSpace(o)
+		if !ok || !slices.Contains([]string{opObserve, opCreate, opUpdate}, o) {
+			return 0, invalid(fmt.Sprintf(msgUnknownOperationFmt, o))
+		}
+		i, err := parse(c)
+		if err != nil {
+			return 0, err
+		}
+		if o == op {
+			n = i
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+)
+
+const (
+	msgSyncObserved       = "external resource was observed; management policies don't allow it to be updated"
+	msgSyncNotExist       = "external resource does not exist; management policies don't allow it to be created"
+	msgSyncCreated        = "external resource was created"
+	msgSyncUpdated        = "external resource was updated"
+	msgSyncDeletedPending = "managed resource was deleted before the request was synced"
+)
+
+// A SyncRequestRecorder records requests to sync it with the backend.
+type SyncRequestRecorder interface {
+	GetSyncRequests() []v1alpha1.SyncRequest
+	SetSyncRequests(r []v1alpha1.SyncRequest)
+}
+
+type syncRequestedKey struct{}
+
+// SyncRequested returns true if the supplied context belongs to a call made
+// to satisfy a sync request. External clients should bypass any caching and
+// write the external resource unconditionally when a sync is requested.
+func SyncRequested(ctx context.Context) bool {
+	v, _ := ctx.Value(syncRequestedKey{}).(bool)
+	return v
+}
+
+// SyncRequests wraps the supplied connector such that its clients honour
+// sync requests. When a managed resource's sync request annotation changes the
+// external resource is synced - created if it doesn't exist, and updated even
+// if it appears to be up to date. The request, the field manager that made it,
+// and its outcome are recorded in the managed resource's status.
+func SyncRequests(c managed.ExternalConnector) managed.ExternalConnector {
+	return &syncConnector{ExternalConnector: c}
+}
+
+type syncConnector struct {
+	managed.ExternalConnector
+}
+
+func (c *syncConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &syncClient{ExternalClient: ec}, nil
+}
+
+type syncClient struct {
+	managed.ExternalClient
+
+	// pending is set by Observe if the resource has a sync request that has
+	// not yet completed.
+	pending *v1alpha1.SyncRequest
+}
+
+func (c *syncClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	rr, ok := mg.(SyncRequestRecorder)
+	id := mg.GetAnnotations()[v1alpha1.AnnotationKeySyncRequest]
+	if !ok || id == "" {
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+
+	req := v1alpha1.SyncRequest{
+		ID:         id,
+		Requester:  requester(mg),
+		ObservedAt: metav1.Now(),
+		Outcome:    v1alpha1.SyncOutcomePending,
+	}
+	if r := rr.GetSyncRequests(); len(r) > 0 && r[0].ID == id {
+		req = r[0]
+	}
+	if req.Outcome != v1alpha1.SyncOutcomePending {
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+	record(rr, req)
+
+	if meta.WasDeleted(mg) {
+		complete(rr, req, v1alpha1.SyncOutcomeFailed, msgSyncDeletedPending)
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+
+	o, err := c.ExternalClient.Observe(context.WithValue(ctx, syncRequestedKey{}, true), mg)
+	if err != nil {
+		complete(rr, req, v1alpha1.SyncOutcomeFailed, err.Error())
+		return o, err
+	}
+
+	// The managed reconciler won't call Create or Update if the resource's
+	// management policies don't allow it, so the sync is as complete as it
+	// will ever be.
+	mp := mg.GetManagementPolicies()
+	switch {
+	case o.ResourceExists && !Allows(mp, xpv1.ManagementActionUpdate):
+		complete(rr, req, v1alpha1.SyncOutcomeSucceeded, msgSyncObserved)
+		return o, nil
+	case !o.ResourceExists && !Allows(mp, xpv1.ManagementActionCreate):
+		complete(rr, req, v1alpha1.SyncOutcomeFailed, msgSyncNotExist)
+		return o, nil
+	}
+
+	c.pending = &req
+	o.ResourceUpToDate = false
+	return o, nil
+}
+
+func (c *syncClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if c.pending == nil {
+		return c.ExternalClient.Create(ctx, mg)
+	}
+	cr, err := c.ExternalClient.Create(context.WithValue(ctx, syncRequestedKey{}, true), mg)
+	completeWithError(mg, *c.pending, msgSyncCreated, err)
+	return cr, err
+}
+
+func (c *syncClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if c.pending == nil {
+		return c.ExternalClient.Update(ctx, mg)
+	}
+	u, err := c.ExternalClient.Update(context.WithValue(ctx, syncRequestedKey{}, true), mg)
+	completeWithError(mg, *c.pending, msgSyncUpdated, err)
+	return u, err
+}
+
+// Allows returns true if the supplied management policies allow the supplied
+// action. Management policies are only honoured when the feature is enabled,
+// but the CRD defaults them to allow all actions.
+func Allows(mp xpv1.ManagementPolicies, a xpv1.ManagementAction) bool {
+	return slices.Contains(mp, xpv1.ManagementActionAll) || slices.Contains(mp, a)
+}
+
+func completeWithError(mg resource.Managed, req v1alpha1.SyncRequest, msg string, err error) {
+	rr, ok := mg.(SyncRequestRecorder)
+	if !ok {
+		return
+	}
+	if err != nil {
+		complete(rr, req, v1alpha1.SyncOutcomeFailed, err.Error())
+		return
+	}
+	complete(rr, req, v1alpha1.SyncOutcomeSucceeded, msg)
+}
+
+// complete records the outcome of the supplied sync request.
+func complete(rr SyncRequestRecorder, req v1alpha1.SyncRequest, outcome v1alpha1.SyncOutcome, msg string) {
+	now := metav1.Now()
+	req.Outcome = outcome
+	req.Message = msg
+	req.CompletedAt = &now
+	record(rr, req)
+}
+
+// record records the supplied sync request as the newest, replacing any
+// existing record of the same request. External clients may refresh the
+// managed resource from the API server while syncing it, discarding any sync
+// requests recorded in its status but not yet persisted, so the request is
+// recorded again when it completes.
+func record(rr SyncRequestRecorder, req v1alpha1.SyncRequest) {
+	requests := []v1alpha1.SyncRequest{req}
+	for _, r := range rr.GetSyncRequests() {
+		if r.ID != req.ID {
+			requests = append(requests, r)
+		}
+	}
+	if len(requests) > v1alpha1.MaxSyncRequests {
+		requests = requests[:v1alpha1.MaxSyncRequests]
+	}
+	rr.SetSyncRequests(requests)
+}
+
+// requester returns the field manager that most recently set the supplied
+// resource's sync request annotation, if any.
+func requester(mg resource.Managed) string {
+	field := []byte(`"f:` + v1alpha1.AnnotationKeySyncRequest + `"`)
+
+	var latest *metav1.ManagedFieldsEntry
+	mf := mg.GetManagedFields()
+	for i := range mf {
+		e := &mf[i]
+		if e.FieldsV1 == nil || !bytes.Contains(e.FieldsV1.Raw, field) {
+			continue
+		}
+		if latest == nil || (e.Time != nil && latest.Time != nil && latest.Time.Before(e.Time)) {
+			latest = e
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Manager
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"maps"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+// A Tagged managed resource tags its external resource.
+type Tagged interface {
+	resource.Managed
+
+	GetTags() map[string]string
+	SetTags(tags map[string]string)
+}
+
+// PropagateTags wraps the supplied connector such that its clients tag the
+// external resources of Tagged managed resources with the labels and
+// annotations their provider config's tag propagation names. The propagated
+// tags are added to the managed resource's tags only while the wrapped
+// client is called, so they're written to and compared with the backend but
+// never to the managed resource's spec. Tags the managed resource sets take
+// precedence over propagated ones.
+func PropagateTags(kube client.Reader, c managed.ExternalConnector) managed.ExternalConnector {
+	return &tagConnector{ExternalConnector: c, kube: kube}
+}
+
+type tagConnector struct {
+	managed.ExternalConnector
+	kube client.Reader
+}
+
+func (c *tagConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := mg.(Tagged); !ok {
+		return ec, nil
+	}
+	// The wrapped connector resolved the provider config to connect, and
+	// would have returned any error doing so.
+	_, pc, rerr := clients.ResolveProviderConfig(ctx, c.kube, mg)
+	if rerr != nil || pc.TagPropagation == nil {
+		return ec, nil
+	}
+	return &tagClient{ExternalClient: ec, config: pc.TagPropagation}, nil
+}
+
+type tagClient struct {
+	managed.ExternalClient
+	config *apisv1alpha1.TagPropagationConfig
+}
+
+func (c *tagClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	defer c.tag(mg.(Tagged))()
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *tagClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	defer c.tag(mg.(Tagged))()
+	return c.ExternalClient.Create(ctx, mg)
+}
+
+func (c *tagClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	defer c.tag(mg.(Tagged))()
+	return c.ExternalClient.Update(ctx, mg)
+}
+
+// propagated returns the tags propagated from the supplied managed resource's
+// labels and annotations, excluding those it sets itself.
+func (c *tagClient) propagated(mg Tagged) map[string]string {
+	set := mg.GetTags()
+	tags := map[string]string{}
+	add := func(from map[string]string, keys []string) {
+		for _, k := range keys {
+			v, ok := from[k]
+			if !ok {
+				continue
+			}
+			if _, ok := set[k]; ok {
+				continue
+			}
+			if _, ok := tags[k]; ok {
+				continue
+			}
+			tags[k] = v
+		}
+	}
+	add(mg.GetLabels(), c.config.Labels)
+	add(mg.GetAnnotations(), c.config.Annotations)
+	return tags
+}
+
+// tag adds the supplied managed resource's propagated tags to its tags. It
+// returns a function that removes them again, leaving any tags the wrapped
+// client late initialized.
+func (c *tagClient) tag(mg Tagged) func() {
+	propagated := c.propagated(mg)
+	if len(propagated) == 0 {
+		return func() {}
+	}
+	set := mg.GetTags()
+	tags := make(map[string]string, len(set)+len(propagated))
+	maps.Copy(tags, set)
+	maps.Copy(tags, propagated)
+	mg.SetTags(tags)
+
+	return func() {
+		tags := mg.GetTags()
+		for k, v := range propagated {
+			if tags[k] == v {
+				delete(tags, k)
+			}
+		}
+		if len(tags) == 0 && set == nil {
+			tags = nil
+		}
+		mg.SetTags(tags)
+	}
+}
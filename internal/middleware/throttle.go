@@ -0,0 +1,313 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// A RequeueScenario is what an external operation found that determines how
+// soon its managed resource should be reconciled again.
+type RequeueScenario string
+
+// Requeue scenarios.
+const (
+	// RequeueThrottled operations were throttled by the backend. They're
+	// retried no sooner than the backend asked, or RequeueAfterThrottled if
+	// it didn't say.
+	RequeueThrottled RequeueScenario = "Throttled"
+
+	// RequeuePending operations found a resource the backend is still
+	// working on: one that isn't ready yet, or couldn't be written because
+	// it's busy. They're requeued after
+	// RequeueAfterPending, unless they'd be polled sooner.
+	RequeuePending RequeueScenario = "Pending"
+
+	// RequeueDrifted operations found a resource that had drifted from its
+	// desired state, and updated it. They're requeued immediately, to check
+	// that the update took. A resource that's still drifted when it's
+	// requeued is polled as usual, so that one that can't be brought up to
+	// date isn't reconciled in a hot loop.
+	RequeueDrifted RequeueScenario = "Drifted"
+)
+
+// How soon managed resources are requeued in each scenario.
+const (
+	RequeueAfterThrottled = time.Minute
+	RequeueAfterPending   = 5 * time.Second
+
+	// requeueImmediately is the shortest requeue there is. A request can't
+	// be requeued after zero, which doesn't requeue it at all.
+	requeueImmediately = time.Nanosecond
+)
+
+// A requeue is how soon a managed resource should be reconciled again, per
+// what its last external operation found.
+type requeue struct {
+	scenario RequeueScenario
+
+	// until is when a throttled resource may be reconciled.
+	until time.Time
+
+	// after is how long after its reconcile finishes any other resource
+	// should be reconciled again.
+	after time.Duration
+}
+
+// A RetryAfter requeues managed resources according to what their external
+// operations found, rather than uniformly. Resources whose operations were
+// throttled are requeued no sooner than the backend asked, those the backend
+// is still working on are requeued shortly, and those that drifted are
+// requeued immediately once updated. What each operation found is recorded by
+// the clients of a connector it wraps, and honoured by a reconciler it wraps.
+//
+// Without it every failed operation is requeued with the controller's
+// exponential backoff, which starts at a fraction of a second, so a throttled
+// operation is likely to be throttled again. Every successful one is polled
+// at the poll interval, so a resource that isn't ready yet waits a whole
+// interval to become ready.
+type RetryAfter struct {
+	mu       sync.Mutex
+	requeues map[types.NamespacedName]requeue
+
+	// immediate are the resources that were requeued immediately because
+	// they drifted.
+	immediate map[types.NamespacedName]bool
+}
+
+// NewRetryAfter returns a RetryAfter that has recorded no external
+// operations.
+func NewRetryAfter() *RetryAfter {
+	return &RetryAfter{requeues: make(map[types.NamespacedName]requeue), immediate: make(map[types.NamespacedName]bool)}
+}
+
+// Connector wraps the supplied connector such that what the operations of its
+// clients found is recorded.
+func (t *RetryAfter) Connector(c managed.ExternalConnector) managed.ExternalConnector {
+	return &retryAfterConnector{ExternalConnector: c, retry: t}
+}
+
+// Reconciler wraps the supplied reconciler such that a request is requeued
+// per what the external operations of its managed resource found. A request
+// whose managed resource was throttled is requeued once the backend's
+// retry-after hint has passed. Requests that arrive before then, e.g. because
+// the managed resource was updated, are requeued without being reconciled.
+func (t *RetryAfter) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if d := t.wait(req.NamespacedName); d > 0 {
+			return reconcile.Result{RequeueAfter: d}, nil
+		}
+		res, err := r.Reconcile(ctx, req)
+		rq, ok := t.take(req.NamespacedName)
+		if !ok || err != nil {
+			return res, err
+		}
+
+		// The managed reconciler doesn't return the errors of external
+		// operations, but instead requeues with backoff, and requeues
+		// successful operations after its poll interval. We replace that
+		// requeue with one that suits what the operations found.
+		switch rq.scenario {
+		case RequeueThrottled:
+			if d := time.Until(rq.until); d > 0 {
+				return reconcile.Result{RequeueAfter: d}, nil
+			}
+		case RequeuePending:
+			if res.RequeueAfter > 0 && res.RequeueAfter < rq.after {
+				return res, nil
+			}
+		case RequeueDrifted:
+			if !t.requeueImmediately(req.NamespacedName) {
+				return res, nil
+			}
+		}
+		return reconcile.Result{RequeueAfter: rq.after}, nil
+	})
+}
+
+// record records what an operation on the supplied resource found, replacing
+// what earlier operations found.
+func (t *RetryAfter) record(mg resource.Managed, rq requeue) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.requeues[types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}] = rq
+}
+
+// forget forgets what earlier operations on the supplied resource found,
+// unless it was throttled and must still wait.
+func (t *RetryAfter) forget(mg resource.Managed) {
+	nn := types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if rq, ok := t.requeues[nn]; ok && rq.scenario == RequeueThrottled && time.Now().Before(rq.until) {
+		return
+	}
+	delete(t.requeues, nn)
+}
+
+// failed records that an operation on the supplied resource returned the
+// supplied error. An operation that was throttled, or that failed because
+// the backend is busy with the resource, is requeued per its scenario. Any
+// other failure is requeued with the controller's backoff.
+func (t *RetryAfter) failed(mg resource.Managed, err error) {
+	switch {
+	case backend.IsThrottled(err):
+		d := backend.RetryAfter(err)
+		if d <= 0 {
+			d = RequeueAfterThrottled
+		}
+		t.record(mg, requeue{scenario: RequeueThrottled, until: time.Now().Add(d), after: d})
+	case backend.IsConflict(err):
+		t.record(mg, requeue{scenario: RequeuePending, after: RequeueAfterPending})
+	default:
+		t.forget(mg)
+	}
+}
+
+// observed records what observing the supplied resource found.
+func (t *RetryAfter) observed(mg resource.Managed, o managed.ExternalObservation) {
+	switch {
+	case o.ResourceExists && !o.ResourceUpToDate:
+		t.record(mg, requeue{scenario: RequeueDrifted, after: requeueImmediately})
+	case o.ResourceExists && mg.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue:
+		t.record(mg, requeue{scenario: RequeuePending, after: RequeueAfterPending})
+	default:
+		t.forget(mg)
+	}
+}
+
+// requeueImmediately returns true if the named resource, which drifted, should
+// be requeued immediately. It returns false if the resource was requeued
+// immediately the last time it drifted, and hasn't since been reconciled
+// without drifting.
+func (t *RetryAfter) requeueImmediately(nn types.NamespacedName) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.immediate[nn] {
+		delete(t.immediate, nn)
+		return false
+	}
+	t.immediate[nn] = true
+	return true
+}
+
+// wait returns how much longer the named resource must wait before it's
+// reconciled because it was throttled, forgetting its throttled operation
+// once it need wait no longer.
+func (t *RetryAfter) wait(nn types.NamespacedName) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rq, ok := t.requeues[nn]
+	if !ok || rq.scenario != RequeueThrottled {
+		return 0
+	}
+	d := time.Until(rq.until)
+	if d <= 0 {
+		delete(t.requeues, nn)
+		return 0
+	}
+	return d
+}
+
+// take returns what the external operations of the named resource found
+// during the reconcile that just finished. Throttled resources stay recorded
+// until they need wait no longer; anything else is forgotten.
+func (t *RetryAfter) take(nn types.NamespacedName) (requeue, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rq, ok := t.requeues[nn]
+	if !ok || rq.scenario != RequeueDrifted {
+		delete(t.immediate, nn)
+	}
+	if ok && rq.scenario != RequeueThrottled {
+		delete(t.requeues, nn)
+	}
+	return rq, ok
+}
+
+type retryAfterConnector struct {
+	managed.ExternalConnector
+	retry *RetryAfter
+}
+
+func (c *retryAfterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		c.retry.failed(mg, err)
+		return nil, err
+	}
+	return &retryAfterClient{ExternalClient: ec, retry: c.retry}, nil
+}
+
+type retryAfterClient struct {
+	managed.ExternalClient
+	retry *RetryAfter
+}
+
+func (c *retryAfterClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		c.retry.failed(mg, err)
+		return o, err
+	}
+	c.retry.observed(mg, o)
+	return o, nil
+}
+
+// The managed reconciler promptly requeues a resource it created or deleted,
+// so those operations forget what observing it found.
+func (c *retryAfterClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	if err != nil {
+		c.retry.failed(mg, err)
+		return cr, err
+	}
+	c.retry.forget(mg)
+	return cr, nil
+}
+
+// A resource that was updated keeps what observing it found, e.g. that it
+// drifted.
+func (c *retryAfterClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := c.ExternalClient.Update(ctx, mg)
+	if err != nil {
+		c.retry.failed(mg, err)
+	}
+	return u, err
+}
+
+func (c *retryAfterClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	if err != nil {
+		c.retry.failed(mg, err)
+		return d, err
+	}
+	c.retry.forget(mg)
+	return d, nil
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import "time"
+
+// DefaultReconcileTimeout is how long the operations a reconcile performs on
+// an external resource may take by default. It's the managed reconciler's
+// default.
+const DefaultReconcileTimeout = time.Minute
+
+// ReconcileTimeout is how long the operations a reconcile performs on an
+// external resource, from connecting to the backend to updating or deleting
+// the external resource, may take in total. Operations still running when it
+// passes are cancelled, and fail with an error that satisfies
+// backend.IsTimeout, which ReportErrors reports with the Timeout reason. It
+// must be set before controllers are set up.
+var ReconcileTimeout = DefaultReconcileTimeout
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane/provider-bork/internal/tracing"
+)
+
+// Trace wraps the supplied connector such that connecting, and every
+// operation its clients perform on an external resource, is traced. The
+// supplied kind is the kind of managed resource the connector's clients
+// operate on. Spans are children of the reconcile's span, if any, and the
+// parents of the spans of the backend calls made by the operation.
+func Trace(kind string, c managed.ExternalConnector) managed.ExternalConnector {
+	return &traceConnector{ExternalConnector: c, kind: kind}
+}
+
+type traceConnector struct {
+	managed.ExternalConnector
+	kind string
+}
+
+func (c *traceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ctx, span := start(ctx, c.kind, "Connect", mg)
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return &traceClient{ExternalClient: ec, kind: c.kind}, nil
+}
+
+type traceClient struct {
+	managed.ExternalClient
+	kind string
+}
+
+func (c *traceClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := start(ctx, c.kind, "Observe", mg)
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	tracing.End(span, err)
+	return o, err
+}
+
+func (c *traceClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := start(ctx, c.kind, "Create", mg)
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	tracing.End(span, err)
+	return cr, err
+}
+
+func (c *traceClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := start(ctx, c.kind, "Update", mg)
+	u, err := c.ExternalClient.Update(ctx, mg)
+	tracing.End(span, err)
+	return u, err
+}
+
+func (c *traceClient) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	ctx, span := start(ctx, c.kind, "Delete", mg)
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	tracing.End(span, err)
+	return d, err
+}
+
+// start starts a span of the named phase of reconciling the supplied
+// resource.
+func start(ctx context.Context, kind, phase string, mg resource.Managed) (context.Context, trace.Span) {
+	return tracing.Start(ctx, kind+"."+phase, trace.WithAttributes(
+		tracing.AttrKind.String(kind),
+		tracing.AttrNamespace.String(mg.GetNamespace()),
+		tracing.AttrName.String(mg.GetName()),
+		tracing.AttrExternalName.String(meta.GetExternalName(mg)),
+		tracing.AttrProviderConfig.String(providerConfig(mg)),
+	))
+}
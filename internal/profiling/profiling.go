@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package profiling serves the Go runtime's profiles, and writes snapshots of
+// them to files periodically or on demand, so that memory and goroutine
+// growth during a long running soak test can be diagnosed after the fact.
+package profiling
+
+import (
+	"context"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime"
+	rpprof "runtime/pprof"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+)
+
+const (
+	errDisabled          = "profiling is disabled"
+	errUnknownProfileFmt = "unknown profile %q"
+	errCreateDir         = "cannot create profile snapshot directory"
+	errWriteSnapshotFmt  = "cannot write %s profile snapshot"
+	errPruneSnapshotsFmt = "cannot prune %s profile snapshots"
+	errAddSnapshotter    = "cannot add profile snapshotter to controller manager"
+)
+
+// Defaults for snapshotting profiles.
+const (
+	DefaultDirectory = "bork-profiles"
+	DefaultRetain    = 10
+)
+
+// DefaultProfiles are the profiles snapshotted periodically, and on demand
+// unless others are requested.
+var DefaultProfiles = []string{"goroutine", "heap"}
+
+// snapshotSuffix is the suffix of a snapshot's file, which is a gzipped
+// protocol buffer that go tool pprof reads.
+const snapshotSuffix = ".pb.gz"
+
+// Handlers returns the handlers of the net/http/pprof package, keyed by the
+// path it serves them at. Every named profile, e.g. heap or goroutine, is
+// served beneath /debug/pprof/.
+func Handlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		"/debug/pprof/":        http.HandlerFunc(pprof.Index),
+		"/debug/pprof/cmdline": http.HandlerFunc(pprof.Cmdline),
+		"/debug/pprof/profile": http.HandlerFunc(pprof.Profile),
+		"/debug/pprof/symbol":  http.HandlerFunc(pprof.Symbol),
+		"/debug/pprof/trace":   http.HandlerFunc(pprof.Trace),
+	}
+}
+
+// Default snapshots the provider's profiles. It's disabled until it's set
+// up.
+var Default = &Snapshotter{log: logging.NewNopLogger()}
+
+// A Snapshotter writes snapshots of the Go runtime's profiles to files.
+type Snapshotter struct {
+	mu     sync.Mutex
+	log    logging.Logger
+	dir    string
+	retain int
+}
+
+// Setup enables the snapshotter, which writes snapshots to the supplied
+// directory and keeps the supplied number of the latest snapshots of each
+// profile, or every snapshot if it's zero. If the supplied interval is
+// positive the DefaultProfiles are snapshotted that often.
+func (s *Snapshotter) Setup(mgr ctrl.Manager, log logging.Logger, dir string, retain int, interval time.Duration) error {
+	s.mu.Lock()
+	s.log = log.WithValues("controller", "profiling")
+	s.dir = dir
+	s.retain = retain
+	s.mu.Unlock()
+
+	if interval <= 0 {
+		return nil
+	}
+	return errors.Wrap(mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+			if _, err := s.Snapshot(); err != nil {
+				s.log.Info("Cannot snapshot profiles", "error", err)
+			}
+		}
+	})), errAddSnapshotter)
+}
+
+// Enabled returns true if the snapshotter has been set up.
+func (s *Snapshotter) Enabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dir != ""
+}
+
+// Snapshot writes a snapshot of each of the supplied profiles, or of the
+// DefaultProfiles if none are supplied, returning the paths of the files it
+// wrote. A garbage collection is run before the heap profile is snapshotted,
+// so that it's up to date. Older snapshots beyond those the snapshotter
+// retains are deleted.
+func (s *Snapshotter) Snapshot(profiles ...string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dir == "" {
+		return nil, errors.New(errDisabled)
+	}
+	if len(profiles) == 0 {
+		profiles = DefaultProfiles
+	}
+	for _, name := range profiles {
+		if rpprof.Lookup(name) == nil {
+			return nil, errors.Errorf(errUnknownProfileFmt, name)
+		}
+	}
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return nil, errors.Wrap(err, errCreateDir)
+	}
+
+	now := time.Now().UTC().Format("20060102T150405.000Z")
+	paths := make([]string, 0, len(profiles))
+	for _, name := range profiles {
+		path := filepath.Join(s.dir, name+"-"+now+snapshotSuffix)
+		if err := write(name, path); err != nil {
+			return paths, errors.Wrapf(err, errWriteSnapshotFmt, name)
+		}
+		paths = append(paths, path)
+		if err := s.prune(name); err != nil {
+			return paths, errors.Wrapf(err, errPruneSnapshotsFmt, name)
+		}
+	}
+	s.log.Debug("Snapshotted profiles", "paths", paths)
+	return paths, nil
+}
+
+// Snapshots returns the paths of the snapshots in the snapshotter's
+// directory, oldest first within each profile.
+func (s *Snapshotter) Snapshots() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dir == "" {
+		return nil, errors.New(errDisabled)
+	}
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*"+snapshotSuffix))
+	slices.Sort(paths)
+	return paths, err
+}
+
+// prune deletes the oldest snapshots of the named profile beyond those the
+// snapshotter retains. The caller must hold the snapshotter's lock.
+func (s *Snapshotter) prune(name string) error {
+	if s.retain <= 0 {
+		return nil
+	}
+	paths, err := filepath.Glob(filepath.Join(s.dir, name+"-*"+snapshotSuffix))
+	if err != nil {
+		return err
+	}
+	// Snapshots are named for when they were written, so they sort oldest
+	// first.
+	slices.Sort(paths)
+	for _, p := range paths[:max(len(paths)-s.retain, 0)] {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes the named profile to the file at the supplied path.
+func write(name, path string) error {
+	if name == "heap" {
+		runtime.GC()
+	}
+	f, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	if err := rpprof.Lookup(name).WriteTo(f, 0); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quarantine lets operators stop the provider reconciling a
+// misbehaving kind of managed resource, or every managed resource that uses a
+// misbehaving provider config, without scaling the provider to zero.
+package quarantine
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+const (
+	errNewManagedFmt = "cannot create managed resource of kind %s"
+	errGetManaged    = "cannot get managed resource"
+	errGetPC         = "cannot get provider config"
+	errUpdateStatus  = "cannot update managed resource status"
+)
+
+const msgPausedFmt = "Reconciliation is paused by provider config %s"
+
+// Default quarantine of the provider. It disables no kinds until it's set.
+var Default = &Quarantine{}
+
+// A Quarantine determines which managed resources mustn't be reconciled.
+type Quarantine struct {
+	mu       sync.RWMutex
+	disabled map[string]bool
+}
+
+// Disable the controllers of the supplied kinds. Disable must be called
+// before the provider's controllers are set up.
+func (q *Quarantine) Disable(kinds ...string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.disabled = make(map[string]bool, len(kinds))
+	for _, k := range kinds {
+		q.disabled[k] = true
+	}
+}
+
+// Disabled returns true if the controller of the supplied kind is disabled.
+func (q *Quarantine) Disabled(kind string) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.disabled[kind]
+}
+
+// Reconciler wraps the supplied managed reconciler such that it doesn't
+// reconcile managed resources whose provider config is paused by Crossplane's
+// crossplane.io/paused annotation. Their Synced condition reports that they're
+// paused, and they're requeued at the supplied poll interval, so that they
+// resume soon after their provider config is unpaused. Managed resources
+// whose provider config can't be resolved are reconciled as usual, so that
+// the managed reconciler reports why.
+func (q *Quarantine) Reconciler(mgr ctrl.Manager, of resource.ManagedKind, poll time.Duration, r reconcile.Reconciler) reconcile.Reconciler {
+	kube := mgr.GetClient()
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		obj, err := mgr.GetScheme().New(schema.GroupVersionKind(of))
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, errNewManagedFmt, of)
+		}
+		mg, ok := obj.(resource.Managed)
+		if !ok {
+			return reconcile.Result{}, errors.Errorf(errNewManagedFmt, of)
+		}
+		if err := kube.Get(ctx, req.NamespacedName, mg); err != nil {
+			if kerrors.IsNotFound(err) {
+				return reconcile.Result{}, nil
+			}
+			return reconcile.Result{}, errors.Wrap(err, errGetManaged)
+		}
+
+		key, paused, err := pausedBy(ctx, kube, mg)
+		if err != nil || !paused {
+			return r.Reconcile(ctx, req)
+		}
+
+		c := xpv1.ReconcilePaused().WithMessage(fmt.Sprintf(msgPausedFmt, key))
+		if !mg.GetCondition(xpv1.TypeSynced).Equal(c) {
+			mg.SetConditions(c)
+			if err := kube.Status().Update(ctx, mg); err != nil {
+				return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errUpdateStatus)
+			}
+		}
+		return reconcile.Result{RequeueAfter: poll}, nil
+	})
+}
+
+// pausedBy returns the provider config the supplied managed resource uses,
+// and whether it's paused.
+func pausedBy(ctx context.Context, kube client.Reader, mg resource.Managed) (clients.ProviderConfigKey, bool, error) {
+	key, _, err := clients.ResolveProviderConfig(ctx, kube, mg)
+	if err != nil {
+		return clients.ProviderConfigKey{}, false, err
+	}
+	var pc client.Object = &apisv1alpha1.ClusterProviderConfig{}
+	if key.Kind == apisv1alpha1.ProviderConfigKind {
+		pc = &apisv1alpha1.ProviderConfig{}
+	}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: key.Namespace, Name: key.Name}, pc); err != nil {
+		return clients.ProviderConfigKey{}, false, errors.Wrap(err, errGetPC)
+	}
+	return key, meta.IsPaused(pc), nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit configures how the provider's controllers rate limit and
+// back off reconciles, so that backoff strategies can be compared without
+// changing code.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+
+	"github.com/crossplane/provider-bork/internal/metrics"
+)
+
+const (
+	errBaseDelay = "backoff base delay must be greater than zero"
+	errMaxDelay  = "backoff max delay must be at least the base delay"
+	errBurst     = "reconcile burst must be at least 1"
+)
+
+// Defaults, which match those of crossplane-runtime's rate limiters.
+const (
+	// DefaultBaseDelay is how long a failing request is first backed off.
+	DefaultBaseDelay = 1 * time.Second
+
+	// DefaultMaxDelay is the longest a failing request is backed off.
+	DefaultMaxDelay = 60 * time.Second
+
+	// DefaultBurstPerRate is how many reconciles the global rate limiter
+	// allows in a burst, per reconcile per second it allows on average.
+	DefaultBurstPerRate = 10
+)
+
+// Default is the backoff of every controller's workqueue.
+var Default = &Backoff{base: DefaultBaseDelay, max: DefaultMaxDelay}
+
+// A Backoff configures the per-request exponential backoff of controllers'
+// workqueues. A request that fails, or asks to be requeued, is requeued after
+// the base delay, which doubles every time it fails in a row, to the max
+// delay.
+type Backoff struct {
+	mu   sync.RWMutex
+	base time.Duration
+	max  time.Duration
+}
+
+// Set the base and max delays of the backoff. It only affects controllers
+// set up after it's called.
+func (b *Backoff) Set(base, maxDelay time.Duration) error {
+	if base <= 0 {
+		return errors.New(errBaseDelay)
+	}
+	if maxDelay < base {
+		return errors.New(errMaxDelay)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.base, b.max = base, maxDelay
+	return nil
+}
+
+// NewController returns a workqueue rate limiter that backs off requests
+// exponentially, from the base delay to the max delay.
+func (b *Backoff) NewController() ratelimiter.ControllerRateLimiter {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](b.base, b.max)
+}
+
+// ForControllerRuntime returns the controller-runtime options of the supplied
+// options, using a workqueue rate limiter that backs off as configured rather
+// than crossplane-runtime's default, and a workqueue whose metrics are
+// reported for the supplied kind, which the controller reconciles.
+func (b *Backoff) ForControllerRuntime(kind string, o controller.Options) crcontroller.Options {
+	co := o.ForControllerRuntime()
+	co.RateLimiter = b.NewController()
+	co.NewQueue = func(name string, rl workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		wq := workqueue.NewTypedRateLimitingQueueWithConfig(rl, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{Name: name})
+		return metrics.InstrumentQueue(kind, name, wq)
+	}
+	return co
+}
+
+// NewGlobal returns a token bucket rate limiter that limits the average rate
+// of reconciles of every controller to the supplied rate per second, allowing
+// bursts of up to the supplied number of reconciles. Unlike
+// ratelimiter.NewGlobal the burst needn't be ten times the rate.
+func NewGlobal(rps, burst int) (*ratelimiter.BucketRateLimiter, error) {
+	if burst < 1 {
+		return nil, errors.New(errBurst)
+	}
+	return &workqueue.TypedBucketRateLimiter[string]{Limiter: rate.NewLimiter(rate.Limit(rps), burst)}, nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scenario
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// A Baseline is how the provider's flags configured the behaviors a phase can
+// change, which they're restored to when it ends. Operations don't fail, and
+// every region is healthy, unless a phase says otherwise.
+type Baseline struct {
+	Throttle Throttle
+	Hang     Hang
+}
+
+// Behaviors a phase can change, which are restored when it ends. Each region
+// is a behavior of its own.
+const (
+	behaviorFail     = "fail"
+	behaviorThrottle = "throttle"
+	behaviorHang     = "hang"
+	behaviorRegion   = "region/"
+)
+
+// An event starts or ends a phase.
+type event struct {
+	at    time.Duration
+	phase int
+	end   bool
+}
+
+// A Runner runs a scenario against the in-process backend, starting from when
+// the provider starts.
+type Runner struct {
+	tenants  *backend.Tenants
+	scenario Scenario
+	baseline Baseline
+	log      logging.Logger
+
+	// owners are the phases that last changed each behavior. A phase that
+	// ends restores only the behaviors no later phase has changed since.
+	owners map[string]int
+}
+
+// NewRunner returns a runner that runs the supplied scenario against the
+// supplied tenants' backend.
+func NewRunner(t *backend.Tenants, s Scenario, b Baseline, log logging.Logger) *Runner {
+	return &Runner{tenants: t, scenario: s, baseline: b, log: log, owners: make(map[string]int)}
+}
+
+// NeedLeaderElection returns false. Every replica of the provider has an
+// in-process backend of its own.
+func (r *Runner) NeedLeaderElection() bool {
+	return false
+}
+
+// Start runs the scenario until every phase has started, and every phase
+// with a duration has ended, or the supplied context is done.
+func (r *Runner) Start(ctx context.Context) error {
+	start := time.Now()
+	for _, e := range r.events() {
+		t := time.NewTimer(time.Until(start.Add(e.at)))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil
+		case <-t.C:
+		}
+		if e.end {
+			r.end(e.phase)
+			continue
+		}
+		r.start(e.phase)
+	}
+	r.log.Info("Scenario finished")
+	return nil
+}
+
+// events returns when each phase starts and ends, in order. A phase that
+// ends when another starts ends first, so that it doesn't restore what the
+// other changes.
+func (r *Runner) events() []event {
+	events := make([]event, 0, 2*len(r.scenario.Phases))
+	for i, p := range r.scenario.Phases {
+		events = append(events, event{at: p.At.Duration, phase: i})
+		if p.Duration != nil {
+			events = append(events, event{at: p.At.Duration + p.Duration.Duration, phase: i, end: true})
+		}
+	}
+	slices.SortStableFunc(events, func(a, b event) int {
+		switch {
+		case a.at != b.at:
+			return cmp.Compare(a.at, b.at)
+		case a.end == b.end:
+			return 0
+		case a.end:
+			return -1
+		default:
+			return 1
+		}
+	})
+	return events
+}
+
+// start changes the behaviors the phase at the supplied index changes.
+func (r *Runner) start(i int) {
+	p := r.scenario.Phases[i]
+	log := r.log.WithValues("phase", name(i, p))
+
+	if f := p.Fail; f != nil {
+		r.tenants.SetOperationFault(f)
+		r.owners[behaviorFail] = i
+	}
+	if t := p.Throttle; t != nil {
+		r.tenants.SetThrottle(t.RatePerSecond, t.Burst)
+		r.owners[behaviorThrottle] = i
+	}
+	if h := p.Hang; h != nil {
+		r.tenants.SetHang(h.Duration.Duration, h.Operations...)
+		r.owners[behaviorHang] = i
+	}
+	for _, region := range p.UnhealthyRegions {
+		r.tenants.SetRegionHealthy(region, false)
+		r.owners[behaviorRegion+region] = i
+	}
+	if p.Drift {
+		log = log.WithValues("drifted", r.tenants.DriftRecords())
+	}
+	log.Info("Scenario phase started")
+}
+
+// end restores the behaviors the phase at the supplied index changed, unless
+// a later phase has changed them since.
+func (r *Runner) end(i int) {
+	p := r.scenario.Phases[i]
+	if r.owns(i, behaviorFail) {
+		r.tenants.SetOperationFault(nil)
+	}
+	if r.owns(i, behaviorThrottle) {
+		r.tenants.SetThrottle(r.baseline.Throttle.RatePerSecond, r.baseline.Throttle.Burst)
+	}
+	if r.owns(i, behaviorHang) {
+		r.tenants.SetHang(r.baseline.Hang.Duration.Duration, r.baseline.Hang.Operations...)
+	}
+	for _, region := range p.UnhealthyRegions {
+		if r.owns(i, behaviorRegion+region) {
+			r.tenants.SetRegionHealthy(region, true)
+		}
+	}
+	r.log.Info("Scenario phase ended", "phase", name(i, p))
+}
+
+// owns returns true, and forgets the owner of the supplied behavior, if the
+// phase at the supplied index changed it last.
+func (r *Runner) owns(i int, behavior string) bool {
+	if o, ok := r.owners[behavior]; !ok || o != i {
+		return false
+	}
+	delete(r.owners, behavior)
+	return true
+}
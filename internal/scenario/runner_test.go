@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scenario
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// A state is how the backend behaves after a phase starts or ends.
+type state struct {
+	// Code of the error that getting a record twice fails with, if any. A
+	// throttled backend admits a burst of one get, then throttles.
+	Code backend.ErrorCode
+
+	// Unhealthy regions.
+	Unhealthy []string
+}
+
+// observe returns how the supplied tenants' backend behaves.
+func observe(t *testing.T, tn *backend.Tenants) state {
+	t.Helper()
+	c, err := tn.For("").Connect()
+	if err != nil {
+		t.Fatalf("Connect(): %v", err)
+	}
+	s := state{Unhealthy: tn.UnhealthyRegions()}
+	for range 2 {
+		if _, err := c.Get(context.Background(), "bork"); err != nil {
+			s.Code = backend.Code(err)
+			break
+		}
+	}
+	return s
+}
+
+func TestRunner(t *testing.T) {
+	internal := &backend.OperationFault{Code: backend.ErrorCodeInternal}
+	unavailable := &backend.OperationFault{Code: backend.ErrorCodeUnavailable}
+	throttle := &Throttle{RatePerSecond: 0.001, Burst: 1}
+	at := func(d time.Duration) metav1.Duration { return metav1.Duration{Duration: d} }
+
+	cases := map[string]struct {
+		reason   string
+		phases   []Phase
+		baseline Baseline
+		// want is the state after each phase starts or ends, in order.
+		want []state
+	}{
+		"Sequential": {
+			reason: "Each phase should change how the backend behaves until it ends.",
+			phases: []Phase{
+				{At: at(0), Duration: duration(time.Minute), Fail: internal},
+				{At: at(2 * time.Minute), Duration: duration(time.Minute), Throttle: throttle},
+			},
+			want: []state{
+				{Code: backend.ErrorCodeInternal},
+				{},
+				{Code: backend.ErrorCodeThrottled},
+				{},
+			},
+		},
+		"Overlapping": {
+			reason: "A later phase should win where it changes the same behavior as an earlier one, and an earlier phase that ends should leave it alone.",
+			phases: []Phase{
+				{At: at(0), Duration: duration(3 * time.Minute), Fail: internal},
+				{At: at(time.Minute), Fail: unavailable},
+			},
+			want: []state{
+				{Code: backend.ErrorCodeInternal},
+				{Code: backend.ErrorCodeUnavailable},
+				{Code: backend.ErrorCodeUnavailable},
+			},
+		},
+		"Nested": {
+			reason: "A phase that ends should restore what it changed to how the flags configured it, not to what an earlier phase changed it to.",
+			phases: []Phase{
+				{At: at(0), Duration: duration(3 * time.Minute), Fail: internal},
+				{At: at(time.Minute), Duration: duration(time.Minute), Fail: unavailable},
+			},
+			want: []state{
+				{Code: backend.ErrorCodeInternal},
+				{Code: backend.ErrorCodeUnavailable},
+				{},
+				{},
+			},
+		},
+		"Handover": {
+			reason: "A phase that ends when another starts should end first, so that it doesn't restore what the other changes.",
+			phases: []Phase{
+				{At: at(time.Minute), Fail: unavailable},
+				{At: at(0), Duration: duration(time.Minute), Fail: internal},
+			},
+			want: []state{
+				{Code: backend.ErrorCodeInternal},
+				{},
+				{Code: backend.ErrorCodeUnavailable},
+			},
+		},
+		"Regions": {
+			reason: "Each region should be healthy again only once the phase that last marked it unhealthy ends.",
+			phases: []Phase{
+				{At: at(0), Duration: duration(time.Minute), UnhealthyRegions: []string{backend.DefaultRegion}},
+				{At: at(30 * time.Second), Duration: duration(time.Minute), UnhealthyRegions: []string{backend.DefaultRegion, "bork-east-1"}},
+			},
+			want: []state{
+				{Unhealthy: []string{backend.DefaultRegion}},
+				{Unhealthy: []string{backend.DefaultRegion, "bork-east-1"}},
+				{Unhealthy: []string{backend.DefaultRegion, "bork-east-1"}},
+				{},
+			},
+		},
+		"Baseline": {
+			reason: "A phase that ends should restore the throttle the flags configured.",
+			phases: []Phase{
+				{At: at(0), Duration: duration(time.Minute), Throttle: &Throttle{RatePerSecond: 1000, Burst: 1000}},
+			},
+			baseline: Baseline{Throttle: *throttle},
+			want: []state{
+				{},
+				{Code: backend.ErrorCodeThrottled},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tn := backend.NewTenants(backend.NewStore())
+			if _, err := tn.For("").Create(context.Background(), backend.Record{Name: "bork", BorkValue: map[string]string{"bork": "1"}}); err != nil {
+				t.Fatalf("Create(...): %v", err)
+			}
+			r := NewRunner(tn, Scenario{Phases: tc.phases}, tc.baseline, logging.NewNopLogger())
+
+			got := make([]state, 0, len(tc.want))
+			for _, e := range r.events() {
+				if e.end {
+					r.end(e.phase)
+				} else {
+					r.start(e.phase)
+				}
+				got = append(got, observe(t, tn))
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nRunner: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scenario scripts how the in-process backend behaves over time, so
+// that chaos and end to end suites can run reproducible scenarios of several
+// phases against the provider, e.g. failing creates for five minutes, then
+// drifting every record, then throttling.
+package scenario
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const (
+	errReadFmt   = "cannot read scenario %s"
+	errDecodeFmt = "cannot decode scenario %s"
+	errPhaseFmt  = "invalid phase %s"
+
+	errNoPhases       = "a scenario must have at least one phase"
+	errNegativeAt     = "at must not be negative"
+	errDuration       = "duration must be positive"
+	errNoBehavior     = "a phase must fail, throttle or hang operations, mark regions unhealthy or drift records"
+	errDriftDuration  = "a phase that only drifts records happens at once, and can't have a duration"
+	errUnknownCodeFmt = "unknown error code %q; codes are %v"
+	errUnknownOpFmt   = "unknown operation %q; operations are %s"
+	errThrottleRate   = "throttle ratePerSecond must be positive"
+	errHangDuration   = "hang duration must be positive"
+)
+
+// A Scenario scripts how the in-process backend behaves over time.
+type Scenario struct {
+	// Phases of the scenario. Phases may overlap; where they change the same
+	// behavior, the phase that started last wins.
+	Phases []Phase `json:"phases"`
+}
+
+// A Phase changes how the in-process backend behaves for a while.
+type Phase struct {
+	// Name of the phase, which is logged when it starts and ends.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// At is how long after the provider starts that the phase starts.
+	At metav1.Duration `json:"at"`
+
+	// Duration is how long the phase lasts. When it ends, the behaviors it
+	// changed are restored to how the provider's flags configured them. A
+	// phase without a duration lasts until the provider exits.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Fail makes operations fail with an error of a code.
+	// +optional
+	Fail *backend.OperationFault `json:"fail,omitempty"`
+
+	// Throttle throttles operations.
+	// +optional
+	Throttle *Throttle `json:"throttle,omitempty"`
+
+	// Hang makes operations wait before they're performed.
+	// +optional
+	Hang *Hang `json:"hang,omitempty"`
+
+	// UnhealthyRegions are regions that are unhealthy, as if their API were
+	// down.
+	// +optional
+	UnhealthyRegions []string `json:"unhealthyRegions,omitempty"`
+
+	// Drift drifts every ACTIVE record once, when the phase starts.
+	// +optional
+	Drift bool `json:"drift,omitempty"`
+}
+
+// Throttle throttles operations per backend.Store.SetThrottle.
+type Throttle struct {
+	RatePerSecond float64 `json:"ratePerSecond"`
+	Burst         int     `json:"burst,omitempty"`
+}
+
+// Hang makes operations hang per backend.Store.SetHang.
+type Hang struct {
+	Duration   metav1.Duration `json:"duration"`
+	Operations []string        `json:"operations,omitempty"`
+}
+
+// Load loads and validates the scenario at the supplied path.
+func Load(path string) (Scenario, error) {
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return Scenario{}, errors.Wrapf(err, errReadFmt, path)
+	}
+	s := Scenario{}
+	if err := yaml.UnmarshalStrict(b, &s); err != nil {
+		return Scenario{}, errors.Wrapf(err, errDecodeFmt, path)
+	}
+	return s, s.Validate()
+}
+
+// Validate returns an error if the scenario can't be run.
+func (s Scenario) Validate() error {
+	if len(s.Phases) == 0 {
+		return errors.New(errNoPhases)
+	}
+	for i, p := range s.Phases {
+		if err := p.validate(); err != nil {
+			return errors.Wrapf(err, errPhaseFmt, name(i, p))
+		}
+	}
+	return nil
+}
+
+func (p Phase) validate() error {
+	if p.At.Duration < 0 {
+		return errors.New(errNegativeAt)
+	}
+	if p.Duration != nil && p.Duration.Duration <= 0 {
+		return errors.New(errDuration)
+	}
+	lasting := p.Fail != nil || p.Throttle != nil || p.Hang != nil || len(p.UnhealthyRegions) > 0
+	switch {
+	case !lasting && !p.Drift:
+		return errors.New(errNoBehavior)
+	case !lasting && p.Duration != nil:
+		return errors.New(errDriftDuration)
+	}
+	if f := p.Fail; f != nil {
+		if !slices.Contains(backend.ErrorCodes, f.Code) {
+			return errors.Errorf(errUnknownCodeFmt, f.Code, backend.ErrorCodes)
+		}
+		if err := validateOperations(f.Operations); err != nil {
+			return err
+		}
+	}
+	if p.Throttle != nil && p.Throttle.RatePerSecond <= 0 {
+		return errors.New(errThrottleRate)
+	}
+	if h := p.Hang; h != nil {
+		if h.Duration.Duration <= 0 {
+			return errors.New(errHangDuration)
+		}
+		if err := validateOperations(h.Operations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateOperations(ops []string) error {
+	known := backend.Operations()
+	for _, op := range ops {
+		if !slices.Contains(known, op) {
+			return errors.Errorf(errUnknownOpFmt, op, strings.Join(known, ", "))
+		}
+	}
+	return nil
+}
+
+// name returns the name the phase at the supplied index is logged by.
+func name(i int, p Phase) string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return fmt.Sprintf("phase-%d", i)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scenario
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+func duration(d time.Duration) *metav1.Duration {
+	return &metav1.Duration{Duration: d}
+}
+
+func TestValidate(t *testing.T) {
+	fail := &backend.OperationFault{Code: backend.ErrorCodeUnavailable}
+
+	cases := map[string]struct {
+		reason string
+		s      Scenario
+		want   error
+	}{
+		"Valid": {
+			reason: "A scenario whose phases each change a behavior is valid.",
+			s: Scenario{Phases: []Phase{
+				{At: metav1.Duration{}, Duration: duration(time.Minute), Fail: fail},
+				{At: metav1.Duration{Duration: time.Minute}, Drift: true},
+			}},
+		},
+		"NoPhases": {
+			reason: "A scenario must have a phase.",
+			s:      Scenario{},
+			want:   errors.New(errNoPhases),
+		},
+		"NegativeAt": {
+			reason: "A phase can't start before the provider does.",
+			s:      Scenario{Phases: []Phase{{Name: "early", At: metav1.Duration{Duration: -time.Second}, Fail: fail}}},
+			want:   errors.Wrapf(errors.New(errNegativeAt), errPhaseFmt, "early"),
+		},
+		"ZeroDuration": {
+			reason: "A phase with a duration must last a while.",
+			s:      Scenario{Phases: []Phase{{Duration: duration(0), Fail: fail}}},
+			want:   errors.Wrapf(errors.New(errDuration), errPhaseFmt, "phase-0"),
+		},
+		"NoBehavior": {
+			reason: "A phase must change a behavior.",
+			s:      Scenario{Phases: []Phase{{Name: "idle"}}},
+			want:   errors.Wrapf(errors.New(errNoBehavior), errPhaseFmt, "idle"),
+		},
+		"DriftDuration": {
+			reason: "A phase that only drifts records can't last a while.",
+			s:      Scenario{Phases: []Phase{{Duration: duration(time.Minute), Drift: true}}},
+			want:   errors.Wrapf(errors.New(errDriftDuration), errPhaseFmt, "phase-0"),
+		},
+		"UnknownCode": {
+			reason: "A phase must fail operations with a known error code.",
+			s:      Scenario{Phases: []Phase{{Fail: &backend.OperationFault{Code: "Explode"}}}},
+			want:   errors.Wrapf(errors.Errorf(errUnknownCodeFmt, "Explode", backend.ErrorCodes), errPhaseFmt, "phase-0"),
+		},
+		"UnknownFailOperation": {
+			reason: "A phase must fail known operations.",
+			s:      Scenario{Phases: []Phase{{Fail: &backend.OperationFault{Code: backend.ErrorCodeInternal, Operations: []string{"Explode"}}}}},
+			want:   errors.Wrapf(errors.Errorf(errUnknownOpFmt, "Explode", strings.Join(backend.Operations(), ", ")), errPhaseFmt, "phase-0"),
+		},
+		"ThrottleRate": {
+			reason: "A phase must throttle to a positive rate.",
+			s:      Scenario{Phases: []Phase{{Throttle: &Throttle{}}}},
+			want:   errors.Wrapf(errors.New(errThrottleRate), errPhaseFmt, "phase-0"),
+		},
+		"HangDuration": {
+			reason: "A phase must hang operations for a while.",
+			s:      Scenario{Phases: []Phase{{Hang: &Hang{}}}},
+			want:   errors.Wrapf(errors.New(errHangDuration), errPhaseFmt, "phase-0"),
+		},
+		"UnknownHangOperation": {
+			reason: "A phase must hang known operations.",
+			s:      Scenario{Phases: []Phase{{Hang: &Hang{Duration: metav1.Duration{Duration: time.Second}, Operations: []string{"Explode"}}}}},
+			want:   errors.Wrapf(errors.Errorf(errUnknownOpFmt, "Explode", strings.Join(backend.Operations(), ", ")), errPhaseFmt, "phase-0"),
+		},
+		"LaterPhaseInvalid": {
+			reason: "Every phase of a scenario must be valid.",
+			s:      Scenario{Phases: []Phase{{Fail: fail}, {Throttle: &Throttle{}}}},
+			want:   errors.Wrapf(errors.New(errThrottleRate), errPhaseFmt, "phase-1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.s.Validate()
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("WriteFile(...): %v", err)
+		}
+		return path
+	}
+	valid := write("valid.yaml", "phases:\n- name: outage\n  at: 1m\n  duration: 5m\n  unhealthyRegions: [bork-central-1]\n")
+	unknown := write("unknown.yaml", "phases:\n- name: outage\n  at: 1m\n  outage: true\n")
+	invalid := write("invalid.yaml", "phases: []\n")
+
+	type want struct {
+		s   Scenario
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		path   string
+		want   want
+	}{
+		"Valid": {
+			reason: "A valid scenario should be loaded.",
+			path:   valid,
+			want: want{s: Scenario{Phases: []Phase{{
+				Name:             "outage",
+				At:               metav1.Duration{Duration: time.Minute},
+				Duration:         duration(5 * time.Minute),
+				UnhealthyRegions: []string{backend.DefaultRegion},
+			}}}},
+		},
+		"UnknownField": {
+			reason: "A scenario with a field phases don't have should fail to load, rather than silently do nothing.",
+			path:   unknown,
+			want:   want{err: errors.Wrapf(errors.New(`error unmarshaling JSON: while decoding JSON: json: unknown field "outage"`), errDecodeFmt, unknown)},
+		},
+		"Invalid": {
+			reason: "A scenario that can't be run should fail to load.",
+			path:   invalid,
+			want:   want{err: errors.New(errNoPhases)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := Load(tc.path)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nLoad(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.s, s); diff != "" {
+				t.Errorf("\n%s\nLoad(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// TestLoadExample loads the example scenario, so that it stays valid.
+func TestLoadExample(t *testing.T) {
+	if _, err := Load(filepath.Join("..", "..", "examples", "provider", "scenario.yaml")); err != nil {
+		t.Errorf("Load(...): %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shard divides managed resources between replicas of the provider,
+// so that it can be scaled out horizontally. Each replica reconciles only the
+// resources whose namespace and name hash to its shard.
+package shard
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	errCount     = "shard count must be at least 1"
+	errKeyFmt    = "shard key %q is neither a shard index nor a name ending in one, like provider-bork-2"
+	errKeyMaxFmt = "shard key %q is index %d, but there are only %d shards"
+)
+
+// Default is the shard of this replica of the provider. It owns every
+// resource until it's set.
+var Default = &Shard{}
+
+// A Shard is one of a number of shards that resources are divided between.
+// The zero value has a single shard, which owns every resource.
+type Shard struct {
+	index int
+	count int
+}
+
+// Set makes this the shard at the index named by the supplied key, of the
+// supplied number of shards. The key is either the index, from 0 to one less
+// than the count, or a name that ends with one, like the name of a
+// StatefulSet's pod. This allows each pod of a StatefulSet to find its shard
+// from its own name. Set must be called before the shard is used.
+func (s *Shard) Set(key string, count int) error {
+	if count < 1 {
+		return errors.New(errCount)
+	}
+	i, err := strconv.Atoi(key[strings.LastIndex(key, "-")+1:])
+	if err != nil || i < 0 {
+		return errors.Errorf(errKeyFmt, key)
+	}
+	if i >= count {
+		return errors.Errorf(errKeyMaxFmt, key, i, count)
+	}
+	s.index, s.count = i, count
+	return nil
+}
+
+// Index of this shard.
+func (s *Shard) Index() int {
+	return s.index
+}
+
+// Count of shards.
+func (s *Shard) Count() int {
+	return max(s.count, 1)
+}
+
+// Owns returns true if this shard owns the resource with the supplied
+// namespace and name.
+func (s *Shard) Owns(namespace, name string) bool {
+	if s.Count() == 1 {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace + "/" + name))
+	return int(h.Sum32()%uint32(s.Count())) == s.index
+}
+
+// Predicate returns a predicate that filters out events of resources this
+// shard doesn't own.
+func (s *Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return s.Owns(o.GetNamespace(), o.GetName())
+	})
+}
+
+// Reconciler wraps the supplied reconciler such that it ignores requests for
+// resources this shard doesn't own. Unlike the shard's predicate it filters
+// requests from every source, including those that enqueue requests without
+// an event of the resource itself.
+func (s *Shard) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if !s.Owns(req.Namespace, req.Name) {
+			return reconcile.Result{}, nil
+		}
+		return r.Reconcile(ctx, req)
+	})
+}
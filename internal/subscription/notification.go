@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+const errServeReceiver = "cannot serve notification receiver"
+
+// PathNotifications is the path at which the notification receiver receives
+// notifications of changes from the backend.
+const PathNotifications = "/v1/notifications"
+
+// DefaultNotificationTTL is how long the backend notifies the receiver of
+// changes unless the subscription is renewed. Subscriptions are renewed at a
+// third of their TTL, so that they survive a couple of failed renewals.
+const DefaultNotificationTTL = time.Minute
+
+// maxNotificationSize is the largest notification the receiver decodes.
+const maxNotificationSize = 1 << 20
+
+// Query parameters of a notification's URL that identify the provider config
+// whose subscription it was sent to.
+const (
+	paramKind      = "kind"
+	paramNamespace = "namespace"
+	paramName      = "name"
+)
+
+// A receiver serves the notifications the backend POSTs to provider configs
+// that watch in Webhook mode. It only runs while the controller manager is
+// the leader, like the subscriptions it receives notifications for.
+type receiver struct {
+	m       *Manager
+	address string
+	url     string
+	ttl     time.Duration
+}
+
+// SetReceiver enables a notification receiver that listens on the supplied
+// address. The backend notifies it of changes at the supplied URL, which
+// must reach the receiver. It must be called before Setup.
+func (m *Manager) SetReceiver(address, url string) {
+	m.receiver = &receiver{m: m, address: address, url: url, ttl: DefaultNotificationTTL}
+}
+
+// Start serves the receiver until the supplied context is done.
+func (r *receiver) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("POST "+PathNotifications, r)
+	hs := &http.Server{Addr: r.address, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	errs := make(chan error, 1)
+	go func() {
+		if err := hs.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- errors.Wrap(err, errServeReceiver)
+		}
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+	}
+	_ = hs.Close()
+	return err
+}
+
+// ServeHTTP requeues the managed resources affected by a notification. Only
+// notifications to provider configs that are receiving them are accepted.
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	key := clients.ProviderConfigKey{Kind: q.Get(paramKind), Namespace: q.Get(paramNamespace), Name: q.Get(paramName)}
+
+	r.m.mu.RLock()
+	ok := r.m.receiving[key]
+	r.m.mu.RUnlock()
+	if !ok {
+		http.Error(w, "provider config is not receiving notifications", http.StatusNotFound)
+		return
+	}
+
+	e := backend.Event{}
+	if err := json.NewDecoder(io.LimitReader(req.Body, maxNotificationSize)).Decode(&e); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.m.dispatch(req.Context(), key, e)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// urlFor returns the URL at which the supplied provider config is notified.
+func (r *receiver) urlFor(key clients.ProviderConfigKey) string {
+	q := url.Values{paramKind: {key.Kind}, paramName: {key.Name}}
+	if key.Namespace != "" {
+		q.Set(paramNamespace, key.Namespace)
+	}
+	return r.url + PathNotifications + "?" + q.Encode()
+}
+
+// receive subscribes the receiver to notifications of changes to the backend
+// of the supplied provider config, renewing the subscription until the
+// supplied context is done. It then unsubscribes.
+func (m *Manager) receive(ctx context.Context, log logging.Logger, key clients.ProviderConfigKey, pc *apisv1alpha1.ProviderConfigSpec) {
+	r := m.receiver
+	if r == nil {
+		log.Info("Cannot subscribe to backend notifications because the notification receiver isn't enabled; falling back to polling")
+		return
+	}
+	u := r.urlFor(key)
+
+	m.mu.Lock()
+	m.receiving[key] = true
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.receiving, key)
+		m.mu.Unlock()
+	}()
+
+	for {
+		wait := r.ttl / 3
+		svc, err := clients.ConnectWith(ctx, m.kube, m.tenants.For(key.Namespace), pc)
+		if err == nil {
+			_, err = svc.SubscribeNotifications(ctx, backend.NotificationRequest{URL: u, TTL: r.ttl})
+			_ = svc.Close()
+		}
+		if err != nil {
+			log.Info("Cannot subscribe to backend notifications; falling back to polling until subscribed", "error", err)
+			wait = m.retry
+		} else {
+			log.Debug("Subscribed to backend notifications", "url", u)
+		}
+
+		select {
+		case <-ctx.Done():
+			m.unsubscribe(log, key, pc, u)
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// unsubscribe stops the backend of the supplied provider config notifying the
+// supplied URL. The subscription expires anyway if this fails.
+func (m *Manager) unsubscribe(log logging.Logger, key clients.ProviderConfigKey, pc *apisv1alpha1.ProviderConfigSpec, u string) {
+	ctx, cancel := context.WithTimeout(context.Background(), backend.NotificationTimeout)
+	defer cancel()
+	svc, err := clients.ConnectWith(ctx, m.kube, m.tenants.For(key.Namespace), pc)
+	if err == nil {
+		err = svc.UnsubscribeNotifications(ctx, u)
+		_ = svc.Close()
+	}
+	if err != nil {
+		log.Debug("Cannot unsubscribe from backend notifications", "error", err)
+	}
+}
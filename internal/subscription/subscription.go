@@ -0,0 +1,289 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package subscription subscribes to changes in the backend, and requeues the
+// managed resources whose external resources changed.
+package subscription
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+const (
+	errAddManager  = "cannot add subscription manager to controller manager"
+	errAddReceiver = "cannot add notification receiver to controller manager"
+	errListPCs     = "cannot list ProviderConfigs"
+	errListCPCs    = "cannot list ClusterProviderConfigs"
+)
+
+// Default intervals at which subscriptions are managed.
+const (
+	// DefaultResyncInterval is how often provider configs are listed to
+	// determine which subscriptions should be running.
+	DefaultResyncInterval = 30 * time.Second
+
+	// DefaultRetryInterval is how long a dropped subscription waits before it
+	// resubscribes.
+	DefaultRetryInterval = 10 * time.Second
+)
+
+// Default manages subscriptions to the simulated backend shared by all of the
+// provider's controllers.
+var Default = NewManager(backend.DefaultTenants)
+
+// A Manager runs a subscription to changes in the backend for each provider
+// config that enables one. When an external resource changes the managed
+// resources that use the subscription's provider config and that have the
+// external resource's external name are requeued immediately.
+//
+// Subscriptions supplement polling; they don't replace it. A subscription
+// that drops misses whatever changes are made before it resubscribes, which
+// are detected when the affected resources are next polled.
+type Manager struct {
+	tenants *backend.Tenants
+	kube    client.Client
+	log     logging.Logger
+	resync  time.Duration
+	retry   time.Duration
+
+	// receiver receives notifications of changes from the backend, if
+	// enabled.
+	receiver *receiver
+
+	mu        sync.RWMutex
+	targets   map[string][]target
+	receiving map[clients.ProviderConfigKey]bool
+}
+
+// A subscription is running using the provider config it was started with.
+// It's restarted if the provider config changes.
+type subscription struct {
+	pc     *apisv1alpha1.ProviderConfigSpec
+	cancel context.CancelFunc
+}
+
+type target struct {
+	newList func() resource.ManagedList
+	events  chan event.GenericEvent
+}
+
+// NewManager returns a Manager of subscriptions to the supplied tenants'
+// stores. A ProviderConfig that doesn't specify an endpoint subscribes to the
+// store of the tenant its namespace belongs to, and a ClusterProviderConfig
+// to the shared store.
+func NewManager(tenants *backend.Tenants) *Manager {
+	return &Manager{
+		tenants:   tenants,
+		log:       logging.NewNopLogger(),
+		resync:    DefaultResyncInterval,
+		retry:     DefaultRetryInterval,
+		targets:   make(map[string][]target),
+		receiving: make(map[clients.ProviderConfigKey]bool),
+	}
+}
+
+// Source returns a source of events for the managed resources whose external
+// resources are backend resources of the supplied kind. newList must return
+// an empty list of the managed resource kind.
+func (m *Manager) Source(kind string, newList func() resource.ManagedList) source.Source {
+	ch := make(chan event.GenericEvent, backend.WatchBufferSize)
+
+	m.mu.Lock()
+	m.targets[kind] = append(m.targets[kind], target{newList: newList, events: ch})
+	m.mu.Unlock()
+
+	return source.Channel(ch, &handler.EnqueueRequestForObject{})
+}
+
+// Setup adds the Manager to the supplied controller manager, using its client
+// to read provider configs and managed resources. Subscriptions only run
+// while the controller manager is the leader.
+func (m *Manager) Setup(mgr ctrl.Manager, log logging.Logger) error {
+	m.kube = mgr.GetClient()
+	m.log = log.WithValues("controller", "subscriptions")
+	if m.receiver != nil {
+		if err := mgr.Add(m.receiver); err != nil {
+			return errors.Wrap(err, errAddReceiver)
+		}
+	}
+	return errors.Wrap(mgr.Add(m), errAddManager)
+}
+
+// Start runs a subscription for each provider config that enables one, until
+// the supplied context is done.
+func (m *Manager) Start(ctx context.Context) error {
+	running := make(map[clients.ProviderConfigKey]subscription)
+	defer func() {
+		for _, sub := range running {
+			sub.cancel()
+		}
+	}()
+
+	t := time.NewTicker(m.resync)
+	defer t.Stop()
+
+	for {
+		want, err := m.enabled(ctx)
+		if err != nil {
+			m.log.Info("Cannot determine which subscriptions should run", "error", err)
+		}
+		for key, sub := range running {
+			// Keep running subscriptions if we can't tell whether
+			// they're still wanted.
+			if pc, ok := want[key]; err != nil || (ok && equality.Semantic.DeepEqual(pc, sub.pc)) {
+				continue
+			}
+			sub.cancel()
+			delete(running, key)
+		}
+		for key, pc := range want {
+			if _, ok := running[key]; ok {
+				continue
+			}
+			sctx, cancel := context.WithCancel(ctx)
+			running[key] = subscription{pc: pc, cancel: cancel}
+			go m.subscribe(sctx, key, pc)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// enabled returns the provider configs that enable a subscription.
+func (m *Manager) enabled(ctx context.Context) (map[clients.ProviderConfigKey]*apisv1alpha1.ProviderConfigSpec, error) {
+	want := make(map[clients.ProviderConfigKey]*apisv1alpha1.ProviderConfigSpec)
+
+	pcs := &apisv1alpha1.ProviderConfigList{}
+	if err := m.kube.List(ctx, pcs); err != nil {
+		return nil, errors.Wrap(err, errListPCs)
+	}
+	for i := range pcs.Items {
+		pc := &pcs.Items[i]
+		if watchEnabled(pc.Spec) {
+			want[clients.ProviderConfigKey{Kind: apisv1alpha1.ProviderConfigKind, Namespace: pc.GetNamespace(), Name: pc.GetName()}] = &pc.Spec
+		}
+	}
+
+	cpcs := &apisv1alpha1.ClusterProviderConfigList{}
+	if err := m.kube.List(ctx, cpcs); err != nil {
+		return nil, errors.Wrap(err, errListCPCs)
+	}
+	for i := range cpcs.Items {
+		pc := &cpcs.Items[i]
+		if watchEnabled(pc.Spec) {
+			want[clients.ProviderConfigKey{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: pc.GetName()}] = &pc.Spec
+		}
+	}
+
+	return want, nil
+}
+
+func watchEnabled(pc apisv1alpha1.ProviderConfigSpec) bool {
+	return pc.Watch != nil && pc.Watch.Enabled
+}
+
+// subscribe watches the backend using the supplied provider config until the
+// supplied context is done, resubscribing whenever the watch drops. Provider
+// configs that watch in Webhook mode are notified of changes instead.
+func (m *Manager) subscribe(ctx context.Context, key clients.ProviderConfigKey, pc *apisv1alpha1.ProviderConfigSpec) {
+	log := m.log.WithValues("kind", key.Kind, "namespace", key.Namespace, "name", key.Name)
+
+	if pc.Watch.Mode == apisv1alpha1.WatchModeWebhook {
+		m.receive(ctx, log, key, pc)
+		return
+	}
+
+	for {
+		svc, err := clients.ConnectWith(ctx, m.kube, m.tenants.For(key.Namespace), pc)
+		if err != nil {
+			log.Info("Cannot subscribe to backend changes; falling back to polling", "error", err)
+		} else {
+			log.Debug("Subscribed to backend changes")
+			for e := range svc.Watch(ctx) {
+				m.dispatch(ctx, key, e)
+			}
+			_ = svc.Close()
+			if ctx.Err() != nil {
+				return
+			}
+			log.Info("Subscription to backend changes dropped; falling back to polling until resubscribed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.retry):
+		}
+	}
+}
+
+// dispatch requeues the managed resources affected by the supplied event that
+// use the supplied provider config.
+func (m *Manager) dispatch(ctx context.Context, key clients.ProviderConfigKey, e backend.Event) {
+	m.mu.RLock()
+	targets := m.targets[e.Kind]
+	m.mu.RUnlock()
+
+	for _, t := range targets {
+		l := t.newList()
+		if err := m.kube.List(ctx, l); err != nil {
+			m.log.Debug("Cannot list managed resources affected by backend change", "error", err, "kind", e.Kind, "name", e.Name)
+			continue
+		}
+		for _, mg := range l.GetItems() {
+			if meta.GetExternalName(mg) != e.Name {
+				continue
+			}
+			// Paused resources aren't reconciled, so there's no point
+			// requeueing them. They're requeued when they're unpaused.
+			if meta.IsPaused(mg) {
+				continue
+			}
+			// Resources that use another provider config are requeued by
+			// that provider config's subscription, if it has one.
+			if k, _, err := clients.ResolveProviderConfig(ctx, m.kube, mg); err != nil || k != key {
+				continue
+			}
+			select {
+			case t.events <- event.GenericEvent{Object: mg}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing configures OpenTelemetry tracing of the provider and the
+// bork API server.
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/provider-bork/internal/version"
+)
+
+const (
+	errNewExporter = "cannot create OTLP trace exporter"
+	errNewResource = "cannot describe traced service"
+)
+
+// Name of the tracer used by the provider and the bork API server.
+const Name = "github.com/crossplane/provider-bork"
+
+// Attributes recorded on spans.
+const (
+	AttrKind           = attribute.Key("bork.kind")
+	AttrNamespace      = attribute.Key("bork.namespace")
+	AttrName           = attribute.Key("bork.name")
+	AttrExternalName   = attribute.Key("bork.external_name")
+	AttrOperation      = attribute.Key("bork.operation")
+	AttrProviderConfig = attribute.Key("bork.provider_config")
+)
+
+// Options configure tracing.
+type Options struct {
+	// Service that is being traced, e.g. provider-bork.
+	Service string
+
+	// Endpoint of the OTLP gRPC collector to which spans are exported, e.g.
+	// otel-collector:4317. Tracing is disabled if it is empty.
+	Endpoint string
+
+	// Insecure disables TLS when exporting spans.
+	Insecure bool
+
+	// SampleRatio is the fraction of traces that are sampled, from 0 to 1.
+	// Spans whose parent was sampled are always sampled.
+	SampleRatio float64
+}
+
+// Setup configures the global OpenTelemetry tracer provider to export spans
+// to the configured collector, and the global propagator to propagate W3C
+// trace context. It returns a function that flushes and stops the exporter,
+// which should be called before the process exits. Setup does nothing if no
+// endpoint is configured.
+func Setup(ctx context.Context, o Options) (func(context.Context) error, error) {
+	if o.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	eo := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(o.Endpoint)}
+	if o.Insecure {
+		eo = append(eo, otlptracegrpc.WithInsecure())
+	}
+	exp, err := otlptracegrpc.New(ctx, eo...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", o.Service),
+		attribute.String("service.version", version.Version),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewResource)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(o.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+// Start starts a span using the global tracer provider. It is a no-op unless
+// Setup has configured tracing.
+func Start(ctx context.Context, name string, o ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(Name).Start(ctx, name, o...)
+}
+
+// End records the supplied error, if any, then ends the supplied span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// NewReconciler wraps the supplied reconciler such that every reconcile is
+// traced, as the parent of the spans started while reconciling.
+func NewReconciler(name string, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (res reconcile.Result, err error) {
+		ctx, span := Start(ctx, name+".Reconcile", trace.WithAttributes(
+			AttrNamespace.String(req.Namespace),
+			AttrName.String(req.Name),
+		))
+		defer func() { End(span, err) }()
+		return r.Reconcile(ctx, req)
+	})
+}
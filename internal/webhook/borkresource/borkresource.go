@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package borkresource implements admission webhooks for BorkResources.
+package borkresource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+const (
+	errNotBorkResource = "object is not a BorkResource"
+	errGetPC           = "cannot get provider config"
+	errConnect         = "cannot connect to the backend"
+	errListRegions     = "cannot list regions"
+)
+
+// Setup registers webhooks that default and validate BorkResources.
+func Setup(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.BorkResource{}).
+		WithDefaulter(&defaulter{}).
+		WithValidator(&validator{kube: mgr.GetClient(), tenants: backend.DefaultTenants}).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-bork-crossplane-io-v1alpha1-borkresource,mutating=false,failurePolicy=fail,groups=bork.crossplane.io,resources=borkresources,versions=v1alpha1,name=borkresources.bork.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// A validator rejects BorkResources that the backend could never accept.
+type validator struct {
+	kube    client.Client
+	tenants *backend.Tenants
+}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, obj)
+}
+
+func (v *validator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, newObj)
+}
+
+func (v *validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) validate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*v1alpha1.BorkResource)
+	if !ok {
+		return nil, errors.New(errNotBorkResource)
+	}
+
+	p := cr.Spec.ForProvider
+	fp := field.NewPath("spec", "forProvider")
+
+	// A BorkResource that may only be observed never writes its parameters
+	// to the backend, so there is nothing they need to be valid against.
+	if observeOnly(cr) {
+		var w admission.Warnings
+		if len(p.BorkValue) > 0 || len(p.DataValue) > 0 {
+			w = append(w, fmt.Sprintf("%s and %s are ignored when managementPolicies only allow the BorkResource to be observed", fp.Child("borkValue"), fp.Child("dataValue")))
+		}
+		return w, nil
+	}
+
+	var errs field.ErrorList
+	if v, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyPollInterval]; ok {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			errs = append(errs, field.Invalid(field.NewPath("metadata", "annotations").Key(v1alpha1.AnnotationKeyPollInterval), v, "must be a positive duration, e.g. 30s"))
+		}
+	}
+	errs = append(errs, p.Validate(fp)...)
+
+	regionErrs, err := v.validateRegion(ctx, cr, fp)
+	if err != nil {
+		return nil, err
+	}
+	errs = append(errs, regionErrs...)
+
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(v1alpha1.BorkResourceGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// validateRegion rejects regions the backend doesn't offer, and tiers that
+// aren't offered in the requested region. Unset regions and tiers are
+// defaulted by the backend, and are always valid. Regions are those offered
+// by the backend the BorkResource's provider config connects to, which isn't
+// the in-process backend if the provider config has an endpoint or the
+// provider uses a remote backend. Regions aren't validated if the provider
+// config doesn't exist yet; the controller reports that it's missing.
+func (v *validator) validateRegion(ctx context.Context, cr *v1alpha1.BorkResource, fp *field.Path) (field.ErrorList, error) {
+	p := cr.Spec.ForProvider
+	if p.Region == nil {
+		return nil, nil
+	}
+
+	pc, err := clients.GetProviderConfig(ctx, v.kube, cr)
+	if kerrors.IsNotFound(errors.Cause(err)) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	svc, err := clients.ConnectWith(ctx, v.kube, v.tenants.For(cr.GetNamespace()), pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+	regions, err := svc.ListRegions(ctx)
+	_ = svc.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, errListRegions)
+	}
+
+	names := make([]string, 0, len(regions))
+	for _, r := range regions {
+		names = append(names, r.Name)
+		if r.Name != *p.Region {
+			continue
+		}
+		if p.Tier != nil && !slices.Contains(r.Tiers, *p.Tier) {
+			return field.ErrorList{field.NotSupported(fp.Child("tier"), *p.Tier, r.Tiers)}, nil
+		}
+		return nil, nil
+	}
+	return field.ErrorList{field.NotSupported(fp.Child("region"), *p.Region, names)}, nil
+}
+
+// observeOnly returns true if the supplied BorkResource's management policies
+// only allow it to be observed.
+func observeOnly(cr *v1alpha1.BorkResource) bool {
+	mp := cr.GetManagementPolicies()
+	return len(mp) == 1 && mp[0] == xpv1.ManagementActionObserve
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/crossplane/provider-bork/apis"
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-bork/apis/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+	"github.com/crossplane/provider-bork/internal/clients"
+)
+
+const (
+	// localRegion is offered only by the in-process backend.
+	localRegion = "bork-local-1"
+
+	// remoteRegion is offered only by the remote backend.
+	remoteRegion = "bork-remote-1"
+)
+
+type borkResourceModifier func(cr *v1alpha1.BorkResource)
+
+func withRegion(region string) borkResourceModifier {
+	return func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.Region = ptr.To(region) }
+}
+
+func withTier(tier string) borkResourceModifier {
+	return func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.Tier = ptr.To(tier) }
+}
+
+func withProviderConfig(name string) borkResourceModifier {
+	return func(cr *v1alpha1.BorkResource) {
+		cr.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: name})
+	}
+}
+
+func withManagementPolicies(p ...xpv1.ManagementAction) borkResourceModifier {
+	return func(cr *v1alpha1.BorkResource) { cr.SetManagementPolicies(p) }
+}
+
+func borkResource(m ...borkResourceModifier) *v1alpha1.BorkResource {
+	cr := &v1alpha1.BorkResource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool"},
+		Spec: v1alpha1.BorkResourceSpec{
+			ForProvider: v1alpha1.BorkResourceParameters{BorkValue: map[string]string{"bork": "2"}},
+		},
+	}
+	cr.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+	cr.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: apisv1alpha1.ClusterProviderConfigKind, Name: "default"})
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func invalid(errs ...*field.Error) error {
+	return kerrors.NewInvalid(v1alpha1.BorkResourceGroupVersionKind.GroupKind(), "cool", errs)
+}
+
+func TestValidate(t *testing.T) {
+	fp := field.NewPath("spec", "forProvider")
+
+	// The in-process and remote backends each offer a region the other
+	// doesn't. The remote backend is a bork API server.
+	local := backend.NewStore()
+	if err := local.SetRegion(context.Background(), backend.Region{Name: localRegion, Tiers: []string{backend.DefaultTier}, Available: true}); err != nil {
+		t.Fatalf("SetRegion(...): %v", err)
+	}
+	remote := backend.NewStore()
+	if err := remote.SetRegion(context.Background(), backend.Region{Name: remoteRegion, Tiers: []string{backend.DefaultTier}, Available: true}); err != nil {
+		t.Fatalf("SetRegion(...): %v", err)
+	}
+	srv := httptest.NewServer(backend.NewHandler(remote, ""))
+	defer srv.Close()
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+	none := apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&apisv1alpha1.ClusterProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: none},
+		},
+		&apisv1alpha1.ClusterProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "remote"},
+			Spec:       apisv1alpha1.ProviderConfigSpec{Credentials: none, Endpoint: &apisv1alpha1.Endpoint{URL: srv.URL}},
+		},
+	).Build()
+
+	type want struct {
+		warnings admission.Warnings
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		// backend is the backend provider configs without an endpoint
+		// connect to, if it's not the in-process backend.
+		backend string
+		obj     runtime.Object
+		want    want
+	}{
+		"NotBorkResource": {
+			reason: "We should return an error if the object isn't a BorkResource.",
+			obj:    &v1alpha1.BorkFleet{},
+			want:   want{err: errors.New(errNotBorkResource)},
+		},
+		"ObserveOnly": {
+			reason: "We should warn that the values of a BorkResource that's only observed are ignored, rather than validate them.",
+			obj: borkResource(withManagementPolicies(xpv1.ManagementActionObserve), func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.BorkValue = map[string]string{"bork": strings.Repeat("2", v1alpha1.MaxValueLength+1)}
+			}),
+			want: want{warnings: admission.Warnings{"spec.forProvider.borkValue and spec.forProvider.dataValue are ignored when managementPolicies only allow the BorkResource to be observed"}},
+		},
+		"InvalidPollInterval": {
+			reason: "We should reject a poll interval annotation that isn't a positive duration.",
+			obj: borkResource(func(cr *v1alpha1.BorkResource) {
+				cr.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyPollInterval: "-1s"})
+			}),
+			want: want{err: invalid(field.Invalid(field.NewPath("metadata", "annotations").Key(v1alpha1.AnnotationKeyPollInterval), "-1s", "must be a positive duration, e.g. 30s"))},
+		},
+		"InvalidParameters": {
+			reason: "We should reject parameters the backend could never accept.",
+			obj: borkResource(func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.BorkValue = map[string]string{"bork": strings.Repeat("2", v1alpha1.MaxValueLength+1)}
+			}),
+			want: want{err: invalid(field.TooLong(fp.Child("borkValue").Key("bork"), strings.Repeat("2", v1alpha1.MaxValueLength+1), v1alpha1.MaxValueLength))},
+		},
+		"RegionOffered": {
+			reason: "We should accept a region and tier the in-process backend offers.",
+			obj:    borkResource(withRegion("bork-west-2"), withTier("archive")),
+			want:   want{},
+		},
+		"RegionNotOffered": {
+			reason: "We should reject a region the in-process backend doesn't offer.",
+			obj:    borkResource(withRegion(remoteRegion)),
+			want:   want{err: invalid(field.NotSupported(fp.Child("region"), remoteRegion, []string{backend.DefaultRegion, "bork-east-1", localRegion, "bork-west-2"}))},
+		},
+		"TierNotOffered": {
+			reason: "We should reject a tier that isn't offered in the requested region.",
+			obj:    borkResource(withRegion("bork-east-1"), withTier("premium")),
+			want:   want{err: invalid(field.NotSupported(fp.Child("tier"), "premium", []string{backend.DefaultTier}))},
+		},
+		"ProviderConfigNotFound": {
+			reason: "We shouldn't validate the region of a BorkResource whose provider config doesn't exist yet.",
+			obj:    borkResource(withRegion(remoteRegion), withProviderConfig("missing")),
+			want:   want{},
+		},
+		"EndpointRegionOffered": {
+			reason: "We should accept a region offered by the endpoint the provider config connects to, not the in-process backend.",
+			obj:    borkResource(withRegion(remoteRegion), withProviderConfig("remote")),
+			want:   want{},
+		},
+		"EndpointRegionNotOffered": {
+			reason: "We should reject a region the endpoint the provider config connects to doesn't offer, even though the in-process backend does.",
+			obj:    borkResource(withRegion(localRegion), withProviderConfig("remote")),
+			want:   want{err: invalid(field.NotSupported(fp.Child("region"), localRegion, []string{backend.DefaultRegion, "bork-east-1", remoteRegion, "bork-west-2"}))},
+		},
+		"RemoteBackendRegionOffered": {
+			reason:  "We should accept a region offered by the remote backend the provider uses, not the in-process backend.",
+			backend: backend.BackendHTTP,
+			obj:     borkResource(withRegion(remoteRegion)),
+			want:    want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.backend != "" {
+				clients.UseBackend(tc.backend, srv.URL)
+				defer clients.UseBackend(backend.BackendMemory, "")
+			}
+			v := &validator{kube: kube, tenants: backend.NewTenants(local)}
+			w, err := v.ValidateCreate(context.Background(), tc.obj)
+			if diff := cmp.Diff(tc.want.warnings, w); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want warnings, +got warnings:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
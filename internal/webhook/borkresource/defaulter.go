@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// TierPollIntervals are the poll intervals suggested for BorkResources stored
+// at each tier of service. BorkResources at other tiers are polled at the
+// provider's default interval.
+var TierPollIntervals = map[string]string{
+	"premium": "30s",
+	"archive": "10m",
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-bork-crossplane-io-v1alpha1-borkresource,mutating=true,failurePolicy=fail,groups=bork.crossplane.io,resources=borkresources,versions=v1alpha1,name=borkresources.bork.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// A defaulter fills in optional BorkResource fields at admission time. Fields
+// the defaulter doesn't set are defaulted by the backend and late-initialized
+// by the BorkResource controller.
+type defaulter struct{}
+
+func (d *defaulter) Default(_ context.Context, obj runtime.Object) error {
+	cr, ok := obj.(*v1alpha1.BorkResource)
+	if !ok {
+		return errors.New(errNotBorkResource)
+	}
+
+	// A BorkResource that may only be observed never writes its parameters
+	// to the backend, so they're late-initialized from it instead.
+	if observeOnly(cr) {
+		return nil
+	}
+
+	p := &cr.Spec.ForProvider
+	if p.Tier == nil {
+		p.Tier = ptr.To(backend.DefaultTier)
+	}
+
+	// The poll interval annotation is ignored if pollIntervalSeconds is set.
+	if _, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyPollInterval]; !ok && p.PollIntervalSeconds == nil {
+		if pi, ok := TierPollIntervals[*p.Tier]; ok {
+			meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyPollInterval: pi})
+		}
+	}
+
+	if !deletes(cr) {
+		if p.Tags == nil {
+			p.Tags = make(map[string]string, 1)
+		}
+		if _, ok := p.Tags[v1alpha1.TagKeyRetained]; !ok {
+			p.Tags[v1alpha1.TagKeyRetained] = "true"
+		}
+	}
+
+	return nil
+}
+
+// deletes returns true if the supplied BorkResource's management policies
+// allow its bork record to be deleted when it is.
+func deletes(cr *v1alpha1.BorkResource) bool {
+	mp := cr.GetManagementPolicies()
+	return slices.Contains(mp, xpv1.ManagementActionAll) || slices.Contains(mp, xpv1.ManagementActionDelete)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	v1alpha1 "github.com/crossplane/provider-bork/apis/bork/v1alpha1"
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+func withTags(tags map[string]string) borkResourceModifier {
+	return func(cr *v1alpha1.BorkResource) { cr.Spec.ForProvider.Tags = tags }
+}
+
+func withPollInterval(pi string) borkResourceModifier {
+	return func(cr *v1alpha1.BorkResource) {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyPollInterval: pi})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	// A BorkResource that may be updated but not deleted retains its record.
+	retains := withManagementPolicies(xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate)
+
+	type want struct {
+		obj runtime.Object
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		obj    runtime.Object
+		want   want
+	}{
+		"NotBorkResource": {
+			reason: "We should return an error if the object isn't a BorkResource.",
+			obj:    &v1alpha1.BorkFleet{},
+			want: want{
+				obj: &v1alpha1.BorkFleet{},
+				err: errors.New(errNotBorkResource),
+			},
+		},
+		"ObserveOnly": {
+			reason: "We shouldn't default a BorkResource that's only observed, whose parameters are late-initialized.",
+			obj:    borkResource(withManagementPolicies(xpv1.ManagementActionObserve)),
+			want:   want{obj: borkResource(withManagementPolicies(xpv1.ManagementActionObserve))},
+		},
+		"DefaultTier": {
+			reason: "We should default the tier.",
+			obj:    borkResource(),
+			want:   want{obj: borkResource(withTier(backend.DefaultTier))},
+		},
+		"TierPollInterval": {
+			reason: "We should suggest the poll interval of the BorkResource's tier.",
+			obj:    borkResource(withTier("premium")),
+			want:   want{obj: borkResource(withTier("premium"), withPollInterval("30s"))},
+		},
+		"PollIntervalAnnotated": {
+			reason: "We shouldn't replace a poll interval that's already annotated.",
+			obj:    borkResource(withTier("premium"), withPollInterval("1m")),
+			want:   want{obj: borkResource(withTier("premium"), withPollInterval("1m"))},
+		},
+		"PollIntervalSeconds": {
+			reason: "We shouldn't suggest a poll interval when pollIntervalSeconds, which takes precedence, is set.",
+			obj: borkResource(withTier("archive"), func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.PollIntervalSeconds = ptr.To[int64](60)
+			}),
+			want: want{obj: borkResource(withTier("archive"), func(cr *v1alpha1.BorkResource) {
+				cr.Spec.ForProvider.PollIntervalSeconds = ptr.To[int64](60)
+			})},
+		},
+		"Retained": {
+			reason: "We should tag the bork record as retained when the BorkResource's management policies don't delete it.",
+			obj:    borkResource(retains, withTags(map[string]string{"team": "bork"})),
+			want:   want{obj: borkResource(retains, withTier(backend.DefaultTier), withTags(map[string]string{v1alpha1.TagKeyRetained: "true", "team": "bork"}))},
+		},
+		"RetainedTagSet": {
+			reason: "We shouldn't replace a retained tag that's already set.",
+			obj:    borkResource(retains, withTags(map[string]string{v1alpha1.TagKeyRetained: "false"})),
+			want:   want{obj: borkResource(retains, withTier(backend.DefaultTier), withTags(map[string]string{v1alpha1.TagKeyRetained: "false"}))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&defaulter{}).Default(context.Background(), tc.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDefault(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, tc.obj); diff != "" {
+				t.Errorf("\n%s\nDefault(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkclient
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+const (
+	errNoEndpoint       = "an endpoint is required"
+	errUnknownTransport = "unknown transport %q; transports are %s and %s"
+	errNewClient        = "cannot create bork client"
+)
+
+// A Client of the bork backend. It satisfies Interface.
+type Client = backend.Client
+
+// A Store is an in-process bork backend: the simulated system the provider
+// uses unless it's configured to use a bork API server. It's safe for
+// concurrent use.
+type Store = backend.Store
+
+// NewStore returns a new, empty in-process backend.
+func NewStore() *Store {
+	return backend.NewStore()
+}
+
+// Records manages bork records.
+type Records interface {
+	// Head returns the current revision of the named record.
+	Head(ctx context.Context, name string) (int64, error)
+
+	// Get returns the named record.
+	Get(ctx context.Context, name string) (Record, error)
+
+	// GetByUID returns the record with the supplied UID, whatever its name.
+	GetByUID(ctx context.Context, uid string) (Record, error)
+
+	// Create creates the supplied record, returning it as it was created.
+	Create(ctx context.Context, r Record) (Record, error)
+
+	// Commit creates every record of the supplied transaction, or none of
+	// them.
+	Commit(ctx context.Context, tx Transaction) ([]Record, error)
+
+	// Update overwrites the supplied record, returning it as it was
+	// written.
+	Update(ctx context.Context, r Record) (Record, error)
+
+	// Patch applies the supplied patch to its record.
+	Patch(ctx context.Context, p RecordPatch) (Record, error)
+
+	// Delete deletes the named record.
+	Delete(ctx context.Context, name string) error
+
+	// List returns a page of records.
+	List(ctx context.Context, req ListRequest) (ListPage, error)
+
+	// Activate activates the named pending record.
+	Activate(ctx context.Context, name string) (Record, error)
+}
+
+// Placements manages placement policies.
+type Placements interface {
+	GetPlacement(ctx context.Context, name string) (Placement, error)
+	CreatePlacement(ctx context.Context, p Placement) (Placement, error)
+	UpdatePlacement(ctx context.Context, p Placement) (Placement, error)
+	DeletePlacement(ctx context.Context, name string) error
+}
+
+// Buckets manages buckets.
+type Buckets interface {
+	GetBucket(ctx context.Context, name string) (Bucket, error)
+	CreateBucket(ctx context.Context, b Bucket) (Bucket, error)
+	UpdateBucket(ctx context.Context, b Bucket) (Bucket, error)
+	DeleteBucket(ctx context.Context, name string) error
+}
+
+// Plans manages throttle plans.
+type Plans interface {
+	GetPlan(ctx context.Context, name string) (Plan, error)
+	CreatePlan(ctx context.Context, p Plan) (Plan, error)
+	UpdatePlan(ctx context.Context, p Plan) (Plan, error)
+	DeletePlan(ctx context.Context, name string) error
+
+	// GetPlanUsage returns how much of the named plan is used.
+	GetPlanUsage(ctx context.Context, name string) (PlanUsage, error)
+}
+
+// Keys manages API keys.
+type Keys interface {
+	GetKey(ctx context.Context, name string) (Key, error)
+	CreateKey(ctx context.Context, k Key) (Key, error)
+	UpdateKey(ctx context.Context, k Key) (Key, error)
+	DeleteKey(ctx context.Context, name string) error
+}
+
+// Objects manages the objects stored in buckets.
+type Objects interface {
+	GetObject(ctx context.Context, name string) (Object, error)
+	CreateObject(ctx context.Context, o Object) (Object, error)
+	UpdateObject(ctx context.Context, o Object) (Object, error)
+	DeleteObject(ctx context.Context, name string) error
+}
+
+// Exports manages cost exports.
+type Exports interface {
+	GetExport(ctx context.Context, name string) (Export, error)
+	CreateExport(ctx context.Context, e Export) (Export, error)
+	UpdateExport(ctx context.Context, e Export) (Export, error)
+	DeleteExport(ctx context.Context, name string) error
+}
+
+// ServiceEndpoints manages service endpoints.
+type ServiceEndpoints interface {
+	GetServiceEndpoint(ctx context.Context, name string) (ServiceEndpoint, error)
+	CreateServiceEndpoint(ctx context.Context, e ServiceEndpoint) (ServiceEndpoint, error)
+	UpdateServiceEndpoint(ctx context.Context, e ServiceEndpoint) (ServiceEndpoint, error)
+	DeleteServiceEndpoint(ctx context.Context, name string) error
+}
+
+// Queues manages queues.
+type Queues interface {
+	GetQueue(ctx context.Context, name string) (Queue, error)
+	CreateQueue(ctx context.Context, q Queue) (Queue, error)
+	UpdateQueue(ctx context.Context, q Queue) (Queue, error)
+	DeleteQueue(ctx context.Context, name string) error
+}
+
+// Databases manages databases.
+type Databases interface {
+	GetDatabase(ctx context.Context, name string) (Database, error)
+	CreateDatabase(ctx context.Context, d Database) (Database, error)
+	UpdateDatabase(ctx context.Context, d Database) (Database, error)
+	DeleteDatabase(ctx context.Context, name string) error
+}
+
+// Certificates manages certificates. Certificates can't be updated.
+type Certificates interface {
+	GetCertificate(ctx context.Context, name string) (Certificate, error)
+	CreateCertificate(ctx context.Context, c Certificate) (Certificate, error)
+	DeleteCertificate(ctx context.Context, name string) error
+}
+
+// Topics manages topics.
+type Topics interface {
+	GetTopic(ctx context.Context, name string) (Topic, error)
+	CreateTopic(ctx context.Context, t Topic) (Topic, error)
+	UpdateTopic(ctx context.Context, t Topic) (Topic, error)
+	DeleteTopic(ctx context.Context, name string) error
+}
+
+// AccessPolicies manages access policies.
+type AccessPolicies interface {
+	GetAccessPolicy(ctx context.Context, name string) (AccessPolicy, error)
+	CreateAccessPolicy(ctx context.Context, p AccessPolicy) (AccessPolicy, error)
+	UpdateAccessPolicy(ctx context.Context, p AccessPolicy) (AccessPolicy, error)
+	DeleteAccessPolicy(ctx context.Context, name string) error
+}
+
+// Schedules manages schedules.
+type Schedules interface {
+	GetSchedule(ctx context.Context, name string) (Schedule, error)
+	CreateSchedule(ctx context.Context, s Schedule) (Schedule, error)
+	UpdateSchedule(ctx context.Context, s Schedule) (Schedule, error)
+	DeleteSchedule(ctx context.Context, name string) error
+}
+
+// Users manages users.
+type Users interface {
+	GetUser(ctx context.Context, name string) (User, error)
+	CreateUser(ctx context.Context, u User) (User, error)
+	UpdateUser(ctx context.Context, u User) (User, error)
+	DeleteUser(ctx context.Context, name string) error
+}
+
+// Links manages links between records.
+type Links interface {
+	GetLink(ctx context.Context, name string) (Link, error)
+	CreateLink(ctx context.Context, l Link) (Link, error)
+	UpdateLink(ctx context.Context, l Link) (Link, error)
+	DeleteLink(ctx context.Context, name string) error
+}
+
+// Account describes the account the client is authenticated as, and the
+// backend it's connected to.
+type Account interface {
+	// WhoAmI returns the identity the client is authenticated as.
+	WhoAmI(ctx context.Context) (Identity, error)
+
+	// IssueToken issues a token that expires after the supplied duration.
+	IssueToken(ctx context.Context, ttl time.Duration) (Token, error)
+
+	// ListRegions returns the backend's regions.
+	ListRegions(ctx context.Context) ([]Region, error)
+
+	// APIVersion returns the version of the bork API the backend serves.
+	APIVersion(ctx context.Context) (string, error)
+}
+
+// Notifications manages subscriptions to notifications of changes made to
+// the backend, which are delivered to webhooks.
+type Notifications interface {
+	SubscribeNotifications(ctx context.Context, req NotificationRequest) (NotificationSubscription, error)
+	UnsubscribeNotifications(ctx context.Context, url string) error
+}
+
+// A Watcher reports the changes made to the backend.
+type Watcher interface {
+	// Watch returns a channel of the changes made to the backend, which is
+	// closed when the supplied context is done.
+	Watch(ctx context.Context) <-chan Event
+}
+
+// Interface is everything a client of the bork backend can do.
+type Interface interface {
+	Records
+	Placements
+	Buckets
+	Plans
+	Keys
+	Objects
+	Exports
+	ServiceEndpoints
+	Queues
+	Databases
+	Certificates
+	Topics
+	AccessPolicies
+	Schedules
+	Users
+	Links
+	Account
+	Notifications
+	Watcher
+
+	// ContentType returns the content type the client encodes payloads
+	// with.
+	ContentType() string
+
+	// Close releases any resources held by the client, such as idle
+	// network connections.
+	Close() error
+}
+
+var _ Interface = &Client{}
+
+// A Transport reaches a bork API server.
+type Transport string
+
+// Transports.
+const (
+	TransportHTTP Transport = "HTTP"
+	TransportGRPC Transport = "GRPC"
+)
+
+// Options configure a client.
+type Options struct {
+	// Endpoint of a bork API server, e.g. https://bork.example.org. It's
+	// required by New, and ignored by Connect.
+	Endpoint string
+
+	// Transport used to reach the endpoint. Defaults to HTTP.
+	Transport Transport
+
+	// TLS configures connections to the endpoint. Defaults to requiring TLS
+	// 1.2 or later.
+	TLS *tls.Config
+
+	// Token presented to the backend, if not empty.
+	Token string
+
+	// ContentTypes the client prefers to encode payloads with, in order.
+	// Defaults to the backend's preference.
+	ContentTypes []string
+}
+
+// New returns a client of the bork API server at the supplied options'
+// endpoint.
+func New(ctx context.Context, o Options) (*Client, error) {
+	if o.Endpoint == "" {
+		return nil, errors.New(errNoEndpoint)
+	}
+	name := backend.BackendHTTP
+	switch o.Transport {
+	case "", TransportHTTP:
+	case TransportGRPC:
+		name = backend.BackendGRPC
+	default:
+		return nil, errors.Errorf(errUnknownTransport, o.Transport, TransportHTTP, TransportGRPC)
+	}
+	if o.TLS == nil {
+		o.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	c, err := backend.Open(ctx, name, backend.DialOptions{
+		Endpoint:     o.Endpoint,
+		TLS:          o.TLS,
+		Token:        o.Token,
+		ContentTypes: o.ContentTypes,
+	})
+	return c, errors.Wrap(err, errNewClient)
+}
+
+// Connect returns a client of the supplied in-process backend. Payloads are
+// encoded just as they would be for a bork API server, so that the client
+// behaves like a remote one.
+func Connect(s *Store, o Options) (*Client, error) {
+	c, err := s.ConnectWithToken(o.Token, o.ContentTypes...)
+	return c, errors.Wrap(err, errNewClient)
+}
+
+// An Interceptor is called before a client performs each operation, with the
+// operation's name, which is that of the client method that performs it, e.g.
+// Get or CreateBucket. If it returns an error the operation fails with it,
+// without being sent to the backend.
+type Interceptor = backend.Interceptor
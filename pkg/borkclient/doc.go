@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package borkclient is a Go client of the bork backend, the simulated system
+// that provider-bork manages. External test suites and other controllers can
+// use it to drive the same backend the provider uses, either in process or
+// through a bork API server such as cmd/bork-server.
+//
+// The package's API is versioned separately from the provider. Version names
+// the stability of the API: while it's v1alpha1 exported identifiers may
+// change between minor releases of the provider, and each change is noted in
+// its release notes. The bork API the client speaks is versioned too, by
+// APIVersion. A client of a backend that serves a different version works the
+// same way; the client's APIVersion method returns the version its backend
+// serves.
+//
+// Clients satisfy Interface, and the narrower interfaces it embeds, such as
+// Records and Buckets. Code that uses the client should accept the narrowest
+// interface it needs, so that it can be tested with a fake from
+// pkg/borkclient/fake.
+package borkclient
+
+import (
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// Version is the version of this package's API.
+const Version = "v1alpha1"
+
+// APIVersion is the version of the bork API that clients expect their
+// backend to serve.
+const APIVersion = backend.ClientAPIVersion
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkclient
+
+import (
+	"time"
+
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// An ErrorCode classifies an error returned by the backend. Codes survive
+// being sent over HTTP or gRPC, so an error is classified the same way
+// whichever transport the client uses.
+type ErrorCode = backend.ErrorCode
+
+// Error codes.
+const (
+	ErrorCodeNotFound            = backend.ErrorCodeNotFound
+	ErrorCodeAlreadyExists       = backend.ErrorCodeAlreadyExists
+	ErrorCodeConflict            = backend.ErrorCodeConflict
+	ErrorCodeDependencyViolation = backend.ErrorCodeDependencyViolation
+	ErrorCodeBadRequest          = backend.ErrorCodeBadRequest
+	ErrorCodeUnauthorized        = backend.ErrorCodeUnauthorized
+	ErrorCodeThrottled           = backend.ErrorCodeThrottled
+	ErrorCodeCredentialsExpired  = backend.ErrorCodeCredentialsExpired
+	ErrorCodeInternal            = backend.ErrorCodeInternal
+	ErrorCodeTimeout             = backend.ErrorCodeTimeout
+	ErrorCodeUnavailable         = backend.ErrorCodeUnavailable
+	ErrorCodeUnknown             = backend.ErrorCodeUnknown
+)
+
+// Code returns the code the supplied error is classified as.
+func Code(err error) ErrorCode { return backend.Code(err) }
+
+// NewError returns an error with the supplied message that is classified as
+// the supplied code, as if the backend returned it. Fakes use it to simulate
+// backend errors.
+func NewError(code ErrorCode, msg string) error { return backend.NewError(code, msg) }
+
+// IsNotFound returns true if the supplied error indicates a resource doesn't
+// exist.
+func IsNotFound(err error) bool { return backend.IsNotFound(err) }
+
+// IsAlreadyExists returns true if the supplied error indicates a resource
+// already exists.
+func IsAlreadyExists(err error) bool { return backend.IsAlreadyExists(err) }
+
+// IsConflict returns true if the supplied error indicates a request conflicts
+// with the current state of what it operates on. It may succeed if retried.
+func IsConflict(err error) bool { return backend.IsConflict(err) }
+
+// IsDependencyViolation returns true if the supplied error indicates a
+// resource can't be deleted because other resources depend on it.
+func IsDependencyViolation(err error) bool { return backend.IsDependencyViolation(err) }
+
+// IsUnauthorized returns true if the supplied error indicates the backend
+// rejected the client's credentials.
+func IsUnauthorized(err error) bool { return backend.IsUnauthorized(err) }
+
+// IsCredentialsExpired returns true if the supplied error indicates the
+// client's credentials expired.
+func IsCredentialsExpired(err error) bool { return backend.IsCredentialsExpired(err) }
+
+// IsThrottled returns true if the supplied error indicates the backend
+// throttled a request.
+func IsThrottled(err error) bool { return backend.IsThrottled(err) }
+
+// RetryAfter returns how long the backend asked the client to wait before
+// retrying the throttled request that returned the supplied error, or zero.
+func RetryAfter(err error) time.Duration { return backend.RetryAfter(err) }
+
+// IsTimeout returns true if the supplied error indicates a request timed out.
+func IsTimeout(err error) bool { return backend.IsTimeout(err) }
+
+// IsUnavailable returns true if the supplied error indicates the backend is
+// unavailable.
+func IsUnavailable(err error) bool { return backend.IsUnavailable(err) }
+
+// IsInternal returns true if the supplied error indicates the backend failed
+// to serve a request through no fault of the request.
+func IsInternal(err error) bool { return backend.IsInternal(err) }
+
+// IsMalformed returns true if the supplied error indicates the client
+// couldn't decode the backend's response.
+func IsMalformed(err error) bool { return backend.IsMalformed(err) }
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a fake bork client, for testing code that uses
+// pkg/borkclient without a bork API server.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/crossplane/provider-bork/pkg/borkclient"
+)
+
+const errFakeFmt = "fake %s error"
+
+// A Client is a fake bork client. It serves every operation from an
+// in-process backend, the same simulated system the provider uses, so that it
+// behaves like a real client. Operations can be made to fail, and the
+// operations it performs are recorded.
+type Client struct {
+	*borkclient.Client
+
+	// Store is the in-process backend the client is connected to. Use it to
+	// seed the backend, or to simulate its misbehaviour, e.g. with SetHang
+	// or SetThrottle.
+	Store *borkclient.Store
+
+	mu    sync.Mutex
+	errs  map[string]error
+	calls []string
+}
+
+var _ borkclient.Interface = &Client{}
+
+// New returns a fake client of a new, empty in-process backend.
+func New() *Client {
+	return NewFor(borkclient.NewStore())
+}
+
+// NewFor returns a fake client of the supplied in-process backend. Fake
+// clients of the same backend see each other's writes.
+func NewFor(s *borkclient.Store) *Client {
+	c := &Client{Store: s, errs: make(map[string]error)}
+	svc, err := borkclient.Connect(s, borkclient.Options{})
+	if err != nil {
+		// Connecting without a token or preferred content types can't fail.
+		panic(err)
+	}
+	c.Client = svc.Intercept(c.intercept)
+	return c
+}
+
+// Fail makes the named operation, e.g. Get or CreateBucket, fail with the
+// supplied error until Fail is called again for it. An error of nil makes
+// the operation succeed again.
+func (c *Client) Fail(op string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		delete(c.errs, op)
+		return
+	}
+	c.errs[op] = err
+}
+
+// FailWith makes the named operation fail with an error classified as the
+// supplied code, as if the backend returned it.
+func (c *Client) FailWith(op string, code borkclient.ErrorCode) {
+	c.Fail(op, borkclient.NewError(code, fmt.Sprintf(errFakeFmt, code)))
+}
+
+// Calls returns the names of the operations the client has performed, or
+// attempted, in order.
+func (c *Client) Calls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.calls)
+}
+
+// Reset forgets the operations the client has performed, and makes every
+// operation succeed again. The backend is unchanged.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = nil
+	c.errs = make(map[string]error)
+}
+
+func (c *Client) intercept(_ context.Context, op string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, op)
+	return c.errs[op]
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package borkclient
+
+import (
+	"github.com/crossplane/provider-bork/internal/backend"
+)
+
+// Resources of the bork backend, as they are sent to and returned by it.
+type (
+	Record                   = backend.Record
+	RecordRevision           = backend.RecordRevision
+	RecordPatch              = backend.RecordPatch
+	PatchOperation           = backend.PatchOperation
+	Transaction              = backend.Transaction
+	ListRequest              = backend.ListRequest
+	ListPage                 = backend.ListPage
+	Placement                = backend.Placement
+	Bucket                   = backend.Bucket
+	LifecycleRule            = backend.LifecycleRule
+	Plan                     = backend.Plan
+	PlanUsage                = backend.PlanUsage
+	Key                      = backend.Key
+	Object                   = backend.Object
+	Export                   = backend.Export
+	ServiceEndpoint          = backend.ServiceEndpoint
+	Queue                    = backend.Queue
+	Database                 = backend.Database
+	Certificate              = backend.Certificate
+	Topic                    = backend.Topic
+	AccessPolicy             = backend.AccessPolicy
+	Schedule                 = backend.Schedule
+	User                     = backend.User
+	Link                     = backend.Link
+	Region                   = backend.Region
+	Token                    = backend.Token
+	Identity                 = backend.Identity
+	NotificationRequest      = backend.NotificationRequest
+	NotificationSubscription = backend.NotificationSubscription
+	Event                    = backend.Event
+)
+
+// Enumerations of the values of fields of resources.
+type (
+	RecordState        = backend.RecordState
+	UpdateStrategy     = backend.UpdateStrategy
+	EndpointState      = backend.EndpointState
+	LinkDeletionPolicy = backend.LinkDeletionPolicy
+	EventType          = backend.EventType
+)
+
+// States of a record.
+const (
+	RecordPending    = backend.RecordPending
+	RecordActivating = backend.RecordActivating
+	RecordActive     = backend.RecordActive
+	RecordDeleting   = backend.RecordDeleting
+)
+
+// Strategies a record can be updated with.
+const (
+	UpdateStrategyReplace   = backend.UpdateStrategyReplace
+	UpdateStrategyMerge     = backend.UpdateStrategyMerge
+	UpdateStrategyJSONPatch = backend.UpdateStrategyJSONPatch
+)
+
+// States of a service endpoint.
+const (
+	EndpointPendingAcceptance = backend.EndpointPendingAcceptance
+	EndpointAccepted          = backend.EndpointAccepted
+	EndpointAvailable         = backend.EndpointAvailable
+)
+
+// What deleting an endpoint of a link does to the link's other endpoint.
+const (
+	LinkCascade  = backend.LinkCascade
+	LinkOrphan   = backend.LinkOrphan
+	LinkRestrict = backend.LinkRestrict
+)
+
+// Types of change reported by Watch.
+const (
+	EventCreated = backend.EventCreated
+	EventUpdated = backend.EventUpdated
+	EventDeleted = backend.EventDeleted
+)
+
+// Kinds of resource, as reported by the events of Watch.
+const (
+	KindRecord          = backend.KindRecord
+	KindPlacement       = backend.KindPlacement
+	KindBucket          = backend.KindBucket
+	KindPlan            = backend.KindPlan
+	KindKey             = backend.KindKey
+	KindObject          = backend.KindObject
+	KindRegion          = backend.KindRegion
+	KindExport          = backend.KindExport
+	KindServiceEndpoint = backend.KindServiceEndpoint
+	KindQueue           = backend.KindQueue
+	KindDatabase        = backend.KindDatabase
+	KindCertificate     = backend.KindCertificate
+	KindTopic           = backend.KindTopic
+	KindAccessPolicy    = backend.KindAccessPolicy
+	KindSchedule        = backend.KindSchedule
+	KindUser            = backend.KindUser
+	KindLink            = backend.KindLink
+)
+
+// Content types a client can encode payloads with.
+const (
+	ContentTypeJSON        = backend.ContentTypeJSON
+	ContentTypeMessagePack = backend.ContentTypeMessagePack
+)